@@ -30,6 +30,32 @@ type NodePoolData struct {
 	ResourcePoolId string `json:"resourcePoolId"`
 }
 
+// PlacementConstraint describes how the allocated nodes are to be spread across fault domains
+type PlacementConstraint struct {
+	// TopologyKey is the key of the Node label identifying the fault domain of a node, such as a rack or zone.
+	// +kubebuilder:validation:MinLength=1
+	TopologyKey string `json:"topologyKey"`
+	// MinDomains is the minimum number of distinct fault domains the allocated nodes must span.
+	// +kubebuilder:validation:Minimum=1
+	MinDomains int `json:"minDomains"`
+	// NodeGroupName restricts the constraint to the nodes of the given node group. When empty,
+	// the constraint applies to all the nodes in the pool.
+	NodeGroupName string `json:"nodeGroupName,omitempty"`
+}
+
+// HwProfileCapacity describes the compute capacity of a node with a given hardware profile
+type HwProfileCapacity struct {
+	// HwProfile is the name of the hardware profile.
+	// +kubebuilder:validation:MinLength=1
+	HwProfile string `json:"hwProfile"`
+	// VCPUs is the number of virtual CPUs of a node with this profile.
+	// +kubebuilder:validation:Minimum=0
+	VCPUs int `json:"vcpus"`
+	// MemoryGiB is the amount of memory, in GiB, of a node with this profile.
+	// +kubebuilder:validation:Minimum=0
+	MemoryGiB int `json:"memoryGiB"`
+}
+
 // HardwareTemplateSpec defines the desired state of HardwareTemplate
 type HardwareTemplateSpec struct {
 
@@ -44,6 +70,7 @@ type HardwareTemplateSpec struct {
 	BootInterfaceLabel string `json:"bootInterfaceLabel"`
 
 	// HardwareProvisioningTimeout defines the timeout duration string for the hardware provisioning.
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
 	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Hardware Provisioning Timeout",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
 	HardwareProvisioningTimeout string `json:"hardwareProvisioningTimeout,omitempty"`
 
@@ -52,6 +79,14 @@ type HardwareTemplateSpec struct {
 	//+operator-sdk:csv:customresourcedefinitions:type=spec
 	NodePoolData []NodePoolData `json:"nodePoolData"`
 
+	// PlacementConstraints defines how the allocated nodes are to be spread across fault domains
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	PlacementConstraints []PlacementConstraint `json:"placementConstraints,omitempty"`
+
+	// HwProfileCapacities defines the compute capacity of each hardware profile, used for resource accounting
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	HwProfileCapacities []HwProfileCapacity `json:"hwProfileCapacities,omitempty"`
+
 	// Extensions holds additional custom key-value pairs that can be used to extend the node pool's configuration.
 	//+operator-sdk:csv:customresourcedefinitions:type=spec
 	Extensions map[string]string `json:"extensions,omitempty"`
@@ -49,6 +49,10 @@ type NodePoolSpec struct {
 	//+operator-sdk:csv:customresourcedefinitions:type=spec
 	NodeGroup []NodeGroup `json:"nodeGroup"`
 
+	// PlacementConstraints defines how the allocated nodes are to be spread across fault domains
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	PlacementConstraints []PlacementConstraint `json:"placementConstraints,omitempty"`
+
 	//+operator-sdk:csv:customresourcedefinitions:type=spec
 	Extensions map[string]string `json:"extensions,omitempty"`
 }
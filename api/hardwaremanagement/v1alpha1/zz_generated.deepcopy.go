@@ -122,6 +122,16 @@ func (in *HardwareTemplateSpec) DeepCopyInto(out *HardwareTemplateSpec) {
 		*out = make([]NodePoolData, len(*in))
 		copy(*out, *in)
 	}
+	if in.PlacementConstraints != nil {
+		in, out := &in.PlacementConstraints, &out.PlacementConstraints
+		*out = make([]PlacementConstraint, len(*in))
+		copy(*out, *in)
+	}
+	if in.HwProfileCapacities != nil {
+		in, out := &in.HwProfileCapacities, &out.HwProfileCapacities
+		*out = make([]HwProfileCapacity, len(*in))
+		copy(*out, *in)
+	}
 	if in.Extensions != nil {
 		in, out := &in.Extensions, &out.Extensions
 		*out = make(map[string]string, len(*in))
@@ -163,6 +173,21 @@ func (in *HardwareTemplateStatus) DeepCopy() *HardwareTemplateStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HwProfileCapacity) DeepCopyInto(out *HwProfileCapacity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HwProfileCapacity.
+func (in *HwProfileCapacity) DeepCopy() *HwProfileCapacity {
+	if in == nil {
+		return nil
+	}
+	out := new(HwProfileCapacity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Interface) DeepCopyInto(out *Interface) {
 	*out = *in
@@ -351,6 +376,11 @@ func (in *NodePoolSpec) DeepCopyInto(out *NodePoolSpec) {
 		*out = make([]NodeGroup, len(*in))
 		copy(*out, *in)
 	}
+	if in.PlacementConstraints != nil {
+		in, out := &in.PlacementConstraints, &out.PlacementConstraints
+		*out = make([]PlacementConstraint, len(*in))
+		copy(*out, *in)
+	}
 	if in.Extensions != nil {
 		in, out := &in.Extensions, &out.Extensions
 		*out = make(map[string]string, len(*in))
@@ -454,6 +484,21 @@ func (in *NodeStatus) DeepCopy() *NodeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementConstraint) DeepCopyInto(out *PlacementConstraint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementConstraint.
+func (in *PlacementConstraint) DeepCopy() *PlacementConstraint {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementConstraint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Properties) DeepCopyInto(out *Properties) {
 	*out = *in
@@ -47,12 +47,46 @@ type ClusterTemplateSpec struct {
 	// Metadata defines a List of key/value pairs describing metadata associated with the template.
 	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Metadata",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
 	Metadata map[string]string `json:"metadata,omitempty"`
+	// HostnameTemplate defines an optional expression used to generate the node hostnames
+	// of the rendered ClusterInstance, e.g. "{{site}}-{{role}}-{{index}}". Supported variables
+	// are {{site}}, {{cluster}}, {{role}} and {{index}}, where index is the position of the node
+	// among the nodes sharing the same role, starting at 0. The generated hostnames must be unique
+	// valid DNS subdomains. Hostnames set explicitly in the ProvisioningRequest are kept.
+	// +kubebuilder:validation:Pattern=`^([a-z0-9.-]|\{\{(site|cluster|role|index)\}\})*$`
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Hostname Template",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	HostnameTemplate string `json:"hostnameTemplate,omitempty"`
 	// Release defines the openshift release version of the template
 	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Release",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
 	Release string `json:"release"`
 	// Templates defines the references to the templates required for ClusterTemplate.
 	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Templates",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
 	Templates Templates `json:"templates"`
+	// ResourceBarriers defines optional ordering barriers that hold back the creation of the cluster
+	// resources until their prerequisites exist.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Resource Barriers"
+	ResourceBarriers []ResourceBarrier `json:"resourceBarriers,omitempty"`
+	// PollIntervals defines optional intervals at which the progress of the ProvisioningRequests using the
+	// template is polled, overriding the default requeue intervals.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Poll Intervals"
+	PollIntervals *PollIntervals `json:"pollIntervals,omitempty"`
+	// FulfillmentChecks defines optional downstream health signals required before the ProvisioningRequests
+	// using the template are declared fulfilled.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Fulfillment Checks"
+	FulfillmentChecks *FulfillmentChecks `json:"fulfillmentChecks,omitempty"`
+	// ClusterProvisioningTimeout defines an optional timeout for the cluster installation of the ProvisioningRequests
+	// using the template, as a duration string such as "90m". It overrides the default timeout and the one from the
+	// ClusterInstance defaults ConfigMap.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Cluster Provisioning Timeout",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	ClusterProvisioningTimeout string `json:"clusterProvisioningTimeout,omitempty"`
+	// ClusterConfigurationTimeout defines an optional timeout for the cluster configuration of the
+	// ProvisioningRequests using the template, as a duration string such as "30m". It overrides the default timeout
+	// and the one from the policy template defaults ConfigMap.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Cluster Configuration Timeout",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	ClusterConfigurationTimeout string `json:"clusterConfigurationTimeout,omitempty"`
+	// ConfigurationChangeWindow defines an optional daily window outside of which the enforcement of the
+	// configuration policies of the ProvisioningRequests using the template is deferred.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Configuration Change Window"
+	ConfigurationChangeWindow *ChangeWindow `json:"configurationChangeWindow,omitempty"`
 	// TemplateParameterSchema defines the parameters required for ClusterTemplate.
 	// The parameter definitions should follow the OpenAPI V3 schema and
 	// explicitly define required fields.
@@ -66,6 +100,10 @@ type ClusterTemplateSpec struct {
 type Templates struct {
 	// HwTemplate defines a reference to a HardwareTemplate resource
 	HwTemplate string `json:"hwTemplate,omitempty"`
+	// SiteHwTemplates maps oCloudSiteId values to the HardwareTemplate resource to use for the
+	// ProvisioningRequests of that site, for templates serving sites with different hardware.
+	// Sites that are not listed use HwTemplate, which must be set along with this field.
+	SiteHwTemplates map[string]string `json:"siteHwTemplates,omitempty"`
 
 	// ClusterInstanceDefaults defines a reference to a configmap with
 	// default values for ClusterInstance
@@ -78,6 +116,71 @@ type Templates struct {
 	UpgradeDefaults string `json:"upgradeDefaults,omitempty"`
 }
 
+// PollIntervals defines the preferred intervals at which the progress of a ProvisioningRequest is polled
+// while waiting in each phase of the provisioning.
+type PollIntervals struct {
+	// HardwareProvisioning defines the poll interval while waiting for the hardware to be provisioned and configured.
+	HardwareProvisioning *metav1.Duration `json:"hardwareProvisioning,omitempty"`
+	// ClusterProvisioning defines the poll interval while waiting for the cluster installation to complete.
+	ClusterProvisioning *metav1.Duration `json:"clusterProvisioning,omitempty"`
+	// ClusterConfiguration defines the poll interval while waiting for the enforce policies to be compliant.
+	ClusterConfiguration *metav1.Duration `json:"clusterConfiguration,omitempty"`
+}
+
+// FulfillmentChecks defines the downstream health signals that must hold, in addition to the cluster installation
+// being completed and all the policies being compliant, before a ProvisioningRequest is declared fulfilled.
+type FulfillmentChecks struct {
+	// ManagedClusterAvailable requires the ManagedCluster to be reported as available by the hub.
+	ManagedClusterAvailable bool `json:"managedClusterAvailable,omitempty"`
+	// AddOnsAvailable requires all the add-on agents deployed by the hub on the cluster to be reported as available.
+	AddOnsAvailable bool `json:"addOnsAvailable,omitempty"`
+}
+
+// ChangeWindow defines a daily time window, in UTC, during which the configuration changes are allowed to be
+// enforced on a cluster.
+type ChangeWindow struct {
+	// Start defines the time of the day at which the window opens, in the HH:MM format.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Start string `json:"start"`
+	// End defines the time of the day at which the window closes, in the HH:MM format. The window spans
+	// midnight when it is earlier than the start.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	End string `json:"end"`
+}
+
+// ClusterResource identifies one of the resources created for the cluster deployment ahead of the ClusterInstance.
+// +kubebuilder:validation:Enum=bmcSecrets;pullSecret;extraManifests;policyTemplateConfigMap
+type ClusterResource string
+
+const (
+	ClusterResourceBMCSecrets              ClusterResource = "bmcSecrets"
+	ClusterResourcePullSecret              ClusterResource = "pullSecret"
+	ClusterResourceExtraManifests          ClusterResource = "extraManifests"
+	ClusterResourcePolicyTemplateConfigMap ClusterResource = "policyTemplateConfigMap"
+)
+
+// ResourceBarrier holds back the creation of a cluster resource until all of its prerequisites exist.
+type ResourceBarrier struct {
+	// Resource defines the cluster resource held back by the barrier.
+	Resource ClusterResource `json:"resource"`
+	// Prerequisites defines the objects that must exist before the resource is created.
+	// +kubebuilder:validation:MinItems=1
+	Prerequisites []ResourcePrerequisite `json:"prerequisites"`
+}
+
+// ResourcePrerequisite defines a reference to an object that must exist before a cluster resource is created.
+type ResourcePrerequisite struct {
+	// APIVersion defines the API version of the object, e.g. "v1".
+	APIVersion string `json:"apiVersion"`
+	// Kind defines the kind of the object.
+	Kind string `json:"kind"`
+	// Name defines the name of the object.
+	Name string `json:"name"`
+	// Namespace defines the namespace of the object. It defaults to the namespace of the cluster
+	// and is ignored for cluster-scoped objects.
+	Namespace string `json:"namespace,omitempty"`
+}
+
 // ClusterTemplateStatus defines the observed state of ClusterTemplate
 type ClusterTemplateStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
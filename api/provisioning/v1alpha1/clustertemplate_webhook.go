@@ -0,0 +1,131 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// ClusterTemplateForceDeleteAnnotation, when set to "true", lets a ClusterTemplate be deleted while it is still
+// used by ProvisioningRequests. It is meant for emergency cleanups.
+const ClusterTemplateForceDeleteAnnotation = "oran.org/force-delete"
+
+// maxListedProvisioningRequests is the number of ProvisioningRequests named in the denial of a ClusterTemplate
+// deletion
+const maxListedProvisioningRequests = 5
+
+var clustertemplatelog = logf.Log.WithName("clustertemplate-webhook")
+
+// SetupWebhookWithManager will setup the manager to manage the webhooks
+func (r *ClusterTemplate) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	if webhookClient == nil {
+		webhookClient = mgr.GetClient()
+	}
+
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// NOTE: The 'path' attribute must follow a specific pattern and should not be modified directly here.
+// Modifying the path for an invalid path can cause API server errors; failing to locate the webhook.
+//+kubebuilder:webhook:path=/validate-o2ims-provisioning-oran-org-v1alpha1-clustertemplate,mutating=false,failurePolicy=fail,sideEffects=None,groups=o2ims.provisioning.oran.org,resources=clustertemplates,verbs=delete,versions=v1alpha1,name=clustertemplates.o2ims.provisioning.oran.org,admissionReviewVersions=v1
+
+var _ webhook.Validator = &ClusterTemplate{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *ClusterTemplate) ValidateCreate() (admission.Warnings, error) {
+	// The ClusterTemplate content is validated by the controller
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *ClusterTemplate) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	// The ClusterTemplate content is validated by the controller
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type. The deletion is
+// rejected while ProvisioningRequests use the ClusterTemplate, unless the force-delete annotation is set.
+func (r *ClusterTemplate) ValidateDelete() (admission.Warnings, error) {
+	clustertemplatelog.Info("validate delete", "name", r.Name, "namespace", r.Namespace)
+
+	if r.GetAnnotations()[ClusterTemplateForceDeleteAnnotation] == "true" {
+		return admission.Warnings{fmt.Sprintf(
+			"ClusterTemplate %s/%s is deleted regardless of the ProvisioningRequests using it", r.Namespace, r.Name)}, nil
+	}
+
+	users, err := r.getProvisioningRequestUsers(context.TODO())
+	if err != nil {
+		clustertemplatelog.Error(err, "failed to list the ProvisioningRequests using the ClusterTemplate")
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, nil
+	}
+
+	names := users
+	if len(names) > maxListedProvisioningRequests {
+		names = append(names[:maxListedProvisioningRequests:maxListedProvisioningRequests],
+			fmt.Sprintf("and %d more", len(users)-maxListedProvisioningRequests))
+	}
+	return nil, apierrors.NewForbidden(GroupVersion.WithResource("clustertemplates").GroupResource(), r.Name,
+		fmt.Errorf("the ClusterTemplate is used by %d ProvisioningRequest(s): %s; set the %s annotation to \"true\" "+
+			"to delete it anyway", len(users), strings.Join(names, ", "), ClusterTemplateForceDeleteAnnotation))
+}
+
+// getProvisioningRequestUsers returns the names of the ProvisioningRequests using the ClusterTemplate. The
+// ProvisioningRequests only reference the name and the version of their ClusterTemplate, so one referencing a
+// ClusterTemplate with the same name in another namespace is not considered a user, unless it no longer resolves to
+// any ClusterTemplate.
+func (r *ClusterTemplate) getProvisioningRequestUsers(ctx context.Context) ([]string, error) {
+	provisioningRequests := &ProvisioningRequestList{}
+	if err := webhookClient.List(ctx, provisioningRequests); err != nil {
+		return nil, fmt.Errorf("failed to list ProvisioningRequests: %w", err)
+	}
+
+	var users []string
+	for _, pr := range provisioningRequests.Items {
+		if fmt.Sprintf("%s.%s", pr.Spec.TemplateName, pr.Spec.TemplateVersion) != r.Name {
+			continue
+		}
+
+		clusterTemplate, err := pr.GetClusterTemplateRef(ctx, webhookClient)
+		if err != nil {
+			var notFoundErr *ClusterTemplateNotFoundError
+			if !errors.As(err, &notFoundErr) {
+				return nil, err
+			}
+		} else if clusterTemplate.Namespace != r.Namespace {
+			continue
+		}
+		users = append(users, pr.Name)
+	}
+	slices.Sort(users)
+	return users, nil
+}
@@ -0,0 +1,106 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("ClusterTemplate webhook", func() {
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		ct         *ClusterTemplate
+		tName      = "clustertemplate-a"
+		tVersion   = "v1.0.0"
+	)
+
+	newClusterTemplate := func(namespace string) *ClusterTemplate {
+		return &ClusterTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      tName + "." + tVersion,
+				Namespace: namespace,
+			},
+			Spec: ClusterTemplateSpec{
+				Name:    tName,
+				Version: tVersion,
+			},
+			Status: ClusterTemplateStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:   string(CTconditionTypes.Validated),
+						Reason: string(CTconditionReasons.Completed),
+						Status: metav1.ConditionTrue,
+					},
+				},
+			},
+		}
+	}
+
+	newProvisioningRequest := func(name, version string) *ProvisioningRequest {
+		return &ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: ProvisioningRequestSpec{
+				TemplateName:    tName,
+				TemplateVersion: version,
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ct = newClusterTemplate("clustertemplate-a-v4-16")
+
+		fakeClient = fake.NewClientBuilder().WithScheme(s).Build()
+		Expect(fakeClient.Create(ctx, ct)).To(Succeed())
+
+		previousClient := webhookClient
+		webhookClient = fakeClient
+		DeferCleanup(func() { webhookClient = previousClient })
+	})
+
+	It("allows the deletion of an unused ClusterTemplate", func() {
+		Expect(fakeClient.Create(ctx, newProvisioningRequest("cluster-1", "v2.0.0"))).To(Succeed())
+
+		warnings, err := ct.ValidateDelete()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(warnings).To(BeEmpty())
+	})
+
+	It("rejects the deletion of a ClusterTemplate in use and lists the ProvisioningRequests", func() {
+		for i := range 7 {
+			Expect(fakeClient.Create(ctx, newProvisioningRequest(fmt.Sprintf("cluster-%d", i), tVersion))).To(Succeed())
+		}
+
+		_, err := ct.ValidateDelete()
+		Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring(
+			"used by 7 ProvisioningRequest(s): cluster-0, cluster-1, cluster-2, cluster-3, cluster-4, and 2 more"))
+		Expect(err.Error()).To(ContainSubstring(ClusterTemplateForceDeleteAnnotation))
+	})
+
+	It("allows the deletion of a ClusterTemplate in use with the force-delete annotation", func() {
+		Expect(fakeClient.Create(ctx, newProvisioningRequest("cluster-1", tVersion))).To(Succeed())
+		ct.SetAnnotations(map[string]string{ClusterTemplateForceDeleteAnnotation: "true"})
+
+		warnings, err := ct.ValidateDelete()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(warnings).To(HaveLen(1))
+	})
+
+	It("ignores the ProvisioningRequests using a ClusterTemplate of the same name in another namespace", func() {
+		ct.Status.Conditions = nil
+		Expect(fakeClient.Update(ctx, ct)).To(Succeed())
+		Expect(fakeClient.Create(ctx, newClusterTemplate("clustertemplate-a-v4-17"))).To(Succeed())
+		Expect(fakeClient.Create(ctx, newProvisioningRequest("cluster-1", tVersion))).To(Succeed())
+
+		_, err := ct.ValidateDelete()
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
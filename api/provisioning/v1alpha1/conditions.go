@@ -17,11 +17,14 @@ var PRconditionTypes = struct {
 	HardwareProvisioned       ConditionType
 	HardwareNodeConfigApplied ConditionType
 	HardwareConfigured        ConditionType
+	HardwarePluginUnavailable ConditionType
+	HardwareHealthy           ConditionType
 	ClusterInstanceRendered   ConditionType
 	ClusterResourcesCreated   ConditionType
 	ClusterInstanceProcessed  ConditionType
 	ClusterProvisioned        ConditionType
 	ConfigurationApplied      ConditionType
+	PolicyBindingMismatch     ConditionType
 	UpgradeCompleted          ConditionType
 }{
 	Validated:                 "ProvisioningRequestValidated",
@@ -29,11 +32,14 @@ var PRconditionTypes = struct {
 	HardwareProvisioned:       "HardwareProvisioned",
 	HardwareNodeConfigApplied: "HardwareNodeConfigApplied",
 	HardwareConfigured:        "HardwareConfigured",
+	HardwarePluginUnavailable: "HardwarePluginUnavailable",
+	HardwareHealthy:           "HardwareHealthy",
 	ClusterInstanceRendered:   "ClusterInstanceRendered",
 	ClusterResourcesCreated:   "ClusterResourcesCreated",
 	ClusterInstanceProcessed:  "ClusterInstanceProcessed",
 	ClusterProvisioned:        "ClusterProvisioned",
 	ConfigurationApplied:      "ConfigurationApplied",
+	PolicyBindingMismatch:     "PolicyBindingMismatch",
 	UpgradeCompleted:          "UpgradeCompleted",
 }
 
@@ -51,23 +57,47 @@ var CTconditionReasons = struct {
 
 // The following constants define the different reasons that conditions will be set for ProvisioningRequest
 var CRconditionReasons = struct {
-	NotApplied      ConditionReason
-	ClusterNotReady ConditionReason
-	Completed       ConditionReason
-	Failed          ConditionReason
-	InProgress      ConditionReason
-	Missing         ConditionReason
-	OutOfDate       ConditionReason
-	TimedOut        ConditionReason
-	Unknown         ConditionReason
+	NotApplied               ConditionReason
+	ClusterMissing           ConditionReason
+	ClusterNotReady          ConditionReason
+	Completed                ConditionReason
+	Degraded                 ConditionReason
+	DryRun                   ConditionReason
+	DuplicateNARReference    ConditionReason
+	Failed                   ConditionReason
+	InProgress               ConditionReason
+	LabelsMismatched         ConditionReason
+	Missing                  ConditionReason
+	NodePoolIdentityMismatch ConditionReason
+	OutOfDate                ConditionReason
+	PlacementConstraintUnmet ConditionReason
+	RollingBack              ConditionReason
+	TemplateVersionTooOld    ConditionReason
+	TimedOut                 ConditionReason
+	Unknown                  ConditionReason
+	Unreachable              ConditionReason
+	WaitingForChangeWindow   ConditionReason
+	WaitingForUpgradeSlot    ConditionReason
 }{
-	NotApplied:      "NotApplied",
-	ClusterNotReady: "ClusterNotReady",
-	Completed:       "Completed",
-	Failed:          "Failed",
-	InProgress:      "InProgress",
-	Missing:         "Missing",
-	OutOfDate:       "OutOfDate",
-	TimedOut:        "TimedOut",
-	Unknown:         "Unknown",
+	NotApplied:               "NotApplied",
+	ClusterMissing:           "ClusterMissing",
+	ClusterNotReady:          "ClusterNotReady",
+	Completed:                "Completed",
+	Degraded:                 "Degraded",
+	DryRun:                   "DryRun",
+	DuplicateNARReference:    "DuplicateNARReference",
+	Failed:                   "Failed",
+	InProgress:               "InProgress",
+	LabelsMismatched:         "LabelsMismatched",
+	Missing:                  "Missing",
+	NodePoolIdentityMismatch: "NodePoolIdentityMismatch",
+	OutOfDate:                "OutOfDate",
+	PlacementConstraintUnmet: "PlacementConstraintUnmet",
+	RollingBack:              "RollingBack",
+	TemplateVersionTooOld:    "TemplateVersionTooOld",
+	TimedOut:                 "TimedOut",
+	Unknown:                  "Unknown",
+	Unreachable:              "Unreachable",
+	WaitingForChangeWindow:   "WaitingForChangeWindow",
+	WaitingForUpgradeSlot:    "WaitingForUpgradeSlot",
 }
@@ -56,6 +56,28 @@ type ProvisioningRequestSpec struct {
 	// Extensions holds additional custom key-value pairs that can be used to extend the cluster's configuration.
 	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Extensions",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
 	Extensions runtime.RawExtension `json:"extensions,omitempty"`
+
+	// Timeouts defines optional timeouts for the phases of the provisioning. When set, they take precedence over
+	// the timeouts defined by the referenced ClusterTemplate and the defaults.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Timeouts"
+	Timeouts *ProvisioningRequestTimeouts `json:"timeouts,omitempty"`
+}
+
+// ProvisioningRequestTimeouts defines the time allowed for each phase of the provisioning before it is declared
+// as timed out.
+type ProvisioningRequestTimeouts struct {
+	// HardwareProvisioning defines the timeout for the hardware to be provisioned and configured.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	HardwareProvisioning *metav1.Duration `json:"hardwareProvisioning,omitempty"`
+	// ClusterProvisioning defines the timeout for the cluster installation to complete.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	ClusterProvisioning *metav1.Duration `json:"clusterProvisioning,omitempty"`
+	// ClusterConfiguration defines the timeout for the enforce policies to be compliant.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	ClusterConfiguration *metav1.Duration `json:"clusterConfiguration,omitempty"`
 }
 
 // NodePoolRef references a node pool.
@@ -82,6 +104,14 @@ type ClusterDetails struct {
 
 	// Holds the first timestamp when the configuration was found NonCompliant for the cluster.
 	NonCompliantAt *metav1.Time `json:"nonCompliantAt,omitempty"`
+
+	// The digest of the inputs, other than the policy template parameters, the cluster was installed from.
+	// Changes to the policy template parameters alone only reconcile the configuration.
+	InstallationInputsHash string `json:"installationInputsHash,omitempty"`
+
+	// The generation of the ProvisioningRequest the installation inputs have last been reconciled at.
+	// Only a change to the policy template parameters made since then skips the installation steps.
+	InstallationGeneration int64 `json:"installationGeneration,omitempty"`
 }
 
 type Extensions struct {
@@ -93,6 +123,19 @@ type Extensions struct {
 
 	// Holds policies that are matched with the ManagedCluster created by the ProvisioningRequest.
 	Policies []PolicyDetails `json:"policies,omitempty"`
+
+	// Summarizes the compliance of the policies matched with the ManagedCluster.
+	PolicyCompliance *PolicyComplianceSummary `json:"policyCompliance,omitempty"`
+}
+
+// PolicyComplianceSummary summarizes the compliance of the policies matched with the ManagedCluster.
+type PolicyComplianceSummary struct {
+	// The number of compliant policies.
+	CompliantPolicies int `json:"compliantPolicies"`
+	// The total number of policies.
+	TotalPolicies int `json:"totalPolicies"`
+	// The percentage of compliant policies, rounded down.
+	CompliancePercentage int `json:"compliancePercentage"`
 }
 
 // PolicyDetails holds information about an ACM policy.
@@ -128,6 +171,11 @@ const (
 	// has started, ensuring that all dependent resources are removed before finalizing the
 	// ProvisioningRequest deletion.
 	StateDeleting ProvisioningPhase = "deleting"
+
+	// StateCancelled indicates that the provisioning process has been cancelled through the cancel
+	// annotation. The in-progress hardware and cluster installation resources are torn down, and the
+	// ProvisioningRequest remains cancelled until it is deleted.
+	StateCancelled ProvisioningPhase = "cancelled"
 )
 
 // ProvisionedResources contains the resources that were provisioned as part of the provisioning process.
@@ -136,9 +184,24 @@ type ProvisionedResources struct {
 	OCloudNodeClusterId string `json:"oCloudNodeClusterId,omitempty"`
 }
 
+// ResourceAccounting summarizes the compute resources allocated to the provisioned cluster.
+type ResourceAccounting struct {
+	// The total number of allocated nodes.
+	NodeCount int `json:"nodeCount"`
+
+	// The number of allocated nodes for each hardware profile.
+	NodeCountByProfile map[string]int `json:"nodeCountByProfile,omitempty"`
+
+	// The total number of virtual CPUs of the allocated nodes.
+	TotalVCPUs int `json:"totalVCPUs"`
+
+	// The total amount of memory, in GiB, of the allocated nodes.
+	TotalMemoryGiB int `json:"totalMemoryGiB"`
+}
+
 type ProvisioningStatus struct {
 	// The current state of the provisioning process.
-	// +kubebuilder:validation:Enum=progressing;fulfilled;failed;deleting
+	// +kubebuilder:validation:Enum=progressing;fulfilled;failed;deleting;cancelled
 	ProvisioningPhase ProvisioningPhase `json:"provisioningPhase,omitempty"`
 
 	// The details about the current state of the provisioning process.
@@ -147,10 +210,39 @@ type ProvisioningStatus struct {
 	// The resources that have been successfully provisioned as part of the provisioning process.
 	ProvisionedResources *ProvisionedResources `json:"provisionedResources,omitempty"`
 
+	// The summary of the compute resources allocated to the provisioned cluster.
+	ResourceAccounting *ResourceAccounting `json:"resourceAccounting,omitempty"`
+
+	// The time at which the current phase of the provisioning times out, while the provisioning is progressing.
+	TimeoutDeadline *metav1.Time `json:"timeoutDeadline,omitempty"`
+
 	// The timestamp of the last update to the provisioning status.
 	UpdateTime metav1.Time `json:"updateTime,omitempty"`
 }
 
+// UpgradePhase defines the phase of the cluster upgrade.
+type UpgradePhase string
+
+// The following constants define the different phases of the cluster upgrade.
+const (
+	// UpgradeStateProgressing means the upgrade has been initiated and is still running.
+	UpgradeStateProgressing UpgradePhase = "progressing"
+	// UpgradeStateCompleted means the cluster has been upgraded to the target release.
+	UpgradeStateCompleted UpgradePhase = "completed"
+	// UpgradeStateFailed means the upgrade has failed.
+	UpgradeStateFailed UpgradePhase = "failed"
+)
+
+// UpgradeStatus summarizes the state of the cluster upgrade. It mirrors the UpgradeCompleted condition.
+type UpgradeStatus struct {
+	// The current state of the upgrade.
+	// +kubebuilder:validation:Enum=progressing;completed;failed
+	UpgradePhase UpgradePhase `json:"upgradePhase,omitempty"`
+
+	// The release the cluster is being upgraded to.
+	TargetRelease string `json:"targetRelease,omitempty"`
+}
+
 // ProvisioningRequestStatus defines the observed state of ProvisioningRequest
 type ProvisioningRequestStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
@@ -164,6 +256,34 @@ type ProvisioningRequestStatus struct {
 	Extensions Extensions `json:"extensions,omitempty"`
 
 	ProvisioningStatus ProvisioningStatus `json:"provisioningStatus,omitempty"`
+
+	// The state of the cluster upgrade, if one has been initiated.
+	UpgradeStatus *UpgradeStatus `json:"upgradeStatus,omitempty"`
+
+	// The outcome of the last reconciliation and the reason for it, e.g. "requeued after 1m0s, reason:
+	// Hardware provisioning is in progress". It is only meant to help debugging.
+	LastReconcileAction string `json:"lastReconcileAction,omitempty"`
+
+	// The ClusterInstance rendered for a dry-run ProvisioningRequest, in YAML format. It is only set while the
+	// dry-run annotation is present, in which case the ClusterInstance is never applied.
+	RenderedClusterInstance string `json:"renderedClusterInstance,omitempty"`
+
+	// The ConfigMap recording the manifests applied for the current generation.
+	// It is only set when the audit-rendered-manifests feature flag is enabled.
+	RenderedManifests *RenderedManifestsReference `json:"renderedManifests,omitempty"`
+}
+
+// RenderedManifestsReference identifies the ConfigMap recording the manifests applied for a generation of a
+// ProvisioningRequest.
+type RenderedManifestsReference struct {
+	// The namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// The name of the ConfigMap.
+	Name string `json:"name"`
+
+	// The generation of the ProvisioningRequest the manifests were rendered from.
+	Generation int64 `json:"generation"`
 }
 
 //+kubebuilder:object:root=true
@@ -172,6 +292,8 @@ type ProvisioningRequestStatus struct {
 //+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 //+kubebuilder:printcolumn:name="ProvisionPhase",type="string",JSONPath=".status.provisioningStatus.provisioningPhase"
 //+kubebuilder:printcolumn:name="ProvisionDetails",type="string",JSONPath=".status.provisioningStatus.provisioningDetails"
+//+kubebuilder:printcolumn:name="UpgradePhase",type="string",JSONPath=".status.upgradeStatus.upgradePhase"
+//+kubebuilder:printcolumn:name="UpgradeRelease",type="string",JSONPath=".status.upgradeStatus.targetRelease",priority=1
 
 // ProvisioningRequest is the Schema for the provisioningrequests API
 // +operator-sdk:csv:customresourcedefinitions:displayName="ORAN O2IMS Provisioning Request",resources={{Namespace, v1},{ClusterInstance, siteconfig.open-cluster-management.io/v1alpha1}}
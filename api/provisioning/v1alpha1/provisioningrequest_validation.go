@@ -4,12 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/r3labs/diff/v3"
 	"github.com/xeipuuv/gojsonschema"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -18,6 +21,29 @@ const (
 	TemplateParamPolicyConfig    = "policyTemplateParameters"
 )
 
+// FeatureFlagAnnotationPrefix is the prefix of the annotations enabling an experimental behavior of the controller
+// for a single ProvisioningRequest. The name of the flag follows the prefix and the flag is enabled when the value
+// of the annotation is "true", e.g. "features.provisioningrequest.o2ims.provisioning.oran.org/<flag>: "true"".
+const FeatureFlagAnnotationPrefix = "features.provisioningrequest.o2ims.provisioning.oran.org/"
+
+// FeatureFlag is the name of an experimental behavior that can be enabled per ProvisioningRequest
+type FeatureFlag string
+
+const (
+	// FeatureParallelPreprovisioning starts the hardware provisioning while the cluster resources are held back by
+	// the resource barriers of the ClusterTemplate, rather than after they have all been created.
+	FeatureParallelPreprovisioning FeatureFlag = "enable-parallel-preprovisioning"
+	// FeatureAuditRenderedManifests records the manifests applied for each generation of the ProvisioningRequest in
+	// a ConfigMap referenced from the status, so that the applied state can be audited.
+	FeatureAuditRenderedManifests FeatureFlag = "audit-rendered-manifests"
+)
+
+// KnownFeatureFlags lists the feature flags that can be set on a ProvisioningRequest
+var KnownFeatureFlags = []FeatureFlag{
+	FeatureParallelPreprovisioning,
+	FeatureAuditRenderedManifests,
+}
+
 var (
 	// allowedClusterInstanceFields contains path patterns for fields that are allowed to be updated.
 	// The wildcard "*" is used to match any index in a list.
@@ -107,7 +133,50 @@ func disallowUnknownFieldsInSchema(schema map[string]any) {
 	// Ignore other keywords that could have "properties"
 }
 
-// ValidateJsonAgainstJsonSchema validates the input against the schema.
+// JsonSchemaValidationError is returned when an input does not match its JSON schema. It keeps the individual
+// schema violations so that they can be reported along with the path of the offending fields.
+type JsonSchemaValidationError struct {
+	Errors []gojsonschema.ResultError
+}
+
+func (e *JsonSchemaValidationError) Error() string {
+	var errs []string
+	for _, description := range e.Errors {
+		errs = append(errs, description.String())
+	}
+	return fmt.Sprintf("invalid input: %s", strings.Join(errs, "; "))
+}
+
+// FieldErrors returns the schema violations as field errors, with the path of each offending field relative to
+// the given path of the input. A missing required property is reported with its own path.
+func (e *JsonSchemaValidationError) FieldErrors(root *field.Path) field.ErrorList {
+	// Use a separator that cannot be confused with the dots in the property names
+	const separator = "\x00"
+
+	var errs field.ErrorList
+	for _, resultError := range e.Errors {
+		path := root
+		// The first element of the context is the root of the input
+		for _, element := range strings.Split(resultError.Context().String(separator), separator)[1:] {
+			if index, err := strconv.Atoi(element); err == nil {
+				path = path.Index(index)
+			} else {
+				path = path.Child(element)
+			}
+		}
+
+		if resultError.Type() == "required" {
+			property, _ := resultError.Details()["property"].(string)
+			errs = append(errs, field.Required(path.Child(property), resultError.Description()))
+		} else {
+			errs = append(errs, field.Invalid(path, field.OmitValueType{}, resultError.Description()))
+		}
+	}
+	return errs
+}
+
+// ValidateJsonAgainstJsonSchema validates the input against the schema. A *JsonSchemaValidationError is returned
+// if the input does not match the schema.
 func ValidateJsonAgainstJsonSchema(schema, input any) error {
 	schemaLoader := gojsonschema.NewGoLoader(schema)
 	inputLoader := gojsonschema.NewGoLoader(input)
@@ -119,14 +188,8 @@ func ValidateJsonAgainstJsonSchema(schema, input any) error {
 
 	if result.Valid() {
 		return nil
-	} else {
-		var errs []string
-		for _, description := range result.Errors() {
-			errs = append(errs, description.String())
-		}
-
-		return fmt.Errorf("invalid input: %s", strings.Join(errs, "; "))
 	}
+	return &JsonSchemaValidationError{Errors: result.Errors()}
 }
 
 // validateTemplateInputMatchesSchema validates the input parameters from the ProvisioningRequest
@@ -228,6 +291,54 @@ func (r *ProvisioningRequest) ValidateClusterInstanceInputMatchesSchema(
 	return clusterInstanceMatchingInput, nil
 }
 
+// ValidateFeatureFlags checks that the feature flag annotations of the ProvisioningRequest, if any, name a known
+// flag and are set to "true" or "false".
+func (r *ProvisioningRequest) ValidateFeatureFlags() error {
+	for key, value := range r.GetAnnotations() {
+		name, found := strings.CutPrefix(key, FeatureFlagAnnotationPrefix)
+		if !found {
+			continue
+		}
+		if !slices.Contains(KnownFeatureFlags, FeatureFlag(name)) {
+			return fmt.Errorf("unknown feature flag %q in the annotation %s, the known feature flags are: %v",
+				name, key, KnownFeatureFlags)
+		}
+		if !strings.EqualFold(value, "true") && !strings.EqualFold(value, "false") {
+			return fmt.Errorf("the annotation %s must be set to \"true\" or \"false\", got %q", key, value)
+		}
+	}
+	return nil
+}
+
+// IsFeatureEnabled checks whether the given feature flag is enabled for the ProvisioningRequest. Feature flags are
+// disabled by default.
+func (r *ProvisioningRequest) IsFeatureEnabled(flag FeatureFlag) bool {
+	return strings.EqualFold(r.GetAnnotations()[FeatureFlagAnnotationPrefix+string(flag)], "true")
+}
+
+// ValidateTimeouts checks that the timeouts set in the spec of the ProvisioningRequest, if any, are positive.
+func (r *ProvisioningRequest) ValidateTimeouts() error {
+	timeouts := r.Spec.Timeouts
+	if timeouts == nil {
+		return nil
+	}
+
+	for _, timeout := range []struct {
+		name  string
+		value *metav1.Duration
+	}{
+		{"hardwareProvisioning", timeouts.HardwareProvisioning},
+		{"clusterProvisioning", timeouts.ClusterProvisioning},
+		{"clusterConfiguration", timeouts.ClusterConfiguration},
+	} {
+		if timeout.value != nil && timeout.value.Duration <= 0 {
+			return fmt.Errorf("spec.timeouts.%s must be a positive duration, got %s",
+				timeout.name, timeout.value.Duration)
+		}
+	}
+	return nil
+}
+
 func (r *ProvisioningRequest) GetClusterTemplateRef(ctx context.Context, client client.Client) (*ClusterTemplate, error) {
 	// Check the clusterTemplateRef references an existing template in the same namespace
 	// as the current provisioningRequest.
@@ -252,9 +363,17 @@ func (r *ProvisioningRequest) GetClusterTemplateRef(ctx context.Context, client
 	}
 
 	// If the referenced ClusterTemplate does not exist, log and return an appropriate error.
-	return nil, fmt.Errorf(
-		"a valid ClusterTemplate (%s) does not exist in any namespace",
-		clusterTemplateRefName)
+	return nil, &ClusterTemplateNotFoundError{Name: clusterTemplateRefName}
+}
+
+// ClusterTemplateNotFoundError is returned when the ClusterTemplate referenced by a ProvisioningRequest does not
+// exist or has not been validated.
+type ClusterTemplateNotFoundError struct {
+	Name string
+}
+
+func (e *ClusterTemplateNotFoundError) Error() string {
+	return fmt.Sprintf("a valid ClusterTemplate (%s) does not exist in any namespace", e.Name)
 }
 
 // FindClusterInstanceImmutableFieldUpdates identifies updates made to immutable fields
@@ -18,11 +18,14 @@ package v1alpha1
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -57,12 +60,13 @@ var _ webhook.Validator = &ProvisioningRequest{}
 func (r *ProvisioningRequest) ValidateCreate() (admission.Warnings, error) {
 	provisioningrequestlog.Info("validate create", "name", r.Spec.Name)
 
-	if err := r.validateCreateOrUpdate(nil); err != nil {
+	warnings, err := r.validateCreateOrUpdate(nil)
+	if err != nil {
 		provisioningrequestlog.Error(err, "failed to validate the ProvisioningRequest")
 		return nil, err
 	}
 
-	return nil, nil
+	return warnings, nil
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -80,12 +84,13 @@ func (r *ProvisioningRequest) ValidateUpdate(old runtime.Object) (admission.Warn
 		return nil, nil
 	}
 
-	if err := r.validateCreateOrUpdate(oldPr); err != nil {
+	warnings, err := r.validateCreateOrUpdate(oldPr)
+	if err != nil {
 		provisioningrequestlog.Error(err, "failed to validate the ProvisioningRequest")
 		return nil, err
 	}
 
-	return nil, nil
+	return warnings, nil
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -96,26 +101,52 @@ func (r *ProvisioningRequest) ValidateDelete() (admission.Warnings, error) {
 	return nil, nil
 }
 
-func (r *ProvisioningRequest) validateCreateOrUpdate(oldPr *ProvisioningRequest) error {
+// schemaValidationError converts a JSON schema validation error of the templateParameters into an Invalid error
+// reporting the path of each offending field. Any other error is returned as is.
+func (r *ProvisioningRequest) schemaValidationError(err error, root *field.Path) error {
+	var schemaErr *JsonSchemaValidationError
+	if !errors.As(err, &schemaErr) {
+		return err
+	}
+	return apierrors.NewInvalid(
+		GroupVersion.WithKind("ProvisioningRequest").GroupKind(), r.Name, schemaErr.FieldErrors(root))
+}
+
+func (r *ProvisioningRequest) validateCreateOrUpdate(oldPr *ProvisioningRequest) (admission.Warnings, error) {
+	if err := r.ValidateTimeouts(); err != nil {
+		return nil, err
+	}
+	if err := r.ValidateFeatureFlags(); err != nil {
+		return nil, err
+	}
+
 	clusterTemplate, err := r.GetClusterTemplateRef(context.TODO(), webhookClient)
 	if err != nil {
-		return err
+		var notFoundErr *ClusterTemplateNotFoundError
+		if errors.As(err, &notFoundErr) {
+			// The ClusterTemplate may be created after the ProvisioningRequest, in which case the
+			// templateParameters are validated by the controller once the ClusterTemplate is available.
+			return admission.Warnings{fmt.Sprintf(
+				"%s; spec.templateParameters will be validated once the ClusterTemplate is available", err.Error())}, nil
+		}
+		return nil, err
 	}
 
+	templateParametersPath := field.NewPath("spec", "templateParameters")
 	if err = r.ValidateTemplateInputMatchesSchema(clusterTemplate); err != nil {
-		return err
+		return nil, r.schemaValidationError(err, templateParametersPath)
 	}
 
 	// We only validate the ClusterInstance input here, not the PolicyTemplate input since
 	// its schema is not just for ProvisioningRequest.
 	newPrClusterInstanceInput, err := r.ValidateClusterInstanceInputMatchesSchema(clusterTemplate)
 	if err != nil {
-		return err
+		return nil, r.schemaValidationError(err, templateParametersPath.Child(TemplateParamClusterInstance))
 	}
 
 	if oldPr == nil {
 		// ProvisioningRequest is being created, no immutable fields to check
-		return nil
+		return nil, nil
 	}
 
 	// Check for updates to immutable fields in the ClusterInstance input.
@@ -128,14 +159,14 @@ func (r *ProvisioningRequest) validateCreateOrUpdate(oldPr *ProvisioningRequest)
 		oldPrClusterInstanceInput, err := ExtractMatchingInput(
 			oldPr.Spec.TemplateParameters.Raw, TemplateParamClusterInstance)
 		if err != nil {
-			return fmt.Errorf(
+			return nil, fmt.Errorf(
 				"failed to extract matching input for subSchema %s: %w", TemplateParamClusterInstance, err)
 		}
 
 		updatedFields, scalingNodes, err := FindClusterInstanceImmutableFieldUpdates(
 			oldPrClusterInstanceInput.(map[string]any), newPrClusterInstanceInput.(map[string]any), [][]string{})
 		if err != nil {
-			return fmt.Errorf("failed to find immutable field updates for ClusterInstance (%s): %w", r.Name, err)
+			return nil, fmt.Errorf("failed to find immutable field updates for ClusterInstance (%s): %w", r.Name, err)
 		}
 
 		if len(scalingNodes) != 0 && crProvisionedCond.Reason != "Completed" {
@@ -143,11 +174,11 @@ func (r *ProvisioningRequest) validateCreateOrUpdate(oldPr *ProvisioningRequest)
 		}
 
 		if len(updatedFields) != 0 {
-			return fmt.Errorf("only \"extraAnnotations\" and/or \"extraLabels\" changes in spec.TemplateParameters.ClusterInstanceParameters "+
+			return nil, fmt.Errorf("only \"extraAnnotations\" and/or \"extraLabels\" changes in spec.TemplateParameters.ClusterInstanceParameters "+
 				"are allowed once cluster installation has started or reached to Completed/Failed state, detected changes in immutable fields: %s",
 				strings.Join(updatedFields, ", "))
 		}
 	}
 
-	return nil
+	return nil, nil
 }
@@ -0,0 +1,168 @@
+package v1alpha1
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testWebhookTemplateParameterSchema = `{
+  "properties": {
+    "nodeClusterName": {"type": "string"},
+    "oCloudSiteId": {"type": "string"},
+    "clusterInstanceParameters": {
+      "properties": {
+        "clusterName": {"type": "string"},
+        "nodes": {
+          "items": {
+            "properties": {"hostName": {"type": "string"}},
+            "required": ["hostName"],
+            "type": "object"
+          },
+          "type": "array"
+        }
+      },
+      "required": ["clusterName"],
+      "type": "object"
+    },
+    "policyTemplateParameters": {"type": "object"}
+  },
+  "required": ["nodeClusterName", "oCloudSiteId", "clusterInstanceParameters"],
+  "type": "object"
+}`
+
+var _ = Describe("ProvisioningRequest webhook", func() {
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		pr         *ProvisioningRequest
+		tName      = "clustertemplate-a"
+		tVersion   = "v1.0.0"
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		ct := &ClusterTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      tName + "." + tVersion,
+				Namespace: "clustertemplate-a-v4-16",
+			},
+			Spec: ClusterTemplateSpec{
+				Name:    tName,
+				Version: tVersion,
+				TemplateParameterSchema: runtime.RawExtension{
+					Raw: []byte(testWebhookTemplateParameterSchema),
+				},
+			},
+			Status: ClusterTemplateStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:   string(CTconditionTypes.Validated),
+						Reason: string(CTconditionReasons.Completed),
+						Status: metav1.ConditionTrue,
+					},
+				},
+			},
+		}
+
+		pr = &ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "cluster-1",
+			},
+			Spec: ProvisioningRequestSpec{
+				TemplateName:    tName,
+				TemplateVersion: tVersion,
+			},
+		}
+
+		fakeClient = fake.NewClientBuilder().WithScheme(s).Build()
+		Expect(fakeClient.Create(ctx, ct)).To(Succeed())
+
+		previousClient := webhookClient
+		webhookClient = fakeClient
+		DeferCleanup(func() { webhookClient = previousClient })
+	})
+
+	setTemplateParameters := func(templateParameters string) {
+		pr.Spec.TemplateParameters = runtime.RawExtension{Raw: []byte(templateParameters)}
+	}
+
+	It("accepts templateParameters matching the schema", func() {
+		setTemplateParameters(`{"nodeClusterName": "exampleCluster", "oCloudSiteId": "local-123",
+			"clusterInstanceParameters": {"clusterName": "sno1", "nodes": [{"hostName": "node1"}]}}`)
+
+		warnings, err := pr.ValidateCreate()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(warnings).To(BeEmpty())
+	})
+
+	It("rejects missing required keys with the path of the missing field", func() {
+		setTemplateParameters(`{"oCloudSiteId": "local-123",
+			"clusterInstanceParameters": {"clusterName": "sno1"}}`)
+
+		_, err := pr.ValidateCreate()
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("spec.templateParameters.nodeClusterName: Required value"))
+
+		setTemplateParameters(`{"nodeClusterName": "exampleCluster", "oCloudSiteId": "local-123",
+			"clusterInstanceParameters": {"clusterName": "sno1", "nodes": [{"hostName": "node1"}, {}]}}`)
+
+		_, err = pr.ValidateCreate()
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring(
+			"spec.templateParameters.clusterInstanceParameters.nodes[1].hostName: Required value"))
+	})
+
+	It("rejects type mismatches with the path of the offending field", func() {
+		setTemplateParameters(`{"nodeClusterName": 5, "oCloudSiteId": "local-123",
+			"clusterInstanceParameters": {"clusterName": "sno1"}}`)
+
+		_, err := pr.ValidateCreate()
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("spec.templateParameters.nodeClusterName: Invalid value"))
+
+		setTemplateParameters(`{"nodeClusterName": "exampleCluster", "oCloudSiteId": "local-123",
+			"clusterInstanceParameters": {"clusterName": ["sno1"]}}`)
+
+		_, err = pr.ValidateUpdate(pr.DeepCopy())
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring(
+			"spec.templateParameters.clusterInstanceParameters.clusterName: Invalid value"))
+	})
+
+	It("validates the feature flag annotations", func() {
+		setTemplateParameters(`{"nodeClusterName": "exampleCluster", "oCloudSiteId": "local-123",
+			"clusterInstanceParameters": {"clusterName": "sno1"}}`)
+
+		pr.SetAnnotations(map[string]string{FeatureFlagAnnotationPrefix + string(FeatureParallelPreprovisioning): "True"})
+		_, err := pr.ValidateCreate()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pr.IsFeatureEnabled(FeatureParallelPreprovisioning)).To(BeTrue())
+
+		pr.SetAnnotations(map[string]string{FeatureFlagAnnotationPrefix + string(FeatureParallelPreprovisioning): "yes"})
+		_, err = pr.ValidateCreate()
+		Expect(err).To(MatchError(ContainSubstring("must be set to \"true\" or \"false\"")))
+
+		pr.SetAnnotations(map[string]string{FeatureFlagAnnotationPrefix + "enable-everything": "true"})
+		_, err = pr.ValidateUpdate(pr.DeepCopy())
+		Expect(err).To(MatchError(ContainSubstring("unknown feature flag \"enable-everything\"")))
+		Expect(pr.IsFeatureEnabled(FeatureParallelPreprovisioning)).To(BeFalse())
+	})
+
+	It("accepts the request with a warning when the ClusterTemplate does not exist", func() {
+		pr.Spec.TemplateVersion = "v2.0.0"
+		setTemplateParameters(`{"nodeClusterName": 5}`)
+
+		warnings, err := pr.ValidateCreate()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(warnings).To(ConsistOf(ContainSubstring(
+			"a valid ClusterTemplate (clustertemplate-a.v2.0.0) does not exist in any namespace")))
+	})
+})
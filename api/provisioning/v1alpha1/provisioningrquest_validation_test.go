@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2/dsl/core"
 	. "github.com/onsi/gomega"
@@ -691,3 +692,34 @@ func TestExtractMatchingInput(t *testing.T) {
 		})
 	}
 }
+
+var _ = Describe("ValidateTimeouts", func() {
+	It("accepts a ProvisioningRequest without timeouts", func() {
+		pr := &ProvisioningRequest{}
+		Expect(pr.ValidateTimeouts()).To(Succeed())
+	})
+
+	It("accepts positive timeouts", func() {
+		pr := &ProvisioningRequest{
+			Spec: ProvisioningRequestSpec{
+				Timeouts: &ProvisioningRequestTimeouts{
+					HardwareProvisioning: &metav1.Duration{Duration: 30 * time.Minute},
+					ClusterProvisioning:  &metav1.Duration{Duration: 90 * time.Minute},
+				},
+			},
+		}
+		Expect(pr.ValidateTimeouts()).To(Succeed())
+	})
+
+	It("rejects a non-positive timeout", func() {
+		pr := &ProvisioningRequest{
+			Spec: ProvisioningRequestSpec{
+				Timeouts: &ProvisioningRequestTimeouts{
+					ClusterConfiguration: &metav1.Duration{Duration: -time.Minute},
+				},
+			},
+		}
+		Expect(pr.ValidateTimeouts()).To(MatchError(
+			"spec.timeouts.clusterConfiguration must be a positive duration, got -1m0s"))
+	})
+})
@@ -16,5 +16,5 @@ func TestProvisioningApiSuite(t *testing.T) {
 var s = scheme.Scheme
 
 var _ = BeforeSuite(func() {
-	s.AddKnownTypes(GroupVersion, &ProvisioningRequest{}, &ClusterTemplate{}, &ClusterTemplateList{})
+	s.AddKnownTypes(GroupVersion, &ProvisioningRequest{}, &ProvisioningRequestList{}, &ClusterTemplate{}, &ClusterTemplateList{})
 })
@@ -25,6 +25,21 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChangeWindow) DeepCopyInto(out *ChangeWindow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChangeWindow.
+func (in *ChangeWindow) DeepCopy() *ChangeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ChangeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterDetails) DeepCopyInto(out *ClusterDetails) {
 	*out = *in
@@ -124,7 +139,29 @@ func (in *ClusterTemplateSpec) DeepCopyInto(out *ClusterTemplateSpec) {
 			(*out)[key] = val
 		}
 	}
-	out.Templates = in.Templates
+	in.Templates.DeepCopyInto(&out.Templates)
+	if in.ResourceBarriers != nil {
+		in, out := &in.ResourceBarriers, &out.ResourceBarriers
+		*out = make([]ResourceBarrier, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PollIntervals != nil {
+		in, out := &in.PollIntervals, &out.PollIntervals
+		*out = new(PollIntervals)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FulfillmentChecks != nil {
+		in, out := &in.FulfillmentChecks, &out.FulfillmentChecks
+		*out = new(FulfillmentChecks)
+		**out = **in
+	}
+	if in.ConfigurationChangeWindow != nil {
+		in, out := &in.ConfigurationChangeWindow, &out.ConfigurationChangeWindow
+		*out = new(ChangeWindow)
+		**out = **in
+	}
 	in.TemplateParameterSchema.DeepCopyInto(&out.TemplateParameterSchema)
 }
 
@@ -178,6 +215,11 @@ func (in *Extensions) DeepCopyInto(out *Extensions) {
 		*out = make([]PolicyDetails, len(*in))
 		copy(*out, *in)
 	}
+	if in.PolicyCompliance != nil {
+		in, out := &in.PolicyCompliance, &out.PolicyCompliance
+		*out = new(PolicyComplianceSummary)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Extensions.
@@ -190,6 +232,21 @@ func (in *Extensions) DeepCopy() *Extensions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FulfillmentChecks) DeepCopyInto(out *FulfillmentChecks) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FulfillmentChecks.
+func (in *FulfillmentChecks) DeepCopy() *FulfillmentChecks {
+	if in == nil {
+		return nil
+	}
+	out := new(FulfillmentChecks)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodePoolRef) DeepCopyInto(out *NodePoolRef) {
 	*out = *in
@@ -213,6 +270,21 @@ func (in *NodePoolRef) DeepCopy() *NodePoolRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyComplianceSummary) DeepCopyInto(out *PolicyComplianceSummary) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyComplianceSummary.
+func (in *PolicyComplianceSummary) DeepCopy() *PolicyComplianceSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyComplianceSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PolicyDetails) DeepCopyInto(out *PolicyDetails) {
 	*out = *in
@@ -228,6 +300,36 @@ func (in *PolicyDetails) DeepCopy() *PolicyDetails {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PollIntervals) DeepCopyInto(out *PollIntervals) {
+	*out = *in
+	if in.HardwareProvisioning != nil {
+		in, out := &in.HardwareProvisioning, &out.HardwareProvisioning
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ClusterProvisioning != nil {
+		in, out := &in.ClusterProvisioning, &out.ClusterProvisioning
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ClusterConfiguration != nil {
+		in, out := &in.ClusterConfiguration, &out.ClusterConfiguration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PollIntervals.
+func (in *PollIntervals) DeepCopy() *PollIntervals {
+	if in == nil {
+		return nil
+	}
+	out := new(PollIntervals)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProvisionedResources) DeepCopyInto(out *ProvisionedResources) {
 	*out = *in
@@ -307,6 +409,11 @@ func (in *ProvisioningRequestSpec) DeepCopyInto(out *ProvisioningRequestSpec) {
 	*out = *in
 	in.TemplateParameters.DeepCopyInto(&out.TemplateParameters)
 	in.Extensions.DeepCopyInto(&out.Extensions)
+	if in.Timeouts != nil {
+		in, out := &in.Timeouts, &out.Timeouts
+		*out = new(ProvisioningRequestTimeouts)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningRequestSpec.
@@ -331,6 +438,16 @@ func (in *ProvisioningRequestStatus) DeepCopyInto(out *ProvisioningRequestStatus
 	}
 	in.Extensions.DeepCopyInto(&out.Extensions)
 	in.ProvisioningStatus.DeepCopyInto(&out.ProvisioningStatus)
+	if in.UpgradeStatus != nil {
+		in, out := &in.UpgradeStatus, &out.UpgradeStatus
+		*out = new(UpgradeStatus)
+		**out = **in
+	}
+	if in.RenderedManifests != nil {
+		in, out := &in.RenderedManifests, &out.RenderedManifests
+		*out = new(RenderedManifestsReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningRequestStatus.
@@ -343,6 +460,36 @@ func (in *ProvisioningRequestStatus) DeepCopy() *ProvisioningRequestStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisioningRequestTimeouts) DeepCopyInto(out *ProvisioningRequestTimeouts) {
+	*out = *in
+	if in.HardwareProvisioning != nil {
+		in, out := &in.HardwareProvisioning, &out.HardwareProvisioning
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ClusterProvisioning != nil {
+		in, out := &in.ClusterProvisioning, &out.ClusterProvisioning
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ClusterConfiguration != nil {
+		in, out := &in.ClusterConfiguration, &out.ClusterConfiguration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningRequestTimeouts.
+func (in *ProvisioningRequestTimeouts) DeepCopy() *ProvisioningRequestTimeouts {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisioningRequestTimeouts)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProvisioningStatus) DeepCopyInto(out *ProvisioningStatus) {
 	*out = *in
@@ -351,6 +498,15 @@ func (in *ProvisioningStatus) DeepCopyInto(out *ProvisioningStatus) {
 		*out = new(ProvisionedResources)
 		**out = **in
 	}
+	if in.ResourceAccounting != nil {
+		in, out := &in.ResourceAccounting, &out.ResourceAccounting
+		*out = new(ResourceAccounting)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TimeoutDeadline != nil {
+		in, out := &in.TimeoutDeadline, &out.TimeoutDeadline
+		*out = (*in).DeepCopy()
+	}
 	in.UpdateTime.DeepCopyInto(&out.UpdateTime)
 }
 
@@ -364,9 +520,88 @@ func (in *ProvisioningStatus) DeepCopy() *ProvisioningStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RenderedManifestsReference) DeepCopyInto(out *RenderedManifestsReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenderedManifestsReference.
+func (in *RenderedManifestsReference) DeepCopy() *RenderedManifestsReference {
+	if in == nil {
+		return nil
+	}
+	out := new(RenderedManifestsReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceAccounting) DeepCopyInto(out *ResourceAccounting) {
+	*out = *in
+	if in.NodeCountByProfile != nil {
+		in, out := &in.NodeCountByProfile, &out.NodeCountByProfile
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceAccounting.
+func (in *ResourceAccounting) DeepCopy() *ResourceAccounting {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceAccounting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceBarrier) DeepCopyInto(out *ResourceBarrier) {
+	*out = *in
+	if in.Prerequisites != nil {
+		in, out := &in.Prerequisites, &out.Prerequisites
+		*out = make([]ResourcePrerequisite, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceBarrier.
+func (in *ResourceBarrier) DeepCopy() *ResourceBarrier {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceBarrier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePrerequisite) DeepCopyInto(out *ResourcePrerequisite) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePrerequisite.
+func (in *ResourcePrerequisite) DeepCopy() *ResourcePrerequisite {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePrerequisite)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Templates) DeepCopyInto(out *Templates) {
 	*out = *in
+	if in.SiteHwTemplates != nil {
+		in, out := &in.SiteHwTemplates, &out.SiteHwTemplates
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Templates.
@@ -378,3 +613,18 @@ func (in *Templates) DeepCopy() *Templates {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeStatus) DeepCopyInto(out *UpgradeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeStatus.
+func (in *UpgradeStatus) DeepCopy() *UpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in
+compliance with the License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is
+distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+implied. See the License for the specific language governing permissions and limitations under the
+License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+
+	hwv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
+	"github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
+	"github.com/openshift-kni/oran-o2ims/internal/exit"
+)
+
+// Supported values of the `--output` flag of the `hwplugin validate-template` command:
+const (
+	validateTemplateOutputText = "text"
+	validateTemplateOutputJSON = "json"
+)
+
+// HwPlugin creates and returns the `hwplugin` command.
+func HwPlugin() *cobra.Command {
+	result := &cobra.Command{
+		Use:   "hwplugin",
+		Short: "Hardware plugin tools",
+		Args:  cobra.NoArgs,
+	}
+	result.AddCommand(ValidateTemplate())
+	return result
+}
+
+// ValidateTemplate creates and returns the `hwplugin validate-template` command.
+func ValidateTemplate() *cobra.Command {
+	c := NewValidateTemplateCommand()
+	result := &cobra.Command{
+		Use:   "validate-template",
+		Short: "Validates a HardwareTemplate file",
+		Long: "Validates a HardwareTemplate file without accessing a cluster, with the same checks as the " +
+			"controller. Every problem found is reported and the command exits with a non-zero code if there " +
+			"is any, so that it can be used to gate CI.",
+		Args: cobra.NoArgs,
+		RunE: c.run,
+	}
+	flags := result.Flags()
+	flags.StringVarP(
+		&c.filename,
+		"filename",
+		"f",
+		"",
+		"Path of the HardwareTemplate YAML or JSON file.",
+	)
+	flags.StringVarP(
+		&c.output,
+		"output",
+		"o",
+		validateTemplateOutputText,
+		fmt.Sprintf("Output format, one of '%s' or '%s'.", validateTemplateOutputText, validateTemplateOutputJSON),
+	)
+	_ = result.MarkFlagRequired("filename")
+	return result
+}
+
+// ValidateTemplateCommand contains the data and logic needed to run the `hwplugin validate-template` command.
+type ValidateTemplateCommand struct {
+	filename string
+	output   string
+}
+
+// TemplateProblem is a problem found in a HardwareTemplate, as reported by the `hwplugin validate-template`
+// command.
+type TemplateProblem struct {
+	Field  string `json:"field"`
+	Type   string `json:"type"`
+	Detail string `json:"detail"`
+}
+
+// ValidateTemplateResult is the machine readable output of the `hwplugin validate-template` command.
+type ValidateTemplateResult struct {
+	File     string            `json:"file"`
+	Valid    bool              `json:"valid"`
+	Problems []TemplateProblem `json:"problems"`
+}
+
+// NewValidateTemplateCommand creates a new runner that knows how to execute the `hwplugin validate-template`
+// command.
+func NewValidateTemplateCommand() *ValidateTemplateCommand {
+	return &ValidateTemplateCommand{}
+}
+
+// run executes the `hwplugin validate-template` command.
+func (c *ValidateTemplateCommand) run(cmd *cobra.Command, argv []string) error {
+	if !slices.Contains([]string{validateTemplateOutputText, validateTemplateOutputJSON}, c.output) {
+		return fmt.Errorf("unsupported output format '%s', must be one of '%s' or '%s'",
+			c.output, validateTemplateOutputText, validateTemplateOutputJSON)
+	}
+
+	data, err := os.ReadFile(c.filename)
+	if err != nil {
+		return fmt.Errorf("failed to read the HardwareTemplate file: %w", err)
+	}
+
+	// Unknown fields are reported as problems, as they are most likely misspelled
+	var errs field.ErrorList
+	hwTemplate := &hwv1alpha1.HardwareTemplate{}
+	if err := yaml.UnmarshalStrict(data, hwTemplate); err != nil {
+		if err := yaml.Unmarshal(data, hwTemplate); err != nil {
+			return fmt.Errorf("failed to parse the HardwareTemplate file %s: %w", c.filename, err)
+		}
+		errs = append(errs, &field.Error{Type: field.ErrorTypeForbidden, Field: "<root>", Detail: err.Error()})
+	}
+	if hwTemplate.Kind != "" && hwTemplate.Kind != "HardwareTemplate" {
+		errs = append(errs, field.NotSupported(field.NewPath("kind"), hwTemplate.Kind, []string{"HardwareTemplate"}))
+	}
+	errs = append(errs, utils.ValidateHardwareTemplate(hwTemplate)...)
+
+	result := ValidateTemplateResult{
+		File:     c.filename,
+		Valid:    len(errs) == 0,
+		Problems: make([]TemplateProblem, 0, len(errs)),
+	}
+	for _, e := range errs {
+		result.Problems = append(result.Problems, TemplateProblem{
+			Field:  e.Field,
+			Type:   string(e.Type),
+			Detail: e.ErrorBody(),
+		})
+	}
+
+	switch c.output {
+	case validateTemplateOutputJSON:
+		data, err := json.Marshal(result)
+		if err != nil {
+			return fmt.Errorf("failed to marshal the validation result: %w", err)
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), string(data))
+	default:
+		if result.Valid {
+			fmt.Fprintf(cmd.OutOrStdout(), "%s: valid\n", c.filename)
+		} else {
+			fmt.Fprintf(cmd.OutOrStdout(), "%s: %d problem(s) found\n", c.filename, len(errs))
+			for _, e := range errs {
+				fmt.Fprintf(cmd.OutOrStdout(), "- %s\n", e.Error())
+			}
+		}
+	}
+
+	if !result.Valid {
+		return exit.Error(1)
+	}
+	return nil
+}
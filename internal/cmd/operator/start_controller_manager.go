@@ -20,6 +20,7 @@ import (
 	"github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
 	openshiftv1 "github.com/openshift/api/config/v1"
 	openshiftoperatorv1 "github.com/openshift/api/operator/v1"
+	agentv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -34,6 +35,7 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	"github.com/go-logr/logr"
+	metal3v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
 	ibguv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/imagebasedgroupupgrades/v1alpha1"
 	pluginv1alpha1 "github.com/openshift-kni/oran-hwmgr-plugin/api/hwmgr-plugin/v1alpha1"
 	hwv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
@@ -45,6 +47,7 @@ import (
 	"github.com/spf13/cobra"
 	siteconfig "github.com/stolostron/siteconfig/api/v1alpha1"
 	clusterv1 "open-cluster-management.io/api/cluster/v1"
+	clusterv1beta1 "open-cluster-management.io/api/cluster/v1beta1"
 	policiesv1 "open-cluster-management.io/governance-policy-propagator/api/v1"
 )
 
@@ -81,6 +84,20 @@ func ControllerManager() *cobra.Command {
 		"enable-webhooks",
 		true,
 		"Enable the o2ims validating webhooks")
+	flags.BoolVar(&c.enableProvisioningSummary,
+		"enable-provisioning-summary",
+		false,
+		"Enable the aggregation of the ProvisioningRequests by phase and template into the metrics")
+	flags.StringVar(&c.provisioningNotificationURL,
+		"provisioning-notification-url",
+		"",
+		"Endpoint (e.g., a Slack compatible webhook) notified when a ProvisioningRequest is fulfilled or fails. "+
+			"Notifications are disabled when empty.")
+	flags.IntVar(&c.maxParallelUpgrades,
+		"max-parallel-upgrades",
+		0,
+		"Maximum number of cluster upgrades in progress at the same time. Further upgrades wait for a slot. "+
+			"There is no limit when 0.")
 	flags.StringVar(
 		&c.image,
 		imageFlagName,
@@ -95,11 +112,14 @@ func ControllerManager() *cobra.Command {
 // ControllerManagerCommand contains the data and logic needed to run the `start controller-manager`
 // command.
 type ControllerManagerCommand struct {
-	metricsAddr          string
-	enableLeaderElection bool
-	enableWebhooks       bool
-	probeAddr            string
-	image                string
+	metricsAddr                 string
+	enableLeaderElection        bool
+	enableWebhooks              bool
+	enableProvisioningSummary   bool
+	provisioningNotificationURL string
+	maxParallelUpgrades         int
+	probeAddr                   string
+	image                       string
 }
 
 // NewControllerManager creates a new runner that knows how to execute the `start
@@ -119,11 +139,14 @@ func init() {
 	utilruntime.Must(siteconfig.AddToScheme(scheme))
 	utilruntime.Must(hwv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(clusterv1.AddToScheme(scheme))
+	utilruntime.Must(clusterv1beta1.AddToScheme(scheme))
 	utilruntime.Must(policiesv1.AddToScheme(scheme))
 	utilruntime.Must(openshiftv1.AddToScheme(scheme))
 	utilruntime.Must(openshiftoperatorv1.AddToScheme(scheme))
 	utilruntime.Must(ibguv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(pluginv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(agentv1beta1.AddToScheme(scheme))
+	utilruntime.Must(metal3v1alpha1.AddToScheme(scheme))
 }
 
 // run executes the `start controller-manager` command.
@@ -232,10 +255,29 @@ func (c *ControllerManagerCommand) run(cmd *cobra.Command, argv []string) error
 		return exit.Error(1)
 	}
 
+	// Start the notifier of the Provisioning Request outcomes.
+	var notifier *controllers.ProvisioningNotifier
+	if c.provisioningNotificationURL != "" {
+		notifier = controllers.NewProvisioningNotifier(controllers.ProvisioningNotifierConfig{
+			URL: c.provisioningNotificationURL,
+		}, slog.With("component", "ProvisioningNotifier"))
+		if err = mgr.Add(notifier); err != nil {
+			logger.ErrorContext(
+				ctx,
+				"Unable to add the provisioning notifier",
+				slog.String("error", err.Error()),
+			)
+			return exit.Error(1)
+		}
+	}
+
 	// Start the Provisioning Request controller.
 	if err = (&controllers.ProvisioningRequestReconciler{
-		Client: mgr.GetClient(),
-		Logger: slog.With("controller", "ProvisioningRequest"),
+		Client:   mgr.GetClient(),
+		Logger:   slog.With("controller", "ProvisioningRequest"),
+		Notifier: notifier,
+
+		MaxParallelUpgrades: c.maxParallelUpgrades,
 	}).SetupWithManager(mgr); err != nil {
 		logger.ErrorContext(
 			ctx,
@@ -246,6 +288,22 @@ func (c *ControllerManagerCommand) run(cmd *cobra.Command, argv []string) error
 		return exit.Error(1)
 	}
 
+	// Start the Provisioning Request summary controller.
+	if c.enableProvisioningSummary {
+		if err = (&controllers.ProvisioningRequestSummaryReconciler{
+			Client: mgr.GetClient(),
+			Logger: slog.With("controller", "ProvisioningRequestSummary"),
+		}).SetupWithManager(mgr); err != nil {
+			logger.ErrorContext(
+				ctx,
+				"Unable to create controller",
+				slog.String("controller", "ProvisioningRequestSummary"),
+				slog.String("error", err.Error()),
+			)
+			return exit.Error(1)
+		}
+	}
+
 	if c.enableWebhooks {
 		if err = (&provisioningv1alpha1.ProvisioningRequest{}).SetupWebhookWithManager(mgr); err != nil {
 			logger.ErrorContext(
@@ -256,6 +314,15 @@ func (c *ControllerManagerCommand) run(cmd *cobra.Command, argv []string) error
 			)
 			return exit.Error(1)
 		}
+		if err = (&provisioningv1alpha1.ClusterTemplate{}).SetupWebhookWithManager(mgr); err != nil {
+			logger.ErrorContext(
+				ctx,
+				"Unable to create webhook",
+				slog.String("webhook", "ClusterTemplate"),
+				slog.String("error", err.Error()),
+			)
+			return exit.Error(1)
+		}
 	}
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
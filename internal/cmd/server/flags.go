@@ -133,6 +133,7 @@ func GetTokenFlag(
 
 // Names of command line flags:
 const (
+	AlarmHintRulesFileFlagName        = "alarm-hint-rules-file"
 	APIListenerAddressFlagName        = "api-listener-address"
 	APIListenerTLSCertFlagName        = "api-listener-tls-crt"
 	APIListenerTLSKeyFlagName         = "api-listener-tls-key"
@@ -141,11 +142,17 @@ const (
 	backendTypeFlagName               = "backend-type"
 	BackendURLFlagName                = "backend-url"
 	CloudIDFlagName                   = "cloud-id"
+	CORSAllowedHeadersFlagName        = "cors-allowed-headers"
+	CORSAllowedMethodsFlagName        = "cors-allowed-methods"
+	CORSAllowedOriginsFlagName        = "cors-allowed-origins"
 	ExtensionsFlagName                = "extensions"
 	ExternalAddressFlagName           = "external-address"
 	GlobalCloudIDFlagName             = "global-cloud-id"
+	ImportBatchSizeFlagName           = "import-batch-size"
 	namespaceFlagName                 = "namespace"
 	resourceServerTokenFlagName       = "resource-server-token"
 	resourceServerURLFlagName         = "resource-server-url"
+	ResponseCacheSizeFlagName         = "response-cache-size"
+	ResponseCacheTTLFlagName          = "response-cache-ttl"
 	subscriptionConfigmapNameFlagName = "configmap-name"
 )
@@ -15,28 +15,60 @@ License.
 package cmd
 
 import (
+	"encoding/json"
+	"fmt"
 	"log/slog"
-	"runtime/debug"
+	"runtime"
+	"slices"
 
 	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
 
 	"github.com/openshift-kni/oran-o2ims/internal"
+	"github.com/openshift-kni/oran-o2ims/internal/version"
+)
+
+// Supported values of the `--output` flag of the `version` command:
+const (
+	versionOutputText = "text"
+	versionOutputJSON = "json"
+	versionOutputYAML = "yaml"
 )
 
 // Version creates and returns the `version` command.
 func Version() *cobra.Command {
 	c := NewVersionCommand()
-	return &cobra.Command{
+	result := &cobra.Command{
 		Use:   "version",
 		Short: "Prints version information",
-		Long:  "Prints version information",
+		Long:  "Prints version information. Use '--output json' or '--output yaml' for a machine readable format.",
 		Args:  cobra.NoArgs,
 		RunE:  c.run,
 	}
+	flags := result.Flags()
+	flags.StringVarP(
+		&c.output,
+		"output",
+		"o",
+		versionOutputText,
+		fmt.Sprintf("Output format, one of '%s', '%s' or '%s'.",
+			versionOutputText, versionOutputJSON, versionOutputYAML),
+	)
+	return result
 }
 
 // VersionCommand contains the data and logic needed to run the `version` command.
 type VersionCommand struct {
+	output string
+}
+
+// VersionInfo is the machine readable output of the `version` command.
+type VersionInfo struct {
+	Component string `json:"component"`
+	Version   string `json:"version"`
+	GitCommit string `json:"gitCommit"`
+	BuildDate string `json:"buildDate"`
+	GoVersion string `json:"goVersion"`
 }
 
 // NewCommand creates a new runner that knows how to execute the `version` command.
@@ -46,54 +78,63 @@ func NewVersionCommand() *VersionCommand {
 
 // run executes the `version` command.
 func (c *VersionCommand) run(cmd *cobra.Command, argv []string) error {
+	if !slices.Contains([]string{versionOutputText, versionOutputJSON, versionOutputYAML}, c.output) {
+		return fmt.Errorf("unsupported output format '%s', must be one of '%s', '%s' or '%s'",
+			c.output, versionOutputText, versionOutputJSON, versionOutputYAML)
+	}
+
 	// Get the context:
 	ctx := cmd.Context()
 
-	// Get the logger:
-	logger := internal.LoggerFromContext(ctx)
-
 	// Calculate the values:
-	buildCommit := unknownSettingValue
-	buildTime := unknownSettingValue
-	info, ok := debug.ReadBuildInfo()
-	if ok {
-		vcsRevision := c.getSetting(info, vcsRevisionSettingKey)
-		if vcsRevision != "" {
-			buildCommit = vcsRevision
-		}
-		vcsTime := c.getSetting(info, vcsTimeSettingKey)
-		if vcsTime != "" {
-			buildTime = vcsTime
-		}
+	info := version.Get()
+	result := VersionInfo{
+		Component: versionComponent(cmd),
+		Version:   info.Version,
+		GitCommit: info.Commit,
+		BuildDate: info.Time,
+		GoVersion: runtime.Version(),
 	}
 
 	// Print the values:
-	logger.InfoContext(
-		ctx,
-		"Version",
-		slog.String("commit", buildCommit),
-		slog.String("time", buildTime),
-	)
+	switch c.output {
+	case versionOutputJSON:
+		data, err := json.Marshal(result)
+		if err != nil {
+			return fmt.Errorf("failed to marshal the version information: %w", err)
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), string(data))
+	case versionOutputYAML:
+		data, err := yaml.Marshal(result)
+		if err != nil {
+			return fmt.Errorf("failed to marshal the version information: %w", err)
+		}
+		fmt.Fprint(cmd.OutOrStdout(), string(data))
+	default:
+		// The servers have their own root commands that don't put the logger in the context, they configure
+		// the default logger instead.
+		logger := slog.Default()
+		if cmd.Parent() == cmd.Root() {
+			logger = internal.LoggerFromContext(ctx)
+		}
+		logger.InfoContext(
+			ctx,
+			"Version",
+			slog.String("component", result.Component),
+			slog.String("version", info.Version),
+			slog.String("commit", info.Commit),
+			slog.String("time", info.Time),
+		)
+	}
 
 	return nil
 }
 
-// getSetting returns the value of the build setting with the given key. Returns an empty string
-// if no such setting exists.
-func (c *VersionCommand) getSetting(info *debug.BuildInfo, key string) string {
-	for _, s := range info.Settings {
-		if s.Key == key {
-			return s.Value
-		}
+// versionComponent returns the name of the component the `version` command reports on, i.e. the server whose
+// command it belongs to, or the tool itself for the top level command.
+func versionComponent(cmd *cobra.Command) string {
+	if cmd.Parent() == nil {
+		return cmd.Name()
 	}
-	return ""
+	return cmd.Parent().Name()
 }
-
-// Names of build settings we are interested on:
-const (
-	vcsRevisionSettingKey = "vcs.revision"
-	vcsTimeSettingKey     = "vcs.time"
-)
-
-// Fallback value for unknown settings:
-const unknownSettingValue = "unknown"
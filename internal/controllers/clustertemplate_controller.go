@@ -184,14 +184,34 @@ func (t *clusterTemplateReconcilerTask) validateClusterTemplateCR(ctx context.Co
 		validationErrs = append(validationErrs, err.Error())
 	}
 
-	// Validate the timeout value from the hardware template if it's present
-	if t.object.Spec.Templates.HwTemplate != "" {
-		_, err = utils.GetTimeoutFromHWTemplate(ctx, t.client, t.object.Spec.Templates.HwTemplate)
+	// The per-site hardware templates are only selectable along with a default one
+	if len(t.object.Spec.Templates.SiteHwTemplates) != 0 && t.object.Spec.Templates.HwTemplate == "" {
+		validationErrs = append(validationErrs, "spec.templates.siteHwTemplates requires spec.templates.hwTemplate to be set")
+	}
+
+	// Validate the timeout value from the hardware templates if they're present
+	for _, hwTemplateName := range utils.GetReferencedHwTemplates(t.object.Spec.Templates) {
+		_, err = utils.GetTimeoutFromHWTemplate(ctx, t.client, hwTemplateName)
 		if err != nil {
 			validationErrs = append(validationErrs, err.Error())
 		}
 	}
 
+	// Validate the timeouts set in the ClusterTemplate
+	if _, err = utils.ParseClusterTemplateTimeout(
+		t.object.Spec.ClusterProvisioningTimeout, "clusterProvisioningTimeout"); err != nil {
+		validationErrs = append(validationErrs, err.Error())
+	}
+	if _, err = utils.ParseClusterTemplateTimeout(
+		t.object.Spec.ClusterConfigurationTimeout, "clusterConfigurationTimeout"); err != nil {
+		validationErrs = append(validationErrs, err.Error())
+	}
+	if t.object.Spec.ConfigurationChangeWindow != nil {
+		if _, err = utils.TimeUntilChangeWindow(t.object.Spec.ConfigurationChangeWindow, time.Now()); err != nil {
+			validationErrs = append(validationErrs, err.Error())
+		}
+	}
+
 	// Validate the ClusterInstance defaults configmap
 	err = validateConfigmapReference[map[string]any](
 		ctx, t.client,
@@ -586,23 +606,24 @@ func checkSchemaContains(actual, expected map[string]any, currentPath string) er
 
 // setStatusConditionValidated updates the Validated status condition of the ClusterTemplate object
 func (t *clusterTemplateReconcilerTask) updateStatusConditionValidated(ctx context.Context, errMsg string) error {
-	if errMsg != "" {
-		utils.SetStatusCondition(&t.object.Status.Conditions,
-			provisioningv1alpha1.CTconditionTypes.Validated,
-			provisioningv1alpha1.CTconditionReasons.Failed,
-			metav1.ConditionFalse,
-			errMsg,
-		)
-	} else {
-		utils.SetStatusCondition(&t.object.Status.Conditions,
-			provisioningv1alpha1.CTconditionTypes.Validated,
-			provisioningv1alpha1.CTconditionReasons.Completed,
-			metav1.ConditionTrue,
-			"The cluster template validation succeeded",
-		)
-	}
-
-	err := utils.UpdateK8sCRStatus(ctx, t.client, t.object)
+	err := utils.UpdateK8sCRStatusWithMutation(ctx, t.client, t.object,
+		func(latest *provisioningv1alpha1.ClusterTemplate) {
+			if errMsg != "" {
+				utils.SetStatusCondition(&latest.Status.Conditions,
+					provisioningv1alpha1.CTconditionTypes.Validated,
+					provisioningv1alpha1.CTconditionReasons.Failed,
+					metav1.ConditionFalse,
+					errMsg,
+				)
+			} else {
+				utils.SetStatusCondition(&latest.Status.Conditions,
+					provisioningv1alpha1.CTconditionTypes.Validated,
+					provisioningv1alpha1.CTconditionReasons.Completed,
+					metav1.ConditionTrue,
+					"The cluster template validation succeeded",
+				)
+			}
+		})
 	if err != nil {
 		return fmt.Errorf("failed to update status for ClusterTemplate %s: %w", t.object.Name, err)
 	}
@@ -662,7 +683,7 @@ func (r *ClusterTemplateReconciler) enqueueClusterTemplatesForConfigmap(ctx cont
 		if clusterTemplate.Namespace == obj.GetNamespace() {
 			if clusterTemplate.Spec.Templates.ClusterInstanceDefaults == obj.GetName() ||
 				clusterTemplate.Spec.Templates.PolicyTemplateDefaults == obj.GetName() ||
-				clusterTemplate.Spec.Templates.HwTemplate == obj.GetName() {
+				slices.Contains(utils.GetReferencedHwTemplates(clusterTemplate.Spec.Templates), obj.GetName()) {
 				// The configmap is referenced in this cluster template , enqueue it
 				requests = append(requests, reconcile.Request{
 					NamespacedName: types.NamespacedName{
@@ -672,7 +693,7 @@ func (r *ClusterTemplateReconciler) enqueueClusterTemplatesForConfigmap(ctx cont
 				})
 			}
 		} else if obj.GetNamespace() == utils.InventoryNamespace {
-			if clusterTemplate.Spec.Templates.HwTemplate == obj.GetName() {
+			if slices.Contains(utils.GetReferencedHwTemplates(clusterTemplate.Spec.Templates), obj.GetName()) {
 				requests = append(requests, reconcile.Request{
 					NamespacedName: types.NamespacedName{
 						Namespace: clusterTemplate.Namespace,
@@ -564,6 +564,29 @@ clustertemplate-a-policy-v1-defaultHugepagesSize: "1G"`,
 			"the value of key %s from ConfigMap %s is not a valid duration string", utils.ClusterInstallationTimeoutConfigKey, ciDefaultsCm)))
 	})
 
+	It("should return false and set status condition to false if timeouts in the ClusterTemplate are invalid", func() {
+		for _, cm := range cms {
+			Expect(c.Create(ctx, cm)).To(Succeed())
+		}
+		t.object.Spec.ClusterProvisioningTimeout = "90"
+		t.object.Spec.ClusterConfigurationTimeout = "-30m"
+
+		valid, err := t.validateClusterTemplateCR(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(valid).To(BeFalse())
+
+		// Check the status condition
+		conditions := t.object.Status.Conditions
+		Expect(conditions).To(HaveLen(1))
+		Expect(conditions[0].Type).To(Equal(string(provisioningv1alpha1.CTconditionTypes.Validated)))
+		Expect(conditions[0].Status).To(Equal(metav1.ConditionFalse))
+		Expect(conditions[0].Reason).To(Equal(string(provisioningv1alpha1.CTconditionReasons.Failed)))
+		Expect(conditions[0].Message).To(ContainSubstring(
+			"the value of spec.clusterProvisioningTimeout is not a valid duration string"))
+		Expect(conditions[0].Message).To(ContainSubstring(
+			"the value of spec.clusterConfigurationTimeout must be a positive duration: -30m"))
+	})
+
 	It("should return validation error message if the hardware template has invalid timeout string", func() {
 
 		hwtmpl.Spec.HardwareProvisioningTimeout = "60"
@@ -575,7 +598,8 @@ clustertemplate-a-policy-v1-defaultHugepagesSize: "1G"`,
 		// Check the status condition
 		conditions := t.object.Status.Conditions
 		Expect(conditions).To(HaveLen(1))
-		errMessage := fmt.Sprintf("the value of HardwareProvisioningTimeout from hardware template %s is not a valid duration string", hwtmpl.Name)
+		errMessage := fmt.Sprintf("hardware template %s is invalid: spec.hardwareProvisioningTimeout: Invalid value: \"60\": "+
+			"not a valid duration string: time: missing unit in duration \"60\"", hwtmpl.Name)
 		Expect(conditions[0].Type).To(Equal(string(provisioningv1alpha1.CTconditionTypes.Validated)))
 		Expect(conditions[0].Status).To(Equal(metav1.ConditionFalse))
 		Expect(conditions[0].Reason).To(Equal(string(provisioningv1alpha1.CTconditionReasons.Failed)))
@@ -589,6 +613,27 @@ clustertemplate-a-policy-v1-defaultHugepagesSize: "1G"`,
 			Message: errMessage,
 		})
 	})
+	It("should validate the per-site hardware templates and require a default one", func() {
+		for _, cm := range cms {
+			Expect(c.Create(ctx, cm)).To(Succeed())
+		}
+		Expect(c.Create(ctx, hwtmpl)).To(Succeed())
+		t.object.Spec.Templates.HwTemplate = ""
+		t.object.Spec.Templates.SiteHwTemplates = map[string]string{"site-a": hwtmpl.Name, "site-b": "hwTemplate-missing"}
+
+		valid, err := t.validateClusterTemplateCR(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(valid).To(BeFalse())
+
+		// Check the status condition
+		conditions := t.object.Status.Conditions
+		Expect(conditions).To(HaveLen(1))
+		Expect(conditions[0].Status).To(Equal(metav1.ConditionFalse))
+		Expect(conditions[0].Message).To(ContainSubstring(
+			"spec.templates.siteHwTemplates requires spec.templates.hwTemplate to be set"))
+		Expect(conditions[0].Message).To(ContainSubstring(
+			"hardware template resource hwTemplate-missing does not exist"))
+	})
 })
 
 var _ = Describe("validateConfigmapReference", func() {
@@ -1088,9 +1088,23 @@ func (t *reconcilerTask) createProvisioningServerClusterRole(ctx context.Context
 					"watch",
 					"create",
 					"update",
+					"patch",
 					"delete",
 				},
 			},
+			{
+				APIGroups: []string{
+					"o2ims.provisioning.oran.org",
+				},
+				Resources: []string{
+					"clustertemplates",
+				},
+				Verbs: []string{
+					"get",
+					"list",
+					"watch",
+				},
+			},
 		},
 	}
 
@@ -1500,6 +1514,7 @@ func (t *reconcilerTask) deployServer(ctx context.Context, serverName string) (u
 							"--tls-cert-file=/secrets/tls/tls.crt",
 							"--tls-private-key-file=/secrets/tls/tls.key",
 							"--tls-min-version=VersionTLS12",
+							"--auth-header-fields-enabled=true",
 							"--v=0"},
 						Ports: []corev1.ContainerPort{
 							{
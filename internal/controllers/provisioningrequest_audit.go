@@ -0,0 +1,226 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+	"github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
+)
+
+// redactedValue replaces the values of the Secrets in the recorded manifests
+const redactedValue = "<redacted>"
+
+// renderedManifestsRetention is the number of generations of a ProvisioningRequest whose rendered manifests are
+// kept, the ConfigMaps of the older generations are deleted
+const renderedManifestsRetention = 5
+
+// maxRenderedManifestsSize is the maximum size of the data of the ConfigMap recording the rendered manifests, as
+// the API server rejects the ConfigMaps larger than 1MiB
+const maxRenderedManifestsSize = 1024 * 1024
+
+// trackAppliedManifest remembers an object applied for the ProvisioningRequest during this reconciliation, so that
+// it is included in the rendered manifests recorded for auditing.
+func (t *provisioningRequestReconcilerTask) trackAppliedManifest(object client.Object) {
+	t.appliedManifests = append(t.appliedManifests, object)
+}
+
+// getRenderedManifestsConfigMapName returns the name of the ConfigMap recording the manifests applied for a
+// generation of the ProvisioningRequest
+func getRenderedManifestsConfigMapName(object *provisioningv1alpha1.ProvisioningRequest) string {
+	return fmt.Sprintf("%s-rendered-%d", object.Name, object.Generation)
+}
+
+// getRenderedManifestsGeneration returns the generation of the ProvisioningRequest recorded by a ConfigMap, or false if
+// the ConfigMap does not record its rendered manifests
+func getRenderedManifestsGeneration(object *provisioningv1alpha1.ProvisioningRequest, configMapName string) (int64, bool) {
+	suffix, found := strings.CutPrefix(configMapName, object.Name+"-rendered-")
+	if !found {
+		return 0, false
+	}
+	generation, err := strconv.ParseInt(suffix, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return generation, true
+}
+
+// getRenderedManifestKey returns the key of a manifest in the ConfigMap recording the rendered manifests
+func getRenderedManifestKey(kind string, object client.Object) string {
+	if object.GetNamespace() == "" {
+		return fmt.Sprintf("%s.%s.yaml", strings.ToLower(kind), object.GetName())
+	}
+	return fmt.Sprintf("%s.%s.%s.yaml", strings.ToLower(kind), object.GetNamespace(), object.GetName())
+}
+
+// volatileFields are the fields of the applied objects that are set by the API server or when the objects are
+// applied, rather than rendered. They are removed from the recorded manifests so that the manifests of different
+// generations can be compared.
+var volatileFields = [][]string{
+	{"metadata", "creationTimestamp"},
+	{"metadata", "generation"},
+	{"metadata", "managedFields"},
+	{"metadata", "ownerReferences"},
+	{"metadata", "resourceVersion"},
+	{"metadata", "uid"},
+	{"status"},
+}
+
+// marshalRenderedManifest returns the key and the YAML manifest of an applied object, without its volatile fields.
+// The values of Secrets are redacted and moved to stringData, so that the manifest remains valid.
+func (t *provisioningRequestReconcilerTask) marshalRenderedManifest(object client.Object) (string, string, error) {
+	gvk, err := apiutil.GVKForObject(object, t.client.Scheme())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get the kind of %s: %w", object.GetName(), err)
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to convert %s %s: %w", gvk.Kind, object.GetName(), err)
+	}
+
+	manifest := &unstructured.Unstructured{Object: content}
+	manifest.SetGroupVersionKind(gvk)
+	for _, field := range volatileFields {
+		unstructured.RemoveNestedField(manifest.Object, field...)
+	}
+	if gvk.GroupKind() == (schema.GroupKind{Kind: "Secret"}) {
+		redacted := make(map[string]any)
+		for _, field := range []string{"data", "stringData"} {
+			values, _, _ := unstructured.NestedMap(manifest.Object, field)
+			for key := range values {
+				redacted[key] = redactedValue
+			}
+			unstructured.RemoveNestedField(manifest.Object, field)
+		}
+		if len(redacted) > 0 {
+			manifest.Object["stringData"] = redacted
+		}
+	}
+
+	data, err := yaml.Marshal(manifest.Object)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal %s %s: %w", gvk.Kind, object.GetName(), err)
+	}
+	return getRenderedManifestKey(gvk.Kind, object), string(data), nil
+}
+
+// handleRenderedManifests records the manifests applied during this reconciliation, whichever steps ran, and
+// persists the reference to them in the status
+func (t *provisioningRequestReconcilerTask) handleRenderedManifests(ctx context.Context) error {
+	previous := t.object.Status.RenderedManifests.DeepCopy()
+	if err := t.recordRenderedManifests(ctx); err != nil {
+		return err
+	}
+	if equality.Semantic.DeepEqual(previous, t.object.Status.RenderedManifests) {
+		return nil
+	}
+	if err := utils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
+		return fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, err)
+	}
+	return nil
+}
+
+// recordRenderedManifests stores the manifests applied during this reconciliation in the ConfigMap recording the
+// rendered manifests of the current generation of the ProvisioningRequest, and references it from the status. The
+// manifests applied by earlier reconciliations of the same generation are kept, and the ConfigMap is only written
+// when its content changes. The manifests are not recorded if they exceed the maximum size of a ConfigMap.
+func (t *provisioningRequestReconcilerTask) recordRenderedManifests(ctx context.Context) error {
+	if !t.object.IsFeatureEnabled(provisioningv1alpha1.FeatureAuditRenderedManifests) ||
+		len(t.appliedManifests) == 0 {
+		return nil
+	}
+
+	configMap := &corev1.ConfigMap{}
+	exists, err := utils.DoesK8SResourceExist(
+		ctx, t.client, getRenderedManifestsConfigMapName(t.object), utils.InventoryNamespace, configMap)
+	if err != nil {
+		return fmt.Errorf("failed to get the rendered manifests ConfigMap: %w", err)
+	}
+
+	data := maps.Clone(configMap.Data)
+	if data == nil {
+		data = make(map[string]string)
+	}
+	for _, object := range t.appliedManifests {
+		key, manifest, err := t.marshalRenderedManifest(object)
+		if err != nil {
+			return err
+		}
+		data[key] = manifest
+	}
+
+	size := 0
+	for key, manifest := range data {
+		size += len(key) + len(manifest)
+	}
+	if size > maxRenderedManifestsSize {
+		t.logger.WarnContext(
+			ctx,
+			"The rendered manifests exceed the maximum size of a ConfigMap, they are not recorded",
+			slog.String("name", t.object.Name),
+			slog.Int("size", size),
+		)
+		return nil
+	}
+
+	if !exists || !maps.Equal(data, configMap.Data) {
+		renderedManifests := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      getRenderedManifestsConfigMapName(t.object),
+				Namespace: utils.InventoryNamespace,
+				Labels:    t.provenanceLabels(),
+			},
+			Data: data,
+		}
+		if err := utils.CreateK8sCR(ctx, t.client, renderedManifests, t.object, utils.UPDATE); err != nil {
+			return fmt.Errorf("failed to record the rendered manifests: %w", err)
+		}
+	}
+
+	t.object.Status.RenderedManifests = &provisioningv1alpha1.RenderedManifestsReference{
+		Namespace:  utils.InventoryNamespace,
+		Name:       getRenderedManifestsConfigMapName(t.object),
+		Generation: t.object.Generation,
+	}
+
+	if !exists {
+		return t.pruneRenderedManifests(ctx)
+	}
+	return nil
+}
+
+// pruneRenderedManifests deletes the ConfigMaps recording the rendered manifests of the generations of the
+// ProvisioningRequest older than the retained ones
+func (t *provisioningRequestReconcilerTask) pruneRenderedManifests(ctx context.Context) error {
+	configMaps := &corev1.ConfigMapList{}
+	if err := t.client.List(ctx, configMaps, client.InNamespace(utils.InventoryNamespace),
+		client.MatchingLabels{provisioningRequestNameLabel: t.object.Name}); err != nil {
+		return fmt.Errorf("failed to list the rendered manifests ConfigMaps: %w", err)
+	}
+
+	for i := range configMaps.Items {
+		configMap := &configMaps.Items[i]
+		generation, ok := getRenderedManifestsGeneration(t.object, configMap.Name)
+		if !ok || generation > t.object.Generation-renderedManifestsRetention {
+			continue
+		}
+		if err := t.client.Delete(ctx, configMap); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete the rendered manifests ConfigMap %s: %w", configMap.Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package controllers
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	siteconfig "github.com/stolostron/siteconfig/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+	"github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
+)
+
+var _ = Describe("recordRenderedManifests", func() {
+	var (
+		ctx             context.Context
+		c               client.Client
+		cr              *provisioningv1alpha1.ProvisioningRequest
+		clusterInstance *siteconfig.ClusterInstance
+		ctNamespace     = "clustertemplate-a-v4-16"
+		crName          = "cluster-1"
+	)
+
+	// newTask returns a task reconciling the ProvisioningRequest
+	newTask := func() *provisioningRequestReconcilerTask {
+		return &provisioningRequestReconcilerTask{
+			logger:       logger,
+			client:       c,
+			object:       cr,
+			clusterInput: &clusterInput{},
+			ctDetails: &clusterTemplateDetails{
+				namespace: ctNamespace,
+			},
+		}
+	}
+
+	// applyManifests applies the pull secret and the ClusterInstance, then records the rendered manifests
+	applyManifests := func(task *provisioningRequestReconcilerTask) {
+		Expect(task.createPullSecret(ctx, clusterInstance.DeepCopy())).To(Succeed())
+		applied := clusterInstance.DeepCopy()
+		Expect(task.applyClusterInstance(ctx, applied, false)).To(Succeed())
+		task.trackAppliedManifest(applied)
+		Expect(task.recordRenderedManifests(ctx)).To(Succeed())
+	}
+
+	getRenderedManifests := func() *corev1.ConfigMap {
+		configMap := &corev1.ConfigMap{}
+		Expect(c.Get(ctx, client.ObjectKey{
+			Namespace: utils.InventoryNamespace,
+			Name:      "cluster-1-rendered-2",
+		}, configMap)).To(Succeed())
+		return configMap
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		cr = &provisioningv1alpha1.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       crName,
+				Generation: 2,
+				Annotations: map[string]string{
+					provisioningv1alpha1.FeatureFlagAnnotationPrefix +
+						string(provisioningv1alpha1.FeatureAuditRenderedManifests): "true",
+				},
+			},
+			Spec: provisioningv1alpha1.ProvisioningRequestSpec{
+				TemplateName:    "clustertemplate-a",
+				TemplateVersion: "v1.0.0",
+			},
+		}
+		pullSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pull-secret",
+				Namespace: ctNamespace,
+			},
+			Data: map[string][]byte{".dockerconfigjson": []byte(`{"auths":{}}`)},
+		}
+		clusterInstance = &siteconfig.ClusterInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      crName,
+				Namespace: crName,
+			},
+			Spec: siteconfig.ClusterInstanceSpec{
+				ClusterName:            crName,
+				BaseDomain:             "example.com",
+				ClusterImageSetNameRef: "4.16",
+				PullSecretRef:          corev1.LocalObjectReference{Name: "pull-secret"},
+			},
+		}
+		c = getFakeClientFromObjects(cr, pullSecret)
+	})
+
+	It("records the applied manifests and references them from the status", func() {
+		task := newTask()
+		applyManifests(task)
+
+		Expect(cr.Status.RenderedManifests).To(Equal(&provisioningv1alpha1.RenderedManifestsReference{
+			Namespace:  utils.InventoryNamespace,
+			Name:       "cluster-1-rendered-2",
+			Generation: 2,
+		}))
+		configMap := getRenderedManifests()
+		Expect(configMap.Labels).To(HaveKeyWithValue(provisioningRequestGenerationLabel, "2"))
+		Expect(configMap.Data).To(HaveLen(2))
+
+		// The recorded ClusterInstance matches the one that was created
+		created := &siteconfig.ClusterInstance{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(clusterInstance), created)).To(Succeed())
+		recorded := &siteconfig.ClusterInstance{}
+		Expect(configMap.Data).To(HaveKey("clusterinstance.cluster-1.cluster-1.yaml"))
+		Expect(yaml.Unmarshal([]byte(configMap.Data["clusterinstance.cluster-1.cluster-1.yaml"]), recorded)).To(Succeed())
+		Expect(recorded.Kind).To(Equal("ClusterInstance"))
+		Expect(recorded.Spec).To(Equal(created.Spec))
+		Expect(recorded.ResourceVersion).To(BeEmpty())
+
+		// The values of the Secrets are redacted
+		recordedSecret := &corev1.Secret{}
+		Expect(configMap.Data).To(HaveKey("secret.cluster-1.pull-secret.yaml"))
+		Expect(yaml.Unmarshal([]byte(configMap.Data["secret.cluster-1.pull-secret.yaml"]), recordedSecret)).To(Succeed())
+		Expect(recordedSecret.Data).To(BeEmpty())
+		Expect(recordedSecret.StringData).To(Equal(map[string]string{".dockerconfigjson": redactedValue}))
+		Expect(configMap.Data["secret.cluster-1.pull-secret.yaml"]).ToNot(ContainSubstring("auths"))
+	})
+
+	It("only writes the ConfigMap when the manifests change", func() {
+		applyManifests(newTask())
+		resourceVersion := getRenderedManifests().ResourceVersion
+
+		// Applying the same manifests again does not write the ConfigMap
+		applyManifests(newTask())
+		Expect(getRenderedManifests().ResourceVersion).To(Equal(resourceVersion))
+
+		// A manifest applied by a later reconciliation of the same generation is added to the others
+		task := newTask()
+		task.trackAppliedManifest(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: crName}})
+		Expect(task.recordRenderedManifests(ctx)).To(Succeed())
+		configMap := getRenderedManifests()
+		Expect(configMap.ResourceVersion).ToNot(Equal(resourceVersion))
+		Expect(configMap.Data).To(HaveLen(3))
+		Expect(configMap.Data).To(HaveKey("namespace.cluster-1.yaml"))
+	})
+
+	It("deletes the rendered manifests of the generations older than the retained ones", func() {
+		for generation := int64(1); generation <= 2+renderedManifestsRetention; generation++ {
+			cr.Generation = generation
+			applyManifests(newTask())
+		}
+
+		configMaps := &corev1.ConfigMapList{}
+		Expect(c.List(ctx, configMaps, client.InNamespace(utils.InventoryNamespace))).To(Succeed())
+		var names []string
+		for _, configMap := range configMaps.Items {
+			names = append(names, configMap.Name)
+		}
+		Expect(names).To(ConsistOf(
+			"cluster-1-rendered-3", "cluster-1-rendered-4", "cluster-1-rendered-5",
+			"cluster-1-rendered-6", "cluster-1-rendered-7"))
+	})
+
+	It("does not record the manifests exceeding the maximum size of a ConfigMap", func() {
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "large", Namespace: crName},
+			Data:       map[string]string{"data": strings.Repeat("x", maxRenderedManifestsSize)},
+		}
+		task := newTask()
+		task.trackAppliedManifest(configMap)
+		Expect(task.recordRenderedManifests(ctx)).To(Succeed())
+
+		Expect(cr.Status.RenderedManifests).To(BeNil())
+		configMaps := &corev1.ConfigMapList{}
+		Expect(c.List(ctx, configMaps, client.InNamespace(utils.InventoryNamespace))).To(Succeed())
+		Expect(configMaps.Items).To(BeEmpty())
+	})
+
+	It("records nothing when the feature flag is not enabled", func() {
+		cr.Annotations = nil
+		applyManifests(newTask())
+
+		Expect(cr.Status.RenderedManifests).To(BeNil())
+		configMaps := &corev1.ConfigMapList{}
+		Expect(c.List(ctx, configMaps, client.InNamespace(utils.InventoryNamespace))).To(Succeed())
+		Expect(configMaps.Items).To(BeEmpty())
+	})
+})
@@ -3,16 +3,23 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	hwv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
 	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
 	"github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
+	siteconfig "github.com/stolostron/siteconfig/api/v1alpha1"
 	clusterv1 "open-cluster-management.io/api/cluster/v1"
+	clusterv1beta1 "open-cluster-management.io/api/cluster/v1beta1"
 	policiesv1 "open-cluster-management.io/governance-policy-propagator/api/v1"
 )
 
@@ -24,25 +31,32 @@ func (t *provisioningRequestReconcilerTask) handleClusterPolicyConfiguration(ctx
 		return false, fmt.Errorf("status.clusterDetails is empty")
 	}
 
-	// Get all the child policies in the namespace of the managed cluster created through
-	// the ProvisioningRequest.
-	policies := &policiesv1.PolicyList{}
-	listOpts := []client.ListOption{
-		client.HasLabels{utils.ChildPolicyRootPolicyLabel},
-		client.InNamespace(t.object.Status.Extensions.ClusterDetails.Name),
+	// Hold the configuration until the change window of the ClusterTemplate opens.
+	waitingForChangeWindow, err := t.waitForChangeWindow(ctx)
+	if err != nil || waitingForChangeWindow {
+		return waitingForChangeWindow, err
 	}
 
-	err = t.client.List(ctx, policies, listOpts...)
+	// Get all the policies in the namespace of the managed cluster created through the
+	// ProvisioningRequest.
+	policies := &policiesv1.PolicyList{}
+	err = t.client.List(ctx, policies, client.InNamespace(t.object.Status.Extensions.ClusterDetails.Name))
 	if err != nil {
 		return false, fmt.Errorf("failed to list Policies: %w", err)
 	}
 
+	// Verify that the child policies carry the labels the policy matching relies on.
+	t.verifyPolicyBinding(ctx, policies.Items)
+
 	allPoliciesCompliant := true
 	allPoliciesInInform := true
 	var targetPolicies []provisioningv1alpha1.PolicyDetails
 	// Go through all the policies and get those that are matched with the managed cluster created
 	// by the current provisioning request.
 	for _, policy := range policies.Items {
+		if _, isChildPolicy := policy.GetLabels()[utils.ChildPolicyRootPolicyLabel]; !isChildPolicy {
+			continue
+		}
 		targetPolicyName, targetPolicyNamespace := utils.GetParentPolicyNameAndNamespace(policy.Name)
 		if !utils.IsParentPolicyInZtpClusterTemplateNs(targetPolicyNamespace, t.ctDetails.namespace) {
 			continue
@@ -63,6 +77,20 @@ func (t *provisioningRequestReconcilerTask) handleClusterPolicyConfiguration(ctx
 			allPoliciesInInform = false
 		}
 	}
+
+	// Keep reporting the expected policies that went missing so that the configuration status does not go stale.
+	missingPolicies, err := t.correctPolicyDrift(ctx, targetPolicies)
+	if err != nil {
+		return false, err
+	}
+	for _, missingPolicy := range missingPolicies {
+		targetPolicies = append(targetPolicies, missingPolicy)
+		allPoliciesCompliant = false
+		if !strings.EqualFold(missingPolicy.RemediationAction, string(policiesv1.Inform)) {
+			allPoliciesInInform = false
+		}
+	}
+
 	policyConfigTimedOut, err := t.updateConfigurationAppliedStatus(
 		ctx, targetPolicies, allPoliciesCompliant, allPoliciesInInform)
 	if err != nil {
@@ -72,14 +100,293 @@ func (t *provisioningRequestReconcilerTask) handleClusterPolicyConfiguration(ctx
 	if err != nil {
 		return false, err
 	}
-	err = t.finalizeProvisioningIfComplete(ctx, allPoliciesCompliant)
+	fulfillmentWithheld, err := t.finalizeProvisioningIfComplete(ctx, allPoliciesCompliant)
 	if err != nil {
 		return false, err
 	}
 
 	// If there are policies that are not Compliant and the configuration has not timed out,
-	// we need to requeue and see if the timeout is reached.
-	return (!allPoliciesCompliant && !allPoliciesInInform) && !policyConfigTimedOut, nil
+	// we need to requeue and see if the timeout is reached. We also need to requeue while the
+	// fulfillment is withheld to poll the downstream health signals.
+	return ((!allPoliciesCompliant && !allPoliciesInInform) && !policyConfigTimedOut) || fulfillmentWithheld, nil
+}
+
+// correctPolicyDrift restores, once the cluster is provisioned, the ManagedCluster labels the policy placements
+// select on when they were removed out-of-band. It then returns, as pending, the root policies placed on the
+// managed cluster whose child policy is missing, so that the configuration status does not go stale until the
+// policy propagator recreates them.
+func (t *provisioningRequestReconcilerTask) correctPolicyDrift(
+	ctx context.Context, currentPolicies []provisioningv1alpha1.PolicyDetails) ([]provisioningv1alpha1.PolicyDetails, error) {
+	clusterName := t.object.Status.Extensions.ClusterDetails.Name
+	ztpNamespace := fmt.Sprintf("ztp-%s", t.ctDetails.namespace)
+
+	rootPolicies := &policiesv1.PolicyList{}
+	if err := t.client.List(ctx, rootPolicies, client.InNamespace(ztpNamespace)); err != nil {
+		return nil, fmt.Errorf("failed to list Policies in namespace %s: %w", ztpNamespace, err)
+	}
+
+	// The labels are still being applied by the installation while the cluster is provisioning.
+	if utils.IsClusterProvisionCompleted(t.object) {
+		corrected, err := t.restoreManagedClusterLabels(ctx, rootPolicies.Items)
+		if err != nil {
+			return nil, err
+		}
+		if corrected && t.recorder != nil {
+			t.recorder.Eventf(t.object, corev1.EventTypeNormal, policyDriftCorrectedEvent,
+				"Re-applied the labels of ManagedCluster %s to restore the binding of its policies", clusterName)
+		}
+	}
+
+	var missingPolicies []provisioningv1alpha1.PolicyDetails
+	var missingPolicyNames []string
+	for _, rootPolicy := range rootPolicies.Items {
+		if !isPolicyPlacedOnCluster(rootPolicy, clusterName) {
+			continue
+		}
+		if slices.ContainsFunc(currentPolicies, func(current provisioningv1alpha1.PolicyDetails) bool {
+			return current.PolicyName == rootPolicy.Name && current.PolicyNamespace == rootPolicy.Namespace
+		}) {
+			continue
+		}
+
+		missingPolicies = append(missingPolicies, provisioningv1alpha1.PolicyDetails{
+			Compliant:         string(policiesv1.Pending),
+			PolicyName:        rootPolicy.Name,
+			PolicyNamespace:   rootPolicy.Namespace,
+			RemediationAction: string(rootPolicy.Spec.RemediationAction),
+		})
+		missingPolicyNames = append(missingPolicyNames, rootPolicy.Namespace+"."+rootPolicy.Name)
+	}
+	if len(missingPolicies) != 0 {
+		t.logger.InfoContext(ctx, fmt.Sprintf("Expected policies are missing for cluster %s: %s",
+			clusterName, strings.Join(missingPolicyNames, ", ")))
+	}
+
+	return missingPolicies, nil
+}
+
+// isPolicyPlacedOnCluster checks if the placement decisions of a root policy include the managed cluster.
+func isPolicyPlacedOnCluster(rootPolicy policiesv1.Policy, clusterName string) bool {
+	return slices.ContainsFunc(rootPolicy.Status.Placement, func(placement *policiesv1.Placement) bool {
+		return placement != nil && slices.ContainsFunc(placement.Decisions,
+			func(decision policiesv1.PlacementDecision) bool {
+				return decision.ClusterName == clusterName
+			})
+	})
+}
+
+// getPlacementLabelKeys returns the keys of the ManagedCluster labels the Placements bound to the root policies
+// select on.
+func (t *provisioningRequestReconcilerTask) getPlacementLabelKeys(
+	ctx context.Context, rootPolicies []policiesv1.Policy) (map[string]bool, error) {
+	keys := make(map[string]bool)
+	checked := make(map[types.NamespacedName]bool)
+	for _, rootPolicy := range rootPolicies {
+		for _, placementStatus := range rootPolicy.Status.Placement {
+			if placementStatus == nil || placementStatus.Placement == "" {
+				continue
+			}
+			key := types.NamespacedName{Name: placementStatus.Placement, Namespace: rootPolicy.Namespace}
+			if checked[key] {
+				continue
+			}
+			checked[key] = true
+
+			placement := &clusterv1beta1.Placement{}
+			exists, err := utils.DoesK8SResourceExist(ctx, t.client, key.Name, key.Namespace, placement)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get Placement %s in namespace %s: %w", key.Name, key.Namespace, err)
+			}
+			if !exists {
+				continue
+			}
+			for _, predicate := range placement.Spec.Predicates {
+				selector := predicate.RequiredClusterSelector.LabelSelector
+				for label := range selector.MatchLabels {
+					keys[label] = true
+				}
+				for _, expression := range selector.MatchExpressions {
+					keys[expression.Key] = true
+				}
+			}
+		}
+	}
+	return keys, nil
+}
+
+// restoreManagedClusterLabels re-applies the ManagedCluster labels requested through the ClusterInstance that the
+// placements of the root policies select on and that were removed out-of-band. Labels whose value was changed are
+// left as they are. It returns whether any label had to be restored.
+func (t *provisioningRequestReconcilerTask) restoreManagedClusterLabels(
+	ctx context.Context, rootPolicies []policiesv1.Policy) (bool, error) {
+	clusterName := t.object.Status.Extensions.ClusterDetails.Name
+
+	placementKeys, err := t.getPlacementLabelKeys(ctx, rootPolicies)
+	if err != nil || len(placementKeys) == 0 {
+		return false, err
+	}
+
+	clusterInstance := &siteconfig.ClusterInstance{}
+	exists, err := utils.DoesK8SResourceExist(ctx, t.client, clusterName, clusterName, clusterInstance)
+	if err != nil {
+		return false, fmt.Errorf("failed to get ClusterInstance %s: %w", clusterName, err)
+	}
+	if !exists {
+		return false, nil
+	}
+
+	managedCluster := &clusterv1.ManagedCluster{}
+	exists, err = utils.DoesK8SResourceExist(ctx, t.client, clusterName, "", managedCluster)
+	if err != nil {
+		return false, fmt.Errorf("failed to get ManagedCluster %s: %w", clusterName, err)
+	}
+	if !exists {
+		return false, nil
+	}
+
+	patch := client.MergeFrom(managedCluster.DeepCopy())
+	labels := managedCluster.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	corrected := false
+	for key, value := range clusterInstance.Spec.ExtraLabels["ManagedCluster"] {
+		if _, found := labels[key]; found || !placementKeys[key] {
+			continue
+		}
+		labels[key] = value
+		corrected = true
+	}
+	if !corrected {
+		return false, nil
+	}
+
+	managedCluster.SetLabels(labels)
+	if err := t.client.Patch(ctx, managedCluster, patch); err != nil {
+		return false, fmt.Errorf("failed to restore the labels of ManagedCluster %s: %w", clusterName, err)
+	}
+	return true, nil
+}
+
+// waitForChangeWindow defers the enforcement of the configuration to the change window of the ClusterTemplate. Once
+// the window has opened, the ManagedCluster is labeled so that the placements of the deferred enforce policies select
+// it. The label is kept after the window has closed for as long as the generation of the ProvisioningRequest it was
+// set for does not change, and is removed otherwise so that a new configuration waits for the next window. It returns
+// whether the configuration is still waiting for the window, and records the time until it opens for the requeue.
+func (t *provisioningRequestReconcilerTask) waitForChangeWindow(ctx context.Context) (bool, error) {
+	if t.ctDetails == nil || t.ctDetails.changeWindow == nil {
+		return false, nil
+	}
+	clusterName := t.object.Status.Extensions.ClusterDetails.Name
+	generation := strconv.FormatInt(t.object.Generation, 10)
+
+	managedCluster := &clusterv1.ManagedCluster{}
+	exists, err := utils.DoesK8SResourceExist(ctx, t.client, clusterName, "", managedCluster)
+	if err != nil {
+		return false, fmt.Errorf("failed to get ManagedCluster %s: %w", clusterName, err)
+	}
+	labeled := exists && managedCluster.GetLabels()[changeWindowOpenLabel] == "true"
+	if labeled && managedCluster.GetAnnotations()[changeWindowGenerationAnnotation] == generation {
+		return false, nil
+	}
+
+	untilOpen, err := utils.TimeUntilChangeWindow(t.ctDetails.changeWindow, time.Now())
+	if err != nil {
+		return false, err
+	}
+	if exists && (untilOpen == 0 || labeled) {
+		patch := client.MergeFrom(managedCluster.DeepCopy())
+		labels := managedCluster.GetLabels()
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		annotations := managedCluster.GetAnnotations()
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		if untilOpen == 0 {
+			labels[changeWindowOpenLabel] = "true"
+			annotations[changeWindowGenerationAnnotation] = generation
+		} else {
+			delete(labels, changeWindowOpenLabel)
+			delete(annotations, changeWindowGenerationAnnotation)
+		}
+		managedCluster.SetLabels(labels)
+		managedCluster.SetAnnotations(annotations)
+		if err := t.client.Patch(ctx, managedCluster, patch); err != nil {
+			return false, fmt.Errorf("failed to update the change window label of ManagedCluster %s: %w",
+				clusterName, err)
+		}
+		if untilOpen == 0 {
+			t.logger.InfoContext(ctx, fmt.Sprintf("The change window has opened for the configuration of cluster %s",
+				clusterName))
+			return false, nil
+		}
+		t.logger.InfoContext(ctx, fmt.Sprintf(
+			"The configuration of cluster %s has changed since the change window was open, waiting for the next one",
+			clusterName))
+	}
+
+	utils.SetStatusCondition(&t.object.Status.Conditions,
+		provisioningv1alpha1.PRconditionTypes.ConfigurationApplied,
+		provisioningv1alpha1.CRconditionReasons.WaitingForChangeWindow,
+		metav1.ConditionFalse,
+		fmt.Sprintf("The configuration is deferred to the change window %s-%s UTC",
+			t.ctDetails.changeWindow.Start, t.ctDetails.changeWindow.End),
+	)
+	if utils.IsClusterProvisionCompleted(t.object) {
+		utils.SetProvisioningStateInProgress(t.object, "Waiting for the configuration change window to open")
+	}
+	if err := utils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
+		return false, fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, err)
+	}
+	t.changeWindowWait = untilOpen
+	return true, nil
+}
+
+// verifyPolicyBinding checks that the child policies of the ClusterTemplate's ztp namespace propagated to the
+// managed cluster namespace carry the expected child policy labels. Policies missing these labels are silently
+// ignored by the policy matching, so the PolicyBindingMismatch condition is set to report them. The condition
+// is removed once the labels are all present. The status is updated along with the ConfigurationApplied condition.
+func (t *provisioningRequestReconcilerTask) verifyPolicyBinding(ctx context.Context, policies []policiesv1.Policy) {
+	clusterName := t.object.Status.Extensions.ClusterDetails.Name
+
+	var mismatched []string
+	for _, policy := range policies {
+		// Child policies are named <parent namespace>.<parent name>
+		if !strings.Contains(policy.Name, ".") {
+			continue
+		}
+		_, parentNamespace := utils.GetParentPolicyNameAndNamespace(policy.Name)
+		if !utils.IsParentPolicyInZtpClusterTemplateNs(parentNamespace, t.ctDetails.namespace) {
+			continue
+		}
+
+		labels := policy.GetLabels()
+		if labels[utils.ChildPolicyRootPolicyLabel] != policy.Name ||
+			labels[utils.ChildPolicyClusterNameLabel] != clusterName ||
+			labels[utils.ChildPolicyClusterNamespaceLabel] != clusterName {
+			mismatched = append(mismatched, policy.Name)
+		}
+	}
+
+	if len(mismatched) == 0 {
+		meta.RemoveStatusCondition(&t.object.Status.Conditions,
+			string(provisioningv1alpha1.PRconditionTypes.PolicyBindingMismatch))
+		return
+	}
+
+	t.logger.WarnContext(
+		ctx,
+		fmt.Sprintf("Policies in namespace %s are missing the expected child policy labels: %s",
+			clusterName, strings.Join(mismatched, ", ")))
+	utils.SetStatusCondition(&t.object.Status.Conditions,
+		provisioningv1alpha1.PRconditionTypes.PolicyBindingMismatch,
+		provisioningv1alpha1.CRconditionReasons.LabelsMismatched,
+		metav1.ConditionTrue,
+		fmt.Sprintf("Policies are missing the expected child policy labels and are not matched with the cluster: %s",
+			strings.Join(mismatched, ", ")),
+	)
 }
 
 // updateConfigurationAppliedStatus updates the ProvisioningRequest ConfigurationApplied condition
@@ -92,6 +399,7 @@ func (t *provisioningRequestReconcilerTask) updateConfigurationAppliedStatus(
 
 	defer func() {
 		t.object.Status.Extensions.Policies = targetPolicies
+		t.object.Status.Extensions.PolicyCompliance = summarizePolicyCompliance(targetPolicies)
 		// Update the current policy status.
 		if updateErr := utils.UpdateK8sCRStatus(ctx, t.client, t.object); updateErr != nil {
 			err = fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, updateErr)
@@ -187,6 +495,23 @@ func (t *provisioningRequestReconcilerTask) updateConfigurationAppliedStatus(
 	return
 }
 
+// summarizePolicyCompliance counts the compliant policies among the policies matched with the
+// managed cluster. It returns nil when no policy is matched.
+func summarizePolicyCompliance(policies []provisioningv1alpha1.PolicyDetails) *provisioningv1alpha1.PolicyComplianceSummary {
+	if len(policies) == 0 {
+		return nil
+	}
+
+	summary := &provisioningv1alpha1.PolicyComplianceSummary{TotalPolicies: len(policies)}
+	for _, policy := range policies {
+		if policy.Compliant == string(policiesv1.Compliant) {
+			summary.CompliantPolicies++
+		}
+	}
+	summary.CompliancePercentage = summary.CompliantPolicies * 100 / summary.TotalPolicies
+	return summary
+}
+
 // updateZTPStatus updates status.ClusterDetails.ZtpStatus.
 func (t *provisioningRequestReconcilerTask) updateZTPStatus(ctx context.Context, allPoliciesCompliant bool) error {
 	// Check if the cluster provision has started.
@@ -231,21 +556,178 @@ func (t *provisioningRequestReconcilerTask) updateOCloudNodeClusterId(ctx contex
 	return nil
 }
 
+// updateResourceAccounting stores the summary of the compute resources allocated to the cluster
+// in the provisioningStatus, based on the hardware template and the nodes allocated to the NodePool.
+func (t *provisioningRequestReconcilerTask) updateResourceAccounting(ctx context.Context) error {
+	if t.isHardwareProvisionSkipped() || t.object.Status.Extensions.NodePoolRef == nil {
+		return nil
+	}
+
+	hwTemplate, err := utils.GetHardwareTemplate(ctx, t.client, t.getHwTemplateName(t.ctDetails.templates))
+	if err != nil {
+		return fmt.Errorf("failed to get the hardware template for resource accounting: %w", err)
+	}
+
+	nodePool := &hwv1alpha1.NodePool{}
+	exists, err := utils.DoesK8SResourceExist(ctx, t.client,
+		t.object.Status.Extensions.NodePoolRef.Name, t.object.Status.Extensions.NodePoolRef.Namespace, nodePool)
+	if err != nil {
+		return fmt.Errorf("failed to get NodePool %s in namespace %s: %w",
+			t.object.Status.Extensions.NodePoolRef.Name, t.object.Status.Extensions.NodePoolRef.Namespace, err)
+	}
+	if !exists {
+		return nil
+	}
+
+	nodes, err := utils.GetNodePoolNodes(ctx, t.client, nodePool)
+	if err != nil {
+		return fmt.Errorf("failed to get the nodes for resource accounting: %w", err)
+	}
+
+	t.object.Status.ProvisioningStatus.ResourceAccounting = utils.ComputeResourceAccounting(hwTemplate, nodes)
+	return nil
+}
+
 // finalizeProvisioningIfComplete checks if the provisioning process is completed.
 // If so, it sets the provisioning state to "fulfilled" and updates the provisioned
-// resources in the status.
-func (t *provisioningRequestReconcilerTask) finalizeProvisioningIfComplete(ctx context.Context, allPoliciesCompliant bool) error {
+// resources in the status. When the ClusterTemplate requires downstream health signals,
+// the fulfillment is withheld until they hold and true is returned so that they are polled.
+// The health signals only gate the transition to fulfilled, a fulfilled ProvisioningRequest
+// is not withdrawn when they stop holding.
+func (t *provisioningRequestReconcilerTask) finalizeProvisioningIfComplete(ctx context.Context, allPoliciesCompliant bool) (bool, error) {
+	withheld := false
 	if utils.IsClusterProvisionCompleted(t.object) && allPoliciesCompliant {
-		utils.SetProvisioningStateFulfilled(t.object)
-		if err := t.updateOCloudNodeClusterId(ctx); err != nil {
-			return err
+		fulfilled := t.object.Status.ProvisioningStatus.ProvisioningPhase == provisioningv1alpha1.StateFulfilled
+		unhealthy, degraded := "", ""
+		var err error
+		if !fulfilled {
+			if unhealthy, err = t.checkFulfillmentHealth(ctx); err != nil {
+				return false, err
+			}
+		}
+		// Re-check the hardware last, as it can degrade between the allocation and the fulfillment
+		if !fulfilled && unhealthy == "" {
+			if degraded, err = t.checkHardwareHealth(ctx); err != nil {
+				return false, err
+			}
+		}
+		if unhealthy != "" {
+			withheld = true
+			utils.SetProvisioningStateInProgress(t.object,
+				fmt.Sprintf("Waiting for the cluster to be healthy: %s", unhealthy))
+		} else if degraded != "" {
+			withheld = true
+			utils.SetProvisioningStateInProgress(t.object,
+				fmt.Sprintf("Waiting for the hardware to be healthy: %s", degraded))
+		} else {
+			utils.SetProvisioningStateFulfilled(t.object)
+			if err := t.updateOCloudNodeClusterId(ctx); err != nil {
+				return false, err
+			}
+			if err := t.updateResourceAccounting(ctx); err != nil {
+				return false, err
+			}
 		}
 	}
 
 	if err := utils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
-		return fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, err)
+		return false, fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, err)
 	}
-	return nil
+	return withheld, nil
+}
+
+// checkFulfillmentHealth checks the downstream health signals required by the ClusterTemplate before the
+// ProvisioningRequest is declared fulfilled. It returns a description of the first signal that does not hold,
+// or an empty string if all of them hold.
+func (t *provisioningRequestReconcilerTask) checkFulfillmentHealth(ctx context.Context) (string, error) {
+	checks := t.ctDetails.fulfillmentChecks
+	if checks == nil || (!checks.ManagedClusterAvailable && !checks.AddOnsAvailable) {
+		return "", nil
+	}
+
+	clusterName := t.object.Status.Extensions.ClusterDetails.Name
+	managedCluster := &clusterv1.ManagedCluster{}
+	exists, err := utils.DoesK8SResourceExist(ctx, t.client, clusterName, "", managedCluster)
+	if err != nil {
+		return "", fmt.Errorf("failed to get ManagedCluster %s: %w", clusterName, err)
+	}
+	if !exists {
+		return fmt.Sprintf("ManagedCluster %s does not exist", clusterName), nil
+	}
+
+	if checks.ManagedClusterAvailable &&
+		!meta.IsStatusConditionTrue(managedCluster.Status.Conditions, clusterv1.ManagedClusterConditionAvailable) {
+		return fmt.Sprintf("ManagedCluster %s is not available", clusterName), nil
+	}
+
+	if checks.AddOnsAvailable {
+		var addOns, unavailable []string
+		for label, value := range managedCluster.GetLabels() {
+			addOn, found := strings.CutPrefix(label, utils.ManagedClusterAddOnLabelPrefix)
+			if !found {
+				continue
+			}
+			addOns = append(addOns, addOn)
+			if value != utils.ManagedClusterAddOnAvailable {
+				unavailable = append(unavailable, addOn)
+			}
+		}
+		if len(addOns) == 0 {
+			return fmt.Sprintf("no add-ons are reported for ManagedCluster %s", clusterName), nil
+		}
+		if len(unavailable) > 0 {
+			slices.Sort(unavailable)
+			return fmt.Sprintf("add-ons %s of ManagedCluster %s are not available",
+				strings.Join(unavailable, ", "), clusterName), nil
+		}
+	}
+
+	return "", nil
+}
+
+// checkHardwareHealth re-checks the health reported by the hardware plugin for the nodes allocated to the cluster
+// and reflects it in the HardwareHealthy condition. The condition is only added once a node has been found degraded.
+// It returns a description of the degraded nodes, or an empty string if all of them are healthy.
+func (t *provisioningRequestReconcilerTask) checkHardwareHealth(ctx context.Context) (string, error) {
+	if t.isHardwareProvisionSkipped() || t.object.Status.Extensions.NodePoolRef == nil {
+		return "", nil
+	}
+
+	nodePool := &hwv1alpha1.NodePool{}
+	exists, err := utils.DoesK8SResourceExist(ctx, t.client,
+		t.object.Status.Extensions.NodePoolRef.Name, t.object.Status.Extensions.NodePoolRef.Namespace, nodePool)
+	if err != nil {
+		return "", fmt.Errorf("failed to get NodePool %s in namespace %s: %w",
+			t.object.Status.Extensions.NodePoolRef.Name, t.object.Status.Extensions.NodePoolRef.Namespace, err)
+	}
+	if !exists {
+		return "", nil
+	}
+
+	nodes, err := utils.GetNodePoolNodes(ctx, t.client, nodePool)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the nodes for the hardware health check: %w", err)
+	}
+
+	degraded := ""
+	if degradedNodes := utils.GetDegradedNodes(nodes); len(degradedNodes) > 0 {
+		degraded = fmt.Sprintf("nodes %s are degraded", strings.Join(degradedNodes, ", "))
+		utils.SetStatusCondition(&t.object.Status.Conditions,
+			provisioningv1alpha1.PRconditionTypes.HardwareHealthy,
+			provisioningv1alpha1.CRconditionReasons.Degraded,
+			metav1.ConditionFalse,
+			"The hardware plugin reports "+degraded,
+		)
+	} else if meta.FindStatusCondition(t.object.Status.Conditions,
+		string(provisioningv1alpha1.PRconditionTypes.HardwareHealthy)) != nil {
+		utils.SetStatusCondition(&t.object.Status.Conditions,
+			provisioningv1alpha1.PRconditionTypes.HardwareHealthy,
+			provisioningv1alpha1.CRconditionReasons.Completed,
+			metav1.ConditionTrue,
+			"All the nodes are healthy",
+		)
+	}
+	return degraded, nil
 }
 
 // hasPolicyConfigurationTimedOut determines if the policy configuration for the
@@ -281,7 +763,9 @@ func (t *provisioningRequestReconcilerTask) hasPolicyConfigurationTimedOut(ctx c
 			policyTimedOut = true
 		case string(provisioningv1alpha1.CRconditionReasons.Missing):
 			t.object.Status.Extensions.ClusterDetails.NonCompliantAt = &metav1.Time{Time: time.Now()}
-		case string(provisioningv1alpha1.CRconditionReasons.OutOfDate):
+		case string(provisioningv1alpha1.CRconditionReasons.OutOfDate),
+			string(provisioningv1alpha1.CRconditionReasons.WaitingForChangeWindow):
+			// The configuration timeout starts once the change window has opened
 			t.object.Status.Extensions.ClusterDetails.NonCompliantAt = &metav1.Time{Time: time.Now()}
 		case string(provisioningv1alpha1.CRconditionReasons.ClusterNotReady):
 			// The cluster might not be ready because its being initially provisioned or
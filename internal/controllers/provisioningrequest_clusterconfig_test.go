@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -12,6 +13,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -20,6 +22,7 @@ import (
 	"github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
 	siteconfig "github.com/stolostron/siteconfig/api/v1alpha1"
 	clusterv1 "open-cluster-management.io/api/cluster/v1"
+	clusterv1beta1 "open-cluster-management.io/api/cluster/v1beta1"
 	policiesv1 "open-cluster-management.io/governance-policy-propagator/api/v1"
 )
 
@@ -220,6 +223,7 @@ defaultHugepagesSize: "1G"`,
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "cluster-1",
 				Namespace: utils.UnitTestHwmgrNamespace,
+				Labels:    map[string]string{provisioningRequestNameLabel: "cluster-1"},
 				Annotations: map[string]string{
 					utils.HwTemplateBootIfaceLabel: "bootable-interface",
 				},
@@ -1264,6 +1268,13 @@ defaultHugepagesSize: "1G"`,
 				},
 			},
 		))
+		Expect(CRTask.object.Status.Extensions.PolicyCompliance).To(Equal(
+			&provisioningv1alpha1.PolicyComplianceSummary{
+				CompliantPolicies:    1,
+				TotalPolicies:        2,
+				CompliancePercentage: 50,
+			},
+		))
 
 		// Verify that the ConfigurationApplied condition is set to InProgress.
 		conditions := CRTask.object.Status.Conditions
@@ -1807,6 +1818,33 @@ defaultHugepagesSize: "1G"`,
 	})
 })
 
+var _ = Describe("summarizePolicyCompliance", func() {
+	It("returns nil when no policy is matched", func() {
+		Expect(summarizePolicyCompliance(nil)).To(BeNil())
+	})
+
+	It("counts the compliant policies of a mixed set", func() {
+		summary := summarizePolicyCompliance([]provisioningv1alpha1.PolicyDetails{
+			{PolicyName: "v1-sriov-configuration-policy", Compliant: "Compliant"},
+			{PolicyName: "v1-subscriptions-policy", Compliant: "NonCompliant"},
+			{PolicyName: "v1-validator-policy", Compliant: "Pending"},
+		})
+		Expect(summary).To(Equal(&provisioningv1alpha1.PolicyComplianceSummary{
+			CompliantPolicies:    1,
+			TotalPolicies:        3,
+			CompliancePercentage: 33,
+		}))
+	})
+
+	It("reports full compliance", func() {
+		summary := summarizePolicyCompliance([]provisioningv1alpha1.PolicyDetails{
+			{PolicyName: "v1-sriov-configuration-policy", Compliant: "Compliant"},
+			{PolicyName: "v1-subscriptions-policy", Compliant: "Compliant"},
+		})
+		Expect(summary.CompliancePercentage).To(Equal(100))
+	})
+})
+
 var _ = Describe("hasPolicyConfigurationTimedOut", func() {
 	var (
 		ctx          context.Context
@@ -1931,6 +1969,19 @@ var _ = Describe("hasPolicyConfigurationTimedOut", func() {
 		Expect(CRTask.object.Status.Extensions.ClusterDetails.NonCompliantAt).ToNot(BeZero())
 	})
 
+	It("Returns false if the status is WaitingForChangeWindow and sets NonCompliantAt", func() {
+		utils.SetStatusCondition(&CRTask.object.Status.Conditions,
+			provisioningv1alpha1.PRconditionTypes.ConfigurationApplied,
+			provisioningv1alpha1.CRconditionReasons.WaitingForChangeWindow,
+			metav1.ConditionFalse,
+			"",
+		)
+		Expect(CRTask.object.Status.Extensions.ClusterDetails.NonCompliantAt).To(BeZero())
+		policyTimedOut := CRTask.hasPolicyConfigurationTimedOut(ctx)
+		Expect(policyTimedOut).To(BeFalse())
+		Expect(CRTask.object.Status.Extensions.ClusterDetails.NonCompliantAt).ToNot(BeZero())
+	})
+
 	It("Returns false if the status is Missing and sets NonCompliantAt", func() {
 		// Set the status to InProgress.
 		utils.SetStatusCondition(&CRTask.object.Status.Conditions,
@@ -1970,3 +2021,779 @@ var _ = Describe("hasPolicyConfigurationTimedOut", func() {
 		Expect(CRTask.object.Status.Extensions.ClusterDetails.NonCompliantAt).ToNot(BeZero())
 	})
 })
+
+var _ = Describe("updateResourceAccounting", func() {
+	var (
+		ctx        context.Context
+		c          client.Client
+		task       *provisioningRequestReconcilerTask
+		cr         *provisioningv1alpha1.ProvisioningRequest
+		crName     = "cluster-1"
+		poolns     = utils.UnitTestHwmgrNamespace
+		hwTemplate = "hwTemplate-v1"
+		masterProf = "profile-spr-single-processor-64G"
+		workerProf = "profile-spr-dual-processor-128G"
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		cr = &provisioningv1alpha1.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: crName,
+			},
+			Status: provisioningv1alpha1.ProvisioningRequestStatus{
+				Extensions: provisioningv1alpha1.Extensions{
+					NodePoolRef: &provisioningv1alpha1.NodePoolRef{
+						Name:      crName,
+						Namespace: poolns,
+					},
+				},
+			},
+		}
+
+		template := &hwv1alpha1.HardwareTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      hwTemplate,
+				Namespace: utils.InventoryNamespace,
+			},
+			Spec: hwv1alpha1.HardwareTemplateSpec{
+				HwMgrId:            utils.UnitTestHwmgrID,
+				BootInterfaceLabel: "bootable-interface",
+				NodePoolData: []hwv1alpha1.NodePoolData{
+					{Name: groupNameController, Role: "master", ResourcePoolId: "xyz", HwProfile: masterProf},
+					{Name: groupNameWorker, Role: "worker", ResourcePoolId: "xyz", HwProfile: workerProf},
+				},
+				HwProfileCapacities: []hwv1alpha1.HwProfileCapacity{
+					{HwProfile: masterProf, VCPUs: 32, MemoryGiB: 64},
+					{HwProfile: workerProf, VCPUs: 64, MemoryGiB: 128},
+				},
+			},
+		}
+
+		np := &hwv1alpha1.NodePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      crName,
+				Namespace: poolns,
+			},
+			Status: hwv1alpha1.NodePoolStatus{
+				Properties: hwv1alpha1.Properties{
+					NodeNames: []string{"master-node-1", "master-node-2", "master-node-3", "worker-node-1", "worker-node-2"},
+				},
+			},
+		}
+
+		objs := []client.Object{cr, template, np}
+		for _, name := range np.Status.Properties.NodeNames[:3] {
+			node := createNode(name, "", "", groupNameController, poolns, crName, nil)
+			node.Status.HwProfile = masterProf
+			objs = append(objs, node)
+		}
+		for _, name := range np.Status.Properties.NodeNames[3:] {
+			node := createNode(name, "", "", groupNameWorker, poolns, crName, nil)
+			node.Status.HwProfile = workerProf
+			objs = append(objs, node)
+		}
+
+		c = getFakeClientFromObjects(objs...)
+		task = &provisioningRequestReconcilerTask{
+			logger: logger,
+			client: c,
+			object: cr,
+			ctDetails: &clusterTemplateDetails{
+				templates: provisioningv1alpha1.Templates{HwTemplate: hwTemplate},
+			},
+		}
+	})
+
+	It("totals the allocated resources of a multi-profile node pool", func() {
+		Expect(task.updateResourceAccounting(ctx)).To(Succeed())
+		Expect(cr.Status.ProvisioningStatus.ResourceAccounting).To(Equal(&provisioningv1alpha1.ResourceAccounting{
+			NodeCount: 5,
+			NodeCountByProfile: map[string]int{
+				masterProf: 3,
+				workerProf: 2,
+			},
+			TotalVCPUs:     3*32 + 2*64,
+			TotalMemoryGiB: 3*64 + 2*128,
+		}))
+	})
+
+	It("skips the accounting when hardware provisioning is skipped", func() {
+		task.ctDetails.templates.HwTemplate = ""
+		Expect(task.updateResourceAccounting(ctx)).To(Succeed())
+		Expect(cr.Status.ProvisioningStatus.ResourceAccounting).To(BeNil())
+	})
+})
+
+var _ = Describe("verifyPolicyBinding", func() {
+	var (
+		ctx         context.Context
+		c           client.Client
+		task        *provisioningRequestReconcilerTask
+		cr          *provisioningv1alpha1.ProvisioningRequest
+		clusterName = "cluster-1"
+		policyName  = "ztp-clustertemplate-a-v4-16.v1-subscriptions-policy"
+	)
+
+	newChildPolicy := func(labels map[string]string) *policiesv1.Policy {
+		return &policiesv1.Policy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      policyName,
+				Namespace: clusterName,
+				Labels:    labels,
+			},
+			Spec: policiesv1.PolicySpec{
+				RemediationAction: "enforce",
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		cr = &provisioningv1alpha1.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: clusterName,
+			},
+			Status: provisioningv1alpha1.ProvisioningRequestStatus{
+				Extensions: provisioningv1alpha1.Extensions{
+					ClusterDetails: &provisioningv1alpha1.ClusterDetails{
+						Name: clusterName,
+					},
+				},
+			},
+		}
+	})
+
+	setupTask := func(objs ...client.Object) {
+		c = getFakeClientFromObjects(append(objs, cr)...)
+		task = &provisioningRequestReconcilerTask{
+			logger:    logger,
+			client:    c,
+			object:    cr,
+			ctDetails: &clusterTemplateDetails{namespace: "clustertemplate-a-v4-16"},
+		}
+	}
+
+	listPolicies := func() []policiesv1.Policy {
+		policies := &policiesv1.PolicyList{}
+		Expect(c.List(ctx, policies, client.InNamespace(clusterName))).To(Succeed())
+		return policies.Items
+	}
+
+	It("does not set the condition when the child policy labels are correct", func() {
+		setupTask(newChildPolicy(map[string]string{
+			utils.ChildPolicyRootPolicyLabel:       policyName,
+			utils.ChildPolicyClusterNameLabel:      clusterName,
+			utils.ChildPolicyClusterNamespaceLabel: clusterName,
+		}))
+
+		task.verifyPolicyBinding(ctx, listPolicies())
+		Expect(meta.FindStatusCondition(cr.Status.Conditions,
+			string(provisioningv1alpha1.PRconditionTypes.PolicyBindingMismatch))).To(BeNil())
+	})
+
+	It("sets the condition when the child policy labels are missing or mismatched", func() {
+		setupTask(newChildPolicy(map[string]string{
+			utils.ChildPolicyClusterNameLabel: "another-cluster",
+		}))
+
+		task.verifyPolicyBinding(ctx, listPolicies())
+		condition := meta.FindStatusCondition(cr.Status.Conditions,
+			string(provisioningv1alpha1.PRconditionTypes.PolicyBindingMismatch))
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(string(provisioningv1alpha1.CRconditionReasons.LabelsMismatched)))
+		Expect(condition.Message).To(ContainSubstring(policyName))
+
+		// Fixing the labels clears the condition
+		policy := &policiesv1.Policy{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: policyName, Namespace: clusterName}, policy)).To(Succeed())
+		policy.Labels = map[string]string{
+			utils.ChildPolicyRootPolicyLabel:       policyName,
+			utils.ChildPolicyClusterNameLabel:      clusterName,
+			utils.ChildPolicyClusterNamespaceLabel: clusterName,
+		}
+		Expect(c.Update(ctx, policy)).To(Succeed())
+
+		task.verifyPolicyBinding(ctx, listPolicies())
+		Expect(meta.FindStatusCondition(cr.Status.Conditions,
+			string(provisioningv1alpha1.PRconditionTypes.PolicyBindingMismatch))).To(BeNil())
+	})
+})
+
+var _ = Describe("correctPolicyDrift", func() {
+	var (
+		ctx             context.Context
+		c               client.Client
+		recorder        *record.FakeRecorder
+		task            *provisioningRequestReconcilerTask
+		cr              *provisioningv1alpha1.ProvisioningRequest
+		clusterName     = "cluster-1"
+		ctNamespace     = "clustertemplate-a-v4-16"
+		rootPolicyName  = "v1-subscriptions-policy"
+		rootPolicyNs    = "ztp-clustertemplate-a-v4-16"
+		childPolicyName = "ztp-clustertemplate-a-v4-16.v1-subscriptions-policy"
+		bindingLabels   = map[string]string{"sites": "site-1", "common": "true"}
+	)
+
+	newChildPolicy := func() *policiesv1.Policy {
+		return &policiesv1.Policy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      childPolicyName,
+				Namespace: clusterName,
+				Labels: map[string]string{
+					utils.ChildPolicyRootPolicyLabel:       childPolicyName,
+					utils.ChildPolicyClusterNameLabel:      clusterName,
+					utils.ChildPolicyClusterNamespaceLabel: clusterName,
+				},
+			},
+			Spec: policiesv1.PolicySpec{
+				RemediationAction: "enforce",
+			},
+			Status: policiesv1.PolicyStatus{
+				ComplianceState: policiesv1.Compliant,
+			},
+		}
+	}
+
+	configurationApplied := func() *metav1.Condition {
+		return meta.FindStatusCondition(task.object.Status.Conditions,
+			string(provisioningv1alpha1.PRconditionTypes.ConfigurationApplied))
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		cr = &provisioningv1alpha1.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: clusterName,
+			},
+			Status: provisioningv1alpha1.ProvisioningRequestStatus{
+				Extensions: provisioningv1alpha1.Extensions{
+					ClusterDetails: &provisioningv1alpha1.ClusterDetails{
+						Name: clusterName,
+					},
+					Policies: []provisioningv1alpha1.PolicyDetails{
+						{
+							Compliant:         string(policiesv1.Compliant),
+							PolicyName:        rootPolicyName,
+							PolicyNamespace:   rootPolicyNs,
+							RemediationAction: "enforce",
+						},
+					},
+				},
+			},
+		}
+		utils.SetStatusCondition(&cr.Status.Conditions,
+			provisioningv1alpha1.PRconditionTypes.ConfigurationApplied,
+			provisioningv1alpha1.CRconditionReasons.Completed,
+			metav1.ConditionTrue,
+			"The configuration is up to date",
+		)
+		utils.SetStatusCondition(&cr.Status.Conditions,
+			provisioningv1alpha1.PRconditionTypes.ClusterProvisioned,
+			provisioningv1alpha1.CRconditionReasons.Completed,
+			metav1.ConditionTrue,
+			"Provisioning completed",
+		)
+
+		rootPolicy := &policiesv1.Policy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      rootPolicyName,
+				Namespace: rootPolicyNs,
+			},
+			Spec: policiesv1.PolicySpec{
+				RemediationAction: "enforce",
+			},
+			Status: policiesv1.PolicyStatus{
+				Placement: []*policiesv1.Placement{{
+					PlacementBinding: "v1-subscriptions-placementbinding",
+					Placement:        "v1-subscriptions-placement",
+					Decisions: []policiesv1.PlacementDecision{
+						{ClusterName: clusterName, ClusterNamespace: clusterName},
+					},
+				}},
+			},
+		}
+		placement := &clusterv1beta1.Placement{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "v1-subscriptions-placement",
+				Namespace: rootPolicyNs,
+			},
+			Spec: clusterv1beta1.PlacementSpec{
+				Predicates: []clusterv1beta1.ClusterPredicate{{
+					RequiredClusterSelector: clusterv1beta1.ClusterSelector{
+						LabelSelector: metav1.LabelSelector{
+							MatchLabels: map[string]string{"common": "true"},
+							MatchExpressions: []metav1.LabelSelectorRequirement{
+								{Key: "sites", Operator: metav1.LabelSelectorOpIn, Values: []string{"site-1"}},
+							},
+						},
+					},
+				}},
+			},
+		}
+		clusterInstance := &siteconfig.ClusterInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      clusterName,
+				Namespace: clusterName,
+			},
+			Spec: siteconfig.ClusterInstanceSpec{
+				ExtraLabels: map[string]map[string]string{
+					"ManagedCluster": {"sites": "site-1", "common": "true", "du-profile": "4.16"},
+				},
+			},
+		}
+		// The binding labels were removed out-of-band, so the child policy is gone.
+		managedCluster := &clusterv1.ManagedCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   clusterName,
+				Labels: map[string]string{"common": "true"},
+			},
+		}
+		for _, conditionType := range []string{clusterv1.ManagedClusterConditionAvailable,
+			clusterv1.ManagedClusterConditionHubAccepted, clusterv1.ManagedClusterConditionJoined} {
+			utils.SetStatusCondition(&managedCluster.Status.Conditions,
+				provisioningv1alpha1.ConditionType(conditionType), "Ready", metav1.ConditionTrue, "")
+		}
+
+		c = getFakeClientFromObjects(cr, rootPolicy, placement, clusterInstance, managedCluster)
+		recorder = record.NewFakeRecorder(10)
+		task = &provisioningRequestReconcilerTask{
+			logger:    logger,
+			client:    c,
+			recorder:  recorder,
+			object:    cr,
+			ctDetails: &clusterTemplateDetails{namespace: ctNamespace},
+			timeouts: &timeouts{
+				clusterConfiguration: utils.DefaultClusterConfigurationTimeout,
+			},
+		}
+	})
+
+	It("restores the policy binding of a placed policy and recovers once it is recreated", func() {
+		requeue, err := task.handleClusterPolicyConfiguration(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requeue).To(BeTrue())
+
+		// The missing policy is still reported, as pending, so the configuration is no longer up to date.
+		Expect(task.object.Status.Extensions.Policies).To(ConsistOf(provisioningv1alpha1.PolicyDetails{
+			Compliant:         string(policiesv1.Pending),
+			PolicyName:        rootPolicyName,
+			PolicyNamespace:   rootPolicyNs,
+			RemediationAction: "enforce",
+		}))
+		Expect(configurationApplied().Reason).To(Equal(string(provisioningv1alpha1.CRconditionReasons.InProgress)))
+
+		// The binding labels are re-applied to the ManagedCluster.
+		managedCluster := &clusterv1.ManagedCluster{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: clusterName}, managedCluster)).To(Succeed())
+		Expect(managedCluster.Labels).To(Equal(bindingLabels))
+		Expect(recorder.Events).To(Receive(ContainSubstring(policyDriftCorrectedEvent)))
+
+		// The policy propagator recreates the child policy and the condition recovers.
+		Expect(c.Create(ctx, newChildPolicy())).To(Succeed())
+		requeue, err = task.handleClusterPolicyConfiguration(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requeue).To(BeFalse())
+		Expect(configurationApplied().Status).To(Equal(metav1.ConditionTrue))
+		Expect(configurationApplied().Reason).To(Equal(string(provisioningv1alpha1.CRconditionReasons.Completed)))
+		Expect(recorder.Events).ToNot(Receive())
+	})
+
+	It("does not emit an event when the policy binding is intact", func() {
+		managedCluster := &clusterv1.ManagedCluster{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: clusterName}, managedCluster)).To(Succeed())
+		managedCluster.Labels = bindingLabels
+		Expect(c.Update(ctx, managedCluster)).To(Succeed())
+
+		missing, err := task.correctPolicyDrift(ctx, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(missing).To(HaveLen(1))
+		Expect(recorder.Events).ToNot(Receive())
+	})
+
+	It("does not restore the labels the placements do not select on or whose value was changed", func() {
+		managedCluster := &clusterv1.ManagedCluster{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: clusterName}, managedCluster)).To(Succeed())
+		managedCluster.Labels = map[string]string{"sites": "site-2"}
+		Expect(c.Update(ctx, managedCluster)).To(Succeed())
+
+		_, err := task.correctPolicyDrift(ctx, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(c.Get(ctx, types.NamespacedName{Name: clusterName}, managedCluster)).To(Succeed())
+		Expect(managedCluster.Labels).To(Equal(map[string]string{"sites": "site-2", "common": "true"}))
+		Expect(recorder.Events).To(Receive(ContainSubstring(policyDriftCorrectedEvent)))
+	})
+
+	It("does not restore the labels while the cluster is still provisioning", func() {
+		utils.SetStatusCondition(&task.object.Status.Conditions,
+			provisioningv1alpha1.PRconditionTypes.ClusterProvisioned,
+			provisioningv1alpha1.CRconditionReasons.InProgress,
+			metav1.ConditionFalse,
+			"Provisioning cluster",
+		)
+
+		_, err := task.correctPolicyDrift(ctx, nil)
+		Expect(err).ToNot(HaveOccurred())
+		managedCluster := &clusterv1.ManagedCluster{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: clusterName}, managedCluster)).To(Succeed())
+		Expect(managedCluster.Labels).To(Equal(map[string]string{"common": "true"}))
+		Expect(recorder.Events).ToNot(Receive())
+	})
+
+	It("drops the expected policies whose root policy was deleted", func() {
+		Expect(c.Delete(ctx, &policiesv1.Policy{
+			ObjectMeta: metav1.ObjectMeta{Name: rootPolicyName, Namespace: rootPolicyNs},
+		})).To(Succeed())
+
+		missing, err := task.correctPolicyDrift(ctx, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(missing).To(BeEmpty())
+	})
+
+	It("does not expect the policies that are no longer placed on the cluster", func() {
+		rootPolicy := &policiesv1.Policy{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: rootPolicyName, Namespace: rootPolicyNs}, rootPolicy)).To(Succeed())
+		rootPolicy.Status.Placement[0].Decisions = []policiesv1.PlacementDecision{
+			{ClusterName: "cluster-2", ClusterNamespace: "cluster-2"},
+		}
+		Expect(c.Status().Update(ctx, rootPolicy)).To(Succeed())
+
+		// The policy is still recorded in the status, but it is no longer bound to the cluster.
+		missing, err := task.correctPolicyDrift(ctx, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(missing).To(BeEmpty())
+	})
+
+	It("expects the policies placed on the cluster that were never recorded in the status", func() {
+		task.object.Status.Extensions.Policies = nil
+
+		missing, err := task.correctPolicyDrift(ctx, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(missing).To(ConsistOf(provisioningv1alpha1.PolicyDetails{
+			Compliant:         string(policiesv1.Pending),
+			PolicyName:        rootPolicyName,
+			PolicyNamespace:   rootPolicyNs,
+			RemediationAction: "enforce",
+		}))
+	})
+})
+
+var _ = Describe("waitForChangeWindow", func() {
+	var (
+		ctx            context.Context
+		c              client.Client
+		task           *provisioningRequestReconcilerTask
+		cr             *provisioningv1alpha1.ProvisioningRequest
+		managedCluster *clusterv1.ManagedCluster
+		clusterName    = "cluster-1"
+	)
+
+	// changeWindow returns a window, in UTC, opening and closing at the given offsets from now
+	changeWindow := func(start, end time.Duration) *provisioningv1alpha1.ChangeWindow {
+		now := time.Now().UTC()
+		return &provisioningv1alpha1.ChangeWindow{
+			Start: now.Add(start).Format("15:04"),
+			End:   now.Add(end).Format("15:04"),
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		cr = &provisioningv1alpha1.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: clusterName,
+			},
+			Status: provisioningv1alpha1.ProvisioningRequestStatus{
+				Extensions: provisioningv1alpha1.Extensions{
+					ClusterDetails: &provisioningv1alpha1.ClusterDetails{
+						Name: clusterName,
+					},
+				},
+			},
+		}
+		utils.SetStatusCondition(&cr.Status.Conditions,
+			provisioningv1alpha1.PRconditionTypes.ClusterProvisioned,
+			provisioningv1alpha1.CRconditionReasons.Completed,
+			metav1.ConditionTrue,
+			"Provisioning completed",
+		)
+		managedCluster = &clusterv1.ManagedCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: clusterName,
+			},
+		}
+
+		c = getFakeClientFromObjects(cr, managedCluster)
+		task = &provisioningRequestReconcilerTask{
+			logger:    logger,
+			client:    c,
+			object:    cr,
+			ctDetails: &clusterTemplateDetails{namespace: "clustertemplate-a-v4-16"},
+			timeouts: &timeouts{
+				clusterConfiguration: utils.DefaultClusterConfigurationTimeout,
+			},
+		}
+	})
+
+	It("holds the enforcement outside of the change window", func() {
+		task.ctDetails.changeWindow = changeWindow(2*time.Hour, 3*time.Hour)
+
+		requeue, err := task.handleClusterPolicyConfiguration(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requeue).To(BeTrue())
+
+		condition := meta.FindStatusCondition(cr.Status.Conditions,
+			string(provisioningv1alpha1.PRconditionTypes.ConfigurationApplied))
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(string(provisioningv1alpha1.CRconditionReasons.WaitingForChangeWindow)))
+		Expect(cr.Status.ProvisioningStatus.ProvisioningPhase).To(Equal(provisioningv1alpha1.StateProgressing))
+		Expect(cr.Status.ProvisioningStatus.ProvisioningDetails).To(Equal(
+			"Waiting for the configuration change window to open"))
+		Expect(cr.Status.Extensions.ClusterDetails.NonCompliantAt).To(BeNil())
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(managedCluster), managedCluster)).To(Succeed())
+		Expect(managedCluster.GetLabels()).ToNot(HaveKey(changeWindowOpenLabel))
+
+		// The request is requeued when the window opens
+		result := task.requeueForClusterProgress()
+		Expect(result.RequeueAfter).To(BeNumerically("~", 2*time.Hour, time.Minute))
+	})
+
+	It("activates the enforcement within the change window and keeps it once the window closes", func() {
+		task.ctDetails.changeWindow = changeWindow(-time.Hour, time.Hour)
+
+		_, err := task.handleClusterPolicyConfiguration(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		condition := meta.FindStatusCondition(cr.Status.Conditions,
+			string(provisioningv1alpha1.PRconditionTypes.ConfigurationApplied))
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Reason).ToNot(Equal(string(provisioningv1alpha1.CRconditionReasons.WaitingForChangeWindow)))
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(managedCluster), managedCluster)).To(Succeed())
+		Expect(managedCluster.GetLabels()).To(HaveKeyWithValue(changeWindowOpenLabel, "true"))
+
+		// The configuration is not held anymore once the window has closed
+		task.ctDetails.changeWindow = changeWindow(2*time.Hour, 3*time.Hour)
+		waiting, err := task.waitForChangeWindow(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(waiting).To(BeFalse())
+	})
+
+	It("waits for the next change window once the ProvisioningRequest is modified", func() {
+		task.ctDetails.changeWindow = changeWindow(-time.Hour, time.Hour)
+		waiting, err := task.waitForChangeWindow(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(waiting).To(BeFalse())
+
+		// The ProvisioningRequest is modified after the window has closed
+		task.ctDetails.changeWindow = changeWindow(2*time.Hour, 3*time.Hour)
+		cr.Generation++
+		waiting, err = task.waitForChangeWindow(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(waiting).To(BeTrue())
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(managedCluster), managedCluster)).To(Succeed())
+		Expect(managedCluster.GetLabels()).ToNot(HaveKey(changeWindowOpenLabel))
+		Expect(managedCluster.GetAnnotations()).ToNot(HaveKey(changeWindowGenerationAnnotation))
+
+		// The label is set again for the new generation once the next window opens
+		task.ctDetails.changeWindow = changeWindow(-time.Hour, time.Hour)
+		waiting, err = task.waitForChangeWindow(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(waiting).To(BeFalse())
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(managedCluster), managedCluster)).To(Succeed())
+		Expect(managedCluster.GetLabels()).To(HaveKeyWithValue(changeWindowOpenLabel, "true"))
+		Expect(managedCluster.GetAnnotations()).To(HaveKeyWithValue(changeWindowGenerationAnnotation,
+			strconv.FormatInt(cr.Generation, 10)))
+	})
+})
+
+var _ = Describe("finalizeProvisioningIfComplete", func() {
+	var (
+		ctx            context.Context
+		task           *provisioningRequestReconcilerTask
+		cr             *provisioningv1alpha1.ProvisioningRequest
+		managedCluster *clusterv1.ManagedCluster
+		crName         = "cluster-1"
+	)
+
+	newTask := func() *provisioningRequestReconcilerTask {
+		return &provisioningRequestReconcilerTask{
+			logger: logger,
+			client: getFakeClientFromObjects(cr, managedCluster),
+			object: cr,
+			ctDetails: &clusterTemplateDetails{
+				fulfillmentChecks: &provisioningv1alpha1.FulfillmentChecks{
+					ManagedClusterAvailable: true,
+					AddOnsAvailable:         true,
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		cr = &provisioningv1alpha1.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: crName,
+			},
+			Status: provisioningv1alpha1.ProvisioningRequestStatus{
+				Extensions: provisioningv1alpha1.Extensions{
+					ClusterDetails: &provisioningv1alpha1.ClusterDetails{
+						Name: crName,
+					},
+				},
+			},
+		}
+		utils.SetStatusCondition(&cr.Status.Conditions,
+			provisioningv1alpha1.PRconditionTypes.ClusterProvisioned,
+			provisioningv1alpha1.CRconditionReasons.Completed,
+			metav1.ConditionTrue,
+			"Provisioning completed",
+		)
+		managedCluster = &clusterv1.ManagedCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: crName,
+				Labels: map[string]string{
+					"clusterID": "76b8cbad-9928-48a0-bcf0-bb16a777b5f7",
+					utils.ManagedClusterAddOnLabelPrefix + "work-manager":             "available",
+					utils.ManagedClusterAddOnLabelPrefix + "config-policy-controller": "unhealthy",
+				},
+			},
+			Status: clusterv1.ManagedClusterStatus{
+				Conditions: []metav1.Condition{
+					{Type: clusterv1.ManagedClusterConditionAvailable, Status: metav1.ConditionFalse},
+				},
+			},
+		}
+	})
+
+	It("fulfills the ProvisioningRequest when no health signals are required", func() {
+		task = newTask()
+		task.ctDetails.fulfillmentChecks = nil
+
+		withheld, err := task.finalizeProvisioningIfComplete(ctx, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(withheld).To(BeFalse())
+		Expect(cr.Status.ProvisioningStatus.ProvisioningPhase).To(Equal(provisioningv1alpha1.StateFulfilled))
+	})
+
+	It("withholds the fulfillment until the required health signals are present", func() {
+		task = newTask()
+		withheld, err := task.finalizeProvisioningIfComplete(ctx, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(withheld).To(BeTrue())
+		Expect(cr.Status.ProvisioningStatus.ProvisioningPhase).To(Equal(provisioningv1alpha1.StateProgressing))
+		Expect(cr.Status.ProvisioningStatus.ProvisioningDetails).To(Equal(
+			"Waiting for the cluster to be healthy: ManagedCluster cluster-1 is not available"))
+
+		managedCluster.Status.Conditions[0].Status = metav1.ConditionTrue
+		task = newTask()
+		withheld, err = task.finalizeProvisioningIfComplete(ctx, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(withheld).To(BeTrue())
+		Expect(cr.Status.ProvisioningStatus.ProvisioningDetails).To(Equal(
+			"Waiting for the cluster to be healthy: add-ons config-policy-controller of ManagedCluster cluster-1 " +
+				"are not available"))
+
+		managedCluster.Labels[utils.ManagedClusterAddOnLabelPrefix+"config-policy-controller"] = "available"
+		task = newTask()
+		withheld, err = task.finalizeProvisioningIfComplete(ctx, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(withheld).To(BeFalse())
+		Expect(cr.Status.ProvisioningStatus.ProvisioningPhase).To(Equal(provisioningv1alpha1.StateFulfilled))
+		Expect(cr.Status.ProvisioningStatus.ProvisionedResources.OCloudNodeClusterId).To(
+			Equal("76b8cbad-9928-48a0-bcf0-bb16a777b5f7"))
+	})
+
+	It("withholds the fulfillment while a node is degraded", func() {
+		cr.Status.Extensions.NodePoolRef = &provisioningv1alpha1.NodePoolRef{Name: crName, Namespace: "hwmgr"}
+		nodePool := &hwv1alpha1.NodePool{
+			ObjectMeta: metav1.ObjectMeta{Name: crName, Namespace: "hwmgr"},
+			Status: hwv1alpha1.NodePoolStatus{
+				Properties: hwv1alpha1.Properties{NodeNames: []string{"node-1", "node-2"}},
+			},
+		}
+		healthyNode := &hwv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-1", Namespace: "hwmgr"},
+			Status: hwv1alpha1.NodeStatus{Conditions: []metav1.Condition{
+				{Type: string(hwv1alpha1.Provisioned), Status: metav1.ConditionTrue, Reason: string(hwv1alpha1.Completed)},
+			}},
+		}
+		degradedNode := &hwv1alpha1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-2", Namespace: "hwmgr"},
+			Status: hwv1alpha1.NodeStatus{Conditions: []metav1.Condition{
+				{Type: string(hwv1alpha1.Provisioned), Status: metav1.ConditionFalse, Reason: string(hwv1alpha1.Failed),
+					Message: "BMC unreachable"},
+			}},
+		}
+		hwTemplate := &hwv1alpha1.HardwareTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "hwtemplate", Namespace: utils.InventoryNamespace},
+		}
+		newHwTask := func() *provisioningRequestReconcilerTask {
+			return &provisioningRequestReconcilerTask{
+				logger: logger,
+				client: getFakeClientFromObjects(cr, managedCluster, nodePool, healthyNode, degradedNode, hwTemplate),
+				object: cr,
+				ctDetails: &clusterTemplateDetails{
+					templates: provisioningv1alpha1.Templates{HwTemplate: "hwtemplate"},
+				},
+			}
+		}
+
+		task = newHwTask()
+		withheld, err := task.finalizeProvisioningIfComplete(ctx, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(withheld).To(BeTrue())
+		Expect(cr.Status.ProvisioningStatus.ProvisioningPhase).To(Equal(provisioningv1alpha1.StateProgressing))
+		Expect(cr.Status.ProvisioningStatus.ProvisioningDetails).To(Equal(
+			"Waiting for the hardware to be healthy: nodes node-2 (BMC unreachable) are degraded"))
+		condition := meta.FindStatusCondition(cr.Status.Conditions,
+			string(provisioningv1alpha1.PRconditionTypes.HardwareHealthy))
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(string(provisioningv1alpha1.CRconditionReasons.Degraded)))
+
+		// The request is fulfilled once the node has recovered
+		degradedNode.Status.Conditions[0].Status = metav1.ConditionTrue
+		degradedNode.Status.Conditions[0].Reason = string(hwv1alpha1.Completed)
+		task = newHwTask()
+		withheld, err = task.finalizeProvisioningIfComplete(ctx, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(withheld).To(BeFalse())
+		Expect(cr.Status.ProvisioningStatus.ProvisioningPhase).To(Equal(provisioningv1alpha1.StateFulfilled))
+		Expect(meta.IsStatusConditionTrue(cr.Status.Conditions,
+			string(provisioningv1alpha1.PRconditionTypes.HardwareHealthy))).To(BeTrue())
+
+		// A node degrading after the fulfillment does not withdraw it
+		degradedNode.Status.Conditions[0].Status = metav1.ConditionFalse
+		degradedNode.Status.Conditions[0].Reason = string(hwv1alpha1.Failed)
+		task = newHwTask()
+		withheld, err = task.finalizeProvisioningIfComplete(ctx, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(withheld).To(BeFalse())
+		Expect(cr.Status.ProvisioningStatus.ProvisioningPhase).To(Equal(provisioningv1alpha1.StateFulfilled))
+	})
+
+	It("keeps a fulfilled ProvisioningRequest fulfilled when the health signals stop holding", func() {
+		utils.SetProvisioningStateFulfilled(cr)
+
+		task = newTask()
+		withheld, err := task.finalizeProvisioningIfComplete(ctx, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(withheld).To(BeFalse())
+		Expect(cr.Status.ProvisioningStatus.ProvisioningPhase).To(Equal(provisioningv1alpha1.StateFulfilled))
+	})
+
+	It("does not check the health signals while the policies are not compliant", func() {
+		task = newTask()
+		withheld, err := task.finalizeProvisioningIfComplete(ctx, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(withheld).To(BeFalse())
+		Expect(cr.Status.ProvisioningStatus.ProvisioningPhase).To(BeEmpty())
+	})
+})
@@ -4,15 +4,21 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"maps"
 	"slices"
+	"strconv"
 	"strings"
 
+	metal3v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	agentv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	clusterv1 "open-cluster-management.io/api/cluster/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -45,16 +51,38 @@ func (t *provisioningRequestReconcilerTask) renderClusterInstanceTemplate(
 	if err != nil {
 		return nil, utils.NewInputError("failed to render the ClusterInstance template for ProvisioningRequest: %w", err)
 	} else {
-		// Add ProvisioningRequest labels to the generated ClusterInstance
-		labels := make(map[string]string)
-		labels[provisioningRequestNameLabel] = t.object.Name
+		// Add ProvisioningRequest labels to the generated ClusterInstance and, through its extraLabels,
+		// to the ManagedCluster
+		labels := t.provenanceLabels()
 		renderedClusterInstanceUnstructure.SetLabels(labels)
+		managedClusterLabels, _, err := unstructured.NestedStringMap(
+			renderedClusterInstanceUnstructure.Object, "spec", "extraLabels", "ManagedCluster")
+		if err != nil {
+			return nil, utils.NewInputError("invalid extraLabels in the rendered ClusterInstance: %w", err)
+		}
+		if managedClusterLabels == nil {
+			managedClusterLabels = make(map[string]string)
+		}
+		maps.Copy(managedClusterLabels, labels)
+		if err := unstructured.SetNestedStringMap(renderedClusterInstanceUnstructure.Object,
+			managedClusterLabels, "spec", "extraLabels", "ManagedCluster"); err != nil {
+			return nil, fmt.Errorf("failed to set the ManagedCluster extraLabels: %w", err)
+		}
 
-		// Create the ClusterInstance namespace if not exist.
+		// Create the ClusterInstance namespace if not exist. The namespace is never created for a dry-run
+		// request, in which case the validation by the API server is only possible if it already exists.
 		ciName := renderedClusterInstanceUnstructure.GetName()
-		err = t.createClusterInstanceNamespace(ctx, ciName)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create cluster namespace %s: %w", ciName, err)
+		validateWithServer := true
+		if t.isDryRun() {
+			validateWithServer, err = utils.DoesK8SResourceExist(ctx, t.client, ciName, "", &corev1.Namespace{})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get cluster namespace %s: %w", ciName, err)
+			}
+		} else {
+			err = t.createClusterInstanceNamespace(ctx, ciName)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create cluster namespace %s: %w", ciName, err)
+			}
 		}
 
 		// Check for updates to immutable fields in the ClusterInstance, if it exists.
@@ -120,10 +148,12 @@ func (t *provisioningRequestReconcilerTask) renderClusterInstanceTemplate(
 		}
 
 		// Validate the rendered ClusterInstance with dry-run
-		isDryRun := true
-		err = t.applyClusterInstance(ctx, renderedClusterInstanceUnstructure, isDryRun)
-		if err != nil {
-			return nil, fmt.Errorf("failed to validate the rendered ClusterInstance with dry-run: %w", err)
+		if validateWithServer {
+			isDryRun := true
+			err = t.applyClusterInstance(ctx, renderedClusterInstanceUnstructure, isDryRun)
+			if err != nil {
+				return nil, fmt.Errorf("failed to validate the rendered ClusterInstance with dry-run: %w", err)
+			}
 		}
 
 		// Convert unstructured to siteconfig.ClusterInstance type
@@ -140,6 +170,9 @@ func (t *provisioningRequestReconcilerTask) renderClusterInstanceTemplate(
 
 // handleClusterInstallation creates/updates the ClusterInstance to handle the cluster provisioning.
 func (t *provisioningRequestReconcilerTask) handleClusterInstallation(ctx context.Context, clusterInstance *siteconfig.ClusterInstance) error {
+	if err := t.checkNotDryRun("install the cluster"); err != nil {
+		return err
+	}
 	isDryRun := false
 	err := t.applyClusterInstance(ctx, clusterInstance, isDryRun)
 	if err != nil {
@@ -152,6 +185,7 @@ func (t *provisioningRequestReconcilerTask) handleClusterInstallation(ctx contex
 		}
 		t.object.Status.Extensions.ClusterDetails.Name = clusterInstance.GetName()
 	}
+	t.trackAppliedManifest(clusterInstance)
 
 	// Continue checking the existing ClusterInstance provision status
 	if err := t.checkClusterProvisionStatus(ctx, clusterInstance.Name); err != nil {
@@ -175,6 +209,9 @@ func (t *provisioningRequestReconcilerTask) checkClusterProvisionStatus(
 	// Check ClusterInstance status and update the corresponding ProvisioningRequest status conditions.
 	t.updateClusterInstanceProcessedStatus(clusterInstance)
 	t.updateClusterProvisionStatus(clusterInstance)
+	if err := t.surfaceInstallFailureCause(ctx, clusterInstance); err != nil {
+		return err
+	}
 
 	if updateErr := utils.UpdateK8sCRStatus(ctx, t.client, t.object); updateErr != nil {
 		return fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, updateErr)
@@ -183,6 +220,54 @@ func (t *provisioningRequestReconcilerTask) checkClusterProvisionStatus(
 	return nil
 }
 
+// checkManagedClusterPresence detects a ManagedCluster deleted out-of-band once the cluster installation has
+// completed. The ProvisioningRequest is then moved out of the fulfilled state with the ClusterProvisioned condition
+// set to ClusterMissing, until the ManagedCluster is back. It returns true if the ManagedCluster is missing.
+func (t *provisioningRequestReconcilerTask) checkManagedClusterPresence(ctx context.Context) (bool, error) {
+	if t.object.Status.Extensions.ClusterDetails == nil {
+		return false, nil
+	}
+	alreadyMissing := utils.IsClusterMissing(t.object)
+	if !alreadyMissing && !utils.IsClusterProvisionCompleted(t.object) {
+		return false, nil
+	}
+
+	clusterName := t.object.Status.Extensions.ClusterDetails.Name
+	exists, err := utils.DoesK8SResourceExist(ctx, t.client, clusterName, "", &clusterv1.ManagedCluster{})
+	if err != nil {
+		return false, fmt.Errorf("failed to get ManagedCluster %s: %w", clusterName, err)
+	}
+	if exists {
+		if alreadyMissing {
+			// Restore the ClusterProvisioned condition from the ClusterInstance now that the cluster is back
+			return false, t.checkClusterProvisionStatus(ctx, clusterName)
+		}
+		return false, nil
+	}
+	if alreadyMissing {
+		return true, nil
+	}
+
+	message := fmt.Sprintf("The ManagedCluster %s no longer exists", clusterName)
+	t.logger.WarnContext(
+		ctx,
+		"The ManagedCluster of a provisioned cluster has been deleted",
+		slog.String("name", t.object.Name),
+		slog.String("managedCluster", clusterName),
+	)
+	utils.SetStatusCondition(&t.object.Status.Conditions,
+		provisioningv1alpha1.PRconditionTypes.ClusterProvisioned,
+		provisioningv1alpha1.CRconditionReasons.ClusterMissing,
+		metav1.ConditionFalse,
+		message,
+	)
+	utils.SetProvisioningStateFailed(t.object, message)
+	if err := utils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
+		return false, fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, err)
+	}
+	return true, nil
+}
+
 func (t *provisioningRequestReconcilerTask) applyClusterInstance(ctx context.Context, clusterInstance client.Object, isDryRun bool) error {
 	var operationType string
 
@@ -232,7 +317,13 @@ func (t *provisioningRequestReconcilerTask) applyClusterInstance(ctx context.Con
 		// Make sure these fields from existing object are copied
 		clusterInstance.SetResourceVersion(existingClusterInstance.GetResourceVersion())
 		clusterInstance.SetFinalizers(existingClusterInstance.GetFinalizers())
-		clusterInstance.SetLabels(existingClusterInstance.GetLabels())
+		// Keep the existing labels while refreshing the provenance labels
+		labels := maps.Clone(existingClusterInstance.GetLabels())
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		maps.Copy(labels, clusterInstance.GetLabels())
+		clusterInstance.SetLabels(labels)
 		clusterInstance.SetAnnotations(existingClusterInstance.GetAnnotations())
 
 		operationType = utils.OperationTypeUpdated
@@ -263,6 +354,17 @@ func (t *provisioningRequestReconcilerTask) applyClusterInstance(ctx context.Con
 	return nil
 }
 
+// provenanceLabels returns the labels recording the ProvisioningRequest, the ClusterTemplate and the generation of
+// the ProvisioningRequest from which the downstream objects are rendered.
+func (t *provisioningRequestReconcilerTask) provenanceLabels() map[string]string {
+	return map[string]string{
+		provisioningRequestNameLabel:            t.object.Name,
+		provisioningRequestTemplateNameLabel:    t.object.Spec.TemplateName,
+		provisioningRequestTemplateVersionLabel: t.object.Spec.TemplateVersion,
+		provisioningRequestGenerationLabel:      strconv.FormatInt(t.object.Generation, 10),
+	}
+}
+
 func (t *provisioningRequestReconcilerTask) updateClusterInstanceProcessedStatus(ci *siteconfig.ClusterInstance) {
 	if ci == nil {
 		return
@@ -369,3 +471,76 @@ func (t *provisioningRequestReconcilerTask) updateClusterProvisionStatus(ci *sit
 		}
 	}
 }
+
+// surfaceInstallFailureCause appends the most relevant error reported by the Agents or BareMetalHosts of the cluster
+// to the ClusterProvisioned condition and provisioning details of a failed installation, so that the root cause can
+// be seen without inspecting the underlying resources.
+func (t *provisioningRequestReconcilerTask) surfaceInstallFailureCause(ctx context.Context, ci *siteconfig.ClusterInstance) error {
+	if !utils.IsClusterProvisionTimedOutOrFailed(t.object) {
+		return nil
+	}
+
+	cause, err := t.getInstallFailureCause(ctx, ci)
+	if err != nil {
+		return err
+	}
+	if cause == "" {
+		return nil
+	}
+
+	condition := meta.FindStatusCondition(t.object.Status.Conditions,
+		string(provisioningv1alpha1.PRconditionTypes.ClusterProvisioned))
+	utils.SetStatusCondition(&t.object.Status.Conditions,
+		provisioningv1alpha1.PRconditionTypes.ClusterProvisioned,
+		provisioningv1alpha1.ConditionReason(condition.Reason),
+		condition.Status,
+		fmt.Sprintf("%s: %s", condition.Message, cause),
+	)
+	utils.SetProvisioningStateFailed(t.object,
+		fmt.Sprintf("%s: %s", t.object.Status.ProvisioningStatus.ProvisioningDetails, cause))
+	return nil
+}
+
+// getInstallFailureCause returns the most relevant error reported by the Agents or BareMetalHosts of the cluster.
+// An Agent installation failure is the most specific cause, followed by BareMetalHost errors and then by any other
+// Agent condition that is not met.  An empty string is returned if none of them report an error.
+func (t *provisioningRequestReconcilerTask) getInstallFailureCause(ctx context.Context, ci *siteconfig.ClusterInstance) (string, error) {
+	agents := &agentv1beta1.AgentList{}
+	if err := t.client.List(ctx, agents, client.InNamespace(ci.Namespace)); err != nil {
+		return "", fmt.Errorf("failed to list Agents in namespace %s: %w", ci.Namespace, err)
+	}
+	hosts := &metal3v1alpha1.BareMetalHostList{}
+	if err := t.client.List(ctx, hosts, client.InNamespace(ci.Namespace)); err != nil {
+		return "", fmt.Errorf("failed to list BareMetalHosts in namespace %s: %w", ci.Namespace, err)
+	}
+
+	var agentCause string
+	for _, agent := range agents.Items {
+		if agent.Spec.ClusterDeploymentName != nil && agent.Spec.ClusterDeploymentName.Name != ci.Spec.ClusterName {
+			continue
+		}
+		for _, condition := range agent.Status.Conditions {
+			if condition.Status != corev1.ConditionFalse {
+				continue
+			}
+			switch condition.Type {
+			case agentv1beta1.InstalledCondition:
+				if condition.Reason == agentv1beta1.InstallationFailedReason {
+					return fmt.Sprintf("Agent %s: %s", agent.Name, condition.Message), nil
+				}
+			case agentv1beta1.ConnectedCondition, agentv1beta1.RequirementsMetCondition, agentv1beta1.ValidatedCondition:
+				if agentCause == "" {
+					agentCause = fmt.Sprintf("Agent %s: %s", agent.Name, condition.Message)
+				}
+			}
+		}
+	}
+
+	for _, host := range hosts.Items {
+		if host.Status.OperationalStatus == metal3v1alpha1.OperationalStatusError || host.Status.ErrorMessage != "" {
+			return fmt.Sprintf("BareMetalHost %s: %s: %s", host.Name, host.Status.ErrorType, host.Status.ErrorMessage), nil
+		}
+	}
+
+	return agentCause, nil
+}
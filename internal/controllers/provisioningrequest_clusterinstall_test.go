@@ -6,6 +6,10 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	metal3v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	agentv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	siteconfig "github.com/stolostron/siteconfig/api/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -132,6 +136,41 @@ nodes:
 		})
 	})
 
+	It("should label the ClusterInstance and ManagedCluster with their provenance", func() {
+		cr.Generation = 3
+		renderedClusterInstance, err := task.handleRenderClusterInstance(ctx)
+		Expect(err).ToNot(HaveOccurred())
+
+		provenance := map[string]string{
+			provisioningRequestNameLabel:            crName,
+			provisioningRequestTemplateNameLabel:    tName,
+			provisioningRequestTemplateVersionLabel: tVersion,
+			provisioningRequestGenerationLabel:      "3",
+		}
+		Expect(renderedClusterInstance.GetLabels()).To(Equal(provenance))
+		managedClusterLabels := renderedClusterInstance.Spec.ExtraLabels["ManagedCluster"]
+		for key, value := range provenance {
+			Expect(managedClusterLabels).To(HaveKeyWithValue(key, value))
+		}
+		// The labels requested through the template parameters are kept
+		Expect(managedClusterLabels).To(HaveKey("cluster-version"))
+
+		// The provenance labels are refreshed on update while other existing labels are kept
+		existing := renderedClusterInstance.DeepCopy()
+		existing.Labels[provisioningRequestGenerationLabel] = "2"
+		existing.Labels["custom"] = "value"
+		existing.Spec.ExtraLabels["ManagedCluster"][provisioningRequestGenerationLabel] = "2"
+		Expect(c.Create(ctx, existing)).To(Succeed())
+		Expect(task.applyClusterInstance(ctx, renderedClusterInstance, false)).To(Succeed())
+
+		updated := &siteconfig.ClusterInstance{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(existing), updated)).To(Succeed())
+		Expect(updated.Labels).To(HaveKeyWithValue(provisioningRequestGenerationLabel, "3"))
+		Expect(updated.Labels).To(HaveKeyWithValue("custom", "value"))
+		Expect(updated.Spec.ExtraLabels["ManagedCluster"]).To(
+			HaveKeyWithValue(provisioningRequestGenerationLabel, "3"))
+	})
+
 	It("should fail to render ClusterInstance due to invalid input", func() {
 		// Modify input data to be invalid
 		task.clusterInput.clusterInstanceData["clusterName"] = ""
@@ -201,3 +240,146 @@ nodes:
 		})
 	})
 })
+
+var _ = Describe("surfaceInstallFailureCause", func() {
+	var (
+		ctx    context.Context
+		cr     *provisioningv1alpha1.ProvisioningRequest
+		ci     *siteconfig.ClusterInstance
+		crName = "cluster-1"
+	)
+
+	newTask := func(objs ...client.Object) *provisioningRequestReconcilerTask {
+		return &provisioningRequestReconcilerTask{
+			logger: logger,
+			client: getFakeClientFromObjects(append(objs, cr)...),
+			object: cr,
+		}
+	}
+
+	newAgent := func(name string, conditions ...conditionsv1.Condition) *agentv1beta1.Agent {
+		return &agentv1beta1.Agent{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: crName,
+			},
+			Spec: agentv1beta1.AgentSpec{
+				ClusterDeploymentName: &agentv1beta1.ClusterReference{Name: crName, Namespace: crName},
+			},
+			Status: agentv1beta1.AgentStatus{
+				Conditions: conditions,
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		cr = &provisioningv1alpha1.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: crName,
+			},
+		}
+		ci = &siteconfig.ClusterInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      crName,
+				Namespace: crName,
+			},
+			Spec: siteconfig.ClusterInstanceSpec{
+				ClusterName: crName,
+			},
+		}
+		utils.SetStatusCondition(&cr.Status.Conditions,
+			provisioningv1alpha1.PRconditionTypes.ClusterProvisioned,
+			provisioningv1alpha1.CRconditionReasons.Failed,
+			metav1.ConditionFalse,
+			"Provisioning failed",
+		)
+		utils.SetProvisioningStateFailed(cr, "Cluster installation failed")
+	})
+
+	It("surfaces a failed Agent installation into the status", func() {
+		task := newTask(
+			newAgent("agent-1", conditionsv1.Condition{
+				Type:    agentv1beta1.ValidatedCondition,
+				Status:  corev1.ConditionFalse,
+				Message: "The agent's validations are failing",
+			}),
+			newAgent("agent-2", conditionsv1.Condition{
+				Type:    agentv1beta1.InstalledCondition,
+				Status:  corev1.ConditionFalse,
+				Reason:  agentv1beta1.InstallationFailedReason,
+				Message: "The installation failed: failed to write image to disk",
+			}),
+		)
+
+		Expect(task.surfaceInstallFailureCause(ctx, ci)).To(Succeed())
+
+		condition := meta.FindStatusCondition(cr.Status.Conditions,
+			string(provisioningv1alpha1.PRconditionTypes.ClusterProvisioned))
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Reason).To(Equal(string(provisioningv1alpha1.CRconditionReasons.Failed)))
+		Expect(condition.Message).To(Equal(
+			"Provisioning failed: Agent agent-2: The installation failed: failed to write image to disk"))
+		Expect(cr.Status.ProvisioningStatus.ProvisioningPhase).To(Equal(provisioningv1alpha1.StateFailed))
+		Expect(cr.Status.ProvisioningStatus.ProvisioningDetails).To(Equal(
+			"Cluster installation failed: Agent agent-2: The installation failed: failed to write image to disk"))
+	})
+
+	It("prefers BareMetalHost errors over other failing Agent conditions", func() {
+		task := newTask(
+			newAgent("agent-1", conditionsv1.Condition{
+				Type:    agentv1beta1.ConnectedCondition,
+				Status:  corev1.ConditionFalse,
+				Message: "The agent has not contacted the installer for some time",
+			}),
+			&metal3v1alpha1.BareMetalHost{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "node1",
+					Namespace: crName,
+				},
+				Status: metal3v1alpha1.BareMetalHostStatus{
+					OperationalStatus: metal3v1alpha1.OperationalStatusError,
+					ErrorType:         metal3v1alpha1.RegistrationError,
+					ErrorMessage:      "Failed to get power state for node: authentication failed",
+				},
+			},
+		)
+
+		Expect(task.surfaceInstallFailureCause(ctx, ci)).To(Succeed())
+		Expect(cr.Status.ProvisioningStatus.ProvisioningDetails).To(Equal(
+			"Cluster installation failed: BareMetalHost node1: registration error: " +
+				"Failed to get power state for node: authentication failed"))
+	})
+
+	It("ignores the Agents of other clusters", func() {
+		agent := newAgent("agent-1", conditionsv1.Condition{
+			Type:    agentv1beta1.InstalledCondition,
+			Status:  corev1.ConditionFalse,
+			Reason:  agentv1beta1.InstallationFailedReason,
+			Message: "The installation failed",
+		})
+		agent.Spec.ClusterDeploymentName.Name = "cluster-2"
+		task := newTask(agent)
+
+		Expect(task.surfaceInstallFailureCause(ctx, ci)).To(Succeed())
+		Expect(cr.Status.ProvisioningStatus.ProvisioningDetails).To(Equal("Cluster installation failed"))
+	})
+
+	It("does not change the status while the installation has not failed", func() {
+		utils.SetStatusCondition(&cr.Status.Conditions,
+			provisioningv1alpha1.PRconditionTypes.ClusterProvisioned,
+			provisioningv1alpha1.CRconditionReasons.InProgress,
+			metav1.ConditionFalse,
+			"Provisioning cluster",
+		)
+		utils.SetProvisioningStateInProgress(cr, "Cluster installation is in progress")
+		task := newTask(newAgent("agent-1", conditionsv1.Condition{
+			Type:    agentv1beta1.ValidatedCondition,
+			Status:  corev1.ConditionFalse,
+			Message: "The agent's validations are failing",
+		}))
+
+		Expect(task.surfaceInstallFailureCause(ctx, ci)).To(Succeed())
+		Expect(cr.Status.ProvisioningStatus.ProvisioningDetails).To(Equal("Cluster installation is in progress"))
+	})
+})
@@ -18,14 +18,21 @@ package controllers
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -34,22 +41,37 @@ import (
 	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
 	"github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
 	siteconfig "github.com/stolostron/siteconfig/api/v1alpha1"
+	policiesv1 "open-cluster-management.io/governance-policy-propagator/api/v1"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
 )
 
 // ProvisioningRequestReconciler reconciles a ProvisioningRequest object
 type ProvisioningRequestReconciler struct {
 	client.Client
-	Logger *slog.Logger
+	Logger   *slog.Logger
+	Recorder record.EventRecorder
+	// Notifier, when set, is told about the ProvisioningRequests that reach a terminal phase
+	Notifier *ProvisioningNotifier
+	// MaxParallelUpgrades limits the number of cluster upgrades in progress at the same time. There is no limit
+	// when it is 0.
+	MaxParallelUpgrades int
 }
 
 type provisioningRequestReconcilerTask struct {
 	logger       *slog.Logger
 	client       client.Client
+	recorder     record.EventRecorder
 	object       *provisioningv1alpha1.ProvisioningRequest
 	clusterInput *clusterInput
 	ctDetails    *clusterTemplateDetails
 	timeouts     *timeouts
+	// maxParallelUpgrades limits the number of cluster upgrades in progress at the same time, 0 meaning no limit
+	maxParallelUpgrades int
+	// appliedManifests are the objects applied during the reconciliation, recorded for auditing when requested
+	appliedManifests []client.Object
+	// changeWindowWait is the time until the configuration change window opens when the configuration is waiting for it
+	changeWindowWait time.Duration
 }
 
 // clusterInput holds the merged input data for a cluster
@@ -60,8 +82,13 @@ type clusterInput struct {
 
 // clusterTemplateDetails holds the details for the referenced ClusterTemplate
 type clusterTemplateDetails struct {
-	namespace string
-	templates provisioningv1alpha1.Templates
+	namespace         string
+	templates         provisioningv1alpha1.Templates
+	hostnameTemplate  string
+	resourceBarriers  []provisioningv1alpha1.ResourceBarrier
+	pollIntervals     *provisioningv1alpha1.PollIntervals
+	fulfillmentChecks *provisioningv1alpha1.FulfillmentChecks
+	changeWindow      *provisioningv1alpha1.ChangeWindow
 }
 
 // timeouts holds the timeout values, in minutes,
@@ -76,6 +103,36 @@ type timeouts struct {
 const (
 	provisioningRequestFinalizer = "provisioningrequest.o2ims.provisioning.oran.org/finalizer"
 	provisioningRequestNameLabel = "provisioningrequest.o2ims.provisioning.oran.org/name"
+	// Provenance labels recording the ClusterTemplate and the generation of the ProvisioningRequest from which the
+	// downstream objects were rendered.
+	provisioningRequestTemplateNameLabel    = "provisioningrequest.o2ims.provisioning.oran.org/template-name"
+	provisioningRequestTemplateVersionLabel = "provisioningrequest.o2ims.provisioning.oran.org/template-version"
+	provisioningRequestGenerationLabel      = "provisioningrequest.o2ims.provisioning.oran.org/generation"
+	// provisioningRequestForceFailureAnnotation is a test-only annotation, honored only when chaos testing is enabled,
+	// that forces the ProvisioningRequest into a failed state. Its value has the form "<conditionType>[/<reason>]".
+	provisioningRequestForceFailureAnnotation = "provisioningrequest.o2ims.provisioning.oran.org/force-failure"
+	// provisioningRequestForceDeletionAnnotation, when set to "true", lets the deletion of the ProvisioningRequest
+	// proceed without waiting for in-progress policy remediation to settle.
+	provisioningRequestForceDeletionAnnotation = "provisioningrequest.o2ims.provisioning.oran.org/force-deletion"
+	// provisioningRequestCancelAnnotation, when set to "true" before the cluster installation has completed, cancels
+	// the provisioning and tears down the in-progress hardware and cluster installation resources.
+	provisioningRequestCancelAnnotation = "provisioningrequest.o2ims.provisioning.oran.org/cancel"
+	// provisioningRequestDryRunAnnotation, when set to "true", limits the reconciliation to the validation and the
+	// rendering of the ClusterInstance, which is recorded in the status instead of being applied. No cluster
+	// resources are created and no hardware is allocated while it is present.
+	provisioningRequestDryRunAnnotation = "provisioning.oran.org/dry-run"
+	// provisioningRequestDryRunAliasAnnotation is accepted in place of provisioningRequestDryRunAnnotation, for
+	// consistency with the other annotations of the ProvisioningRequest.
+	provisioningRequestDryRunAliasAnnotation = "provisioningrequest.o2ims.provisioning.oran.org/dry-run"
+	// policyDriftCorrectedEvent is the reason of the event emitted when the ManagedCluster labels that bind the
+	// policies to the cluster are restored after an out-of-band change.
+	policyDriftCorrectedEvent = "PolicyDriftCorrected"
+	// changeWindowOpenLabel is set on the ManagedCluster once the configuration change window of the ClusterTemplate
+	// has opened. The placements of the enforce policies to defer to the change window must select it.
+	changeWindowOpenLabel = "provisioningrequest.o2ims.provisioning.oran.org/change-window-open"
+	// changeWindowGenerationAnnotation records on the ManagedCluster the generation of the ProvisioningRequest for
+	// which the change window label was set.
+	changeWindowGenerationAnnotation = "provisioningrequest.o2ims.provisioning.oran.org/change-window-generation"
 )
 
 func getClusterTemplateRefName(name, version string) string {
@@ -93,13 +150,18 @@ func getClusterTemplateRefName(name, version string) string {
 //+kubebuilder:rbac:groups=o2ims-hardwaremanagement.oran.openshift.io,resources=nodepools/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=o2ims-hardwaremanagement.oran.openshift.io,resources=nodes,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=o2ims-hardwaremanagement.oran.openshift.io,resources=nodes/status,verbs=get;update;patch
-//+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;create;update;patch;watch
+//+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;create;update;patch;watch;delete
 //+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;create;update;patch;watch
 //+kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=policy.open-cluster-management.io,resources=policies,verbs=list;watch
+//+kubebuilder:rbac:groups=cluster.open-cluster-management.io,resources=managedclusters,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=cluster.open-cluster-management.io,resources=placements,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 //+kubebuilder:rbac:groups=lcm.openshift.io,resources=imagebasedgroupupgrades,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=lcm.openshift.io,resources=imagebasedgroupupgrades/status,verbs=get
 //+kubebuilder:rbac:groups=hwmgr-plugin.oran.openshift.io,resources=hardwaremanagers,verbs=get;list;watch
+//+kubebuilder:rbac:groups=agent-install.openshift.io,resources=agents,verbs=get;list;watch
+//+kubebuilder:rbac:groups=metal3.io,resources=baremetalhosts,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -150,23 +212,265 @@ func (r *ProvisioningRequestReconciler) Reconcile(
 		return res, err
 	}
 
+	if r.isCancellationRequested(ctx, object) {
+		return r.handleCancellation(ctx, object)
+	}
+
 	// Create and run the task:
 	task := &provisioningRequestReconcilerTask{
 		logger:       r.Logger,
 		client:       r.Client,
+		recorder:     r.Recorder,
 		object:       object,
 		clusterInput: &clusterInput{},
 		ctDetails:    &clusterTemplateDetails{},
 		timeouts:     &timeouts{},
+
+		maxParallelUpgrades: r.MaxParallelUpgrades,
 	}
+	previousStatus := object.Status.DeepCopy()
 	result, err = task.run(ctx)
+	if errors.IsConflict(err) {
+		// The ProvisioningRequest kept being modified concurrently while retrying the status update, reconcile it
+		// again from its latest version
+		r.Logger.InfoContext(ctx, "Conflict while updating the ProvisioningRequest status, requeuing",
+			slog.String("name", object.Name))
+		return requeueImmediately(), nil
+	}
+
+	deadlineChanged := task.updateTimeoutDeadline()
+	statusChanged := deadlineChanged ||
+		object.Status.ProvisioningStatus.ProvisioningPhase != previousStatus.ProvisioningStatus.ProvisioningPhase ||
+		!equality.Semantic.DeepEqual(object.Status.Conditions, previousStatus.Conditions)
+	if !r.recordLastReconcileAction(ctx, object, result, err, statusChanged) {
+		// Only the transitions that have been persisted are reported
+		return
+	}
+	recordTransitionEvents(r.Recorder, previousStatus.Conditions, object)
+	observeProvisioningPhases(previousStatus, object)
+	if r.Notifier != nil {
+		r.Notifier.NotifyTransition(previousStatus.ProvisioningStatus.ProvisioningPhase, object)
+	}
 	return
 }
 
+// getReconcileAction describes the outcome of a reconciliation along with the reason for it. The reason is the
+// error when the reconciliation failed, and the provisioning details otherwise.
+func getReconcileAction(result ctrl.Result, err error, provisioningDetails string) string {
+	var action string
+	reason := provisioningDetails
+	switch {
+	case err != nil:
+		action = "requeued with error"
+		reason = err.Error()
+	case result.RequeueAfter > 0:
+		action = fmt.Sprintf("requeued after %s", result.RequeueAfter)
+	case result.Requeue:
+		action = "requeued immediately"
+	default:
+		action = "not requeued"
+	}
+
+	if reason == "" {
+		return action
+	}
+	return fmt.Sprintf("%s, reason: %s", action, reason)
+}
+
+// recordLastReconcileAction records the outcome of the reconciliation in the ProvisioningRequest status, along
+// with the other status changes of the reconciliation when statusChanged is set. Failing to record it does not affect
+// the result of the reconciliation. It returns whether the status is persisted.
+func (r *ProvisioningRequestReconciler) recordLastReconcileAction(ctx context.Context,
+	object *provisioningv1alpha1.ProvisioningRequest, result ctrl.Result, reconcileErr error, statusChanged bool) bool {
+	action := getReconcileAction(result, reconcileErr, object.Status.ProvisioningStatus.ProvisioningDetails)
+	if object.Status.LastReconcileAction == action && !statusChanged {
+		return true
+	}
+
+	var err error
+	if statusChanged {
+		object.Status.LastReconcileAction = action
+		err = utils.UpdateK8sCRStatus(ctx, r.Client, object)
+	} else {
+		err = utils.UpdateK8sCRStatusWithMutation(ctx, r.Client, object,
+			func(latest *provisioningv1alpha1.ProvisioningRequest) {
+				latest.Status.LastReconcileAction = action
+			})
+	}
+	if err != nil {
+		r.Logger.WarnContext(
+			ctx,
+			"Failed to record the last reconcile action",
+			slog.String("name", object.Name),
+			slog.String("error", err.Error()),
+		)
+		return false
+	}
+	return true
+}
+
+// updateTimeoutDeadline sets the time at which the active phase of a progressing ProvisioningRequest times out, from
+// the start of that phase and its timeout, and clears it once the provisioning is no longer progressing. The deadline
+// is left untouched when the timeouts of the current generation of the ProvisioningRequest could not be loaded. It
+// returns whether the deadline has changed.
+func (t *provisioningRequestReconcilerTask) updateTimeoutDeadline() bool {
+	var deadline *metav1.Time
+	if t.object.Status.ProvisioningStatus.ProvisioningPhase == provisioningv1alpha1.StateProgressing {
+		if *t.timeouts == (timeouts{}) {
+			return false
+		}
+		if start, timeout := t.getActivePhaseTimeout(); start != nil {
+			deadline = &metav1.Time{Time: start.Add(timeout)}
+		}
+	}
+
+	current := t.object.Status.ProvisioningStatus.TimeoutDeadline
+	if deadline == nil && current == nil || deadline != nil && current != nil && deadline.Equal(current) {
+		return false
+	}
+	t.object.Status.ProvisioningStatus.TimeoutDeadline = deadline
+	return true
+}
+
+// getActivePhaseTimeout returns the start and the timeout of the timed provisioning phase in progress, or a nil
+// start if none is.
+func (t *provisioningRequestReconcilerTask) getActivePhaseTimeout() (*metav1.Time, time.Duration) {
+	if clusterDetails := t.object.Status.Extensions.ClusterDetails; clusterDetails != nil {
+		if utils.IsClusterProvisionCompleted(t.object) {
+			configurationApplied := meta.FindStatusCondition(t.object.Status.Conditions,
+				string(provisioningv1alpha1.PRconditionTypes.ConfigurationApplied))
+			if configurationApplied != nil && configurationApplied.Status == metav1.ConditionFalse &&
+				(configurationApplied.Reason == string(provisioningv1alpha1.CRconditionReasons.InProgress) ||
+					configurationApplied.Reason == string(provisioningv1alpha1.CRconditionReasons.ClusterNotReady)) &&
+				!clusterDetails.NonCompliantAt.IsZero() {
+				return clusterDetails.NonCompliantAt, t.timeouts.clusterConfiguration
+			}
+			return nil, 0
+		}
+		if utils.IsClusterProvisionPresent(t.object) && !clusterDetails.ClusterProvisionStartedAt.IsZero() {
+			return clusterDetails.ClusterProvisionStartedAt, t.timeouts.clusterProvisioning
+		}
+	}
+
+	if nodePoolRef := t.object.Status.Extensions.NodePoolRef; nodePoolRef != nil {
+		if !nodePoolRef.HardwareConfiguringCheckStart.IsZero() {
+			return nodePoolRef.HardwareConfiguringCheckStart, t.timeouts.hardwareProvisioning
+		}
+		if !nodePoolRef.HardwareProvisioningCheckStart.IsZero() &&
+			!meta.IsStatusConditionTrue(t.object.Status.Conditions,
+				string(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned)) {
+			return nodePoolRef.HardwareProvisioningCheckStart, t.timeouts.hardwareProvisioning
+		}
+	}
+	return nil, 0
+}
+
+// parseForcedFailure parses the value of the force-failure annotation into a condition type and reason
+func parseForcedFailure(value string) (provisioningv1alpha1.ConditionType, provisioningv1alpha1.ConditionReason, error) {
+	conditionType, reason, found := strings.Cut(value, "/")
+	if !found || reason == "" {
+		reason = string(provisioningv1alpha1.CRconditionReasons.Failed)
+	}
+
+	validTypes := []provisioningv1alpha1.ConditionType{
+		provisioningv1alpha1.PRconditionTypes.Validated,
+		provisioningv1alpha1.PRconditionTypes.HardwareTemplateRendered,
+		provisioningv1alpha1.PRconditionTypes.HardwareProvisioned,
+		provisioningv1alpha1.PRconditionTypes.HardwareNodeConfigApplied,
+		provisioningv1alpha1.PRconditionTypes.HardwareConfigured,
+		provisioningv1alpha1.PRconditionTypes.ClusterInstanceRendered,
+		provisioningv1alpha1.PRconditionTypes.ClusterResourcesCreated,
+		provisioningv1alpha1.PRconditionTypes.ClusterInstanceProcessed,
+		provisioningv1alpha1.PRconditionTypes.ClusterProvisioned,
+		provisioningv1alpha1.PRconditionTypes.ConfigurationApplied,
+		provisioningv1alpha1.PRconditionTypes.UpgradeCompleted,
+	}
+	if !slices.Contains(validTypes, provisioningv1alpha1.ConditionType(conditionType)) {
+		return "", "", fmt.Errorf("unknown condition type '%s'", conditionType)
+	}
+
+	return provisioningv1alpha1.ConditionType(conditionType), provisioningv1alpha1.ConditionReason(reason), nil
+}
+
+// handleForcedFailure forces the ProvisioningRequest into the failed state requested by the force-failure
+// annotation. The annotation is ignored unless chaos testing has been enabled through the environment. It
+// returns true if the failure has been applied, in which case the rest of the reconciliation is skipped.
+func (t *provisioningRequestReconcilerTask) handleForcedFailure(ctx context.Context) (bool, error) {
+	value, exists := t.object.GetAnnotations()[provisioningRequestForceFailureAnnotation]
+	if !exists || !utils.GetChaosTestingEnabled() {
+		return false, nil
+	}
+
+	conditionType, reason, err := parseForcedFailure(value)
+	if err != nil {
+		t.logger.WarnContext(
+			ctx,
+			fmt.Sprintf("Ignoring invalid %s annotation on ProvisioningRequest %s: %s",
+				provisioningRequestForceFailureAnnotation, t.object.Name, err.Error()))
+		return false, nil
+	}
+
+	message := fmt.Sprintf("Failure forced by the %s annotation", provisioningRequestForceFailureAnnotation)
+	condition := meta.FindStatusCondition(t.object.Status.Conditions, string(conditionType))
+	if condition != nil && condition.Status == metav1.ConditionFalse && condition.Reason == string(reason) &&
+		t.object.Status.ProvisioningStatus.ProvisioningPhase == provisioningv1alpha1.StateFailed {
+		// Already applied
+		return true, nil
+	}
+
+	t.logger.InfoContext(
+		ctx,
+		fmt.Sprintf("Forcing ProvisioningRequest %s into failed state (condition: %s, reason: %s)",
+			t.object.Name, conditionType, reason))
+
+	utils.SetStatusCondition(&t.object.Status.Conditions,
+		conditionType,
+		reason,
+		metav1.ConditionFalse,
+		message)
+	utils.SetProvisioningStateFailed(t.object, message)
+	if err := utils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
+		return true, fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, err)
+	}
+
+	return true, nil
+}
+
+// run reconciles the ProvisioningRequest, then records the manifests applied by the steps that ran
 func (t *provisioningRequestReconcilerTask) run(ctx context.Context) (ctrl.Result, error) {
+	result, err := t.runSteps(ctx)
+	if errors.IsConflict(err) {
+		// The status is stale, the manifests are recorded by the next reconciliation
+		return result, err
+	}
+	if recordErr := t.handleRenderedManifests(ctx); recordErr != nil && err == nil {
+		return requeueWithError(recordErr)
+	}
+	return result, err
+}
+
+// runSteps runs the steps of the reconciliation of the ProvisioningRequest
+func (t *provisioningRequestReconcilerTask) runSteps(ctx context.Context) (ctrl.Result, error) {
+	// Short-circuit the reconciliation if a forced failure has been requested for chaos testing
+	forced, err := t.handleForcedFailure(ctx)
+	if err != nil {
+		return requeueWithError(err)
+	}
+	if forced {
+		return doNotRequeue(), nil
+	}
+
 	// Validate the ProvisioningRequest
-	err := t.handleValidation(ctx)
+	err = t.handleValidation(ctx)
 	if err != nil {
+		if utils.IsMissingReferenceErr(err) {
+			_, err = t.checkClusterDeployConfigState(ctx)
+			if err != nil {
+				return requeueWithError(err)
+			}
+			// Requeue since we are not watching for the referenced objects to be created
+			return requeueWithMediumInterval(), nil
+		}
 		if utils.IsInputError(err) {
 			return t.checkClusterDeployConfigState(ctx)
 		}
@@ -174,18 +478,85 @@ func (t *provisioningRequestReconcilerTask) run(ctx context.Context) (ctrl.Resul
 		return requeueWithError(err)
 	}
 
-	// Render and validate ClusterInstance
-	renderedClusterInstance, err := t.handleRenderClusterInstance(ctx)
+	// A dry-run request stops once the ClusterInstance has been rendered
+	if t.isDryRun() {
+		return t.handleDryRun(ctx)
+	}
+	if t.hasDryRunAnnotation() {
+		t.logger.WarnContext(
+			ctx,
+			"Ignoring the dry-run annotation since the provisioning has already started",
+			slog.String("name", t.object.Name),
+		)
+	}
+	t.object.Status.RenderedClusterInstance = ""
+
+	// Detect a ManagedCluster deleted out-of-band after the cluster was installed. Nothing
+	// else is reconciled until the cluster is back.
+	missing, err := t.checkManagedClusterPresence(ctx)
 	if err != nil {
-		if utils.IsInputError(err) {
-			return t.checkClusterDeployConfigState(ctx)
-		}
 		return requeueWithError(err)
 	}
+	if missing {
+		// The creation of ManagedClusters is not watched, poll for the cluster to be imported again
+		return requeueWithLongInterval(), nil
+	}
+
+	// Only the configuration needs to be reconciled when nothing but the policy template parameters
+	// changed since the cluster was installed, so the rendering, hardware and installation steps are
+	// skipped and the installed ClusterInstance is used instead.
+	installationInputsHash, err := t.getInstallationInputsHash(ctx)
+	if err != nil {
+		return requeueWithError(err)
+	}
+	configurationOnly := t.isConfigurationOnlyChange(installationInputsHash)
+
+	var renderedClusterInstance *siteconfig.ClusterInstance
+	if configurationOnly {
+		renderedClusterInstance, err = t.getInstalledClusterInstance(ctx)
+		if err != nil {
+			return requeueWithError(err)
+		}
+		// The full reconciliation recreates a ClusterInstance that has gone missing
+		configurationOnly = renderedClusterInstance != nil
+	}
+	if configurationOnly {
+		t.logger.InfoContext(
+			ctx,
+			"Only the configuration has changed, skipping the hardware and installation steps",
+			slog.String("name", t.object.Name),
+		)
+		// Keep the provisioning status of the installed cluster up to date
+		if err := t.checkClusterProvisionStatus(ctx, renderedClusterInstance.Name); err != nil {
+			return requeueWithError(err)
+		}
+	} else {
+		// Render and validate ClusterInstance
+		renderedClusterInstance, err = t.handleRenderClusterInstance(ctx)
+		if err != nil {
+			if utils.IsInputError(err) {
+				return t.checkClusterDeployConfigState(ctx)
+			}
+			return requeueWithError(err)
+		}
+	}
 
 	// Handle the creation of resources required for cluster deployment
 	err = t.handleClusterResources(ctx, renderedClusterInstance)
 	if err != nil {
+		if isResourceBarrierError(err) {
+			// With parallel pre-provisioning, the hardware is provisioned while the cluster resources wait for
+			// their prerequisites. The cluster installation still waits for both.
+			if !configurationOnly && !t.isHardwareProvisionSkipped() &&
+				t.object.IsFeatureEnabled(provisioningv1alpha1.FeatureParallelPreprovisioning) {
+				res, proceed, err := t.handleNodePoolProvisioning(ctx, renderedClusterInstance)
+				if err != nil || (res == doNotRequeue() && !proceed) {
+					return res, err
+				}
+			}
+			// Requeue since we are not watching for the prerequisites of the held back resources
+			return requeueWithMediumInterval(), nil
+		}
 		if utils.IsInputError(err) {
 			_, err = t.checkClusterDeployConfigState(ctx)
 			if err != nil {
@@ -198,18 +569,20 @@ func (t *provisioningRequestReconcilerTask) run(ctx context.Context) (ctrl.Resul
 		return requeueWithError(err)
 	}
 
-	// Handle hardware template and NodePool provisioning/configuring
-	if !t.isHardwareProvisionSkipped() {
-		res, proceed, err := t.handleNodePoolProvisioning(ctx, renderedClusterInstance)
-		if err != nil || (res == doNotRequeue() && !proceed) || res.RequeueAfter > 0 {
-			return res, err
+	if !configurationOnly {
+		// Handle hardware template and NodePool provisioning/configuring
+		if !t.isHardwareProvisionSkipped() {
+			res, proceed, err := t.handleNodePoolProvisioning(ctx, renderedClusterInstance)
+			if err != nil || (res == doNotRequeue() && !proceed) || res.RequeueAfter > 0 {
+				return res, err
+			}
 		}
-	}
 
-	// Handle the cluster install with ClusterInstance
-	err = t.handleClusterInstallation(ctx, renderedClusterInstance)
-	if err != nil {
-		return requeueWithError(err)
+		// Handle the cluster install with ClusterInstance
+		err = t.handleClusterInstallation(ctx, renderedClusterInstance)
+		if err != nil {
+			return requeueWithError(err)
+		}
 	}
 
 	// Handle policy configuration only after the cluster provisioning
@@ -217,6 +590,13 @@ func (t *provisioningRequestReconcilerTask) run(ctx context.Context) (ctrl.Resul
 	if utils.IsClusterProvisionPresent(t.object) &&
 		!utils.IsClusterProvisionTimedOutOrFailed(t.object) {
 
+		// Record the inputs the cluster has been installed from
+		if utils.IsClusterProvisionCompleted(t.object) {
+			if err := t.recordInstallationInputs(ctx, installationInputsHash); err != nil {
+				return requeueWithError(err)
+			}
+		}
+
 		// Handle configuration through policies.
 		requeue, err := t.handleClusterPolicyConfiguration(ctx)
 		if err != nil {
@@ -226,7 +606,7 @@ func (t *provisioningRequestReconcilerTask) run(ctx context.Context) (ctrl.Resul
 		// Requeue if cluster provisioning is not completed (in-progress or unknown)
 		// or there are enforce policies that are not Compliant.
 		if !utils.IsClusterProvisionCompleted(t.object) || requeue {
-			return requeueWithLongInterval(), nil
+			return t.requeueForClusterProgress(), nil
 		}
 
 		shouldUpgrade, err := t.IsUpgradeRequested(ctx, renderedClusterInstance.GetName())
@@ -271,12 +651,45 @@ func (t *provisioningRequestReconcilerTask) handleNodePoolProvisioning(ctx conte
 		return res, false, requeueErr
 	}
 
+	// Make sure no other ProvisioningRequest is using the same NodePool
+	duplicate, err := t.checkDuplicateNodePoolReference(ctx, renderedNodePool)
+	if err != nil {
+		res, requeueErr := requeueWithError(err)
+		return res, false, requeueErr
+	}
+	if duplicate {
+		return doNotRequeue(), false, nil
+	}
+
+	// Make sure an existing NodePool that is going to be adopted was allocated for this cluster
+	conflict, err := t.checkNodePoolAdoption(ctx, renderedNodePool)
+	if err != nil {
+		res, requeueErr := requeueWithError(err)
+		return res, false, requeueErr
+	}
+	if conflict {
+		return doNotRequeue(), false, nil
+	}
+
 	// Create/Update the NodePool
 	if err := t.createOrUpdateNodePool(ctx, renderedNodePool); err != nil {
 		res, requeueErr := requeueWithError(err)
 		return res, false, requeueErr
 	}
 
+	// Hold the hardware provisioning in its current phase while the hardware plugin is unavailable, rather than
+	// letting it fail or time out
+	if !t.isHardwareProvisioningCompleted() {
+		available, err := t.checkHardwarePluginAvailability(ctx, renderedNodePool)
+		if err != nil {
+			res, requeueErr := requeueWithError(err)
+			return res, false, requeueErr
+		}
+		if !available {
+			return t.requeueForHardwareProgress(), false, nil
+		}
+	}
+
 	// Wait for the NodePool to be provisioned and update BMC details if necessary
 	provisioned, configured, timedOutOrFailed, err := t.waitForHardwareData(ctx, renderedClusterInstance, renderedNodePool)
 	if err != nil {
@@ -295,7 +708,7 @@ func (t *provisioningRequestReconcilerTask) handleNodePoolProvisioning(ctx conte
 				renderedNodePool.GetNamespace(),
 			),
 		)
-		return requeueWithMediumInterval(), false, nil
+		return t.requeueForHardwareProgress(), false, nil
 	}
 
 	// If configuration is not yet complete, wait for it to complete
@@ -309,13 +722,46 @@ func (t *provisioningRequestReconcilerTask) handleNodePoolProvisioning(ctx conte
 				renderedNodePool.GetNamespace(),
 			),
 		)
-		return requeueWithMediumInterval(), false, nil
+		return t.requeueForHardwareProgress(), false, nil
 	}
 
 	// Provisioning completed successfully; proceed with further processing
 	return doNotRequeue(), true, nil
 }
 
+// requeueWithPollInterval requeues after the poll interval preferred by the ClusterTemplate, when set,
+// and returns the given default result otherwise.
+func requeueWithPollInterval(pollInterval *metav1.Duration, defaultResult ctrl.Result) ctrl.Result {
+	if pollInterval != nil && pollInterval.Duration > 0 {
+		return requeueWithCustomInterval(pollInterval.Duration)
+	}
+	return defaultResult
+}
+
+// requeueForHardwareProgress requeues while waiting for the hardware to be provisioned and configured
+func (t *provisioningRequestReconcilerTask) requeueForHardwareProgress() ctrl.Result {
+	if t.ctDetails.pollIntervals == nil {
+		return requeueWithMediumInterval()
+	}
+	return requeueWithPollInterval(t.ctDetails.pollIntervals.HardwareProvisioning, requeueWithMediumInterval())
+}
+
+// requeueForClusterProgress requeues while waiting for the cluster installation to complete, or for the
+// enforce policies to be compliant once it has completed. The configuration waiting for the change window is requeued
+// when the window opens.
+func (t *provisioningRequestReconcilerTask) requeueForClusterProgress() ctrl.Result {
+	if t.changeWindowWait > 0 {
+		return requeueWithCustomInterval(t.changeWindowWait)
+	}
+	if t.ctDetails.pollIntervals == nil {
+		return requeueWithLongInterval()
+	}
+	if !utils.IsClusterProvisionCompleted(t.object) {
+		return requeueWithPollInterval(t.ctDetails.pollIntervals.ClusterProvisioning, requeueWithLongInterval())
+	}
+	return requeueWithPollInterval(t.ctDetails.pollIntervals.ClusterConfiguration, requeueWithLongInterval())
+}
+
 // checkClusterDeployConfigState checks the current deployment and configuration state of
 // the cluster by evaluating the statuses of related resources like NodePool, ClusterInstance
 // and policy configuration when applicable, and update the corresponding ProvisioningRequest
@@ -344,7 +790,7 @@ func (t *provisioningRequestReconcilerTask) checkClusterDeployConfigState(ctx co
 			return doNotRequeue(), nil
 		}
 		if !hwProvisioned {
-			return requeueWithMediumInterval(), nil
+			return t.requeueForHardwareProgress(), nil
 		}
 	}
 
@@ -356,6 +802,16 @@ func (t *provisioningRequestReconcilerTask) checkClusterDeployConfigState(ctx co
 			return requeueWithError(err)
 		}
 
+		// Detect a ManagedCluster deleted out-of-band after the cluster was installed
+		missing, err := t.checkManagedClusterPresence(ctx)
+		if err != nil {
+			return requeueWithError(err)
+		}
+		if missing {
+			// The creation of ManagedClusters is not watched, poll for the cluster to be imported again
+			return requeueWithLongInterval(), nil
+		}
+
 		// Check the policy configuration status only after the cluster provisioning
 		// has started, and not failed or timedout
 		if utils.IsClusterProvisionPresent(t.object) &&
@@ -367,7 +823,7 @@ func (t *provisioningRequestReconcilerTask) checkClusterDeployConfigState(ctx co
 			// Requeue if Cluster Provisioned is not completed (in-progress or unknown)
 			// or there are enforce policies that are not Compliant
 			if !utils.IsClusterProvisionCompleted(t.object) || requeue {
-				return requeueWithLongInterval(), nil
+				return t.requeueForClusterProgress(), nil
 			}
 		}
 	}
@@ -413,9 +869,13 @@ func (t *provisioningRequestReconcilerTask) handleValidation(ctx context.Context
 			slog.String("name", t.object.Name),
 			slog.String("error", err.Error()),
 		)
+		reason := provisioningv1alpha1.CRconditionReasons.Failed
+		if utils.IsTemplateVersionTooOldErr(err) {
+			reason = provisioningv1alpha1.CRconditionReasons.TemplateVersionTooOld
+		}
 		utils.SetStatusCondition(&t.object.Status.Conditions,
 			provisioningv1alpha1.PRconditionTypes.Validated,
-			provisioningv1alpha1.CRconditionReasons.Failed,
+			reason,
 			metav1.ConditionFalse,
 			"Failed to validate the ProvisioningRequest: "+err.Error(),
 		)
@@ -481,9 +941,97 @@ func (t *provisioningRequestReconcilerTask) handleRenderClusterInstance(ctx cont
 	return renderedClusterInstance, nil
 }
 
+// hasDryRunAnnotation returns true if the ProvisioningRequest has been annotated for a dry-run
+func (t *provisioningRequestReconcilerTask) hasDryRunAnnotation() bool {
+	return isDryRunAnnotated(t.object.GetAnnotations())
+}
+
+// isDryRunAnnotated returns true if either of the dry-run annotations is set to "true"
+func isDryRunAnnotated(annotations map[string]string) bool {
+	return strings.EqualFold(annotations[provisioningRequestDryRunAnnotation], "true") ||
+		strings.EqualFold(annotations[provisioningRequestDryRunAliasAnnotation], "true")
+}
+
+// isDryRun returns true if the ProvisioningRequest has been annotated for a dry-run before its hardware
+// provisioning or cluster installation started. The annotation is ignored afterwards, as the resources
+// it holds back already exist.
+func (t *provisioningRequestReconcilerTask) isDryRun() bool {
+	return t.hasDryRunAnnotation() && !t.isProvisioningStarted()
+}
+
+// isProvisioningStarted returns true if the hardware provisioning or the cluster installation has started
+func (t *provisioningRequestReconcilerTask) isProvisioningStarted() bool {
+	return meta.FindStatusCondition(t.object.Status.Conditions,
+		string(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned)) != nil ||
+		utils.IsClusterProvisionPresent(t.object)
+}
+
+// checkNotDryRun guards the steps that create cluster resources or allocate hardware against a dry-run request
+func (t *provisioningRequestReconcilerTask) checkNotDryRun(action string) error {
+	if t.isDryRun() {
+		return fmt.Errorf("refusing to %s for ProvisioningRequest %s in dry-run mode", action, t.object.Name)
+	}
+	return nil
+}
+
+// handleDryRun renders the ClusterInstance of a dry-run ProvisioningRequest and records it in the status. The
+// cluster resources, hardware provisioning and cluster installation are skipped, so the request does not progress
+// any further until the dry-run annotation is removed.
+func (t *provisioningRequestReconcilerTask) handleDryRun(ctx context.Context) (ctrl.Result, error) {
+	renderedClusterInstance, err := t.handleRenderClusterInstance(ctx)
+	if err != nil {
+		if utils.IsInputError(err) {
+			return t.checkClusterDeployConfigState(ctx)
+		}
+		return requeueWithError(err)
+	}
+
+	rendered, err := yaml.Marshal(renderedClusterInstance)
+	if err != nil {
+		return requeueWithError(fmt.Errorf("failed to marshal the rendered ClusterInstance: %w", err))
+	}
+
+	t.logger.InfoContext(
+		ctx,
+		"Rendered the ClusterInstance in dry-run mode, skipping the cluster resources and provisioning",
+		slog.String("name", t.object.Name),
+	)
+
+	t.object.Status.RenderedClusterInstance = string(rendered)
+	utils.SetStatusCondition(&t.object.Status.Conditions,
+		provisioningv1alpha1.PRconditionTypes.ClusterResourcesCreated,
+		provisioningv1alpha1.CRconditionReasons.DryRun,
+		metav1.ConditionFalse,
+		fmt.Sprintf("Cluster resources are not created while the %s annotation is set",
+			provisioningRequestDryRunAnnotation),
+	)
+	utils.SetProvisioningStateInProgress(t.object,
+		"Dry-run completed, the rendered ClusterInstance is available in the status")
+	if err := utils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
+		return requeueWithError(
+			fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, err))
+	}
+
+	return doNotRequeue(), nil
+}
+
 func (t *provisioningRequestReconcilerTask) handleClusterResources(ctx context.Context, clusterInstance *siteconfig.ClusterInstance) error {
 	err := t.createOrUpdateClusterResources(ctx, clusterInstance)
-	if err != nil {
+	if isResourceBarrierError(err) {
+		t.logger.InfoContext(
+			ctx,
+			"Waiting for the prerequisites of the cluster resources for ProvisioningRequest",
+			slog.String("name", t.object.Name),
+			slog.String("reason", err.Error()),
+		)
+
+		utils.SetStatusCondition(&t.object.Status.Conditions,
+			provisioningv1alpha1.PRconditionTypes.ClusterResourcesCreated,
+			provisioningv1alpha1.CRconditionReasons.InProgress,
+			metav1.ConditionFalse,
+			"Waiting for the cluster resource prerequisites: "+err.Error(),
+		)
+	} else if err != nil {
 		t.logger.ErrorContext(
 			ctx,
 			"Failed to apply the required cluster resource for ProvisioningRequest",
@@ -574,6 +1122,20 @@ func (r *ProvisioningRequestReconciler) handleFinalizer(
 		}
 	} else if controllerutil.ContainsFinalizer(provisioningRequest, provisioningRequestFinalizer) {
 		r.Logger.Info(fmt.Sprintf("ProvisioningRequest (%s) is being deleted", provisioningRequest.Name))
+		// Wait for active policy remediation to settle before tearing down the cluster.
+		remediationInProgress, err := r.isPolicyRemediationInProgress(ctx, provisioningRequest)
+		if err != nil {
+			return doNotRequeue(), true, err
+		}
+		if remediationInProgress {
+			r.Logger.Info(fmt.Sprintf(
+				"Waiting for the policy remediation of ProvisioningRequest (%s) to settle before deletion. "+
+					"Set the %s annotation to \"true\" to proceed without waiting",
+				provisioningRequest.Name, provisioningRequestForceDeletionAnnotation))
+			// Annotation changes do not trigger a reconciliation, so requeue to pick up a forced deletion.
+			return requeueWithMediumInterval(), true, nil
+		}
+
 		deleteComplete, err := r.handleProvisioningRequestDeletion(ctx, provisioningRequest)
 		if !deleteComplete {
 			// No need to requeue here, deletion of dependents(including their finalizer removal) will
@@ -596,6 +1158,105 @@ func (r *ProvisioningRequestReconciler) handleFinalizer(
 	return doNotRequeue(), false, nil
 }
 
+// isPolicyRemediationInProgress checks whether the deletion of the ProvisioningRequest must wait for the
+// enforce policies to finish remediating the cluster. Remediation is considered in progress while the
+// ConfigurationApplied condition is InProgress and an enforce child policy in the cluster namespace is
+// not compliant. The check is skipped once the teardown has started or when the deletion is forced.
+func (r *ProvisioningRequestReconciler) isPolicyRemediationInProgress(
+	ctx context.Context, provisioningRequest *provisioningv1alpha1.ProvisioningRequest) (bool, error) {
+	if provisioningRequest.Status.ProvisioningStatus.ProvisioningPhase == provisioningv1alpha1.StateDeleting ||
+		provisioningRequest.Status.Extensions.ClusterDetails == nil {
+		return false, nil
+	}
+	if strings.EqualFold(provisioningRequest.GetAnnotations()[provisioningRequestForceDeletionAnnotation], "true") {
+		r.Logger.Info(fmt.Sprintf("Deletion of ProvisioningRequest (%s) is forced, not waiting for policy remediation",
+			provisioningRequest.Name))
+		return false, nil
+	}
+
+	configurationApplied := meta.FindStatusCondition(provisioningRequest.Status.Conditions,
+		string(provisioningv1alpha1.PRconditionTypes.ConfigurationApplied))
+	if configurationApplied == nil ||
+		configurationApplied.Reason != string(provisioningv1alpha1.CRconditionReasons.InProgress) {
+		return false, nil
+	}
+
+	policies := &policiesv1.PolicyList{}
+	listOpts := []client.ListOption{
+		client.HasLabels{utils.ChildPolicyRootPolicyLabel},
+		client.InNamespace(provisioningRequest.Status.Extensions.ClusterDetails.Name),
+	}
+	if err := r.Client.List(ctx, policies, listOpts...); err != nil {
+		return false, fmt.Errorf("failed to list Policies: %w", err)
+	}
+	for _, policy := range policies.Items {
+		if strings.EqualFold(string(policy.Spec.RemediationAction), string(policiesv1.Enforce)) &&
+			policy.Status.ComplianceState != policiesv1.Compliant {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// isCancellationRequested checks whether the provisioning has been cancelled. A cancellation is honored only
+// until the cluster installation has completed, and once cancelled the ProvisioningRequest stays cancelled.
+func (r *ProvisioningRequestReconciler) isCancellationRequested(
+	ctx context.Context, provisioningRequest *provisioningv1alpha1.ProvisioningRequest) bool {
+	if provisioningRequest.Status.ProvisioningStatus.ProvisioningPhase == provisioningv1alpha1.StateCancelled {
+		return true
+	}
+	if !strings.EqualFold(provisioningRequest.GetAnnotations()[provisioningRequestCancelAnnotation], "true") {
+		return false
+	}
+	// The installation inputs are recorded once the installation completes and are kept afterwards, even if the
+	// cluster goes missing later on
+	if details := provisioningRequest.Status.Extensions.ClusterDetails; details != nil && details.InstallationInputsHash != "" {
+		r.Logger.WarnContext(
+			ctx,
+			fmt.Sprintf("Ignoring the %s annotation on ProvisioningRequest %s, the cluster installation has completed",
+				provisioningRequestCancelAnnotation, provisioningRequest.Name))
+		return false
+	}
+	return true
+}
+
+// handleCancellation tears down the hardware and cluster installation resources of a cancelled
+// ProvisioningRequest, and sets the provisioning state to cancelled.
+func (r *ProvisioningRequestReconciler) handleCancellation(
+	ctx context.Context, provisioningRequest *provisioningv1alpha1.ProvisioningRequest) (ctrl.Result, error) {
+	if provisioningRequest.Status.ProvisioningStatus.ProvisioningPhase != provisioningv1alpha1.StateCancelled {
+		r.Logger.InfoContext(ctx, fmt.Sprintf("ProvisioningRequest (%s) has been cancelled", provisioningRequest.Name))
+		if err := utils.UpdateK8sCRStatusWithMutation(ctx, r.Client, provisioningRequest,
+			func(latest *provisioningv1alpha1.ProvisioningRequest) {
+				utils.SetProvisioningStateCancelled(latest, "Cancellation is in progress")
+			}); err != nil {
+			return requeueWithError(
+				fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", provisioningRequest.Name, err))
+		}
+	}
+
+	teardownComplete, err := r.deleteProvisioningRequestDependents(ctx, provisioningRequest)
+	if err != nil {
+		return requeueWithError(err)
+	}
+	if !teardownComplete {
+		// No need to requeue here, the deletion of the dependents will trigger the reconciliation.
+		return doNotRequeue(), nil
+	}
+
+	message := "Provisioning request has been cancelled"
+	if provisioningRequest.Status.ProvisioningStatus.ProvisioningDetails != message {
+		if err := utils.UpdateK8sCRStatusWithMutation(ctx, r.Client, provisioningRequest,
+			func(latest *provisioningv1alpha1.ProvisioningRequest) {
+				utils.SetProvisioningStateCancelled(latest, message)
+			}); err != nil {
+			return requeueWithError(
+				fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", provisioningRequest.Name, err))
+		}
+	}
+	return doNotRequeue(), nil
+}
+
 // handleProvisioningRequestDeletion ensures that specific dependents with potential long-running finalizers
 // are deleted before the ProvisioningRequest itself is finalized. It returns true if all dependents have been
 // deleted; otherwise, it returns false.
@@ -603,12 +1264,19 @@ func (r *ProvisioningRequestReconciler) handleProvisioningRequestDeletion(
 	ctx context.Context, provisioningRequest *provisioningv1alpha1.ProvisioningRequest) (bool, error) {
 	// Set the provisioningState to deleting
 	if provisioningRequest.Status.ProvisioningStatus.ProvisioningPhase != provisioningv1alpha1.StateDeleting {
-		utils.SetProvisioningStateDeleting(provisioningRequest)
-		if err := utils.UpdateK8sCRStatus(ctx, r.Client, provisioningRequest); err != nil {
+		if err := utils.UpdateK8sCRStatusWithMutation(ctx, r.Client, provisioningRequest,
+			utils.SetProvisioningStateDeleting); err != nil {
 			return false, fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", provisioningRequest.Name, err)
 		}
 	}
 
+	return r.deleteProvisioningRequestDependents(ctx, provisioningRequest)
+}
+
+// deleteProvisioningRequestDependents deletes the NodePools and the cluster namespaces created for the
+// ProvisioningRequest. It returns true once all of them are gone.
+func (r *ProvisioningRequestReconciler) deleteProvisioningRequestDependents(
+	ctx context.Context, provisioningRequest *provisioningv1alpha1.ProvisioningRequest) (bool, error) {
 	// List resources by label
 	var labels = map[string]string{
 		provisioningRequestNameLabel: provisioningRequest.Name,
@@ -661,5 +1329,99 @@ func (r *ProvisioningRequestReconciler) handleProvisioningRequestDeletion(
 }
 
 func (t *provisioningRequestReconcilerTask) isHardwareProvisionSkipped() bool {
-	return t.ctDetails.templates.HwTemplate == ""
+	return t.getHwTemplateName(t.ctDetails.templates) == ""
+}
+
+// getHwTemplateName returns the name of the HardwareTemplate selected by the ClusterTemplate templates for the
+// oCloudSiteId of the ProvisioningRequest. The default HwTemplate is used when the site is not set, as the
+// templateParameters are validated against the schema separately.
+func (t *provisioningRequestReconcilerTask) getHwTemplateName(templates provisioningv1alpha1.Templates) string {
+	if len(templates.SiteHwTemplates) == 0 {
+		return templates.HwTemplate
+	}
+
+	siteID, err := provisioningv1alpha1.ExtractMatchingInput(
+		t.object.Spec.TemplateParameters.Raw, utils.TemplateParamOCloudSiteId)
+	if err != nil {
+		return templates.HwTemplate
+	}
+	site, _ := siteID.(string)
+	return utils.GetHwTemplateNameForSite(templates, site)
+}
+
+// getInstallationInputsHash returns a digest of the inputs of the ProvisioningRequest that the hardware
+// provisioning and the cluster installation depend on, which are all of them except for the policy
+// template parameters, along with the ClusterInstance defaults of the template.
+func (t *provisioningRequestReconcilerTask) getInstallationInputsHash(ctx context.Context) (string, error) {
+	parameters := map[string]any{}
+	if err := json.Unmarshal(t.object.Spec.TemplateParameters.Raw, &parameters); err != nil {
+		return "", fmt.Errorf("failed to unmarshal the template parameters: %w", err)
+	}
+	delete(parameters, utils.TemplateParamPolicyConfig)
+
+	defaultsCm, err := utils.GetConfigmap(
+		ctx, t.client, t.ctDetails.templates.ClusterInstanceDefaults, t.ctDetails.namespace)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the ClusterInstance defaults ConfigMap: %w", err)
+	}
+
+	// The keys of the maps are sorted when marshaled, so the digest is stable
+	data, err := json.Marshal(map[string]any{
+		"template":                getClusterTemplateRefName(t.object.Spec.TemplateName, t.object.Spec.TemplateVersion),
+		"parameters":              parameters,
+		"extensions":              t.object.Spec.Extensions,
+		"clusterInstanceDefaults": defaultsCm.Data,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal the installation inputs: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// isConfigurationOnlyChange returns true if the ProvisioningRequest changed since the cluster was installed but
+// only in its policy template parameters, so only the configuration needs to be reconciled. Upgrades in progress
+// always go through the full reconciliation, and so do the reconciliations without any change to the spec.
+func (t *provisioningRequestReconcilerTask) isConfigurationOnlyChange(installationInputsHash string) bool {
+	clusterDetails := t.object.Status.Extensions.ClusterDetails
+	return clusterDetails != nil &&
+		clusterDetails.InstallationGeneration != t.object.Generation &&
+		clusterDetails.InstallationInputsHash == installationInputsHash &&
+		utils.IsClusterProvisionCompleted(t.object) &&
+		!(utils.IsClusterUpgradeInitiated(t.object) && !utils.IsClusterUpgradeCompleted(t.object))
+}
+
+// getInstalledClusterInstance returns the ClusterInstance the cluster has been installed from, or nil if it no
+// longer exists
+func (t *provisioningRequestReconcilerTask) getInstalledClusterInstance(
+	ctx context.Context) (*siteconfig.ClusterInstance, error) {
+	name := t.object.Status.Extensions.ClusterDetails.Name
+	clusterInstance := &siteconfig.ClusterInstance{}
+	exists, err := utils.DoesK8SResourceExist(ctx, t.client, name, name, clusterInstance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ClusterInstance %s: %w", name, err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	return clusterInstance, nil
+}
+
+// recordInstallationInputs records the digest of the inputs the cluster has been installed from, along with the
+// generation of the ProvisioningRequest they have been reconciled at
+func (t *provisioningRequestReconcilerTask) recordInstallationInputs(ctx context.Context,
+	installationInputsHash string) error {
+	clusterDetails := t.object.Status.Extensions.ClusterDetails
+	if clusterDetails == nil ||
+		(clusterDetails.InstallationInputsHash == installationInputsHash &&
+			clusterDetails.InstallationGeneration == t.object.Generation) {
+		return nil
+	}
+
+	clusterDetails.InstallationInputsHash = installationInputsHash
+	clusterDetails.InstallationGeneration = t.object.Generation
+	if err := utils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
+		return fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, err)
+	}
+	return nil
 }
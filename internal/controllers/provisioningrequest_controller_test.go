@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
+	"slices"
+	"strings"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -17,14 +20,20 @@ import (
 	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
 	"github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	clusterv1 "open-cluster-management.io/api/cluster/v1"
 	policiesv1 "open-cluster-management.io/governance-policy-propagator/api/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
 )
 
 const (
@@ -392,6 +401,19 @@ func verifyProvisioningStatus(provStatus provisioningv1alpha1.ProvisioningStatus
 	Expect(provStatus.ProvisionedResources).To(Equal(expectedResources))
 }
 
+// ignoreFieldsStrippedByFakeClient ignores the ClusterInstance fields rendered with empty values, for the tests
+// going through the rendering again once the cluster provisioning has started. The fakeclient strips the fields with
+// empty values like false or "" from the stored ClusterInstance, so they would otherwise be detected as changes to
+// immutable fields.
+func ignoreFieldsStrippedByFakeClient() {
+	ignoredFields := utils.IgnoredClusterInstanceFields
+	utils.IgnoredClusterInstanceFields = append(slices.Clone(ignoredFields),
+		[]string{"holdInstallation"}, []string{"nodes", "*", "ironicInspect"})
+	DeferCleanup(func() {
+		utils.IgnoredClusterInstanceFields = ignoredFields
+	})
+}
+
 func removeRequiredFieldFromClusterInstanceCm(
 	ctx context.Context, c client.Client, cmName, cmNamespace string) {
 	// Remove a required field from ClusterInstance default configmap
@@ -609,6 +631,50 @@ var _ = Describe("ProvisioningRequestReconcile", func() {
 		}
 	})
 
+	It("requeues when the status update keeps conflicting", func() {
+		reconciler.Client = interceptor.NewClient(c.(client.WithWatch), interceptor.Funcs{
+			SubResourceUpdate: func(ctx context.Context, client client.Client, subResourceName string,
+				obj client.Object, opts ...client.SubResourceUpdateOption) error {
+				return errors.NewConflict(provisioningv1alpha1.GroupVersion.WithResource("provisioningrequests").GroupResource(),
+					obj.GetName(), fmt.Errorf("the object has been modified"))
+			},
+		})
+
+		result, err := reconciler.Reconcile(ctx, req)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(requeueImmediately()))
+
+		reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+		Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+		Expect(reconciledCR.Status.Conditions).To(BeEmpty())
+	})
+
+	It("reports the transitions only once they are persisted", func() {
+		// Fail the ClusterTemplate validation
+		ctValidatedCond := meta.FindStatusCondition(
+			ct.Status.Conditions, string(provisioningv1alpha1.CTconditionTypes.Validated))
+		ctValidatedCond.Status = metav1.ConditionFalse
+		ctValidatedCond.Reason = string(provisioningv1alpha1.CTconditionReasons.Failed)
+		Expect(c.Status().Update(ctx, ct)).To(Succeed())
+
+		recorder := record.NewFakeRecorder(10)
+		reconciler.Recorder = recorder
+		reconciler.Client = interceptor.NewClient(c.(client.WithWatch), interceptor.Funcs{
+			SubResourceUpdate: func(ctx context.Context, client client.Client, subResourceName string,
+				obj client.Object, opts ...client.SubResourceUpdateOption) error {
+				return fmt.Errorf("etcdserver: request timed out")
+			},
+		})
+		_, err := reconciler.Reconcile(ctx, req)
+		Expect(err).To(HaveOccurred())
+		Expect(recorder.Events).To(BeEmpty())
+
+		reconciler.Client = c
+		_, err = reconciler.Reconcile(ctx, req)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(recorder.Events).To(Receive(ContainSubstring(validationFailedEvent)))
+	})
+
 	Context("Resources preparation during initial Provisioning", func() {
 		It("Verify status conditions if ProvisioningRequest validation fails", func() {
 			// Fail the ClusterTemplate validation
@@ -677,7 +743,64 @@ var _ = Describe("ProvisioningRequestReconcile", func() {
 				provisioningv1alpha1.StateFailed, "Failed to render and validate ClusterInstance", nil)
 		})
 
-		It("Verify status conditions if Cluster resources creation fails", func() {
+		It("Verify status conditions if the HardwareTemplate is invalid", func() {
+			// Duplicate the name of a node group
+			hwTmpl := &hwv1alpha1.HardwareTemplate{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: hwTemplate, Namespace: utils.InventoryNamespace}, hwTmpl)).To(Succeed())
+			hwTmpl.Spec.NodePoolData[1].Name = "controller"
+			Expect(c.Update(ctx, hwTmpl)).To(Succeed())
+
+			// Start reconciliation
+			result, err := reconciler.Reconcile(ctx, req)
+			// Verify the reconciliation result
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(doNotRequeue()))
+
+			reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+			hwTemplateCond := meta.FindStatusCondition(reconciledCR.Status.Conditions,
+				string(provisioningv1alpha1.PRconditionTypes.HardwareTemplateRendered))
+			Expect(hwTemplateCond).ToNot(BeNil())
+			Expect(hwTemplateCond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(hwTemplateCond.Message).To(ContainSubstring(
+				`spec.nodePoolData[1].name: Duplicate value: "controller"`))
+
+			// Verify the problem is reported on the HardwareTemplate as well
+			Expect(c.Get(ctx, types.NamespacedName{Name: hwTemplate, Namespace: utils.InventoryNamespace}, hwTmpl)).To(Succeed())
+			validationCond := meta.FindStatusCondition(hwTmpl.Status.Conditions, string(hwv1alpha1.Validation))
+			Expect(validationCond).ToNot(BeNil())
+			Expect(validationCond.Status).To(Equal(metav1.ConditionFalse))
+		})
+
+		It("Verify status conditions if the HardwareTemplate timeout is malformed", func() {
+			hwTmpl := &hwv1alpha1.HardwareTemplate{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: hwTemplate, Namespace: utils.InventoryNamespace}, hwTmpl)).To(Succeed())
+			hwTmpl.Spec.HardwareProvisioningTimeout = "1x"
+			Expect(c.Update(ctx, hwTmpl)).To(Succeed())
+
+			// Start reconciliation
+			result, err := reconciler.Reconcile(ctx, req)
+			// Verify the reconciliation result
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(doNotRequeue()))
+
+			reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+			validatedCond := meta.FindStatusCondition(reconciledCR.Status.Conditions,
+				string(provisioningv1alpha1.PRconditionTypes.Validated))
+			Expect(validatedCond).ToNot(BeNil())
+			Expect(validatedCond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(validatedCond.Message).To(ContainSubstring(
+				`spec.hardwareProvisioningTimeout: Invalid value: "1x": not a valid duration string`))
+
+			// Verify the problem is reported on the HardwareTemplate as well
+			Expect(c.Get(ctx, types.NamespacedName{Name: hwTemplate, Namespace: utils.InventoryNamespace}, hwTmpl)).To(Succeed())
+			validationCond := meta.FindStatusCondition(hwTmpl.Status.Conditions, string(hwv1alpha1.Validation))
+			Expect(validationCond).ToNot(BeNil())
+			Expect(validationCond.Status).To(Equal(metav1.ConditionFalse))
+		})
+
+		It("Verify status conditions if a referenced pull secret is missing", func() {
 			// Delete the pull secret for ClusterInstance
 			secret := &corev1.Secret{}
 			secret.SetName("pull-secret")
@@ -686,7 +809,7 @@ var _ = Describe("ProvisioningRequestReconcile", func() {
 
 			// Start reconciliation
 			result, err := reconciler.Reconcile(ctx, req)
-			// Verify the reconciliation result
+			// Verify the reconciliation result, the request is requeued until the pull secret is created
 			Expect(err).ToNot(HaveOccurred())
 			Expect(result).To(Equal(requeueWithMediumInterval()))
 
@@ -694,28 +817,21 @@ var _ = Describe("ProvisioningRequestReconcile", func() {
 			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
 			conditions := reconciledCR.Status.Conditions
 
-			// Verify the ProvisioningRequest's status conditions
-			Expect(len(conditions)).To(Equal(3))
+			// The missing reference is reported by the validation, before any cluster resource is created
+			Expect(len(conditions)).To(Equal(1))
 			verifyStatusCondition(conditions[0], metav1.Condition{
 				Type:   string(provisioningv1alpha1.PRconditionTypes.Validated),
-				Status: metav1.ConditionTrue,
-				Reason: string(provisioningv1alpha1.CRconditionReasons.Completed),
-			})
-			verifyStatusCondition(conditions[1], metav1.Condition{
-				Type:   string(provisioningv1alpha1.PRconditionTypes.ClusterInstanceRendered),
-				Status: metav1.ConditionTrue,
-				Reason: string(provisioningv1alpha1.CRconditionReasons.Completed),
-			})
-			verifyStatusCondition(conditions[2], metav1.Condition{
-				Type:    string(provisioningv1alpha1.PRconditionTypes.ClusterResourcesCreated),
-				Status:  metav1.ConditionFalse,
-				Reason:  string(provisioningv1alpha1.CRconditionReasons.Failed),
-				Message: "failed to create pull Secret for cluster cluster-1",
+				Status: metav1.ConditionFalse,
+				Reason: string(provisioningv1alpha1.CRconditionReasons.Failed),
+				Message: fmt.Sprintf(
+					"Failed to validate the ProvisioningRequest: failed to validate ClusterInstance references: "+
+						"the Secret pull-secret referenced by clusterInstanceParameters.pullSecretRef.name "+
+						"does not exist in the %s namespace", ctNamespace),
 			})
 
-			// Verify provisioningState is failed when the required resource creation fails.
+			// Verify provisioningState is failed when cr validation fails.
 			verifyProvisioningStatus(reconciledCR.Status.ProvisioningStatus,
-				provisioningv1alpha1.StateFailed, "Failed to apply the required cluster resource", nil)
+				provisioningv1alpha1.StateFailed, "Failed to validate the ProvisioningRequest", nil)
 		})
 
 		It("Verify status conditions if all preparation work completes", func() {
@@ -766,6 +882,465 @@ var _ = Describe("ProvisioningRequestReconcile", func() {
 			// Verify provisioningState is progressing when nodePool has been created
 			verifyProvisioningStatus(reconciledCR.Status.ProvisioningStatus,
 				provisioningv1alpha1.StateProgressing, "Hardware provisioning is in progress", nil)
+			// Verify the last reconcile action has been recorded
+			Expect(reconciledCR.Status.LastReconcileAction).To(
+				Equal("requeued after 1m0s, reason: Hardware provisioning is in progress"))
+		})
+	})
+
+	Context("When a forced failure is requested for chaos testing", func() {
+		BeforeEach(func() {
+			cr.SetAnnotations(map[string]string{
+				provisioningRequestForceFailureAnnotation: string(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned) + "/" +
+					string(provisioningv1alpha1.CRconditionReasons.TimedOut),
+			})
+			Expect(c.Update(ctx, cr)).To(Succeed())
+		})
+
+		It("Forces the configured failed state when chaos testing is enabled", func() {
+			Expect(os.Setenv(utils.ChaosTestingEnvName, "true")).To(Succeed())
+			DeferCleanup(os.Unsetenv, utils.ChaosTestingEnvName)
+
+			// Start reconciliation
+			result, err := reconciler.Reconcile(ctx, req)
+			// Verify the reconciliation result
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(doNotRequeue()))
+
+			reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+			conditions := reconciledCR.Status.Conditions
+
+			// Verify only the forced condition has been set
+			Expect(len(conditions)).To(Equal(1))
+			verifyStatusCondition(conditions[0], metav1.Condition{
+				Type:    string(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned),
+				Status:  metav1.ConditionFalse,
+				Reason:  string(provisioningv1alpha1.CRconditionReasons.TimedOut),
+				Message: "Failure forced by the " + provisioningRequestForceFailureAnnotation + " annotation",
+			})
+			verifyProvisioningStatus(reconciledCR.Status.ProvisioningStatus,
+				provisioningv1alpha1.StateFailed, "Failure forced by the", nil)
+
+			// Verify no NodePool has been created
+			nodePool := &hwv1alpha1.NodePool{}
+			err = c.Get(ctx, types.NamespacedName{Name: crName, Namespace: utils.UnitTestHwmgrNamespace}, nodePool)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("Ignores the annotation when chaos testing is disabled", func() {
+			Expect(os.Unsetenv(utils.ChaosTestingEnvName)).To(Succeed())
+
+			// Start reconciliation
+			result, err := reconciler.Reconcile(ctx, req)
+			// Verify the reconciliation result
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(requeueWithMediumInterval()))
+
+			reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+
+			// Verify the normal reconciliation flow ran
+			validatedCond := meta.FindStatusCondition(reconciledCR.Status.Conditions,
+				string(provisioningv1alpha1.PRconditionTypes.Validated))
+			Expect(validatedCond).ToNot(BeNil())
+			Expect(validatedCond.Status).To(Equal(metav1.ConditionTrue))
+			hwCond := meta.FindStatusCondition(reconciledCR.Status.Conditions,
+				string(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned))
+			Expect(hwCond).ToNot(BeNil())
+			Expect(hwCond.Reason).ToNot(Equal(string(provisioningv1alpha1.CRconditionReasons.TimedOut)))
+			Expect(reconciledCR.Status.ProvisioningStatus.ProvisioningPhase).ToNot(Equal(provisioningv1alpha1.StateFailed))
+		})
+	})
+
+	Context("When a dry-run is requested", func() {
+		BeforeEach(func() {
+			cr.SetAnnotations(map[string]string{"provisioning.oran.org/dry-run": "true"})
+			Expect(c.Update(ctx, cr)).To(Succeed())
+		})
+
+		It("Renders the ClusterInstance without creating any resource", func() {
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(doNotRequeue()))
+
+			reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+
+			// Verify the rendered ClusterInstance has been recorded instead of being applied
+			renderedClusterInstance := &siteconfig.ClusterInstance{}
+			Expect(yaml.Unmarshal([]byte(reconciledCR.Status.RenderedClusterInstance), renderedClusterInstance)).To(Succeed())
+			Expect(renderedClusterInstance.Name).To(Equal(crName))
+			err = c.Get(ctx, types.NamespacedName{Name: crName, Namespace: crName}, &siteconfig.ClusterInstance{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			// Verify no namespace and no NodePool have been created
+			err = c.Get(ctx, types.NamespacedName{Name: crName}, &corev1.Namespace{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			err = c.Get(ctx, types.NamespacedName{Name: crName, Namespace: utils.UnitTestHwmgrNamespace}, &hwv1alpha1.NodePool{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			conditions := reconciledCR.Status.Conditions
+			Expect(len(conditions)).To(Equal(3))
+			verifyStatusCondition(conditions[1], metav1.Condition{
+				Type:   string(provisioningv1alpha1.PRconditionTypes.ClusterInstanceRendered),
+				Status: metav1.ConditionTrue,
+				Reason: string(provisioningv1alpha1.CRconditionReasons.Completed),
+			})
+			verifyStatusCondition(conditions[2], metav1.Condition{
+				Type:   string(provisioningv1alpha1.PRconditionTypes.ClusterResourcesCreated),
+				Status: metav1.ConditionFalse,
+				Reason: string(provisioningv1alpha1.CRconditionReasons.DryRun),
+			})
+			verifyProvisioningStatus(reconciledCR.Status.ProvisioningStatus,
+				provisioningv1alpha1.StateProgressing, "Dry-run completed", nil)
+		})
+
+		It("Accepts the annotation named like the other annotations", func() {
+			Expect(c.Get(ctx, req.NamespacedName, cr)).To(Succeed())
+			cr.SetAnnotations(map[string]string{provisioningRequestDryRunAliasAnnotation: "true"})
+			Expect(c.Update(ctx, cr)).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(doNotRequeue()))
+
+			reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+			Expect(reconciledCR.Status.RenderedClusterInstance).ToNot(BeEmpty())
+			condition := meta.FindStatusCondition(reconciledCR.Status.Conditions,
+				string(provisioningv1alpha1.PRconditionTypes.ClusterResourcesCreated))
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Reason).To(Equal(string(provisioningv1alpha1.CRconditionReasons.DryRun)))
+		})
+
+		It("Proceeds with the provisioning once the annotation is removed", func() {
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(c.Get(ctx, req.NamespacedName, cr)).To(Succeed())
+			cr.SetAnnotations(nil)
+			Expect(c.Update(ctx, cr)).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(requeueWithMediumInterval()))
+
+			reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+			Expect(reconciledCR.Status.RenderedClusterInstance).To(BeEmpty())
+			Expect(c.Get(ctx, types.NamespacedName{Name: crName, Namespace: utils.UnitTestHwmgrNamespace},
+				&hwv1alpha1.NodePool{})).To(Succeed())
+		})
+
+		It("Ignores the annotation once the hardware provisioning has started", func() {
+			Expect(c.Get(ctx, req.NamespacedName, cr)).To(Succeed())
+			cr.SetAnnotations(nil)
+			Expect(c.Update(ctx, cr)).To(Succeed())
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+
+			reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+			Expect(meta.FindStatusCondition(reconciledCR.Status.Conditions,
+				string(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned))).ToNot(BeNil())
+			provisioningDetails := reconciledCR.Status.ProvisioningStatus.ProvisioningDetails
+
+			// Annotate the request again while the hardware is being provisioned
+			reconciledCR.SetAnnotations(map[string]string{provisioningRequestDryRunAnnotation: "true"})
+			Expect(c.Update(ctx, reconciledCR)).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(requeueWithMediumInterval()))
+
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+			Expect(reconciledCR.Status.RenderedClusterInstance).To(BeEmpty())
+			verifyStatusCondition(*meta.FindStatusCondition(reconciledCR.Status.Conditions,
+				string(provisioningv1alpha1.PRconditionTypes.ClusterResourcesCreated)), metav1.Condition{
+				Type:   string(provisioningv1alpha1.PRconditionTypes.ClusterResourcesCreated),
+				Status: metav1.ConditionTrue,
+				Reason: string(provisioningv1alpha1.CRconditionReasons.Completed),
+			})
+			Expect(reconciledCR.Status.ProvisioningStatus.ProvisioningDetails).To(Equal(provisioningDetails))
+		})
+
+		It("Refuses to allocate the hardware", func() {
+			task := &provisioningRequestReconcilerTask{
+				logger: logger,
+				client: c,
+				object: cr,
+			}
+			nodePool := &hwv1alpha1.NodePool{}
+			nodePool.SetName(crName)
+			nodePool.SetNamespace(utils.UnitTestHwmgrNamespace)
+			Expect(task.createOrUpdateNodePool(ctx, nodePool)).To(MatchError(ContainSubstring("dry-run")))
+		})
+	})
+
+	Context("When feature flags are set", func() {
+		BeforeEach(func() {
+			// Hold back the pull secret until a ConfigMap that does not exist is created
+			ct.Spec.ResourceBarriers = []provisioningv1alpha1.ResourceBarrier{
+				{
+					Resource: provisioningv1alpha1.ClusterResourcePullSecret,
+					Prerequisites: []provisioningv1alpha1.ResourcePrerequisite{
+						{APIVersion: "v1", Kind: "ConfigMap", Name: "registry-ca"},
+					},
+				},
+			}
+			Expect(c.Update(ctx, ct)).To(Succeed())
+		})
+
+		setFeatureFlag := func(flag, value string) {
+			cr.SetAnnotations(map[string]string{provisioningv1alpha1.FeatureFlagAnnotationPrefix + flag: value})
+			Expect(c.Update(ctx, cr)).To(Succeed())
+		}
+
+		It("Provisions the hardware while the cluster resources wait when parallel pre-provisioning is enabled", func() {
+			setFeatureFlag(string(provisioningv1alpha1.FeatureParallelPreprovisioning), "true")
+
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(requeueWithMediumInterval()))
+
+			reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+			clusterResourcesCond := meta.FindStatusCondition(reconciledCR.Status.Conditions,
+				string(provisioningv1alpha1.PRconditionTypes.ClusterResourcesCreated))
+			Expect(clusterResourcesCond).ToNot(BeNil())
+			Expect(clusterResourcesCond.Reason).To(Equal(string(provisioningv1alpha1.CRconditionReasons.InProgress)))
+
+			Expect(c.Get(ctx, types.NamespacedName{Name: crName, Namespace: utils.UnitTestHwmgrNamespace},
+				&hwv1alpha1.NodePool{})).To(Succeed())
+			Expect(meta.FindStatusCondition(reconciledCR.Status.Conditions,
+				string(provisioningv1alpha1.PRconditionTypes.HardwareTemplateRendered))).ToNot(BeNil())
+		})
+
+		It("Keeps the hardware provisioning behind the cluster resources when the flag is disabled", func() {
+			setFeatureFlag(string(provisioningv1alpha1.FeatureParallelPreprovisioning), "false")
+
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(requeueWithMediumInterval()))
+
+			err = c.Get(ctx, types.NamespacedName{Name: crName, Namespace: utils.UnitTestHwmgrNamespace},
+				&hwv1alpha1.NodePool{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("Records the manifests applied before the cluster installation when auditing is enabled", func() {
+			setFeatureFlag(string(provisioningv1alpha1.FeatureAuditRenderedManifests), "true")
+
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(requeueWithMediumInterval()))
+
+			reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+			Expect(reconciledCR.Status.RenderedManifests).ToNot(BeNil())
+			renderedManifests := &corev1.ConfigMap{}
+			Expect(c.Get(ctx, types.NamespacedName{
+				Name:      reconciledCR.Status.RenderedManifests.Name,
+				Namespace: reconciledCR.Status.RenderedManifests.Namespace,
+			}, renderedManifests)).To(Succeed())
+			Expect(renderedManifests.Data).To(HaveKey("namespace.cluster-1.yaml"))
+		})
+
+		It("Rejects an unknown feature flag", func() {
+			setFeatureFlag("enable-parallel-provisioning", "true")
+
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(doNotRequeue()))
+
+			reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+			Expect(reconciledCR.Status.Conditions).To(HaveLen(1))
+			verifyStatusCondition(reconciledCR.Status.Conditions[0], metav1.Condition{
+				Type:   string(provisioningv1alpha1.PRconditionTypes.Validated),
+				Status: metav1.ConditionFalse,
+				Reason: string(provisioningv1alpha1.CRconditionReasons.Failed),
+				Message: "Failed to validate the ProvisioningRequest: unknown feature flag \"enable-parallel-provisioning\" " +
+					"in the annotation " + provisioningv1alpha1.FeatureFlagAnnotationPrefix + "enable-parallel-provisioning, " +
+					"the known feature flags are: [enable-parallel-preprovisioning audit-rendered-manifests]",
+			})
+		})
+	})
+
+	Context("When a minimum template version is configured", func() {
+		It("Rejects a template version below the minimum", func() {
+			Expect(os.Setenv(utils.MinTemplateVersionEnvName, "v1.1.0")).To(Succeed())
+			DeferCleanup(os.Unsetenv, utils.MinTemplateVersionEnvName)
+
+			// Start reconciliation
+			result, err := reconciler.Reconcile(ctx, req)
+			// Verify the reconciliation result
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(doNotRequeue()))
+
+			reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+			conditions := reconciledCR.Status.Conditions
+
+			// Verify the ProvisioningRequest's status conditions
+			Expect(len(conditions)).To(Equal(1))
+			verifyStatusCondition(conditions[0], metav1.Condition{
+				Type:   string(provisioningv1alpha1.PRconditionTypes.Validated),
+				Status: metav1.ConditionFalse,
+				Reason: string(provisioningv1alpha1.CRconditionReasons.TemplateVersionTooOld),
+				Message: "Failed to validate the ProvisioningRequest: " +
+					"template version v1.0.0 is below the minimum accepted version v1.1.0",
+			})
+			verifyProvisioningStatus(reconciledCR.Status.ProvisioningStatus,
+				provisioningv1alpha1.StateFailed, "Failed to validate the ProvisioningRequest", nil)
+		})
+
+		It("Accepts a template version at the minimum", func() {
+			Expect(os.Setenv(utils.MinTemplateVersionEnvName, tVersion)).To(Succeed())
+			DeferCleanup(os.Unsetenv, utils.MinTemplateVersionEnvName)
+
+			// Start reconciliation
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+
+			reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+
+			// Verify the validation succeeded
+			validatedCond := meta.FindStatusCondition(reconciledCR.Status.Conditions,
+				string(provisioningv1alpha1.PRconditionTypes.Validated))
+			Expect(validatedCond).ToNot(BeNil())
+			Expect(validatedCond.Status).To(Equal(metav1.ConditionTrue))
+		})
+
+		It("Accepts a template version above the minimum", func() {
+			Expect(os.Setenv(utils.MinTemplateVersionEnvName, "0.9.0")).To(Succeed())
+			DeferCleanup(os.Unsetenv, utils.MinTemplateVersionEnvName)
+
+			// Start reconciliation
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+
+			reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+
+			// Verify the validation succeeded
+			validatedCond := meta.FindStatusCondition(reconciledCR.Status.Conditions,
+				string(provisioningv1alpha1.PRconditionTypes.Validated))
+			Expect(validatedCond).ToNot(BeNil())
+			Expect(validatedCond.Status).To(Equal(metav1.ConditionTrue))
+		})
+
+		It("Skips the check for a template version that is not a semantic version", func() {
+			Expect(os.Setenv(utils.MinTemplateVersionEnvName, "v1.1.0")).To(Succeed())
+			DeferCleanup(os.Unsetenv, utils.MinTemplateVersionEnvName)
+
+			cr.Spec.TemplateVersion = "v4-16"
+			task := &provisioningRequestReconcilerTask{
+				logger: reconciler.Logger,
+				client: c,
+				object: cr,
+			}
+			Expect(task.validateTemplateVersion()).To(Succeed())
+		})
+	})
+
+	Context("When the ClusterTemplate defines poll intervals", func() {
+		It("Requeues after the template poll interval while waiting for the hardware", func() {
+			ct.Spec.PollIntervals = &provisioningv1alpha1.PollIntervals{
+				HardwareProvisioning: &metav1.Duration{Duration: 15 * time.Second},
+			}
+			Expect(c.Update(ctx, ct)).To(Succeed())
+
+			// Start reconciliation
+			result, err := reconciler.Reconcile(ctx, req)
+			// Verify the template poll interval is used instead of the default medium interval
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(requeueWithCustomInterval(15 * time.Second)))
+		})
+
+		It("Uses the default interval for the phases without a poll interval", func() {
+			ct.Spec.PollIntervals = &provisioningv1alpha1.PollIntervals{
+				ClusterProvisioning: &metav1.Duration{Duration: 2 * time.Minute},
+			}
+			Expect(c.Update(ctx, ct)).To(Succeed())
+
+			// Start reconciliation
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(requeueWithMediumInterval()))
+		})
+	})
+
+	Context("When the ProvisioningRequest defines timeouts", func() {
+		It("Uses the spec timeouts instead of the template timeouts", func() {
+			cr.Spec.Timeouts = &provisioningv1alpha1.ProvisioningRequestTimeouts{
+				ClusterProvisioning:  &metav1.Duration{Duration: 90 * time.Minute},
+				ClusterConfiguration: &metav1.Duration{Duration: 45 * time.Minute},
+			}
+			task := &provisioningRequestReconcilerTask{
+				logger:    reconciler.Logger,
+				client:    c,
+				object:    cr,
+				ctDetails: &clusterTemplateDetails{templates: ct.Spec.Templates},
+				timeouts:  &timeouts{},
+			}
+			Expect(task.validateAndLoadTimeouts(ctx, ct)).To(Succeed())
+
+			// The ClusterInstance defaults define a 60s installation timeout, the spec takes precedence
+			Expect(task.timeouts.clusterProvisioning).To(Equal(90 * time.Minute))
+			Expect(task.timeouts.clusterConfiguration).To(Equal(45 * time.Minute))
+			// The hardware template timeout is kept since the spec doesn't define one
+			Expect(task.timeouts.hardwareProvisioning).To(Equal(time.Minute))
+		})
+
+		It("Uses the template timeouts instead of the ConfigMap timeouts", func() {
+			ct.Spec.ClusterProvisioningTimeout = "3h"
+			ct.Spec.ClusterConfigurationTimeout = "20m"
+			task := &provisioningRequestReconcilerTask{
+				logger:    reconciler.Logger,
+				client:    c,
+				object:    cr,
+				ctDetails: &clusterTemplateDetails{templates: ct.Spec.Templates},
+				timeouts:  &timeouts{},
+			}
+			Expect(task.validateAndLoadTimeouts(ctx, ct)).To(Succeed())
+
+			// The ClusterInstance defaults define a 60s installation timeout, the template takes precedence
+			Expect(task.timeouts.clusterProvisioning).To(Equal(3 * time.Hour))
+			Expect(task.timeouts.clusterConfiguration).To(Equal(20 * time.Minute))
+
+			// The spec timeouts still take precedence over the template
+			cr.Spec.Timeouts = &provisioningv1alpha1.ProvisioningRequestTimeouts{
+				ClusterProvisioning: &metav1.Duration{Duration: 90 * time.Minute},
+			}
+			Expect(task.validateAndLoadTimeouts(ctx, ct)).To(Succeed())
+			Expect(task.timeouts.clusterProvisioning).To(Equal(90 * time.Minute))
+			Expect(task.timeouts.clusterConfiguration).To(Equal(20 * time.Minute))
+		})
+
+		It("Rejects a non-positive spec timeout", func() {
+			cr.Spec.Timeouts = &provisioningv1alpha1.ProvisioningRequestTimeouts{
+				HardwareProvisioning: &metav1.Duration{Duration: 0},
+			}
+			Expect(c.Update(ctx, cr)).To(Succeed())
+
+			// Start reconciliation
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(doNotRequeue()))
+
+			reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+
+			// Verify the validation failed
+			validatedCond := meta.FindStatusCondition(reconciledCR.Status.Conditions,
+				string(provisioningv1alpha1.PRconditionTypes.Validated))
+			Expect(validatedCond).ToNot(BeNil())
+			Expect(validatedCond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(validatedCond.Message).To(ContainSubstring(
+				"spec.timeouts.hardwareProvisioning must be a positive duration, got 0s"))
 		})
 	})
 
@@ -777,6 +1352,7 @@ var _ = Describe("ProvisioningRequestReconcile", func() {
 			nodePool = &hwv1alpha1.NodePool{}
 			nodePool.SetName(crName)
 			nodePool.SetNamespace(utils.UnitTestHwmgrNamespace)
+			nodePool.SetLabels(map[string]string{provisioningRequestNameLabel: crName})
 			nodePool.Spec.HwMgrId = "hwmgr"
 			nodePool.Spec.NodeGroup = []hwv1alpha1.NodeGroup{
 				{NodePoolData: hwv1alpha1.NodePoolData{
@@ -1046,6 +1622,7 @@ var _ = Describe("ProvisioningRequestReconcile", func() {
 			nodePool = &hwv1alpha1.NodePool{}
 			nodePool.SetName(crName)
 			nodePool.SetNamespace(utils.UnitTestHwmgrNamespace)
+			nodePool.SetLabels(map[string]string{provisioningRequestNameLabel: crName})
 			nodePool.Spec.HwMgrId = "hwmgr"
 			nodePool.Spec.NodeGroup = []hwv1alpha1.NodeGroup{
 				{NodePoolData: hwv1alpha1.NodePoolData{
@@ -1104,6 +1681,46 @@ var _ = Describe("ProvisioningRequestReconcile", func() {
 			Expect(c.Create(ctx, policy)).To(Succeed())
 		})
 
+		// fulfillProvisioningRequest completes the cluster installation and configuration, and returns the
+		// fulfilled ProvisioningRequest
+		fulfillProvisioningRequest := func() *provisioningv1alpha1.ProvisioningRequest {
+			// Patch ClusterInstance provisioned status to Completed
+			crProvisionedCond := metav1.Condition{
+				Type: string(siteconfig.ClusterProvisioned), Status: metav1.ConditionTrue,
+				Reason: string(siteconfig.Completed), Message: "Provisioning completed",
+			}
+			currentCI := &siteconfig.ClusterInstance{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: crName, Namespace: crName}, currentCI)).To(Succeed())
+			currentCI.Status.Conditions = append(clusterInstance.Status.Conditions, crProvisionedCond)
+			Expect(c.Status().Update(ctx, currentCI)).To(Succeed())
+			// Patch ManagedCluster to ready
+			readyCond := meta.FindStatusCondition(
+				managedCluster.Status.Conditions, clusterv1.ManagedClusterConditionAvailable)
+			readyCond.Status = metav1.ConditionTrue
+			Expect(c.Status().Update(ctx, managedCluster)).To(Succeed())
+			// Patch enforce policy to Compliant
+			policy.Status.ComplianceState = policiesv1.Compliant
+			Expect(c.Status().Update(ctx, policy)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+			verifyProvisioningStatus(reconciledCR.Status.ProvisioningStatus,
+				provisioningv1alpha1.StateFulfilled, "Provisioning request has completed successfully", nil)
+			return reconciledCR
+		}
+
+		// setNodePoolInProgress makes the hardware checks fail if they are performed
+		setNodePoolInProgress := func() {
+			currentNodePool := &hwv1alpha1.NodePool{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(nodePool), currentNodePool)).To(Succeed())
+			currentNodePool.Status.Conditions = []metav1.Condition{
+				{Type: string(hwv1alpha1.Provisioned), Status: metav1.ConditionFalse, Reason: string(hwv1alpha1.InProgress)},
+			}
+			Expect(c.Status().Update(ctx, currentNodePool)).To(Succeed())
+		}
+
 		It("Verify status when ClusterInstance provision is still in progress and ManagedCluster is not ready", func() {
 			// Patch ClusterInstance provisioned status to InProgress
 			crProvisionedCond := metav1.Condition{
@@ -1340,6 +1957,67 @@ var _ = Describe("ProvisioningRequestReconcile", func() {
 				&provisioningv1alpha1.ProvisionedResources{OCloudNodeClusterId: "76b8cbad-9928-48a0-bcf0-bb16a777b5f7"})
 		})
 
+		It("Verify status when the ManagedCluster of a fulfilled ProvisioningRequest is deleted", func() {
+			ignoreFieldsStrippedByFakeClient()
+			// Patch ClusterInstance provisioned status to Completed
+			crProvisionedCond := metav1.Condition{
+				Type: string(siteconfig.ClusterProvisioned), Status: metav1.ConditionTrue,
+				Reason: string(siteconfig.Completed), Message: "Provisioning completed",
+			}
+			clusterInstance.Status.Conditions = append(clusterInstance.Status.Conditions, crProvisionedCond)
+			Expect(c.Status().Update(ctx, clusterInstance)).To(Succeed())
+			// Patch ManagedCluster to ready
+			readyCond := meta.FindStatusCondition(
+				managedCluster.Status.Conditions, clusterv1.ManagedClusterConditionAvailable)
+			readyCond.Status = metav1.ConditionTrue
+			Expect(c.Status().Update(ctx, managedCluster)).To(Succeed())
+			// Patch enforce policy to Compliant
+			policy.Status.ComplianceState = policiesv1.Compliant
+			Expect(c.Status().Update(ctx, policy)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+			Expect(reconciledCR.Status.ProvisioningStatus.ProvisioningPhase).To(Equal(provisioningv1alpha1.StateFulfilled))
+
+			// Delete the ManagedCluster out-of-band
+			Expect(c.Delete(ctx, managedCluster)).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(requeueWithLongInterval()))
+
+			reconciledCR = &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+			provisionedCond := meta.FindStatusCondition(reconciledCR.Status.Conditions,
+				string(provisioningv1alpha1.PRconditionTypes.ClusterProvisioned))
+			Expect(provisionedCond).ToNot(BeNil())
+			verifyStatusCondition(*provisionedCond, metav1.Condition{
+				Type:    string(provisioningv1alpha1.PRconditionTypes.ClusterProvisioned),
+				Status:  metav1.ConditionFalse,
+				Reason:  string(provisioningv1alpha1.CRconditionReasons.ClusterMissing),
+				Message: "The ManagedCluster cluster-1 no longer exists",
+			})
+			verifyProvisioningStatus(reconciledCR.Status.ProvisioningStatus,
+				provisioningv1alpha1.StateFailed, "The ManagedCluster cluster-1 no longer exists", nil)
+
+			// The request is fulfilled again once the cluster is imported back
+			managedCluster.ResourceVersion = ""
+			Expect(c.Create(ctx, managedCluster)).To(Succeed())
+			readyCond = meta.FindStatusCondition(
+				managedCluster.Status.Conditions, clusterv1.ManagedClusterConditionAvailable)
+			readyCond.Status = metav1.ConditionTrue
+			Expect(c.Status().Update(ctx, managedCluster)).To(Succeed())
+
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			reconciledCR = &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+			Expect(utils.IsClusterMissing(reconciledCR)).To(BeFalse())
+			Expect(reconciledCR.Status.ProvisioningStatus.ProvisioningPhase).To(Equal(provisioningv1alpha1.StateFulfilled))
+		})
+
 		It("Verify status when configuration change causes ProvisioningRequest validation to fail but ClusterInstall is still in progress", func() {
 			// Patch ClusterInstance provisioned status to InProgress
 			crProvisionedCond := metav1.Condition{
@@ -1699,6 +2377,125 @@ var _ = Describe("ProvisioningRequestReconcile", func() {
 				provisioningv1alpha1.StateProgressing, "Cluster configuration is being applied",
 				&provisioningv1alpha1.ProvisionedResources{OCloudNodeClusterId: "76b8cbad-9928-48a0-bcf0-bb16a777b5f7"})
 		})
+
+		It("Verify only the configuration is reconciled when only the policy template parameters change", func() {
+			ignoreFieldsStrippedByFakeClient()
+			reconciledCR := fulfillProvisioningRequest()
+			Expect(reconciledCR.Status.Extensions.ClusterDetails.InstallationInputsHash).ToNot(BeEmpty())
+			Expect(reconciledCR.Status.Extensions.ClusterDetails.InstallationGeneration).To(Equal(reconciledCR.Generation))
+
+			// Make the hardware checks fail if they are performed
+			setNodePoolInProgress()
+
+			// Change only the policy template parameters
+			reconciledCR.Spec.TemplateParameters.Raw = []byte(strings.Replace(
+				testFullTemplateParameters, `"1-2"`, `"3-4"`, 1))
+			reconciledCR.Generation++
+			Expect(c.Update(ctx, reconciledCR)).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(doNotRequeue()))
+
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+			verifyStatusCondition(*meta.FindStatusCondition(reconciledCR.Status.Conditions,
+				string(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned)), metav1.Condition{
+				Type:   string(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned),
+				Status: metav1.ConditionTrue,
+				Reason: string(provisioningv1alpha1.CRconditionReasons.Completed),
+			})
+			verifyProvisioningStatus(reconciledCR.Status.ProvisioningStatus,
+				provisioningv1alpha1.StateFulfilled, "Provisioning request has completed successfully", nil)
+			Expect(reconciledCR.Status.Extensions.ClusterDetails.InstallationGeneration).To(Equal(reconciledCR.Generation))
+
+			// Changing any other parameter goes through the hardware checks again
+			reconciledCR.Spec.TemplateParameters.Raw = []byte(strings.Replace(
+				string(reconciledCR.Spec.TemplateParameters.Raw), `"local-123"`, `"local-456"`, 1))
+			reconciledCR.Generation++
+			Expect(c.Update(ctx, reconciledCR)).To(Succeed())
+
+			result, err = reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(requeueWithMediumInterval()))
+
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+			verifyStatusCondition(*meta.FindStatusCondition(reconciledCR.Status.Conditions,
+				string(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned)), metav1.Condition{
+				Type:   string(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned),
+				Status: metav1.ConditionFalse,
+				Reason: string(provisioningv1alpha1.CRconditionReasons.InProgress),
+			})
+		})
+
+		It("Verify the full reconciliation runs when the spec has not changed since the configuration change", func() {
+			ignoreFieldsStrippedByFakeClient()
+			reconciledCR := fulfillProvisioningRequest()
+
+			// Change only the policy template parameters, which is only reconciled once
+			reconciledCR.Spec.TemplateParameters.Raw = []byte(strings.Replace(
+				testFullTemplateParameters, `"1-2"`, `"3-4"`, 1))
+			reconciledCR.Generation++
+			Expect(c.Update(ctx, reconciledCR)).To(Succeed())
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(doNotRequeue()))
+
+			// The following reconciliations refresh the hardware and installation status
+			setNodePoolInProgress()
+			result, err = reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(requeueWithMediumInterval()))
+
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+			verifyStatusCondition(*meta.FindStatusCondition(reconciledCR.Status.Conditions,
+				string(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned)), metav1.Condition{
+				Type:   string(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned),
+				Status: metav1.ConditionFalse,
+				Reason: string(provisioningv1alpha1.CRconditionReasons.InProgress),
+			})
+		})
+
+		It("Verify the references are not validated again once the installation has started", func() {
+			ignoreFieldsStrippedByFakeClient()
+			fulfillProvisioningRequest()
+
+			// Delete the source of the pull secret, which has already been copied to the cluster namespace
+			secret := &corev1.Secret{}
+			secret.SetName("pull-secret")
+			secret.SetNamespace(ctNamespace)
+			Expect(c.Delete(ctx, secret)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+
+			reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+			verifyStatusCondition(*meta.FindStatusCondition(reconciledCR.Status.Conditions,
+				string(provisioningv1alpha1.PRconditionTypes.Validated)), metav1.Condition{
+				Type:   string(provisioningv1alpha1.PRconditionTypes.Validated),
+				Status: metav1.ConditionTrue,
+				Reason: string(provisioningv1alpha1.CRconditionReasons.Completed),
+			})
+		})
+
+		It("Verify the ClusterInstance is recreated when it is missing on a configuration change", func() {
+			ignoreFieldsStrippedByFakeClient()
+			reconciledCR := fulfillProvisioningRequest()
+
+			currentCI := &siteconfig.ClusterInstance{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: crName, Namespace: crName}, currentCI)).To(Succeed())
+			Expect(c.Delete(ctx, currentCI)).To(Succeed())
+
+			// Change only the policy template parameters
+			reconciledCR.Spec.TemplateParameters.Raw = []byte(strings.Replace(
+				testFullTemplateParameters, `"1-2"`, `"3-4"`, 1))
+			reconciledCR.Generation++
+			Expect(c.Update(ctx, reconciledCR)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(c.Get(ctx, types.NamespacedName{Name: crName, Namespace: crName}, currentCI)).To(Succeed())
+		})
 	})
 
 	Context("When evaluating ZTP Done", func() {
@@ -1757,6 +2554,7 @@ var _ = Describe("ProvisioningRequestReconcile", func() {
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "cluster-1",
 					Namespace: utils.UnitTestHwmgrNamespace,
+					Labels:    map[string]string{provisioningRequestNameLabel: "cluster-1"},
 					Annotations: map[string]string{
 						utils.HwTemplateBootIfaceLabel: "bootable-interface",
 					},
@@ -2132,6 +2930,7 @@ var _ = Describe("ProvisioningRequestReconcile", func() {
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "cluster-1",
 					Namespace: utils.UnitTestHwmgrNamespace,
+					Labels:    map[string]string{provisioningRequestNameLabel: "cluster-1"},
 					Annotations: map[string]string{
 						utils.HwTemplateBootIfaceLabel: "bootable-interface",
 					},
@@ -2238,6 +3037,10 @@ var _ = Describe("ProvisioningRequestReconcile", func() {
 			verifyProvisioningStatus(reconciledCR.Status.ProvisioningStatus,
 				provisioningv1alpha1.StateProgressing, "Cluster upgrade is in progress",
 				nil)
+			Expect(reconciledCR.Status.UpgradeStatus).To(Equal(&provisioningv1alpha1.UpgradeStatus{
+				UpgradePhase:  provisioningv1alpha1.UpgradeStateProgressing,
+				TargetRelease: newReleaseVersion,
+			}))
 
 			// check ClusterInstance fields
 			ci := &siteconfig.ClusterInstance{}
@@ -2255,6 +3058,60 @@ var _ = Describe("ProvisioningRequestReconcile", func() {
 			Expect(len(ibgu.Spec.Plan)).To(Equal(5))
 		})
 
+		It("Waits for an upgrade slot while the maximum number of upgrades are in progress", func() {
+			reconciler.MaxParallelUpgrades = 1
+
+			// Another ProvisioningRequest is being upgraded
+			otherIBGU := &ibguv1alpha1.ImageBasedGroupUpgrade{ObjectMeta: metav1.ObjectMeta{
+				Name: "cluster-2", Namespace: "cluster-2",
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: provisioningv1alpha1.GroupVersion.String(),
+					Kind:       "ProvisioningRequest",
+					Name:       "cluster-2",
+					UID:        "cluster-2-uid",
+					Controller: ptr.To(true),
+				}},
+			}}
+			Expect(c.Create(ctx, otherIBGU)).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(requeueWithMediumInterval()))
+
+			reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+			verifyStatusCondition(*meta.FindStatusCondition(reconciledCR.Status.Conditions,
+				string(provisioningv1alpha1.PRconditionTypes.UpgradeCompleted)), metav1.Condition{
+				Type:    string(provisioningv1alpha1.PRconditionTypes.UpgradeCompleted),
+				Status:  metav1.ConditionFalse,
+				Reason:  string(provisioningv1alpha1.CRconditionReasons.WaitingForUpgradeSlot),
+				Message: "Waiting for an upgrade slot, 1 upgrades are in progress out of 1 allowed",
+			})
+			verifyProvisioningStatus(reconciledCR.Status.ProvisioningStatus,
+				provisioningv1alpha1.StateProgressing, "Cluster upgrade is waiting for an upgrade slot",
+				nil)
+			ibgu := &ibguv1alpha1.ImageBasedGroupUpgrade{}
+			Expect(errors.IsNotFound(c.Get(ctx, types.NamespacedName{Namespace: "cluster-1", Name: "cluster-1"},
+				ibgu))).To(BeTrue())
+
+			// The other upgrade completes, which frees its slot
+			Expect(c.Delete(ctx, otherIBGU)).To(Succeed())
+
+			result, err = reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(requeueWithMediumInterval()))
+
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+			verifyStatusCondition(*meta.FindStatusCondition(reconciledCR.Status.Conditions,
+				string(provisioningv1alpha1.PRconditionTypes.UpgradeCompleted)), metav1.Condition{
+				Type:    string(provisioningv1alpha1.PRconditionTypes.UpgradeCompleted),
+				Status:  metav1.ConditionFalse,
+				Reason:  string(provisioningv1alpha1.CRconditionReasons.InProgress),
+				Message: "Upgrade is in progress",
+			})
+			Expect(c.Get(ctx, types.NamespacedName{Namespace: "cluster-1", Name: "cluster-1"}, ibgu)).To(Succeed())
+		})
+
 		It("Checks IBGU is in progress", func() {
 
 			ibgu := &ibguv1alpha1.ImageBasedGroupUpgrade{ObjectMeta: metav1.ObjectMeta{
@@ -2283,6 +3140,10 @@ var _ = Describe("ProvisioningRequestReconcile", func() {
 			verifyProvisioningStatus(reconciledCR.Status.ProvisioningStatus,
 				provisioningv1alpha1.StateProgressing, "Cluster upgrade is in progress",
 				nil)
+			Expect(reconciledCR.Status.UpgradeStatus).To(Equal(&provisioningv1alpha1.UpgradeStatus{
+				UpgradePhase:  provisioningv1alpha1.UpgradeStateProgressing,
+				TargetRelease: newReleaseVersion,
+			}))
 
 			// checks SuppressedManifests are not wiped
 			ci := &siteconfig.ClusterInstance{}
@@ -2334,6 +3195,10 @@ var _ = Describe("ProvisioningRequestReconcile", func() {
 				provisioningv1alpha1.StateFulfilled, "Provisioning request has completed successfully",
 				nil)
 			Expect(c.Get(ctx, types.NamespacedName{Namespace: "cluster-1", Name: "cluster-1"}, ibgu)).To(Not(Succeed()))
+			Expect(reconciledCR.Status.UpgradeStatus).To(Equal(&provisioningv1alpha1.UpgradeStatus{
+				UpgradePhase:  provisioningv1alpha1.UpgradeStateCompleted,
+				TargetRelease: newReleaseVersion,
+			}))
 		})
 		It("Checks IBGU is failed", func() {
 
@@ -2389,6 +3254,539 @@ var _ = Describe("ProvisioningRequestReconcile", func() {
 			verifyProvisioningStatus(reconciledCR.Status.ProvisioningStatus,
 				provisioningv1alpha1.StateFailed, "Cluster upgrade is failed",
 				nil)
+			Expect(reconciledCR.Status.UpgradeStatus).To(Equal(&provisioningv1alpha1.UpgradeStatus{
+				UpgradePhase:  provisioningv1alpha1.UpgradeStateFailed,
+				TargetRelease: newReleaseVersion,
+			}))
+		})
+		It("Checks IBGU is rolling back", func() {
+
+			ibgu := &ibguv1alpha1.ImageBasedGroupUpgrade{ObjectMeta: metav1.ObjectMeta{
+				Name: "cluster-1", Namespace: "cluster-1",
+			},
+				Spec: ibguv1alpha1.ImageBasedGroupUpgradeSpec{
+					IBUSpec: lcav1.ImageBasedUpgradeSpec{
+						SeedImageRef: lcav1.SeedImageRef{
+							Version: newReleaseVersion,
+						},
+					},
+				},
+				Status: ibguv1alpha1.ImageBasedGroupUpgradeStatus{
+					Clusters: []ibguv1alpha1.ClusterState{
+						{
+							Name: "cluster-1",
+							FailedActions: []ibguv1alpha1.ActionMessage{
+								{
+									Action:  "Upgrade",
+									Message: "rollback initiated due to validation failure",
+								},
+							},
+						},
+					},
+					Conditions: []metav1.Condition{
+						{
+							Type:   "Progressing",
+							Status: "True",
+						},
+					},
+				}}
+			Expect(c.Create(ctx, ibgu)).To(Succeed())
+
+			clusterInstance.Spec.ClusterImageSetNameRef = newReleaseVersion
+			clusterInstance.Spec.SuppressedManifests = utils.CRDsToBeSuppressedForUpgrade
+			Expect(c.Update(ctx, clusterInstance)).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(requeueWithMediumInterval()))
+
+			reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+
+			verifyStatusCondition(reconciledCR.Status.Conditions[9], metav1.Condition{
+				Type:    string(provisioningv1alpha1.PRconditionTypes.UpgradeCompleted),
+				Status:  metav1.ConditionFalse,
+				Reason:  string(provisioningv1alpha1.CRconditionReasons.RollingBack),
+				Message: "Upgrade is rolling back: action Upgrade failed: rollback initiated due to validation failure",
+			})
+
+			verifyProvisioningStatus(reconciledCR.Status.ProvisioningStatus,
+				provisioningv1alpha1.StateProgressing, "Cluster upgrade is rolling back",
+				nil)
+			Expect(reconciledCR.Status.UpgradeStatus).To(Equal(&provisioningv1alpha1.UpgradeStatus{
+				UpgradePhase:  provisioningv1alpha1.UpgradeStateProgressing,
+				TargetRelease: newReleaseVersion,
+			}))
+
+			// The request only fails once the rollback has completed
+			ibgu.Status.Clusters[0].CompletedActions = []ibguv1alpha1.ActionMessage{{Action: ibguv1alpha1.AbortOnFailure}}
+			ibgu.Status.Conditions[0].Status = "False"
+			Expect(c.Update(ctx, ibgu)).To(Succeed())
+
+			result, err = reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(doNotRequeue()))
+
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+			verifyStatusCondition(reconciledCR.Status.Conditions[9], metav1.Condition{
+				Type:   string(provisioningv1alpha1.PRconditionTypes.UpgradeCompleted),
+				Status: metav1.ConditionFalse,
+				Reason: string(provisioningv1alpha1.CRconditionReasons.Failed),
+				Message: "Upgrade rolled back. Upgrade Failed: " +
+					"Action Upgrade failed: rollback initiated due to validation failure",
+			})
+			verifyProvisioningStatus(reconciledCR.Status.ProvisioningStatus,
+				provisioningv1alpha1.StateFailed, "Cluster upgrade is failed",
+				nil)
+			Expect(reconciledCR.Status.UpgradeStatus.UpgradePhase).To(Equal(provisioningv1alpha1.UpgradeStateFailed))
+		})
+		It("Checks IBGU is rolling back while running the rollback action", func() {
+
+			ibgu := &ibguv1alpha1.ImageBasedGroupUpgrade{ObjectMeta: metav1.ObjectMeta{
+				Name: "cluster-1", Namespace: "cluster-1",
+			},
+				Status: ibguv1alpha1.ImageBasedGroupUpgradeStatus{
+					Clusters: []ibguv1alpha1.ClusterState{
+						{
+							Name:          "cluster-1",
+							CurrentAction: &ibguv1alpha1.ActionMessage{Action: ibguv1alpha1.Rollback},
+						},
+					},
+				}}
+			Expect(c.Create(ctx, ibgu)).To(Succeed())
+
+			clusterInstance.Spec.ClusterImageSetNameRef = newReleaseVersion
+			clusterInstance.Spec.SuppressedManifests = utils.CRDsToBeSuppressedForUpgrade
+			Expect(c.Update(ctx, clusterInstance)).To(Succeed())
+
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(requeueWithMediumInterval()))
+
+			reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+
+			verifyStatusCondition(reconciledCR.Status.Conditions[9], metav1.Condition{
+				Type:    string(provisioningv1alpha1.PRconditionTypes.UpgradeCompleted),
+				Status:  metav1.ConditionFalse,
+				Reason:  string(provisioningv1alpha1.CRconditionReasons.RollingBack),
+				Message: "Upgrade is rolling back: action Rollback is in progress",
+			})
 		})
 	})
 })
+
+var _ = DescribeTable("upgradePhaseFromCondition",
+	func(condition *metav1.Condition, expected provisioningv1alpha1.UpgradePhase) {
+		Expect(upgradePhaseFromCondition(condition)).To(Equal(expected))
+	},
+	Entry("no upgrade initiated", nil, provisioningv1alpha1.UpgradePhase("")),
+	Entry("upgrade in progress", &metav1.Condition{
+		Type:   string(provisioningv1alpha1.PRconditionTypes.UpgradeCompleted),
+		Status: metav1.ConditionFalse,
+		Reason: string(provisioningv1alpha1.CRconditionReasons.InProgress),
+	}, provisioningv1alpha1.UpgradeStateProgressing),
+	Entry("upgrade completed", &metav1.Condition{
+		Type:   string(provisioningv1alpha1.PRconditionTypes.UpgradeCompleted),
+		Status: metav1.ConditionTrue,
+		Reason: string(provisioningv1alpha1.CRconditionReasons.Completed),
+	}, provisioningv1alpha1.UpgradeStateCompleted),
+	Entry("upgrade failed", &metav1.Condition{
+		Type:   string(provisioningv1alpha1.PRconditionTypes.UpgradeCompleted),
+		Status: metav1.ConditionFalse,
+		Reason: string(provisioningv1alpha1.CRconditionReasons.Failed),
+	}, provisioningv1alpha1.UpgradeStateFailed),
+)
+
+var _ = DescribeTable("getReconcileAction",
+	func(result ctrl.Result, err error, details string, expected string) {
+		Expect(getReconcileAction(result, err, details)).To(Equal(expected))
+	},
+	Entry("waiting for hardware", requeueWithMediumInterval(), nil,
+		"Hardware provisioning is in progress", "requeued after 1m0s, reason: Hardware provisioning is in progress"),
+	Entry("waiting for the cluster installation", requeueWithLongInterval(), nil,
+		"Cluster installation is in progress", "requeued after 5m0s, reason: Cluster installation is in progress"),
+	Entry("requeued immediately", requeueImmediately(), nil,
+		"", "requeued immediately"),
+	Entry("fulfilled", doNotRequeue(), nil,
+		"Provisioning request has completed successfully", "not requeued, reason: Provisioning request has completed successfully"),
+	Entry("internal error", ctrl.Result{}, fmt.Errorf("failed to get ClusterTemplate"),
+		"Validating and preparing resources", "requeued with error, reason: failed to get ClusterTemplate"),
+)
+
+var _ = Describe("updateTimeoutDeadline", func() {
+	var (
+		task  *provisioningRequestReconcilerTask
+		cr    *provisioningv1alpha1.ProvisioningRequest
+		start metav1.Time
+	)
+
+	BeforeEach(func() {
+		start = metav1.NewTime(time.Date(2024, 10, 1, 10, 0, 0, 0, time.UTC))
+		cr = &provisioningv1alpha1.ProvisioningRequest{ObjectMeta: metav1.ObjectMeta{Name: "cluster-1"}}
+		utils.SetProvisioningStateInProgress(cr, "in progress")
+		task = &provisioningRequestReconcilerTask{
+			logger: logger,
+			object: cr,
+			timeouts: &timeouts{
+				hardwareProvisioning: time.Hour,
+				clusterProvisioning:  2 * time.Hour,
+				clusterConfiguration: 3 * time.Hour,
+			},
+		}
+	})
+
+	setCondition := func(conditionType provisioningv1alpha1.ConditionType,
+		reason provisioningv1alpha1.ConditionReason, status metav1.ConditionStatus) {
+		utils.SetStatusCondition(&cr.Status.Conditions, conditionType, reason, status, "")
+	}
+
+	It("uses the hardware provisioning window while the hardware is provisioned", func() {
+		cr.Status.Extensions.NodePoolRef = &provisioningv1alpha1.NodePoolRef{HardwareProvisioningCheckStart: &start}
+		setCondition(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned,
+			provisioningv1alpha1.CRconditionReasons.InProgress, metav1.ConditionFalse)
+
+		Expect(task.updateTimeoutDeadline()).To(BeTrue())
+		Expect(cr.Status.ProvisioningStatus.TimeoutDeadline.Time).To(Equal(start.Add(time.Hour)))
+		Expect(task.updateTimeoutDeadline()).To(BeFalse())
+	})
+
+	It("uses the cluster provisioning window while the cluster is installed", func() {
+		hwStart := metav1.NewTime(start.Add(-time.Hour))
+		cr.Status.Extensions.NodePoolRef = &provisioningv1alpha1.NodePoolRef{HardwareProvisioningCheckStart: &hwStart}
+		cr.Status.Extensions.ClusterDetails = &provisioningv1alpha1.ClusterDetails{ClusterProvisionStartedAt: &start}
+		setCondition(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned,
+			provisioningv1alpha1.CRconditionReasons.Completed, metav1.ConditionTrue)
+		setCondition(provisioningv1alpha1.PRconditionTypes.ClusterProvisioned,
+			provisioningv1alpha1.CRconditionReasons.InProgress, metav1.ConditionFalse)
+
+		Expect(task.updateTimeoutDeadline()).To(BeTrue())
+		Expect(cr.Status.ProvisioningStatus.TimeoutDeadline.Time).To(Equal(start.Add(2 * time.Hour)))
+	})
+
+	It("uses the cluster configuration window while the policies are applied", func() {
+		installStart := metav1.NewTime(start.Add(-time.Hour))
+		cr.Status.Extensions.ClusterDetails = &provisioningv1alpha1.ClusterDetails{
+			ClusterProvisionStartedAt: &installStart,
+			NonCompliantAt:            &start,
+		}
+		setCondition(provisioningv1alpha1.PRconditionTypes.ClusterProvisioned,
+			provisioningv1alpha1.CRconditionReasons.Completed, metav1.ConditionTrue)
+		setCondition(provisioningv1alpha1.PRconditionTypes.ConfigurationApplied,
+			provisioningv1alpha1.CRconditionReasons.InProgress, metav1.ConditionFalse)
+
+		Expect(task.updateTimeoutDeadline()).To(BeTrue())
+		Expect(cr.Status.ProvisioningStatus.TimeoutDeadline.Time).To(Equal(start.Add(3 * time.Hour)))
+
+		// The deadline is cleared once the request is fulfilled
+		utils.SetProvisioningStateFulfilled(cr)
+		Expect(task.updateTimeoutDeadline()).To(BeTrue())
+		Expect(cr.Status.ProvisioningStatus.TimeoutDeadline).To(BeNil())
+	})
+
+	It("keeps the deadline when the timeouts of the current generation are not loaded", func() {
+		deadline := metav1.NewTime(start.Add(time.Hour))
+		cr.Status.ProvisioningStatus.TimeoutDeadline = &deadline
+		task.timeouts = &timeouts{}
+
+		Expect(task.updateTimeoutDeadline()).To(BeFalse())
+		Expect(cr.Status.ProvisioningStatus.TimeoutDeadline).To(Equal(&deadline))
+	})
+})
+
+var _ = Describe("ProvisioningRequest deletion during policy remediation", func() {
+	var (
+		c          client.Client
+		ctx        context.Context
+		reconciler *ProvisioningRequestReconciler
+		cr         *provisioningv1alpha1.ProvisioningRequest
+		policy     *policiesv1.Policy
+		crName     = "cluster-1"
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		cr = &provisioningv1alpha1.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              crName,
+				Finalizers:        []string{provisioningRequestFinalizer},
+				DeletionTimestamp: &metav1.Time{Time: time.Now()},
+			},
+			Status: provisioningv1alpha1.ProvisioningRequestStatus{
+				Extensions: provisioningv1alpha1.Extensions{
+					ClusterDetails: &provisioningv1alpha1.ClusterDetails{Name: crName},
+				},
+				ProvisioningStatus: provisioningv1alpha1.ProvisioningStatus{
+					ProvisioningPhase: provisioningv1alpha1.StateProgressing,
+				},
+			},
+		}
+		utils.SetStatusCondition(&cr.Status.Conditions,
+			provisioningv1alpha1.PRconditionTypes.ConfigurationApplied,
+			provisioningv1alpha1.CRconditionReasons.InProgress,
+			metav1.ConditionFalse,
+			"The configuration is still being applied",
+		)
+
+		policy = &policiesv1.Policy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ztp-clustertemplate-a-v4-16.v1-sriov-configuration-policy",
+				Namespace: crName,
+				Labels: map[string]string{
+					utils.ChildPolicyRootPolicyLabel:       "ztp-clustertemplate-a-v4-16.v1-sriov-configuration-policy",
+					utils.ChildPolicyClusterNameLabel:      crName,
+					utils.ChildPolicyClusterNamespaceLabel: crName,
+				},
+			},
+			Spec: policiesv1.PolicySpec{
+				RemediationAction: "enforce",
+			},
+			Status: policiesv1.PolicyStatus{
+				ComplianceState: "NonCompliant",
+			},
+		}
+
+		clusterNs := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   crName,
+				Labels: map[string]string{provisioningRequestNameLabel: crName},
+			},
+		}
+
+		c = getFakeClientFromObjects(cr, policy, clusterNs)
+		reconciler = &ProvisioningRequestReconciler{
+			Client: c,
+			Logger: logger,
+		}
+	})
+
+	It("waits while the policy remediation is InProgress", func() {
+		result, stop, err := reconciler.handleFinalizer(ctx, cr)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stop).To(BeTrue())
+		Expect(result).To(Equal(requeueWithMediumInterval()))
+
+		// The teardown has not started
+		reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: crName}, reconciledCR)).To(Succeed())
+		Expect(reconciledCR.Status.ProvisioningStatus.ProvisioningPhase).To(Equal(provisioningv1alpha1.StateProgressing))
+		Expect(c.Get(ctx, types.NamespacedName{Name: crName}, &corev1.Namespace{})).To(Succeed())
+	})
+
+	It("proceeds once the policy remediation has settled", func() {
+		policy.Status.ComplianceState = policiesv1.Compliant
+		Expect(c.Status().Update(ctx, policy)).To(Succeed())
+
+		result, stop, err := reconciler.handleFinalizer(ctx, cr)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stop).To(BeTrue())
+		Expect(result).To(Equal(doNotRequeue()))
+
+		reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: crName}, reconciledCR)).To(Succeed())
+		Expect(reconciledCR.Status.ProvisioningStatus.ProvisioningPhase).To(Equal(provisioningv1alpha1.StateDeleting))
+		err = c.Get(ctx, types.NamespacedName{Name: crName}, &corev1.Namespace{})
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("proceeds when the deletion is forced", func() {
+		cr.SetAnnotations(map[string]string{provisioningRequestForceDeletionAnnotation: "true"})
+
+		result, stop, err := reconciler.handleFinalizer(ctx, cr)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stop).To(BeTrue())
+		Expect(result).To(Equal(doNotRequeue()))
+
+		reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: crName}, reconciledCR)).To(Succeed())
+		Expect(reconciledCR.Status.ProvisioningStatus.ProvisioningPhase).To(Equal(provisioningv1alpha1.StateDeleting))
+	})
+})
+
+var _ = Describe("ProvisioningRequest cancellation", func() {
+	var (
+		c          client.Client
+		ctx        context.Context
+		reconciler *ProvisioningRequestReconciler
+		cr         *provisioningv1alpha1.ProvisioningRequest
+		req        reconcile.Request
+		crName     = "cluster-1"
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		cr = &provisioningv1alpha1.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        crName,
+				Finalizers:  []string{provisioningRequestFinalizer},
+				Annotations: map[string]string{provisioningRequestCancelAnnotation: "true"},
+			},
+			Status: provisioningv1alpha1.ProvisioningRequestStatus{
+				Extensions: provisioningv1alpha1.Extensions{
+					ClusterDetails: &provisioningv1alpha1.ClusterDetails{Name: crName},
+				},
+				ProvisioningStatus: provisioningv1alpha1.ProvisioningStatus{
+					ProvisioningPhase:   provisioningv1alpha1.StateProgressing,
+					ProvisioningDetails: "Cluster installation is in progress",
+				},
+			},
+		}
+		utils.SetStatusCondition(&cr.Status.Conditions,
+			provisioningv1alpha1.PRconditionTypes.ClusterProvisioned,
+			provisioningv1alpha1.CRconditionReasons.InProgress,
+			metav1.ConditionFalse,
+			"Provisioning cluster",
+		)
+
+		clusterNs := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   crName,
+				Labels: map[string]string{provisioningRequestNameLabel: crName},
+			},
+		}
+		nodePool := &hwv1alpha1.NodePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      crName,
+				Namespace: utils.UnitTestHwmgrNamespace,
+				Labels:    map[string]string{provisioningRequestNameLabel: crName},
+			},
+		}
+
+		c = getFakeClientFromObjects(cr, clusterNs, nodePool)
+		reconciler = &ProvisioningRequestReconciler{
+			Client: c,
+			Logger: logger,
+		}
+		req = reconcile.Request{NamespacedName: types.NamespacedName{Name: crName}}
+	})
+
+	It("tears down the in-progress installation and reaches the cancelled state", func() {
+		result, err := reconciler.Reconcile(ctx, req)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(doNotRequeue()))
+
+		// The teardown has been initiated
+		reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+		Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+		Expect(reconciledCR.Status.ProvisioningStatus.ProvisioningPhase).To(Equal(provisioningv1alpha1.StateCancelled))
+		Expect(reconciledCR.Status.ProvisioningStatus.ProvisioningDetails).To(Equal("Cancellation is in progress"))
+		err = c.Get(ctx, types.NamespacedName{Name: crName}, &corev1.Namespace{})
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+		err = c.Get(ctx, types.NamespacedName{Name: crName, Namespace: utils.UnitTestHwmgrNamespace}, &hwv1alpha1.NodePool{})
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+
+		// The teardown has completed
+		result, err = reconciler.Reconcile(ctx, req)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(doNotRequeue()))
+		Expect(c.Get(ctx, req.NamespacedName, reconciledCR)).To(Succeed())
+		Expect(reconciledCR.Status.ProvisioningStatus.ProvisioningPhase).To(Equal(provisioningv1alpha1.StateCancelled))
+		Expect(reconciledCR.Status.ProvisioningStatus.ProvisioningDetails).To(Equal("Provisioning request has been cancelled"))
+	})
+
+	It("stays cancelled once the annotation is removed", func() {
+		cr.SetAnnotations(nil)
+		utils.SetProvisioningStateCancelled(cr, "Provisioning request has been cancelled")
+		Expect(reconciler.isCancellationRequested(ctx, cr)).To(BeTrue())
+	})
+
+	It("ignores the cancellation once the cluster installation has completed", func() {
+		utils.SetStatusCondition(&cr.Status.Conditions,
+			provisioningv1alpha1.PRconditionTypes.ClusterProvisioned,
+			provisioningv1alpha1.CRconditionReasons.Completed,
+			metav1.ConditionTrue,
+			"Provisioning completed",
+		)
+		cr.Status.Extensions.ClusterDetails = &provisioningv1alpha1.ClusterDetails{
+			Name:                   crName,
+			InstallationInputsHash: "inputs-hash",
+		}
+		Expect(reconciler.isCancellationRequested(ctx, cr)).To(BeFalse())
+	})
+
+	It("ignores the cancellation once the installed cluster has gone missing", func() {
+		utils.SetStatusCondition(&cr.Status.Conditions,
+			provisioningv1alpha1.PRconditionTypes.ClusterProvisioned,
+			provisioningv1alpha1.CRconditionReasons.ClusterMissing,
+			metav1.ConditionFalse,
+			"The ManagedCluster is missing",
+		)
+		cr.Status.Extensions.ClusterDetails = &provisioningv1alpha1.ClusterDetails{
+			Name:                   crName,
+			InstallationInputsHash: "inputs-hash",
+		}
+		Expect(utils.IsClusterProvisionCompleted(cr)).To(BeFalse())
+		Expect(reconciler.isCancellationRequested(ctx, cr)).To(BeFalse())
+	})
+})
+
+var _ = Describe("ProvisioningRequest condition ordering", func() {
+	var (
+		c      client.Client
+		ctx    context.Context
+		cr     *provisioningv1alpha1.ProvisioningRequest
+		task   *provisioningRequestReconcilerTask
+		crName = "cluster-1"
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		cr = &provisioningv1alpha1.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: crName,
+			},
+		}
+		c = getFakeClientFromObjects(cr)
+		task = &provisioningRequestReconcilerTask{
+			logger: logger,
+			client: c,
+			object: cr,
+		}
+	})
+
+	It("writes the conditions in the canonical order regardless of the set order", func() {
+		for _, conditionType := range []provisioningv1alpha1.ConditionType{
+			provisioningv1alpha1.PRconditionTypes.HardwareProvisioned,
+			provisioningv1alpha1.PRconditionTypes.ClusterResourcesCreated,
+			provisioningv1alpha1.PRconditionTypes.Validated,
+			provisioningv1alpha1.PRconditionTypes.HardwareTemplateRendered,
+			provisioningv1alpha1.PRconditionTypes.ClusterInstanceRendered,
+		} {
+			utils.SetStatusCondition(&cr.Status.Conditions, conditionType,
+				provisioningv1alpha1.CRconditionReasons.Completed, metav1.ConditionTrue, "")
+		}
+		Expect(utils.UpdateK8sCRStatus(ctx, c, cr)).To(Succeed())
+
+		reconciledCR := &provisioningv1alpha1.ProvisioningRequest{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: crName}, reconciledCR)).To(Succeed())
+		conditionTypes := []string{}
+		for _, condition := range reconciledCR.Status.Conditions {
+			conditionTypes = append(conditionTypes, condition.Type)
+		}
+		Expect(conditionTypes).To(Equal([]string{
+			string(provisioningv1alpha1.PRconditionTypes.Validated),
+			string(provisioningv1alpha1.PRconditionTypes.ClusterInstanceRendered),
+			string(provisioningv1alpha1.PRconditionTypes.ClusterResourcesCreated),
+			string(provisioningv1alpha1.PRconditionTypes.HardwareTemplateRendered),
+			string(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned),
+		}))
+	})
+
+	It("reports the first failed preparation step in the canonical order", func() {
+		utils.SetStatusCondition(&cr.Status.Conditions,
+			provisioningv1alpha1.PRconditionTypes.HardwareTemplateRendered,
+			provisioningv1alpha1.CRconditionReasons.Failed, metav1.ConditionFalse, "hardware template failure")
+		utils.SetStatusCondition(&cr.Status.Conditions,
+			provisioningv1alpha1.PRconditionTypes.ClusterInstanceRendered,
+			provisioningv1alpha1.CRconditionReasons.Failed, metav1.ConditionFalse, "rendering failure")
+		utils.SetStatusCondition(&cr.Status.Conditions,
+			provisioningv1alpha1.PRconditionTypes.Validated,
+			provisioningv1alpha1.CRconditionReasons.Completed, metav1.ConditionTrue, "")
+
+		Expect(task.checkResourcePreparationStatus(ctx)).To(Succeed())
+		verifyProvisioningStatus(cr.Status.ProvisioningStatus,
+			provisioningv1alpha1.StateFailed, "rendering failure", nil)
+	})
+})
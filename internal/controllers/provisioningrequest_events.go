@@ -0,0 +1,108 @@
+package controllers
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+)
+
+// Reasons of the events emitted on the transitions of a ProvisioningRequest
+const (
+	validationFailedEvent            = "ValidationFailed"
+	hardwareProvisioningStartedEvent = "HardwareProvisioningStarted"
+	clusterInstallCompletedEvent     = "ClusterInstallCompleted"
+	upgradeStartedEvent              = "UpgradeStarted"
+	upgradeCompletedEvent            = "UpgradeCompleted"
+	timedOutEvent                    = "TimedOut"
+)
+
+// transitionEvent describes the event emitted when a condition of a ProvisioningRequest enters a given state. An
+// empty condition type matches any condition and an empty status or reason matches any value.
+type transitionEvent struct {
+	conditionType provisioningv1alpha1.ConditionType
+	status        metav1.ConditionStatus
+	reason        provisioningv1alpha1.ConditionReason
+	eventType     string
+	eventReason   string
+}
+
+// transitionEvents lists the transitions of a ProvisioningRequest reported as events
+var transitionEvents = []transitionEvent{
+	{
+		conditionType: provisioningv1alpha1.PRconditionTypes.Validated,
+		status:        metav1.ConditionFalse,
+		eventType:     corev1.EventTypeWarning,
+		eventReason:   validationFailedEvent,
+	},
+	{
+		conditionType: provisioningv1alpha1.PRconditionTypes.HardwareProvisioned,
+		eventType:     corev1.EventTypeNormal,
+		eventReason:   hardwareProvisioningStartedEvent,
+	},
+	{
+		conditionType: provisioningv1alpha1.PRconditionTypes.ClusterProvisioned,
+		status:        metav1.ConditionTrue,
+		reason:        provisioningv1alpha1.CRconditionReasons.Completed,
+		eventType:     corev1.EventTypeNormal,
+		eventReason:   clusterInstallCompletedEvent,
+	},
+	{
+		conditionType: provisioningv1alpha1.PRconditionTypes.UpgradeCompleted,
+		status:        metav1.ConditionFalse,
+		reason:        provisioningv1alpha1.CRconditionReasons.InProgress,
+		eventType:     corev1.EventTypeNormal,
+		eventReason:   upgradeStartedEvent,
+	},
+	{
+		conditionType: provisioningv1alpha1.PRconditionTypes.UpgradeCompleted,
+		status:        metav1.ConditionTrue,
+		reason:        provisioningv1alpha1.CRconditionReasons.Completed,
+		eventType:     corev1.EventTypeNormal,
+		eventReason:   upgradeCompletedEvent,
+	},
+	{
+		reason:      provisioningv1alpha1.CRconditionReasons.TimedOut,
+		eventType:   corev1.EventTypeWarning,
+		eventReason: timedOutEvent,
+	},
+}
+
+// matches returns whether the condition is in the state described by the transition
+func (e transitionEvent) matches(condition *metav1.Condition) bool {
+	return condition != nil &&
+		(e.status == "" || condition.Status == e.status) &&
+		(e.reason == "" || condition.Reason == string(e.reason))
+}
+
+// recordTransitionEvents emits an event for each condition of the ProvisioningRequest that entered one of the
+// states of transitionEvents since the previous conditions were observed. Conditions that were already in that state
+// are ignored, so that requeues do not emit the same events again. The message of the events is the provisioning
+// details of the ProvisioningRequest, falling back to the message of the condition when they are empty.
+func recordTransitionEvents(recorder record.EventRecorder, previous []metav1.Condition,
+	object *provisioningv1alpha1.ProvisioningRequest) {
+	if recorder == nil {
+		return
+	}
+
+	for i := range object.Status.Conditions {
+		condition := &object.Status.Conditions[i]
+		before := meta.FindStatusCondition(previous, condition.Type)
+		for _, e := range transitionEvents {
+			if e.conditionType != "" && string(e.conditionType) != condition.Type {
+				continue
+			}
+			if !e.matches(condition) || e.matches(before) {
+				continue
+			}
+
+			message := object.Status.ProvisioningStatus.ProvisioningDetails
+			if message == "" {
+				message = condition.Message
+			}
+			recorder.Event(object, e.eventType, e.eventReason, message)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package controllers
+
+import (
+	"slices"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+	"github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
+)
+
+var _ = Describe("recordTransitionEvents", func() {
+	var (
+		recorder *record.FakeRecorder
+		object   *provisioningv1alpha1.ProvisioningRequest
+	)
+
+	setCondition := func(conditionType provisioningv1alpha1.ConditionType, reason provisioningv1alpha1.ConditionReason,
+		status metav1.ConditionStatus, message string) {
+		meta.SetStatusCondition(&object.Status.Conditions, metav1.Condition{
+			Type:    string(conditionType),
+			Reason:  string(reason),
+			Status:  status,
+			Message: message,
+		})
+	}
+
+	// transition applies the change to the ProvisioningRequest and records the resulting events
+	transition := func(change func()) {
+		previous := slices.Clone(object.Status.Conditions)
+		change()
+		recordTransitionEvents(recorder, previous, object)
+	}
+
+	BeforeEach(func() {
+		recorder = record.NewFakeRecorder(10)
+		object = &provisioningv1alpha1.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster-1"},
+		}
+	})
+
+	It("emits a warning with the provisioning details when the validation fails", func() {
+		transition(func() {
+			setCondition(provisioningv1alpha1.PRconditionTypes.Validated, provisioningv1alpha1.CRconditionReasons.Failed,
+				metav1.ConditionFalse, "Failed to validate the ProvisioningRequest")
+			utils.SetProvisioningStateFailed(object, "Failed to validate the ProvisioningRequest: missing field")
+		})
+		Expect(recorder.Events).To(Receive(Equal(
+			"Warning ValidationFailed Failed to validate the ProvisioningRequest: missing field")))
+		Expect(recorder.Events).ToNot(Receive())
+
+		// A requeue in the same state emits nothing
+		transition(func() {})
+		Expect(recorder.Events).ToNot(Receive())
+	})
+
+	It("emits an event for each key transition of the provisioning", func() {
+		transition(func() {
+			setCondition(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned,
+				provisioningv1alpha1.CRconditionReasons.InProgress, metav1.ConditionFalse, "")
+			utils.SetProvisioningStateInProgress(object, "Hardware provisioning is in progress")
+		})
+		Expect(recorder.Events).To(Receive(Equal(
+			"Normal HardwareProvisioningStarted Hardware provisioning is in progress")))
+
+		// Moving to another state of the same condition is not a new start
+		transition(func() {
+			setCondition(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned,
+				provisioningv1alpha1.CRconditionReasons.Completed, metav1.ConditionTrue, "")
+			setCondition(provisioningv1alpha1.PRconditionTypes.ClusterProvisioned,
+				provisioningv1alpha1.CRconditionReasons.InProgress, metav1.ConditionFalse, "")
+		})
+		Expect(recorder.Events).ToNot(Receive())
+
+		transition(func() {
+			setCondition(provisioningv1alpha1.PRconditionTypes.ClusterProvisioned,
+				provisioningv1alpha1.CRconditionReasons.Completed, metav1.ConditionTrue, "")
+			utils.SetProvisioningStateInProgress(object, "Cluster installation completed")
+		})
+		Expect(recorder.Events).To(Receive(Equal("Normal ClusterInstallCompleted Cluster installation completed")))
+
+		transition(func() {
+			setCondition(provisioningv1alpha1.PRconditionTypes.UpgradeCompleted,
+				provisioningv1alpha1.CRconditionReasons.InProgress, metav1.ConditionFalse, "")
+			utils.SetProvisioningStateInProgress(object, "Cluster upgrade is in progress")
+		})
+		Expect(recorder.Events).To(Receive(Equal("Normal UpgradeStarted Cluster upgrade is in progress")))
+
+		transition(func() {
+			setCondition(provisioningv1alpha1.PRconditionTypes.UpgradeCompleted,
+				provisioningv1alpha1.CRconditionReasons.Completed, metav1.ConditionTrue, "")
+			utils.SetProvisioningStateFulfilled(object)
+		})
+		Expect(recorder.Events).To(Receive(Equal(
+			"Normal UpgradeCompleted Provisioning request has completed successfully")))
+		Expect(recorder.Events).ToNot(Receive())
+	})
+
+	It("emits a warning for each timeout", func() {
+		transition(func() {
+			setCondition(provisioningv1alpha1.PRconditionTypes.ConfigurationApplied,
+				provisioningv1alpha1.CRconditionReasons.TimedOut, metav1.ConditionFalse,
+				"Policy configuration timed out")
+		})
+		Expect(recorder.Events).To(Receive(Equal("Warning TimedOut Policy configuration timed out")))
+
+		transition(func() {
+			setCondition(provisioningv1alpha1.PRconditionTypes.ClusterProvisioned,
+				provisioningv1alpha1.CRconditionReasons.TimedOut, metav1.ConditionFalse, "")
+			utils.SetProvisioningStateFailed(object, "Cluster installation timed out")
+		})
+		Expect(recorder.Events).To(Receive(Equal("Warning TimedOut Cluster installation timed out")))
+		Expect(recorder.Events).ToNot(Receive())
+	})
+})
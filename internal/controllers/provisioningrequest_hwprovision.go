@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	pluginv1alpha1 "github.com/openshift-kni/oran-hwmgr-plugin/api/hwmgr-plugin/v1alpha1"
 	hwv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
@@ -19,6 +22,9 @@ import (
 )
 
 func (t *provisioningRequestReconcilerTask) createOrUpdateNodePool(ctx context.Context, nodePool *hwv1alpha1.NodePool) error {
+	if err := t.checkNotDryRun("allocate the hardware"); err != nil {
+		return err
+	}
 
 	existingNodePool := &hwv1alpha1.NodePool{}
 
@@ -31,17 +37,52 @@ func (t *provisioningRequestReconcilerTask) createOrUpdateNodePool(ctx context.C
 		return t.createNodePoolResources(ctx, nodePool)
 	}
 
-	// The template validate is already completed; compare NodeGroup and update them if necessary
-	if !equality.Semantic.DeepEqual(existingNodePool.Spec.NodeGroup, nodePool.Spec.NodeGroup) {
-		// Only process the configuration changes
-		patch := client.MergeFrom(existingNodePool.DeepCopy())
-		// Update the spec field with the new data
-		existingNodePool.Spec = nodePool.Spec
-		// Apply the patch to update the NodePool with the new spec
-		if err = t.client.Patch(ctx, existingNodePool, patch); err != nil {
-			return fmt.Errorf("failed to patch NodePool %s in namespace %s: %w", nodePool.GetName(), nodePool.GetNamespace(), err)
+	// A NodePool without the ProvisioningRequest label was not created for this request, e.g. it was
+	// left behind for the same cluster name. Adopt it and its allocated nodes instead of re-allocating.
+	// Its identity has already been checked against the rendered NodePool by checkNodePoolAdoption.
+	adopt := existingNodePool.GetLabels()[provisioningRequestNameLabel] == ""
+
+	// The template validate is already completed; compare the fields owned by the hardware template and
+	// update them if necessary. For an adopted NodePool, this requests the hardware plugin to allocate
+	// only the remaining nodes.
+	changed := !equality.Semantic.DeepEqual(existingNodePool.Spec.NodeGroup, nodePool.Spec.NodeGroup) ||
+		!equality.Semantic.DeepEqual(existingNodePool.Spec.PlacementConstraints, nodePool.Spec.PlacementConstraints) ||
+		!equality.Semantic.DeepEqual(existingNodePool.Spec.Extensions, nodePool.Spec.Extensions)
+	if !adopt && !changed {
+		return nil
+	}
+
+	patch := client.MergeFrom(existingNodePool.DeepCopy())
+	if adopt {
+		utils.SetNodePoolLabels(existingNodePool, provisioningRequestNameLabel, t.object.Name)
+		if err = controllerutil.SetControllerReference(t.object, existingNodePool, t.client.Scheme()); err != nil {
+			return fmt.Errorf("failed to set controller reference on NodePool %s in namespace %s: %w",
+				nodePool.GetName(), nodePool.GetNamespace(), err)
 		}
+	}
+	if changed {
+		// Only process the configuration changes
+		existingNodePool.Spec.NodeGroup = nodePool.Spec.NodeGroup
+		existingNodePool.Spec.PlacementConstraints = nodePool.Spec.PlacementConstraints
+		existingNodePool.Spec.Extensions = nodePool.Spec.Extensions
+	}
+	// Apply the patch to update the NodePool with the new labels and spec
+	if err = t.client.Patch(ctx, existingNodePool, patch); err != nil {
+		return fmt.Errorf("failed to patch NodePool %s in namespace %s: %w", nodePool.GetName(), nodePool.GetNamespace(), err)
+	}
 
+	if adopt {
+		t.logger.InfoContext(
+			ctx,
+			fmt.Sprintf(
+				"Adopted existing NodePool %s in the namespace %s with %d allocated node(s)",
+				nodePool.GetName(),
+				nodePool.GetNamespace(),
+				len(existingNodePool.Status.Properties.NodeNames),
+			),
+		)
+	}
+	if changed {
 		t.logger.InfoContext(
 			ctx,
 			fmt.Sprintf(
@@ -54,6 +95,112 @@ func (t *provisioningRequestReconcilerTask) createOrUpdateNodePool(ctx context.C
 	return nil
 }
 
+// checkNodePoolAdoption verifies that an existing NodePool that was not created for the ProvisioningRequest
+// can be adopted, i.e. that it was allocated from the same hardware manager, for the same O-Cloud and the
+// same site as the rendered NodePool. Adopting it otherwise would hand over unrelated nodes, so the
+// ProvisioningRequest is failed instead.
+func (t *provisioningRequestReconcilerTask) checkNodePoolAdoption(ctx context.Context, nodePool *hwv1alpha1.NodePool) (bool, error) {
+	existingNodePool := &hwv1alpha1.NodePool{}
+	exist, err := utils.DoesK8SResourceExist(ctx, t.client, nodePool.Name, nodePool.Namespace, existingNodePool)
+	if err != nil {
+		return false, fmt.Errorf("failed to get NodePool %s in namespace %s: %w", nodePool.GetName(), nodePool.GetNamespace(), err)
+	}
+	if !exist || existingNodePool.GetLabels()[provisioningRequestNameLabel] != "" {
+		return false, nil
+	}
+
+	var mismatches []string
+	if existingNodePool.Spec.HwMgrId != nodePool.Spec.HwMgrId {
+		mismatches = append(mismatches, fmt.Sprintf("hwMgrId %q (expected %q)",
+			existingNodePool.Spec.HwMgrId, nodePool.Spec.HwMgrId))
+	}
+	if existingNodePool.Spec.CloudID != nodePool.Spec.CloudID {
+		mismatches = append(mismatches, fmt.Sprintf("cloudID %q (expected %q)",
+			existingNodePool.Spec.CloudID, nodePool.Spec.CloudID))
+	}
+	if existingNodePool.Spec.Site != nodePool.Spec.Site {
+		mismatches = append(mismatches, fmt.Sprintf("site %q (expected %q)",
+			existingNodePool.Spec.Site, nodePool.Spec.Site))
+	}
+	if len(mismatches) == 0 {
+		return false, nil
+	}
+
+	message := fmt.Sprintf("NodePool %s in the namespace %s cannot be adopted, it was allocated with %s",
+		nodePool.GetName(), nodePool.GetNamespace(), strings.Join(mismatches, ", "))
+	t.logger.InfoContext(ctx, message)
+	utils.SetStatusCondition(&t.object.Status.Conditions,
+		provisioningv1alpha1.PRconditionTypes.HardwareProvisioned,
+		provisioningv1alpha1.CRconditionReasons.NodePoolIdentityMismatch,
+		metav1.ConditionFalse,
+		message)
+	utils.SetProvisioningStateFailed(t.object, message)
+
+	if updateErr := utils.UpdateK8sCRStatus(ctx, t.client, t.object); updateErr != nil {
+		return false, fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, updateErr)
+	}
+	return true, nil
+}
+
+// checkDuplicateNodePoolReference verifies that the NodePool, the node allocation request of this tree, is not
+// already referenced by another ProvisioningRequest, either through its status or through the NodePool
+// ProvisioningRequest label. Proceeding would let both requests drive the same NodePool, so the
+// ProvisioningRequest is failed with the DuplicateNARReference reason instead.
+func (t *provisioningRequestReconcilerTask) checkDuplicateNodePoolReference(ctx context.Context, nodePool *hwv1alpha1.NodePool) (bool, error) {
+	existingNodePool := &hwv1alpha1.NodePool{}
+	exist, err := utils.DoesK8SResourceExist(ctx, t.client, nodePool.Name, nodePool.Namespace, existingNodePool)
+	if err != nil {
+		return false, fmt.Errorf("failed to get NodePool %s in namespace %s: %w", nodePool.GetName(), nodePool.GetNamespace(), err)
+	}
+
+	owner := ""
+	if labelOwner := existingNodePool.GetLabels()[provisioningRequestNameLabel]; exist &&
+		labelOwner != "" && labelOwner != t.object.Name {
+		labelOwnerExists, err := utils.DoesK8SResourceExist(ctx, t.client, labelOwner, "",
+			&provisioningv1alpha1.ProvisioningRequest{})
+		if err != nil {
+			return false, fmt.Errorf("failed to get ProvisioningRequest %s: %w", labelOwner, err)
+		}
+		if labelOwnerExists {
+			owner = labelOwner
+		}
+	}
+
+	if owner == "" {
+		provisioningRequests := &provisioningv1alpha1.ProvisioningRequestList{}
+		if err := t.client.List(ctx, provisioningRequests, client.MatchingFields{
+			nodePoolRefIndex: client.ObjectKeyFromObject(nodePool).String(),
+		}); err != nil {
+			return false, fmt.Errorf("failed to list ProvisioningRequests referencing NodePool %s: %w",
+				nodePool.GetName(), err)
+		}
+		for _, pr := range provisioningRequests.Items {
+			if pr.Name != t.object.Name {
+				owner = pr.Name
+				break
+			}
+		}
+	}
+	if owner == "" {
+		return false, nil
+	}
+
+	message := fmt.Sprintf("NodePool %s in the namespace %s is already referenced by ProvisioningRequest %s",
+		nodePool.GetName(), nodePool.GetNamespace(), owner)
+	t.logger.InfoContext(ctx, message)
+	utils.SetStatusCondition(&t.object.Status.Conditions,
+		provisioningv1alpha1.PRconditionTypes.HardwareProvisioned,
+		provisioningv1alpha1.CRconditionReasons.DuplicateNARReference,
+		metav1.ConditionFalse,
+		message)
+	utils.SetProvisioningStateFailed(t.object, message)
+
+	if updateErr := utils.UpdateK8sCRStatus(ctx, t.client, t.object); updateErr != nil {
+		return false, fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, updateErr)
+	}
+	return true, nil
+}
+
 func (t *provisioningRequestReconcilerTask) createNodePoolResources(ctx context.Context, nodePool *hwv1alpha1.NodePool) error {
 	// Create the hardware plugin namespace.
 	pluginNameSpace := nodePool.ObjectMeta.Namespace
@@ -83,6 +230,7 @@ func (t *provisioningRequestReconcilerTask) createNodePoolResources(ctx context.
 		)
 		return fmt.Errorf("failed to create/update the NodePool: %s", createErr.Error())
 	}
+	t.trackAppliedManifest(nodePool)
 
 	// Set NodePoolRef
 	if t.object.Status.Extensions.NodePoolRef == nil {
@@ -119,6 +267,97 @@ func (t *provisioningRequestReconcilerTask) waitForHardwareData(ctx context.Cont
 	return provisioned, configured, timedOutOrFailed, err
 }
 
+// isHardwareProvisioningCompleted returns whether the hardware has been provisioned and, when requested, configured
+func (t *provisioningRequestReconcilerTask) isHardwareProvisioningCompleted() bool {
+	provisioned := meta.FindStatusCondition(t.object.Status.Conditions,
+		string(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned))
+	configured := meta.FindStatusCondition(t.object.Status.Conditions,
+		string(provisioningv1alpha1.PRconditionTypes.HardwareConfigured))
+	return provisioned != nil && provisioned.Status == metav1.ConditionTrue &&
+		(configured == nil || configured.Status == metav1.ConditionTrue)
+}
+
+// checkHardwarePluginAvailability checks whether the hardware plugin serving the NodePool is available, as reported
+// by the Validation condition of its HardwareManager. While it is unavailable, the HardwarePluginUnavailable
+// condition is set so that the hardware provisioning can be held in its current phase rather than failed. Once the
+// plugin is back, the condition is removed and the hardware timeouts are extended by the time the plugin was
+// unavailable, so that the provisioning resumes where it was. The hold lasts at most the hardware provisioning
+// timeout, after which the hardware timeouts apply again, so a plugin that does not come back eventually fails the
+// provisioning.
+func (t *provisioningRequestReconcilerTask) checkHardwarePluginAvailability(ctx context.Context,
+	nodePool *hwv1alpha1.NodePool) (bool, error) {
+
+	hwmgr := &pluginv1alpha1.HardwareManager{}
+	if err := t.client.Get(ctx, types.NamespacedName{Namespace: utils.GetHwMgrPluginNS(), Name: nodePool.Spec.HwMgrId}, hwmgr); err != nil {
+		return false, fmt.Errorf("failed to get HardwareManager %s/%s: %w", utils.GetHwMgrPluginNS(), nodePool.Spec.HwMgrId, err)
+	}
+
+	unavailable := meta.FindStatusCondition(t.object.Status.Conditions,
+		string(provisioningv1alpha1.PRconditionTypes.HardwarePluginUnavailable))
+	validation := meta.FindStatusCondition(hwmgr.Status.Conditions, string(pluginv1alpha1.ConditionTypes.Validation))
+	if validation != nil && validation.Status != metav1.ConditionTrue {
+		if unavailable != nil && time.Since(unavailable.LastTransitionTime.Time) >= t.timeouts.hardwareProvisioning {
+			t.logger.InfoContext(
+				ctx,
+				fmt.Sprintf("No longer holding the hardware provisioning of NodePool %s in the namespace %s, "+
+					"the hardware plugin has been unavailable for longer than the hardware provisioning timeout",
+					nodePool.GetName(), nodePool.GetNamespace()),
+			)
+			return true, nil
+		}
+
+		message := fmt.Sprintf("The hardware plugin is unavailable, HardwareManager %s reports: %s",
+			hwmgr.GetName(), validation.Message)
+		if unavailable != nil && unavailable.Message == message {
+			return false, nil
+		}
+
+		t.logger.InfoContext(
+			ctx,
+			fmt.Sprintf("Holding the hardware provisioning of NodePool %s in the namespace %s", nodePool.GetName(),
+				nodePool.GetNamespace()),
+			slog.String("reason", message),
+		)
+		utils.SetStatusCondition(&t.object.Status.Conditions,
+			provisioningv1alpha1.PRconditionTypes.HardwarePluginUnavailable,
+			provisioningv1alpha1.CRconditionReasons.Unreachable,
+			metav1.ConditionTrue,
+			message)
+		if updateErr := utils.UpdateK8sCRStatus(ctx, t.client, t.object); updateErr != nil {
+			return false, fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, updateErr)
+		}
+		return false, nil
+	}
+	if unavailable == nil {
+		return true, nil
+	}
+
+	// The plugin is back, do not count the time the provisioning was held against the hardware timeouts
+	outage := min(time.Since(unavailable.LastTransitionTime.Time), t.timeouts.hardwareProvisioning)
+	if nodePoolRef := t.object.Status.Extensions.NodePoolRef; nodePoolRef != nil {
+		for _, checkStart := range []*metav1.Time{
+			nodePoolRef.HardwareProvisioningCheckStart,
+			nodePoolRef.HardwareConfiguringCheckStart,
+		} {
+			if checkStart != nil && checkStart.Before(&unavailable.LastTransitionTime) {
+				checkStart.Time = checkStart.Add(outage)
+			}
+		}
+	}
+	t.logger.InfoContext(
+		ctx,
+		fmt.Sprintf("Resuming the hardware provisioning of NodePool %s in the namespace %s", nodePool.GetName(),
+			nodePool.GetNamespace()),
+		slog.Duration("outage", outage),
+	)
+	meta.RemoveStatusCondition(&t.object.Status.Conditions,
+		string(provisioningv1alpha1.PRconditionTypes.HardwarePluginUnavailable))
+	if updateErr := utils.UpdateK8sCRStatus(ctx, t.client, t.object); updateErr != nil {
+		return false, fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, updateErr)
+	}
+	return true, nil
+}
+
 // updateClusterInstance updates the given ClusterInstance object based on the provisioned nodePool.
 func (t *provisioningRequestReconcilerTask) updateClusterInstance(ctx context.Context,
 	clusterInstance *siteconfig.ClusterInstance, nodePool *hwv1alpha1.NodePool) error {
@@ -203,6 +442,13 @@ func (t *provisioningRequestReconcilerTask) checkNodePoolProvisionStatus(ctx con
 				nodePool.GetNamespace(),
 			),
 		)
+		satisfied, err := t.checkPlacementConstraints(ctx, nodePool)
+		if err != nil {
+			return false, false, err
+		}
+		if !satisfied {
+			return false, true, nil
+		}
 		if err = t.updateClusterInstance(ctx, clusterInstance, nodePool); err != nil {
 			return provisioned, timedOutOrFailed, fmt.Errorf("failed to update the rendered cluster instance: %w", err)
 		}
@@ -211,6 +457,40 @@ func (t *provisioningRequestReconcilerTask) checkNodePoolProvisionStatus(ctx con
 	return provisioned, timedOutOrFailed, err
 }
 
+// checkPlacementConstraints verifies the nodes allocated to the NodePool against the placement
+// constraints from the hardware template and fails the ProvisioningRequest if they are not met.
+func (t *provisioningRequestReconcilerTask) checkPlacementConstraints(ctx context.Context, nodePool *hwv1alpha1.NodePool) (bool, error) {
+
+	err := utils.CheckPlacementConstraints(ctx, t.client, nodePool)
+	if err == nil {
+		return true, nil
+	}
+	if !utils.IsPlacementConstraintUnmetErr(err) {
+		return false, fmt.Errorf("failed to check placement constraints for NodePool %s: %w", nodePool.GetName(), err)
+	}
+
+	t.logger.InfoContext(
+		ctx,
+		fmt.Sprintf(
+			"NodePool %s in the namespace %s does not satisfy the placement constraints",
+			nodePool.GetName(),
+			nodePool.GetNamespace(),
+		),
+		slog.String("error", err.Error()),
+	)
+	utils.SetStatusCondition(&t.object.Status.Conditions,
+		provisioningv1alpha1.PRconditionTypes.HardwareProvisioned,
+		provisioningv1alpha1.CRconditionReasons.PlacementConstraintUnmet,
+		metav1.ConditionFalse,
+		err.Error())
+	utils.SetProvisioningStateFailed(t.object, err.Error())
+
+	if updateErr := utils.UpdateK8sCRStatus(ctx, t.client, t.object); updateErr != nil {
+		return false, fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, updateErr)
+	}
+	return false, nil
+}
+
 // checkNodePoolConfigStatus checks the configured status of the node pool.
 func (t *provisioningRequestReconcilerTask) checkNodePoolConfigStatus(ctx context.Context, nodePool *hwv1alpha1.NodePool) (*bool, bool, error) {
 
@@ -427,6 +707,7 @@ func (t *provisioningRequestReconcilerTask) buildNodePoolSpec(clusterInstance *s
 	nodePool.Spec.HwMgrId = hwTemplate.Spec.HwMgrId
 	nodePool.Spec.Extensions = hwTemplate.Spec.Extensions
 	nodePool.Spec.NodeGroup = nodeGroups
+	nodePool.Spec.PlacementConstraints = hwTemplate.Spec.PlacementConstraints
 	nodePool.ObjectMeta.Name = clusterInstance.GetName()
 	nodePool.ObjectMeta.Namespace = utils.GetHwMgrPluginNS()
 
@@ -448,7 +729,7 @@ func (t *provisioningRequestReconcilerTask) handleRenderHardwareTemplate(ctx con
 		return nil, fmt.Errorf("failed to get the ClusterTemplate for ProvisioningRequest %s: %w ", t.object.Name, err)
 	}
 
-	hwTemplateName := clusterTemplate.Spec.Templates.HwTemplate
+	hwTemplateName := t.getHwTemplateName(clusterTemplate.Spec.Templates)
 	hwTemplate, err := utils.GetHardwareTemplate(ctx, t.client, hwTemplateName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get the HardwareTemplate %s resource: %w ", hwTemplateName, err)
@@ -466,6 +747,17 @@ func (t *provisioningRequestReconcilerTask) handleRenderHardwareTemplate(ctx con
 		return nil, err
 	}
 
+	if errs := utils.ValidateHardwareTemplate(hwTemplate); len(errs) != 0 {
+		errMessage := fmt.Sprintf("the HardwareTemplate %s is invalid: %s", hwTemplateName, errs.ToAggregate().Error())
+		updateErr := utils.UpdateHardwareTemplateStatusCondition(ctx, t.client, hwTemplate, provisioningv1alpha1.ConditionType(hwv1alpha1.Validation),
+			provisioningv1alpha1.ConditionReason(hwv1alpha1.Failed), metav1.ConditionFalse, errMessage)
+		if updateErr != nil {
+			// nolint: wrapcheck
+			return nil, updateErr
+		}
+		return nil, utils.NewInputError("%s", errMessage)
+	}
+
 	hwmgr := &pluginv1alpha1.HardwareManager{}
 	if err := t.client.Get(ctx, types.NamespacedName{Namespace: utils.GetHwMgrPluginNS(), Name: hwTemplate.Spec.HwMgrId}, hwmgr); err != nil {
 		updateErr := utils.UpdateHardwareTemplateStatusCondition(ctx, t.client, hwTemplate, provisioningv1alpha1.ConditionType(hwv1alpha1.Validation),
@@ -477,7 +769,6 @@ func (t *provisioningRequestReconcilerTask) handleRenderHardwareTemplate(ctx con
 		return nil, fmt.Errorf("could not find specified HardwareManager: %s/%s, err=%w", utils.GetHwMgrPluginNS(), hwTemplate.Spec.HwMgrId, err)
 	}
 
-	// The HardwareTemplate is validated by the CRD schema and no additional validation is needed
 	updateErr := utils.UpdateHardwareTemplateStatusCondition(ctx, t.client, hwTemplate, provisioningv1alpha1.ConditionType(hwv1alpha1.Validation),
 		provisioningv1alpha1.ConditionReason(hwv1alpha1.Completed), metav1.ConditionTrue, "Validated")
 	if updateErr != nil {
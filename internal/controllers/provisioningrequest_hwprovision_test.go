@@ -3,6 +3,7 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -15,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	pluginv1alpha1 "github.com/openshift-kni/oran-hwmgr-plugin/api/hwmgr-plugin/v1alpha1"
 	hwv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
 	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
 	"github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
@@ -226,6 +228,48 @@ var _ = Describe("renderHardwareTemplate", func() {
 		Expect(err.Error()).To(ContainSubstring("failed to get the ClusterTemplate"))
 	})
 
+	It("selects the HardwareTemplate mapped to the site of the request", func() {
+		ct.Spec.Templates.SiteHwTemplates = map[string]string{"local-123": hwTemplatev2}
+		Expect(c.Create(ctx, ct)).To(Succeed())
+
+		for name, resourcePoolId := range map[string]string{hwTemplate: "pool-default", hwTemplatev2: "pool-local"} {
+			Expect(c.Create(ctx, &hwv1alpha1.HardwareTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: utils.InventoryNamespace,
+				},
+				Spec: hwv1alpha1.HardwareTemplateSpec{
+					HwMgrId:            utils.UnitTestHwmgrID,
+					BootInterfaceLabel: "bootable-interface",
+					NodePoolData: []hwv1alpha1.NodePoolData{
+						{
+							Name:           "controller",
+							Role:           "master",
+							ResourcePoolId: resourcePoolId,
+							HwProfile:      "profile-spr-single-processor-64G",
+						},
+					},
+				},
+			})).To(Succeed())
+		}
+
+		// The request for the mapped site gets the site specific HardwareTemplate
+		nodePool, err := task.renderHardwareTemplate(ctx, clusterInstance)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(nodePool.Spec.Site).To(Equal("local-123"))
+		Expect(nodePool.Spec.NodeGroup).To(HaveLen(1))
+		Expect(nodePool.Spec.NodeGroup[0].NodePoolData.ResourcePoolId).To(Equal("pool-local"))
+
+		// The request for any other site gets the default HardwareTemplate
+		task.object.Spec.TemplateParameters.Raw = []byte(
+			strings.Replace(testFullTemplateParameters, "local-123", "remote-456", 1))
+		nodePool, err = task.renderHardwareTemplate(ctx, clusterInstance)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(nodePool.Spec.Site).To(Equal("remote-456"))
+		Expect(nodePool.Spec.NodeGroup).To(HaveLen(1))
+		Expect(nodePool.Spec.NodeGroup[0].NodePoolData.ResourcePoolId).To(Equal("pool-default"))
+	})
+
 	Context("When NodePool has been created", func() {
 		var nodePool *hwv1alpha1.NodePool
 
@@ -235,6 +279,7 @@ var _ = Describe("renderHardwareTemplate", func() {
 
 			nodePool.SetName(crName)
 			nodePool.SetNamespace("hwmgr")
+			nodePool.SetLabels(map[string]string{provisioningRequestNameLabel: crName})
 			nodePool.Spec.HwMgrId = utils.UnitTestHwmgrID
 			nodePool.Annotations = map[string]string{"bootInterfaceLabel": "bootable-interface"}
 			nodePool.Spec.NodeGroup = []hwv1alpha1.NodeGroup{
@@ -819,6 +864,403 @@ var _ = Describe("updateClusterInstance", func() {
 	})
 })
 
+var _ = Describe("createOrUpdateNodePool", func() {
+	var (
+		ctx      context.Context
+		c        client.Client
+		task     *provisioningRequestReconcilerTask
+		cr       *provisioningv1alpha1.ProvisioningRequest
+		np       *hwv1alpha1.NodePool
+		existing *hwv1alpha1.NodePool
+		crName   = "cluster-1"
+		poolns   = utils.UnitTestHwmgrNamespace
+	)
+
+	newNodeGroups := func(masters, workers int) []hwv1alpha1.NodeGroup {
+		return []hwv1alpha1.NodeGroup{
+			{
+				NodePoolData: hwv1alpha1.NodePoolData{Name: groupNameController, Role: "master"},
+				Size:         masters,
+			},
+			{
+				NodePoolData: hwv1alpha1.NodePoolData{Name: groupNameWorker, Role: "worker"},
+				Size:         workers,
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		// Define the provisioning request.
+		cr = &provisioningv1alpha1.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: crName,
+			},
+		}
+
+		// Define the rendered node pool requesting three masters and one worker.
+		np = &hwv1alpha1.NodePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      crName,
+				Namespace: poolns,
+				Labels:    map[string]string{provisioningRequestNameLabel: crName},
+			},
+			Spec: hwv1alpha1.NodePoolSpec{
+				CloudID:      crName,
+				LocationSpec: hwv1alpha1.LocationSpec{Site: "site-1"},
+				HwMgrId:      utils.UnitTestHwmgrID,
+				NodeGroup:    newNodeGroups(3, 1),
+			},
+		}
+
+		// Define a node pool for the same cluster that was not created for this request.
+		existing = &hwv1alpha1.NodePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      crName,
+				Namespace: poolns,
+			},
+			Spec: hwv1alpha1.NodePoolSpec{
+				CloudID:      crName,
+				LocationSpec: hwv1alpha1.LocationSpec{Location: "rack-7", Site: "site-1"},
+				HwMgrId:      utils.UnitTestHwmgrID,
+			},
+			Status: hwv1alpha1.NodePoolStatus{
+				Properties: hwv1alpha1.Properties{
+					NodeNames: []string{"master-node-1", "master-node-2", "master-node-3"},
+				},
+			},
+		}
+
+		c = getFakeClientFromObjects([]client.Object{cr}...)
+		task = &provisioningRequestReconcilerTask{
+			logger: logger,
+			client: c,
+			object: cr,
+		}
+	})
+
+	It("adopts an existing NodePool that fully matches the request without re-allocating", func() {
+		existing.Spec.NodeGroup = newNodeGroups(3, 1)
+		Expect(c.Create(ctx, existing)).To(Succeed())
+
+		Expect(task.createOrUpdateNodePool(ctx, np)).To(Succeed())
+
+		adopted := &hwv1alpha1.NodePool{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(existing), adopted)).To(Succeed())
+		Expect(adopted.Labels[provisioningRequestNameLabel]).To(Equal(crName))
+		Expect(adopted.Spec.NodeGroup).To(Equal(existing.Spec.NodeGroup))
+		Expect(adopted.Status.Properties.NodeNames).To(Equal(existing.Status.Properties.NodeNames))
+		Expect(metav1.IsControlledBy(adopted, cr)).To(BeTrue())
+	})
+
+	It("adopts an existing NodePool that partially matches the request and allocates the remainder", func() {
+		existing.Spec.NodeGroup = newNodeGroups(3, 0)
+		Expect(c.Create(ctx, existing)).To(Succeed())
+
+		Expect(task.createOrUpdateNodePool(ctx, np)).To(Succeed())
+
+		adopted := &hwv1alpha1.NodePool{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(existing), adopted)).To(Succeed())
+		Expect(adopted.Labels[provisioningRequestNameLabel]).To(Equal(crName))
+		Expect(adopted.Spec.NodeGroup).To(Equal(np.Spec.NodeGroup))
+		Expect(adopted.Status.Properties.NodeNames).To(Equal(existing.Status.Properties.NodeNames))
+		// The fields not owned by the hardware template are kept
+		Expect(adopted.Spec.Location).To(Equal("rack-7"))
+	})
+})
+
+var _ = Describe("checkNodePoolAdoption", func() {
+	var (
+		ctx      context.Context
+		c        client.Client
+		task     *provisioningRequestReconcilerTask
+		cr       *provisioningv1alpha1.ProvisioningRequest
+		np       *hwv1alpha1.NodePool
+		existing *hwv1alpha1.NodePool
+		crName   = "cluster-1"
+		poolns   = utils.UnitTestHwmgrNamespace
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		// Define the provisioning request.
+		cr = &provisioningv1alpha1.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: crName,
+			},
+		}
+
+		// Define the rendered node pool.
+		np = &hwv1alpha1.NodePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      crName,
+				Namespace: poolns,
+				Labels:    map[string]string{provisioningRequestNameLabel: crName},
+			},
+			Spec: hwv1alpha1.NodePoolSpec{
+				CloudID:      crName,
+				LocationSpec: hwv1alpha1.LocationSpec{Site: "site-1"},
+				HwMgrId:      utils.UnitTestHwmgrID,
+			},
+		}
+
+		// Define a node pool for the same cluster that was not created for this request.
+		existing = &hwv1alpha1.NodePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      crName,
+				Namespace: poolns,
+			},
+			Spec: *np.Spec.DeepCopy(),
+		}
+
+		c = getFakeClientFromObjects([]client.Object{cr}...)
+		task = &provisioningRequestReconcilerTask{
+			logger: logger,
+			client: c,
+			object: cr,
+		}
+	})
+
+	It("allows the adoption of a NodePool with the same identity", func() {
+		Expect(c.Create(ctx, existing)).To(Succeed())
+
+		conflict, err := task.checkNodePoolAdoption(ctx, np)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(conflict).To(BeFalse())
+	})
+
+	It("refuses the adoption of a NodePool from another hardware manager and site", func() {
+		existing.Spec.HwMgrId = "other-hwmgr"
+		existing.Spec.Site = "site-2"
+		Expect(c.Create(ctx, existing)).To(Succeed())
+
+		conflict, err := task.checkNodePoolAdoption(ctx, np)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(conflict).To(BeTrue())
+
+		condition := meta.FindStatusCondition(cr.Status.Conditions,
+			string(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned))
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(string(provisioningv1alpha1.CRconditionReasons.NodePoolIdentityMismatch)))
+		Expect(condition.Message).To(Equal(fmt.Sprintf("NodePool cluster-1 in the namespace %s cannot be adopted, "+
+			"it was allocated with hwMgrId \"other-hwmgr\" (expected %q), site \"site-2\" (expected \"site-1\")",
+			poolns, utils.UnitTestHwmgrID)))
+		Expect(cr.Status.ProvisioningStatus.ProvisioningPhase).To(Equal(provisioningv1alpha1.StateFailed))
+	})
+
+	It("does not check a NodePool created for the request", func() {
+		existing.Labels = map[string]string{provisioningRequestNameLabel: crName}
+		existing.Spec.HwMgrId = "other-hwmgr"
+		Expect(c.Create(ctx, existing)).To(Succeed())
+
+		conflict, err := task.checkNodePoolAdoption(ctx, np)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(conflict).To(BeFalse())
+	})
+})
+
+var _ = Describe("checkDuplicateNodePoolReference", func() {
+	var (
+		ctx    context.Context
+		c      client.Client
+		task   *provisioningRequestReconcilerTask
+		cr     *provisioningv1alpha1.ProvisioningRequest
+		other  *provisioningv1alpha1.ProvisioningRequest
+		np     *hwv1alpha1.NodePool
+		crName = "cluster-1"
+		poolns = utils.UnitTestHwmgrNamespace
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		// Define the provisioning request.
+		cr = &provisioningv1alpha1.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: crName,
+			},
+		}
+
+		// Define another provisioning request for a different cluster.
+		other = &provisioningv1alpha1.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "cluster-2",
+			},
+			Status: provisioningv1alpha1.ProvisioningRequestStatus{
+				Extensions: provisioningv1alpha1.Extensions{
+					NodePoolRef: &provisioningv1alpha1.NodePoolRef{
+						Name:      "cluster-2",
+						Namespace: poolns,
+					},
+				},
+			},
+		}
+
+		// Define the rendered node pool.
+		np = &hwv1alpha1.NodePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      crName,
+				Namespace: poolns,
+				Labels:    map[string]string{provisioningRequestNameLabel: crName},
+			},
+		}
+
+		c = getFakeClientFromObjects([]client.Object{cr, other}...)
+		task = &provisioningRequestReconcilerTask{
+			logger: logger,
+			client: c,
+			object: cr,
+		}
+	})
+
+	verifyRejected := func() {
+		duplicate, err := task.checkDuplicateNodePoolReference(ctx, np)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(duplicate).To(BeTrue())
+
+		condition := meta.FindStatusCondition(cr.Status.Conditions,
+			string(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned))
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(string(provisioningv1alpha1.CRconditionReasons.DuplicateNARReference)))
+		Expect(condition.Message).To(ContainSubstring("is already referenced by ProvisioningRequest cluster-2"))
+		Expect(cr.Status.ProvisioningStatus.ProvisioningPhase).To(Equal(provisioningv1alpha1.StateFailed))
+	}
+
+	It("allows a NodePool that is not referenced by another request", func() {
+		duplicate, err := task.checkDuplicateNodePoolReference(ctx, np)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(duplicate).To(BeFalse())
+		Expect(meta.FindStatusCondition(cr.Status.Conditions,
+			string(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned))).To(BeNil())
+	})
+
+	It("rejects a NodePool already referenced by another request", func() {
+		other.Status.Extensions.NodePoolRef.Name = crName
+		Expect(c.Status().Update(ctx, other)).To(Succeed())
+
+		verifyRejected()
+	})
+
+	It("rejects a NodePool labeled for another request", func() {
+		existing := np.DeepCopy()
+		existing.Labels[provisioningRequestNameLabel] = other.Name
+		Expect(c.Create(ctx, existing)).To(Succeed())
+
+		verifyRejected()
+	})
+})
+
+var _ = Describe("checkPlacementConstraints", func() {
+	var (
+		ctx         context.Context
+		c           client.Client
+		task        *provisioningRequestReconcilerTask
+		cr          *provisioningv1alpha1.ProvisioningRequest
+		np          *hwv1alpha1.NodePool
+		crName      = "cluster-1"
+		poolns      = utils.UnitTestHwmgrNamespace
+		topologyKey = "topology.kubernetes.io/zone"
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		// Define the provisioning request.
+		cr = &provisioningv1alpha1.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: crName,
+			},
+		}
+
+		// Define the node pool requesting the master nodes to span three zones.
+		np = &hwv1alpha1.NodePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      crName,
+				Namespace: poolns,
+			},
+			Spec: hwv1alpha1.NodePoolSpec{
+				NodeGroup: []hwv1alpha1.NodeGroup{
+					{NodePoolData: hwv1alpha1.NodePoolData{Name: groupNameController, Role: "master"}, Size: 3},
+					{NodePoolData: hwv1alpha1.NodePoolData{Name: groupNameWorker, Role: "worker"}, Size: 1},
+				},
+				PlacementConstraints: []hwv1alpha1.PlacementConstraint{
+					{
+						TopologyKey:   topologyKey,
+						MinDomains:    3,
+						NodeGroupName: groupNameController,
+					},
+				},
+			},
+			Status: hwv1alpha1.NodePoolStatus{
+				Properties: hwv1alpha1.Properties{
+					NodeNames: []string{"master-node-1", "master-node-2", "master-node-3", "worker-node"},
+				},
+			},
+		}
+
+		c = getFakeClientFromObjects([]client.Object{cr}...)
+		task = &provisioningRequestReconcilerTask{
+			logger: logger,
+			client: c,
+			object: cr,
+		}
+	})
+
+	createZonedNodes := func(masterZones []string) {
+		for i, zone := range masterZones {
+			node := createNode(fmt.Sprintf("master-node-%d", i+1), "", "", groupNameController, poolns, crName, nil)
+			node.Labels = map[string]string{topologyKey: zone}
+			Expect(c.Create(ctx, node)).To(Succeed())
+		}
+		// The worker node is not subject to the constraint and carries no topology label
+		Expect(c.Create(ctx, createNode("worker-node", "", "", groupNameWorker, poolns, crName, nil))).To(Succeed())
+	}
+
+	It("returns true when the nodes are spread across the required domains", func() {
+		createZonedNodes([]string{"zone-a", "zone-b", "zone-c"})
+
+		satisfied, err := task.checkPlacementConstraints(ctx, np)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(satisfied).To(BeTrue())
+		Expect(meta.FindStatusCondition(cr.Status.Conditions,
+			string(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned))).To(BeNil())
+	})
+
+	It("fails the ProvisioningRequest when the nodes are not spread across the required domains", func() {
+		createZonedNodes([]string{"zone-a", "zone-b", "zone-a"})
+
+		satisfied, err := task.checkPlacementConstraints(ctx, np)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(satisfied).To(BeFalse())
+
+		condition := meta.FindStatusCondition(cr.Status.Conditions,
+			string(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned))
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(string(provisioningv1alpha1.CRconditionReasons.PlacementConstraintUnmet)))
+		Expect(condition.Message).To(ContainSubstring("span 2 topology.kubernetes.io/zone domain(s), at least 3 required"))
+		Expect(cr.Status.ProvisioningStatus.ProvisioningPhase).To(Equal(provisioningv1alpha1.StateFailed))
+	})
+
+	It("fails the ProvisioningRequest when a constraint references an unknown node group", func() {
+		createZonedNodes([]string{"zone-a", "zone-b", "zone-c"})
+		np.Spec.PlacementConstraints[0].NodeGroupName = "controler"
+
+		satisfied, err := task.checkPlacementConstraints(ctx, np)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(satisfied).To(BeFalse())
+
+		condition := meta.FindStatusCondition(cr.Status.Conditions,
+			string(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned))
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Message).To(ContainSubstring("the NodePool has no node group controler"))
+	})
+})
+
 // Helper function to transform interfaces into the required map[string]interface{} format
 func getInterfaceMap(interfaces []*hwv1alpha1.Interface) []map[string]interface{} {
 	var ifaceList []map[string]interface{}
@@ -936,3 +1378,203 @@ func VerifyHardwareTemplateStatus(ctx context.Context, c client.Client, template
 		Message: expectedCon.Message,
 	})
 }
+
+var _ = Describe("checkHardwarePluginAvailability", func() {
+	var (
+		ctx    context.Context
+		c      client.Client
+		task   *provisioningRequestReconcilerTask
+		cr     *provisioningv1alpha1.ProvisioningRequest
+		np     *hwv1alpha1.NodePool
+		crName = "cluster-1"
+	)
+
+	// setPluginValidation sets the Validation condition reported by the HardwareManager of the hardware plugin
+	setPluginValidation := func(status metav1.ConditionStatus, message string) {
+		hwmgr := &pluginv1alpha1.HardwareManager{}
+		Expect(c.Get(ctx, client.ObjectKey{Namespace: utils.GetHwMgrPluginNS(), Name: testHwMgrId}, hwmgr)).To(Succeed())
+		meta.SetStatusCondition(&hwmgr.Status.Conditions, metav1.Condition{
+			Type:    string(pluginv1alpha1.ConditionTypes.Validation),
+			Status:  status,
+			Reason:  string(pluginv1alpha1.ConditionReasons.Failed),
+			Message: message,
+		})
+		Expect(c.Status().Update(ctx, hwmgr)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		cr = &provisioningv1alpha1.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: crName,
+			},
+		}
+		np = &hwv1alpha1.NodePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      crName,
+				Namespace: utils.UnitTestHwmgrNamespace,
+			},
+			Spec: hwv1alpha1.NodePoolSpec{
+				HwMgrId: testHwMgrId,
+			},
+			Status: hwv1alpha1.NodePoolStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:   string(hwv1alpha1.Provisioned),
+						Status: metav1.ConditionFalse,
+						Reason: string(hwv1alpha1.InProgress),
+					},
+				},
+			},
+		}
+
+		c = getFakeClientFromObjects(cr, np)
+		task = &provisioningRequestReconcilerTask{
+			logger: logger,
+			client: c,
+			object: cr,
+			timeouts: &timeouts{
+				hardwareProvisioning: 15 * time.Minute,
+			},
+		}
+	})
+
+	It("considers a plugin that does not report its validation as available", func() {
+		available, err := task.checkHardwarePluginAvailability(ctx, np)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(available).To(BeTrue())
+		Expect(cr.Status.Conditions).To(BeEmpty())
+	})
+
+	It("holds the hardware provisioning while the plugin is unavailable and resumes it once it is back", func() {
+		// The hardware provisioning is in progress
+		provisioned, timedOutOrFailed, err := task.checkNodePoolStatus(ctx, np, hwv1alpha1.Provisioned)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(provisioned).To(BeFalse())
+		Expect(timedOutOrFailed).To(BeFalse())
+		checkStart := cr.Status.Extensions.NodePoolRef.HardwareProvisioningCheckStart.Time
+
+		// The plugin becomes unavailable, the phase is held
+		setPluginValidation(metav1.ConditionFalse, "failed to reach the hardware manager")
+		available, err := task.checkHardwarePluginAvailability(ctx, np)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(available).To(BeFalse())
+
+		condition := meta.FindStatusCondition(cr.Status.Conditions,
+			string(provisioningv1alpha1.PRconditionTypes.HardwarePluginUnavailable))
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(string(provisioningv1alpha1.CRconditionReasons.Unreachable)))
+		Expect(condition.Message).To(ContainSubstring("failed to reach the hardware manager"))
+		Expect(cr.Status.ProvisioningStatus.ProvisioningPhase).To(Equal(provisioningv1alpha1.StateProgressing))
+		provisionedCondition := meta.FindStatusCondition(cr.Status.Conditions,
+			string(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned))
+		Expect(provisionedCondition.Reason).To(Equal(string(hwv1alpha1.InProgress)))
+
+		// Checking again while the plugin is still unavailable does not change the status
+		available, err = task.checkHardwarePluginAvailability(ctx, np)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(available).To(BeFalse())
+		Expect(meta.FindStatusCondition(cr.Status.Conditions,
+			string(provisioningv1alpha1.PRconditionTypes.HardwarePluginUnavailable))).To(Equal(condition))
+
+		// Simulate an outage of 10 minutes, 20 minutes after the hardware provisioning started
+		condition.LastTransitionTime = metav1.NewTime(time.Now().Add(-10 * time.Minute))
+		cr.Status.Extensions.NodePoolRef.HardwareProvisioningCheckStart = &metav1.Time{
+			Time: checkStart.Add(-20 * time.Minute),
+		}
+
+		// The plugin is back, the hardware provisioning resumes without counting the outage against the timeout
+		setPluginValidation(metav1.ConditionTrue, "")
+		available, err = task.checkHardwarePluginAvailability(ctx, np)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(available).To(BeTrue())
+		Expect(meta.FindStatusCondition(cr.Status.Conditions,
+			string(provisioningv1alpha1.PRconditionTypes.HardwarePluginUnavailable))).To(BeNil())
+		Expect(cr.Status.Extensions.NodePoolRef.HardwareProvisioningCheckStart.Time).To(
+			BeTemporally("~", checkStart.Add(-10*time.Minute), time.Second))
+
+		provisioned, timedOutOrFailed, err = task.checkNodePoolStatus(ctx, np, hwv1alpha1.Provisioned)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(provisioned).To(BeFalse())
+		Expect(timedOutOrFailed).To(BeFalse())
+		Expect(cr.Status.ProvisioningStatus.ProvisioningPhase).To(Equal(provisioningv1alpha1.StateProgressing))
+	})
+
+	It("stops holding the hardware provisioning after the hardware provisioning timeout", func() {
+		provisioned, timedOutOrFailed, err := task.checkNodePoolStatus(ctx, np, hwv1alpha1.Provisioned)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(provisioned).To(BeFalse())
+		Expect(timedOutOrFailed).To(BeFalse())
+
+		setPluginValidation(metav1.ConditionFalse, "invalid hardware manager credentials")
+		available, err := task.checkHardwarePluginAvailability(ctx, np)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(available).To(BeFalse())
+
+		// Simulate an outage longer than the hardware provisioning timeout, which started when the provisioning did
+		condition := meta.FindStatusCondition(cr.Status.Conditions,
+			string(provisioningv1alpha1.PRconditionTypes.HardwarePluginUnavailable))
+		condition.LastTransitionTime = metav1.NewTime(time.Now().Add(-20 * time.Minute))
+		cr.Status.Extensions.NodePoolRef.HardwareProvisioningCheckStart = &metav1.Time{
+			Time: time.Now().Add(-20 * time.Minute),
+		}
+
+		// The provisioning is no longer held, so that the timeout applies
+		available, err = task.checkHardwarePluginAvailability(ctx, np)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(available).To(BeTrue())
+
+		provisioned, timedOutOrFailed, err = task.checkNodePoolStatus(ctx, np, hwv1alpha1.Provisioned)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(provisioned).To(BeFalse())
+		Expect(timedOutOrFailed).To(BeTrue())
+	})
+
+	It("extends the hardware timeouts by the hardware provisioning timeout at most", func() {
+		provisioned, timedOutOrFailed, err := task.checkNodePoolStatus(ctx, np, hwv1alpha1.Provisioned)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(provisioned).To(BeFalse())
+		Expect(timedOutOrFailed).To(BeFalse())
+		checkStart := cr.Status.Extensions.NodePoolRef.HardwareProvisioningCheckStart.Time
+
+		setPluginValidation(metav1.ConditionFalse, "failed to reach the hardware manager")
+		available, err := task.checkHardwarePluginAvailability(ctx, np)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(available).To(BeFalse())
+
+		// Simulate an outage of an hour
+		condition := meta.FindStatusCondition(cr.Status.Conditions,
+			string(provisioningv1alpha1.PRconditionTypes.HardwarePluginUnavailable))
+		condition.LastTransitionTime = metav1.NewTime(time.Now().Add(-time.Hour))
+		cr.Status.Extensions.NodePoolRef.HardwareProvisioningCheckStart = &metav1.Time{
+			Time: checkStart.Add(-time.Hour),
+		}
+
+		setPluginValidation(metav1.ConditionTrue, "")
+		available, err = task.checkHardwarePluginAvailability(ctx, np)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(available).To(BeTrue())
+		Expect(cr.Status.Extensions.NodePoolRef.HardwareProvisioningCheckStart.Time).To(
+			BeTemporally("~", checkStart.Add(-45*time.Minute), time.Second))
+	})
+
+	It("does not hold the hardware provisioning once it has completed", func() {
+		setPluginValidation(metav1.ConditionFalse, "failed to reach the hardware manager")
+		Expect(task.isHardwareProvisioningCompleted()).To(BeFalse())
+
+		utils.SetStatusCondition(&cr.Status.Conditions,
+			provisioningv1alpha1.PRconditionTypes.HardwareProvisioned,
+			provisioningv1alpha1.CRconditionReasons.Completed,
+			metav1.ConditionTrue,
+			"Hardware provisioning is completed")
+		Expect(task.isHardwareProvisioningCompleted()).To(BeTrue())
+
+		utils.SetStatusCondition(&cr.Status.Conditions,
+			provisioningv1alpha1.PRconditionTypes.HardwareConfigured,
+			provisioningv1alpha1.CRconditionReasons.InProgress,
+			metav1.ConditionFalse,
+			"Hardware configuring is in progress")
+		Expect(task.isHardwareProvisioningCompleted()).To(BeFalse())
+	})
+})
@@ -0,0 +1,123 @@
+package controllers
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+)
+
+// The provisioning phases reported in the phase metrics
+const (
+	provisioningMetricsPhaseHardware       = "hardware"
+	provisioningMetricsPhaseClusterInstall = "cluster-install"
+	provisioningMetricsPhaseConfiguration  = "configuration"
+)
+
+// provisioningPhaseDurationHistogram exposes how long the ProvisioningRequests spent in each provisioning phase
+// before completing it, so that slow provisioning can be detected before it times out.
+var provisioningPhaseDurationHistogram = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Namespace: "o2ims",
+		Name:      "provisioning_phase_duration_seconds",
+		Help:      "Time spent by the ProvisioningRequests in each provisioning phase until it completed",
+		// From one minute to about eight and a half hours
+		Buckets: prometheus.ExponentialBuckets(60, 2, 10),
+	},
+	[]string{"phase"},
+)
+
+// provisioningPhaseTimeoutsCounter exposes the number of ProvisioningRequests that failed because a provisioning
+// phase timed out.
+var provisioningPhaseTimeoutsCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "o2ims",
+		Name:      "provisioning_phase_timeouts_total",
+		Help:      "Number of provisioning phases of the ProvisioningRequests that timed out",
+	},
+	[]string{"phase"},
+)
+
+var registerProvisioningMetricsOnce sync.Once
+
+// registerProvisioningMetrics registers the phase metrics with the controller-runtime registry. It can be called
+// several times, the metrics are only registered once.
+func registerProvisioningMetrics() {
+	registerProvisioningMetricsOnce.Do(func() {
+		metrics.Registry.MustRegister(provisioningPhaseDurationHistogram, provisioningPhaseTimeoutsCounter)
+	})
+}
+
+// phaseStart returns the start of a provisioning phase recorded before the reconciliation, or the one recorded by
+// the reconciliation if the phase started during it. The start of some phases is cleared once they complete.
+func phaseStart(previous, current *metav1.Time) *metav1.Time {
+	if previous != nil && !previous.IsZero() {
+		return previous
+	}
+	return current
+}
+
+// observeProvisioningPhases updates the phase metrics from the transitions of the phase conditions between the
+// status of a ProvisioningRequest before a reconciliation and its status persisted by that reconciliation.
+func observeProvisioningPhases(previous *provisioningv1alpha1.ProvisioningRequestStatus,
+	object *provisioningv1alpha1.ProvisioningRequest) {
+	findCondition := func(status *provisioningv1alpha1.ProvisioningRequestStatus,
+		conditionType provisioningv1alpha1.ConditionType) *metav1.Condition {
+		return meta.FindStatusCondition(status.Conditions, string(conditionType))
+	}
+
+	var previousHardwareStart, currentHardwareStart *metav1.Time
+	if nodePoolRef := previous.Extensions.NodePoolRef; nodePoolRef != nil {
+		previousHardwareStart = nodePoolRef.HardwareProvisioningCheckStart
+	}
+	if nodePoolRef := object.Status.Extensions.NodePoolRef; nodePoolRef != nil {
+		currentHardwareStart = nodePoolRef.HardwareProvisioningCheckStart
+	}
+	observeProvisioningPhase(provisioningMetricsPhaseHardware,
+		findCondition(previous, provisioningv1alpha1.PRconditionTypes.HardwareProvisioned),
+		findCondition(&object.Status, provisioningv1alpha1.PRconditionTypes.HardwareProvisioned),
+		phaseStart(previousHardwareStart, currentHardwareStart))
+
+	var previousDetails, currentDetails provisioningv1alpha1.ClusterDetails
+	if previous.Extensions.ClusterDetails != nil {
+		previousDetails = *previous.Extensions.ClusterDetails
+	}
+	if object.Status.Extensions.ClusterDetails != nil {
+		currentDetails = *object.Status.Extensions.ClusterDetails
+	}
+	observeProvisioningPhase(provisioningMetricsPhaseClusterInstall,
+		findCondition(previous, provisioningv1alpha1.PRconditionTypes.ClusterProvisioned),
+		findCondition(&object.Status, provisioningv1alpha1.PRconditionTypes.ClusterProvisioned),
+		phaseStart(previousDetails.ClusterProvisionStartedAt, currentDetails.ClusterProvisionStartedAt))
+	observeProvisioningPhase(provisioningMetricsPhaseConfiguration,
+		findCondition(previous, provisioningv1alpha1.PRconditionTypes.ConfigurationApplied),
+		findCondition(&object.Status, provisioningv1alpha1.PRconditionTypes.ConfigurationApplied),
+		phaseStart(previousDetails.NonCompliantAt, currentDetails.NonCompliantAt))
+}
+
+// observeProvisioningPhase updates the phase metrics when the condition of a provisioning phase transitions from
+// its previous state. The time since the start of the phase is recorded when the condition becomes true, and a
+// timeout is counted when the condition becomes timed out.
+func observeProvisioningPhase(phase string, previous, current *metav1.Condition, start *metav1.Time) {
+	if current == nil {
+		return
+	}
+	if previous != nil && previous.Status == current.Status && previous.Reason == current.Reason {
+		// No transition
+		return
+	}
+
+	switch {
+	case current.Status == metav1.ConditionTrue:
+		if start != nil && !start.IsZero() {
+			provisioningPhaseDurationHistogram.WithLabelValues(phase).Observe(time.Since(start.Time).Seconds())
+		}
+	case current.Reason == string(provisioningv1alpha1.CRconditionReasons.TimedOut):
+		provisioningPhaseTimeoutsCounter.WithLabelValues(phase).Inc()
+	}
+}
@@ -0,0 +1,156 @@
+package controllers
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+)
+
+var _ = Describe("observeProvisioningPhase", func() {
+	const testPhase = "test-phase"
+
+	// getPhaseMetrics returns the number of durations observed and the number of timeouts counted for the
+	// test phase
+	getPhaseMetrics := func() (durations, timeouts uint64) {
+		families, err := metrics.Registry.Gather()
+		Expect(err).ToNot(HaveOccurred())
+		for _, family := range families {
+			for _, metric := range family.GetMetric() {
+				if len(metric.GetLabel()) != 1 || metric.GetLabel()[0].GetValue() != testPhase {
+					continue
+				}
+				switch family.GetName() {
+				case "o2ims_provisioning_phase_duration_seconds":
+					durations = metric.GetHistogram().GetSampleCount()
+				case "o2ims_provisioning_phase_timeouts_total":
+					timeouts = uint64(metric.GetCounter().GetValue())
+				}
+			}
+		}
+		return
+	}
+
+	newCondition := func(status metav1.ConditionStatus, reason provisioningv1alpha1.ConditionReason) *metav1.Condition {
+		return &metav1.Condition{
+			Type:   string(provisioningv1alpha1.PRconditionTypes.ClusterProvisioned),
+			Status: status,
+			Reason: string(reason),
+		}
+	}
+
+	var start *metav1.Time
+
+	BeforeEach(func() {
+		registerProvisioningMetrics()
+		start = &metav1.Time{Time: time.Now().Add(-10 * time.Minute)}
+	})
+
+	It("records the duration when the phase completes", func() {
+		durations, timeouts := getPhaseMetrics()
+
+		observeProvisioningPhase(testPhase,
+			newCondition(metav1.ConditionFalse, provisioningv1alpha1.CRconditionReasons.InProgress),
+			newCondition(metav1.ConditionTrue, provisioningv1alpha1.CRconditionReasons.Completed),
+			start)
+
+		newDurations, newTimeouts := getPhaseMetrics()
+		Expect(newDurations).To(Equal(durations + 1))
+		Expect(newTimeouts).To(Equal(timeouts))
+	})
+
+	It("counts the timeout when the phase times out", func() {
+		durations, timeouts := getPhaseMetrics()
+
+		observeProvisioningPhase(testPhase,
+			newCondition(metav1.ConditionFalse, provisioningv1alpha1.CRconditionReasons.InProgress),
+			newCondition(metav1.ConditionFalse, provisioningv1alpha1.CRconditionReasons.TimedOut),
+			start)
+
+		newDurations, newTimeouts := getPhaseMetrics()
+		Expect(newDurations).To(Equal(durations))
+		Expect(newTimeouts).To(Equal(timeouts + 1))
+	})
+
+	It("does not record anything without a transition", func() {
+		durations, timeouts := getPhaseMetrics()
+
+		observeProvisioningPhase(testPhase,
+			newCondition(metav1.ConditionTrue, provisioningv1alpha1.CRconditionReasons.Completed),
+			newCondition(metav1.ConditionTrue, provisioningv1alpha1.CRconditionReasons.Completed),
+			start)
+		observeProvisioningPhase(testPhase,
+			newCondition(metav1.ConditionFalse, provisioningv1alpha1.CRconditionReasons.TimedOut),
+			newCondition(metav1.ConditionFalse, provisioningv1alpha1.CRconditionReasons.TimedOut),
+			start)
+		observeProvisioningPhase(testPhase,
+			newCondition(metav1.ConditionFalse, provisioningv1alpha1.CRconditionReasons.InProgress),
+			newCondition(metav1.ConditionFalse, provisioningv1alpha1.CRconditionReasons.InProgress),
+			start)
+
+		newDurations, newTimeouts := getPhaseMetrics()
+		Expect(newDurations).To(Equal(durations))
+		Expect(newTimeouts).To(Equal(timeouts))
+	})
+
+	It("does not record the duration when the start of the phase is unknown", func() {
+		durations, _ := getPhaseMetrics()
+
+		observeProvisioningPhase(testPhase, nil,
+			newCondition(metav1.ConditionTrue, provisioningv1alpha1.CRconditionReasons.Completed),
+			nil)
+
+		newDurations, _ := getPhaseMetrics()
+		Expect(newDurations).To(Equal(durations))
+	})
+
+	It("observes the transitions between the previous and the persisted status", func() {
+		previous := &provisioningv1alpha1.ProvisioningRequestStatus{
+			Conditions: []metav1.Condition{
+				*newCondition(metav1.ConditionTrue, provisioningv1alpha1.CRconditionReasons.Completed),
+				{
+					Type:   string(provisioningv1alpha1.PRconditionTypes.ConfigurationApplied),
+					Status: metav1.ConditionFalse,
+					Reason: string(provisioningv1alpha1.CRconditionReasons.InProgress),
+				},
+			},
+			Extensions: provisioningv1alpha1.Extensions{
+				ClusterDetails: &provisioningv1alpha1.ClusterDetails{NonCompliantAt: start},
+			},
+		}
+		object := &provisioningv1alpha1.ProvisioningRequest{}
+		object.Status = *previous.DeepCopy()
+		// The start of the configuration is cleared once the policies are compliant
+		object.Status.Extensions.ClusterDetails.NonCompliantAt = nil
+		object.Status.Conditions[1].Status = metav1.ConditionTrue
+		object.Status.Conditions[1].Reason = string(provisioningv1alpha1.CRconditionReasons.Completed)
+
+		getConfigurationDurations := func() uint64 {
+			families, err := metrics.Registry.Gather()
+			Expect(err).ToNot(HaveOccurred())
+			for _, family := range families {
+				if family.GetName() != "o2ims_provisioning_phase_duration_seconds" {
+					continue
+				}
+				for _, metric := range family.GetMetric() {
+					if metric.GetLabel()[0].GetValue() == provisioningMetricsPhaseConfiguration {
+						return metric.GetHistogram().GetSampleCount()
+					}
+				}
+			}
+			return 0
+		}
+		durations := getConfigurationDurations()
+
+		observeProvisioningPhases(previous, object)
+		Expect(getConfigurationDurations()).To(Equal(durations + 1))
+
+		// The same persisted status is not observed twice
+		observeProvisioningPhases(&object.Status, object)
+		Expect(getConfigurationDurations()).To(Equal(durations + 1))
+	})
+})
@@ -0,0 +1,172 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+)
+
+// Default values of the provisioning notifier configuration
+const (
+	defaultProvisioningNotificationAttempts      = 5
+	defaultProvisioningNotificationRetryInterval = 5 * time.Second
+	defaultProvisioningNotificationQueueSize     = 100
+	provisioningNotificationTimeout              = 10 * time.Second
+)
+
+// ProvisioningNotification is the payload posted to the notification endpoint when a ProvisioningRequest reaches a
+// terminal phase. The text field makes the payload usable as is by Slack compatible incoming webhooks.
+type ProvisioningNotification struct {
+	Text            string `json:"text"`
+	Name            string `json:"name"`
+	UID             string `json:"uid"`
+	TemplateName    string `json:"templateName"`
+	TemplateVersion string `json:"templateVersion"`
+	Phase           string `json:"phase"`
+	Details         string `json:"details"`
+	Time            string `json:"time"`
+}
+
+// ProvisioningNotifierConfig defines the behavior of the provisioning notifier
+type ProvisioningNotifierConfig struct {
+	// URL is the endpoint the notifications are posted to.
+	URL string
+	// MaxAttempts is the number of times the delivery of a notification is attempted before it is dropped.
+	MaxAttempts int
+	// RetryInterval is the delay before the first retry. It is doubled on every subsequent retry.
+	RetryInterval time.Duration
+	// QueueSize is the number of notifications that can be waiting for delivery. Notifications are dropped when
+	// the queue is full so that the reconciliation is never blocked.
+	QueueSize int
+}
+
+// ProvisioningNotifier delivers the provisioning completion notifications in the background. It implements the
+// manager.Runnable interface so that it is started and stopped along with the controllers.
+type ProvisioningNotifier struct {
+	config ProvisioningNotifierConfig
+	logger *slog.Logger
+	client *http.Client
+	queue  chan ProvisioningNotification
+}
+
+// NewProvisioningNotifier creates a new notifier. Unset configuration values are replaced with their defaults.
+func NewProvisioningNotifier(config ProvisioningNotifierConfig, logger *slog.Logger) *ProvisioningNotifier {
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaultProvisioningNotificationAttempts
+	}
+	if config.RetryInterval <= 0 {
+		config.RetryInterval = defaultProvisioningNotificationRetryInterval
+	}
+	if config.QueueSize <= 0 {
+		config.QueueSize = defaultProvisioningNotificationQueueSize
+	}
+	return &ProvisioningNotifier{
+		config: config,
+		logger: logger,
+		client: &http.Client{Timeout: provisioningNotificationTimeout},
+		queue:  make(chan ProvisioningNotification, config.QueueSize),
+	}
+}
+
+// newProvisioningNotification builds the notification for the current state of a ProvisioningRequest
+func newProvisioningNotification(object *provisioningv1alpha1.ProvisioningRequest) ProvisioningNotification {
+	status := object.Status.ProvisioningStatus
+	return ProvisioningNotification{
+		Text: fmt.Sprintf("ProvisioningRequest %s (%s) is %s: %s", object.Name,
+			getClusterTemplateRefName(object.Spec.TemplateName, object.Spec.TemplateVersion),
+			status.ProvisioningPhase, status.ProvisioningDetails),
+		Name:            object.Name,
+		UID:             string(object.UID),
+		TemplateName:    object.Spec.TemplateName,
+		TemplateVersion: object.Spec.TemplateVersion,
+		Phase:           string(status.ProvisioningPhase),
+		Details:         status.ProvisioningDetails,
+		Time:            status.UpdateTime.UTC().Format(time.RFC3339),
+	}
+}
+
+// NotifyTransition queues a notification if the ProvisioningRequest has moved into a terminal phase. It never blocks.
+func (n *ProvisioningNotifier) NotifyTransition(previous provisioningv1alpha1.ProvisioningPhase,
+	object *provisioningv1alpha1.ProvisioningRequest) {
+	phase := object.Status.ProvisioningStatus.ProvisioningPhase
+	if phase == previous ||
+		(phase != provisioningv1alpha1.StateFulfilled && phase != provisioningv1alpha1.StateFailed) {
+		return
+	}
+
+	select {
+	case n.queue <- newProvisioningNotification(object):
+	default:
+		n.logger.Warn("Dropping provisioning notification, the queue is full",
+			slog.String("name", object.Name), slog.String("phase", string(phase)))
+	}
+}
+
+// Start delivers the queued notifications until the context is cancelled
+func (n *ProvisioningNotifier) Start(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case notification := <-n.queue:
+			n.deliver(ctx, notification)
+		}
+	}
+}
+
+// deliver posts a notification, retrying with an exponential backoff until it is accepted or the attempts run out
+func (n *ProvisioningNotifier) deliver(ctx context.Context, notification ProvisioningNotification) {
+	interval := n.config.RetryInterval
+	for attempt := 1; ; attempt++ {
+		err := n.send(ctx, notification)
+		if err == nil {
+			n.logger.Debug("Delivered provisioning notification",
+				slog.String("name", notification.Name), slog.String("phase", notification.Phase))
+			return
+		}
+		if attempt >= n.config.MaxAttempts {
+			n.logger.Error("Failed to deliver provisioning notification",
+				slog.String("name", notification.Name), slog.Int("attempts", attempt),
+				slog.String("error", err.Error()))
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+		interval *= 2
+	}
+}
+
+// send posts a notification once
+func (n *ProvisioningNotifier) send(ctx context.Context, notification ProvisioningNotification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, n.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := n.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to post notification: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("notification rejected with status code %d", response.StatusCode)
+	}
+	return nil
+}
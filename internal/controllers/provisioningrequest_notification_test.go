@@ -0,0 +1,126 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+	"github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
+)
+
+var _ = Describe("ProvisioningNotifier", func() {
+	var (
+		ctx      context.Context
+		cancel   context.CancelFunc
+		server   *httptest.Server
+		lock     sync.Mutex
+		received []ProvisioningNotification
+		failures int
+		notifier *ProvisioningNotifier
+	)
+
+	notifications := func() []ProvisioningNotification {
+		lock.Lock()
+		defer lock.Unlock()
+		return append([]ProvisioningNotification{}, received...)
+	}
+
+	newProvisioningRequest := func() *provisioningv1alpha1.ProvisioningRequest {
+		return &provisioningv1alpha1.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "cluster-1",
+				UID:  "0cbf9d3d-c2c2-4d8c-9f1f-0b7a2d1c1f3e",
+			},
+			Spec: provisioningv1alpha1.ProvisioningRequestSpec{
+				TemplateName:    "sno",
+				TemplateVersion: "v1",
+			},
+			Status: provisioningv1alpha1.ProvisioningRequestStatus{
+				ProvisioningStatus: provisioningv1alpha1.ProvisioningStatus{
+					ProvisioningPhase: provisioningv1alpha1.StateProgressing,
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		received = nil
+		failures = 0
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			lock.Lock()
+			defer lock.Unlock()
+			if failures > 0 {
+				failures--
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			notification := ProvisioningNotification{}
+			Expect(json.NewDecoder(r.Body).Decode(&notification)).To(Succeed())
+			received = append(received, notification)
+		}))
+		notifier = NewProvisioningNotifier(ProvisioningNotifierConfig{
+			URL:           server.URL,
+			MaxAttempts:   3,
+			RetryInterval: time.Millisecond,
+		}, logger)
+		go func() {
+			defer GinkgoRecover()
+			Expect(notifier.Start(ctx)).To(Succeed())
+		}()
+	})
+
+	AfterEach(func() {
+		cancel()
+		server.Close()
+	})
+
+	It("notifies the fulfillment of a ProvisioningRequest", func() {
+		pr := newProvisioningRequest()
+		utils.SetProvisioningStateFulfilled(pr)
+		notifier.NotifyTransition(provisioningv1alpha1.StateProgressing, pr)
+
+		Eventually(notifications).Should(HaveLen(1))
+		notification := notifications()[0]
+		Expect(notification.Name).To(Equal("cluster-1"))
+		Expect(notification.UID).To(Equal("0cbf9d3d-c2c2-4d8c-9f1f-0b7a2d1c1f3e"))
+		Expect(notification.TemplateName).To(Equal("sno"))
+		Expect(notification.TemplateVersion).To(Equal("v1"))
+		Expect(notification.Phase).To(Equal(string(provisioningv1alpha1.StateFulfilled)))
+		Expect(notification.Details).To(Equal("Provisioning request has completed successfully"))
+		Expect(notification.Text).To(Equal(
+			"ProvisioningRequest cluster-1 (sno.v1) is fulfilled: Provisioning request has completed successfully"))
+	})
+
+	It("retries the delivery of a failure notification", func() {
+		lock.Lock()
+		failures = 2
+		lock.Unlock()
+
+		pr := newProvisioningRequest()
+		utils.SetProvisioningStateFailed(pr, "Cluster installation failed")
+		notifier.NotifyTransition(provisioningv1alpha1.StateProgressing, pr)
+
+		Eventually(notifications).Should(HaveLen(1))
+		Expect(notifications()[0].Phase).To(Equal(string(provisioningv1alpha1.StateFailed)))
+		Expect(notifications()[0].Details).To(Equal("Cluster installation failed"))
+	})
+
+	It("does not notify non-terminal or unchanged phases", func() {
+		pr := newProvisioningRequest()
+		notifier.NotifyTransition("", pr)
+
+		utils.SetProvisioningStateFulfilled(pr)
+		notifier.NotifyTransition(provisioningv1alpha1.StateFulfilled, pr)
+
+		Consistently(notifications, 100*time.Millisecond).Should(BeEmpty())
+	})
+})
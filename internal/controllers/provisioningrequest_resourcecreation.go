@@ -3,11 +3,15 @@ package controllers
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
 	"github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
@@ -18,10 +22,16 @@ import (
 func (t *provisioningRequestReconcilerTask) createOrUpdateClusterResources(
 	ctx context.Context, clusterInstance *siteconfig.ClusterInstance) error {
 
+	if err := t.checkNotDryRun("create the cluster resources"); err != nil {
+		return err
+	}
 	clusterName := clusterInstance.GetName()
 
 	// Create BMC secret if no hardware provisioning
 	if t.isHardwareProvisionSkipped() {
+		if err := t.checkResourceBarrier(ctx, provisioningv1alpha1.ClusterResourceBMCSecrets, clusterName); err != nil {
+			return err
+		}
 		err := t.createClusterInstanceBMCSecrets(ctx, clusterName)
 		if err != nil {
 			return err
@@ -30,6 +40,9 @@ func (t *provisioningRequestReconcilerTask) createOrUpdateClusterResources(
 
 	// Copy the pull secret from the cluster template namespace to the
 	// clusterInstance namespace.
+	if err := t.checkResourceBarrier(ctx, provisioningv1alpha1.ClusterResourcePullSecret, clusterName); err != nil {
+		return err
+	}
 	err := t.createPullSecret(ctx, clusterInstance)
 	if err != nil {
 		return fmt.Errorf("failed to create pull Secret for cluster %s: %w", clusterName, err)
@@ -37,12 +50,19 @@ func (t *provisioningRequestReconcilerTask) createOrUpdateClusterResources(
 
 	// Copy the extra-manifests ConfigMaps from the cluster template namespace
 	// to the clusterInstance namespace.
+	if err = t.checkResourceBarrier(ctx, provisioningv1alpha1.ClusterResourceExtraManifests, clusterName); err != nil {
+		return err
+	}
 	err = t.createExtraManifestsConfigMap(ctx, clusterInstance)
 	if err != nil {
 		return fmt.Errorf("failed to create extraManifests ConfigMap for cluster %s: %w", clusterName, err)
 	}
 
 	// Create the cluster ConfigMap which will be used by ACM policies.
+	if err = t.checkResourceBarrier(
+		ctx, provisioningv1alpha1.ClusterResourcePolicyTemplateConfigMap, clusterName); err != nil {
+		return err
+	}
 	err = t.createPoliciesConfigMap(ctx, clusterInstance)
 	if err != nil {
 		return fmt.Errorf("failed to create policy template ConfigMap for cluster %s: %w", clusterName, err)
@@ -51,6 +71,79 @@ func (t *provisioningRequestReconcilerTask) createOrUpdateClusterResources(
 	return nil
 }
 
+// resourceBarrierError reports a cluster resource held back by a barrier of the ClusterTemplate
+// because one of its prerequisites does not exist yet.
+type resourceBarrierError struct {
+	resource     provisioningv1alpha1.ClusterResource
+	prerequisite provisioningv1alpha1.ResourcePrerequisite
+}
+
+func (e *resourceBarrierError) Error() string {
+	namespacedName := e.prerequisite.Name
+	if e.prerequisite.Namespace != "" {
+		namespacedName = e.prerequisite.Namespace + "/" + namespacedName
+	}
+	return fmt.Sprintf("%s is waiting for %s %s (%s) to exist",
+		e.resource, e.prerequisite.Kind, namespacedName, e.prerequisite.APIVersion)
+}
+
+// isResourceBarrierError checks whether the error reports a cluster resource held back by a barrier
+func isResourceBarrierError(err error) bool {
+	var barrierErr *resourceBarrierError
+	return errors.As(err, &barrierErr)
+}
+
+// checkResourceBarrier checks that all the prerequisites of the barriers defined by the ClusterTemplate for
+// the given cluster resource exist. A resourceBarrierError is returned for the first missing prerequisite,
+// including one whose kind is not served yet. The namespace of a namespaced prerequisite defaults to the
+// namespace of the cluster, while it is ignored for a cluster-scoped one.
+func (t *provisioningRequestReconcilerTask) checkResourceBarrier(
+	ctx context.Context, resource provisioningv1alpha1.ClusterResource, clusterName string) error {
+
+	for _, barrier := range t.ctDetails.resourceBarriers {
+		if barrier.Resource != resource {
+			continue
+		}
+
+		for _, prerequisite := range barrier.Prerequisites {
+			object := &unstructured.Unstructured{}
+			object.SetAPIVersion(prerequisite.APIVersion)
+			object.SetKind(prerequisite.Kind)
+
+			namespaced, err := t.client.IsObjectNamespaced(object)
+			if meta.IsNoMatchError(err) {
+				return &resourceBarrierError{resource: resource, prerequisite: prerequisite}
+			}
+			if err != nil {
+				return fmt.Errorf("failed to get the scope of %s (%s): %w",
+					prerequisite.Kind, prerequisite.APIVersion, err)
+			}
+			if !namespaced {
+				prerequisite.Namespace = ""
+			} else if prerequisite.Namespace == "" {
+				prerequisite.Namespace = clusterName
+			}
+
+			exists, err := utils.DoesK8SResourceExist(
+				ctx, t.client, prerequisite.Name, prerequisite.Namespace, object)
+			if apierrors.IsForbidden(err) {
+				return utils.NewInputError(
+					"the operator is not allowed to get the %s prerequisite %s (%s) of %s, "+
+						"the get permission must be granted to its service account",
+					prerequisite.Kind, prerequisite.Name, prerequisite.APIVersion, resource)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to check if %s %s exists: %w", prerequisite.Kind, prerequisite.Name, err)
+			}
+			if !exists {
+				return &resourceBarrierError{resource: resource, prerequisite: prerequisite}
+			}
+		}
+	}
+
+	return nil
+}
+
 // createPullSecret copies the pull secret from the cluster template namespace
 // to the clusterInstance namespace
 func (t *provisioningRequestReconcilerTask) createPullSecret(
@@ -85,6 +178,7 @@ func (t *provisioningRequestReconcilerTask) createPullSecret(
 	if err := utils.CreateK8sCR(ctx, t.client, newClusterInstancePullSecret, t.object, utils.UPDATE); err != nil {
 		return fmt.Errorf("failed to create Kubernetes CR for ClusterInstancePullSecret: %w", err)
 	}
+	t.trackAppliedManifest(newClusterInstancePullSecret)
 
 	return nil
 }
@@ -119,6 +213,7 @@ func (t *provisioningRequestReconcilerTask) createExtraManifestsConfigMap(
 		if err := utils.CreateK8sCR(ctx, t.client, newExtraManifestsConfigMap, t.object, utils.UPDATE); err != nil {
 			return fmt.Errorf("failed to create extra-manifests ConfigMap: %w", err)
 		}
+		t.trackAppliedManifest(newExtraManifestsConfigMap)
 	}
 
 	return nil
@@ -128,6 +223,9 @@ func (t *provisioningRequestReconcilerTask) createExtraManifestsConfigMap(
 // where all the other resources needed for installation will exist.
 func (t *provisioningRequestReconcilerTask) createClusterInstanceNamespace(
 	ctx context.Context, clusterName string) error {
+	if err := t.checkNotDryRun("create the cluster namespace"); err != nil {
+		return err
+	}
 
 	// Create the namespace.
 	namespace := &corev1.Namespace{
@@ -137,14 +235,13 @@ func (t *provisioningRequestReconcilerTask) createClusterInstanceNamespace(
 	}
 
 	// Add ProvisioningRequest labels to the namespace
-	labels := make(map[string]string)
-	labels[provisioningRequestNameLabel] = t.object.Name
-	namespace.SetLabels(labels)
+	namespace.SetLabels(t.provenanceLabels())
 
 	err := utils.CreateK8sCR(ctx, t.client, namespace, t.object, "")
 	if err != nil {
 		return fmt.Errorf("failed to create or update namespace %s: %w", clusterName, err)
 	}
+	t.trackAppliedManifest(namespace)
 
 	if namespace.Status.Phase == corev1.NamespaceTerminating {
 		return utils.NewInputError("the namespace %s is terminating", clusterName)
@@ -205,6 +302,7 @@ func (t *provisioningRequestReconcilerTask) createPolicyTemplateConfigMap(
 	if err := utils.CreateK8sCR(ctx, t.client, policyTemplateConfigMap, t.object, utils.UPDATE); err != nil {
 		return fmt.Errorf("failed to create Kubernetes CR: %w", err)
 	}
+	t.trackAppliedManifest(policyTemplateConfigMap)
 
 	return nil
 }
@@ -280,6 +378,7 @@ func (t *provisioningRequestReconcilerTask) createClusterInstanceBMCSecrets(
 		if err = utils.CreateK8sCR(ctx, t.client, bmcSecret, nil, utils.UPDATE); err != nil {
 			return fmt.Errorf("failed to create BMC secret: %w", err)
 		}
+		t.trackAppliedManifest(bmcSecret)
 	}
 
 	return nil
@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
 	"github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
@@ -397,4 +398,118 @@ var _ = Describe("createOrUpdateClusterResources", func() {
 		Expect(err).To(HaveOccurred())
 		Expect(errors.IsNotFound(err)).To(BeTrue())
 	})
+
+	It("Holds back a resource until the prerequisite of its barrier exists", func() {
+		renderedClusterInstance := &siteconfig.ClusterInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      crName,
+				Namespace: crName,
+			},
+			Spec: siteconfig.ClusterInstanceSpec{
+				PullSecretRef: corev1.LocalObjectReference{Name: "pull-secret"},
+			},
+		}
+
+		task.ctDetails.templates.HwTemplate = "hwTemplate-v1"
+		task.ctDetails.resourceBarriers = []provisioningv1alpha1.ResourceBarrier{
+			{
+				Resource: provisioningv1alpha1.ClusterResourcePullSecret,
+				Prerequisites: []provisioningv1alpha1.ResourcePrerequisite{
+					{APIVersion: "v1", Kind: "ConfigMap", Name: "registry-ca"},
+				},
+			},
+		}
+
+		// The pull secret is not created while the prerequisite is missing.
+		err := task.createOrUpdateClusterResources(ctx, renderedClusterInstance)
+		Expect(isResourceBarrierError(err)).To(BeTrue())
+		Expect(err).To(MatchError("pullSecret is waiting for ConfigMap cluster-1/registry-ca (v1) to exist"))
+
+		pullSecret := &corev1.Secret{}
+		err = c.Get(ctx, types.NamespacedName{Name: "pull-secret", Namespace: crName}, pullSecret)
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+
+		// The pull secret is created once the prerequisite exists.
+		Expect(c.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "registry-ca",
+				Namespace: crName,
+			},
+		})).To(Succeed())
+
+		err = task.createOrUpdateClusterResources(ctx, renderedClusterInstance)
+		Expect(isResourceBarrierError(err)).To(BeFalse())
+		Expect(c.Get(ctx, types.NamespacedName{Name: "pull-secret", Namespace: crName}, pullSecret)).To(Succeed())
+	})
+
+	It("Ignores the namespace of a cluster-scoped prerequisite", func() {
+		renderedClusterInstance := &siteconfig.ClusterInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      crName,
+				Namespace: crName,
+			},
+			Spec: siteconfig.ClusterInstanceSpec{
+				PullSecretRef: corev1.LocalObjectReference{Name: "pull-secret"},
+			},
+		}
+
+		task.ctDetails.templates.HwTemplate = "hwTemplate-v1"
+		task.ctDetails.resourceBarriers = []provisioningv1alpha1.ResourceBarrier{
+			{
+				Resource: provisioningv1alpha1.ClusterResourcePullSecret,
+				Prerequisites: []provisioningv1alpha1.ResourcePrerequisite{
+					{APIVersion: "v1", Kind: "Namespace", Name: "registry", Namespace: "ignored"},
+				},
+			},
+		}
+
+		err := task.createOrUpdateClusterResources(ctx, renderedClusterInstance)
+		Expect(isResourceBarrierError(err)).To(BeTrue())
+		Expect(err).To(MatchError("pullSecret is waiting for Namespace registry (v1) to exist"))
+
+		Expect(c.Create(ctx, &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: "registry"},
+		})).To(Succeed())
+
+		err = task.createOrUpdateClusterResources(ctx, renderedClusterInstance)
+		Expect(isResourceBarrierError(err)).To(BeFalse())
+		pullSecret := &corev1.Secret{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: "pull-secret", Namespace: crName}, pullSecret)).To(Succeed())
+	})
+
+	It("Holds back a resource while the kind of its prerequisite is not served", func() {
+		task.ctDetails.resourceBarriers = []provisioningv1alpha1.ResourceBarrier{
+			{
+				Resource: provisioningv1alpha1.ClusterResourcePullSecret,
+				Prerequisites: []provisioningv1alpha1.ResourcePrerequisite{
+					{APIVersion: "example.com/v1", Kind: "Registry", Name: "registry"},
+				},
+			},
+		}
+
+		err := task.checkResourceBarrier(ctx, provisioningv1alpha1.ClusterResourcePullSecret, crName)
+		Expect(isResourceBarrierError(err)).To(BeTrue())
+		Expect(err).To(MatchError("pullSecret is waiting for Registry registry (example.com/v1) to exist"))
+	})
+
+	It("Reports an input error when the operator is not allowed to get a prerequisite", func() {
+		task.ctDetails.resourceBarriers = []provisioningv1alpha1.ResourceBarrier{
+			{
+				Resource: provisioningv1alpha1.ClusterResourcePullSecret,
+				Prerequisites: []provisioningv1alpha1.ResourcePrerequisite{
+					{APIVersion: "v1", Kind: "ConfigMap", Name: "registry-ca"},
+				},
+			},
+		}
+		task.client = interceptor.NewClient(getFakeClientFromObjects(), interceptor.Funcs{
+			Get: func(ctx context.Context, client client.WithWatch, key client.ObjectKey,
+				obj client.Object, opts ...client.GetOption) error {
+				return errors.NewForbidden(corev1.Resource("configmaps"), key.Name, fmt.Errorf("access denied"))
+			},
+		})
+
+		err := task.checkResourceBarrier(ctx, provisioningv1alpha1.ClusterResourcePullSecret, crName)
+		Expect(utils.IsInputError(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("the get permission must be granted to its service account"))
+	})
 })
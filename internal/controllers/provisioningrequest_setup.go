@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"context"
+	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -26,15 +27,51 @@ import (
 	policiesv1 "open-cluster-management.io/governance-policy-propagator/api/v1"
 )
 
+// nodePoolRefIndex is the name of the field index of the ProvisioningRequests by the NodePool they reference
+const nodePoolRefIndex = "status.extensions.nodePoolRef"
+
+// nodePoolRefIndexer returns the <namespace>/<name> key of the NodePool referenced by a ProvisioningRequest
+func nodePoolRefIndexer(object client.Object) []string {
+	pr, ok := object.(*provisioningv1alpha1.ProvisioningRequest)
+	if !ok || pr.Status.Extensions.NodePoolRef == nil {
+		return nil
+	}
+	ref := pr.Status.Extensions.NodePoolRef
+	return []string{types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}.String()}
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *ProvisioningRequestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("ProvisioningRequest")
+	}
+	registerProvisioningMetrics()
+
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(),
+		&provisioningv1alpha1.ProvisioningRequest{}, nodePoolRefIndex, nodePoolRefIndexer); err != nil {
+		return fmt.Errorf("failed to index ProvisioningRequests by NodePool: %w", err)
+	}
+
 	//nolint:wrapcheck
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("o2ims-cluster-request").
 		For(
 			&provisioningv1alpha1.ProvisioningRequest{},
-			// Watch for create and update event for ProvisioningRequest.
-			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+			// Watch for create and update event for ProvisioningRequest, and for the cancellation and dry-run
+			// requests.
+			builder.WithPredicates(predicate.Or(
+				predicate.GenerationChangedPredicate{},
+				predicate.Funcs{
+					UpdateFunc: func(e event.UpdateEvent) bool {
+						return e.ObjectOld.GetAnnotations()[provisioningRequestCancelAnnotation] !=
+							e.ObjectNew.GetAnnotations()[provisioningRequestCancelAnnotation] ||
+							isDryRunAnnotated(e.ObjectOld.GetAnnotations()) !=
+								isDryRunAnnotated(e.ObjectNew.GetAnnotations())
+					},
+					CreateFunc:  func(ce event.CreateEvent) bool { return false },
+					GenericFunc: func(ge event.GenericEvent) bool { return false },
+					DeleteFunc:  func(de event.DeleteEvent) bool { return false },
+				}))).
 		Owns(
 			&corev1.Namespace{},
 			builder.WithPredicates(predicate.Funcs{
@@ -151,7 +188,8 @@ func (r *ProvisioningRequestReconciler) SetupWithManager(mgr ctrl.Manager) error
 				},
 				CreateFunc:  func(ce event.CreateEvent) bool { return false },
 				GenericFunc: func(ge event.GenericEvent) bool { return false },
-				DeleteFunc:  func(de event.DeleteEvent) bool { return false },
+				// Detect the ManagedClusters deleted out-of-band
+				DeleteFunc: func(de event.DeleteEvent) bool { return true },
 			})).
 		Complete(r)
 }
@@ -0,0 +1,167 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+)
+
+// provisioningSummaryPendingPhase is the phase reported for the ProvisioningRequests that have not been processed yet
+const provisioningSummaryPendingPhase = "pending"
+
+// provisioningRequestsGauge exposes the number of ProvisioningRequests per phase and template so that a
+// fleet-wide dashboard does not need to list all of the ProvisioningRequests.
+var provisioningRequestsGauge = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: "o2ims",
+		Name:      "provisioning_requests",
+		Help:      "Number of ProvisioningRequests by provisioning phase and cluster template",
+	},
+	[]string{"phase", "template"},
+)
+
+func init() {
+	metrics.Registry.MustRegister(provisioningRequestsGauge)
+}
+
+// ProvisioningSummaryKey identifies one of the aggregated ProvisioningRequest counts
+type ProvisioningSummaryKey struct {
+	Phase    string
+	Template string
+}
+
+// ProvisioningSummary aggregates the ProvisioningRequests into counts by phase and template. The counts are
+// maintained incrementally from the last known phase and template of every ProvisioningRequest.
+type ProvisioningSummary struct {
+	lock    sync.Mutex
+	entries map[string]ProvisioningSummaryKey
+	counts  map[ProvisioningSummaryKey]int
+	gauge   *prometheus.GaugeVec
+}
+
+// NewProvisioningSummary creates a new empty ProvisioningSummary. The counts are also published to the gauge when
+// one is given.
+func NewProvisioningSummary(gauge *prometheus.GaugeVec) *ProvisioningSummary {
+	return &ProvisioningSummary{
+		entries: make(map[string]ProvisioningSummaryKey),
+		counts:  make(map[ProvisioningSummaryKey]int),
+		gauge:   gauge,
+	}
+}
+
+// Counts returns a copy of the current aggregated counts
+func (s *ProvisioningSummary) Counts() map[ProvisioningSummaryKey]int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	result := make(map[ProvisioningSummaryKey]int, len(s.counts))
+	for key, count := range s.counts {
+		result[key] = count
+	}
+	return result
+}
+
+// set records the phase and template of a ProvisioningRequest, moving it out of its previous count if needed
+func (s *ProvisioningSummary) set(name string, key ProvisioningSummaryKey) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if previous, found := s.entries[name]; found {
+		if previous == key {
+			return
+		}
+		s.decrement(previous)
+	}
+	s.entries[name] = key
+	s.counts[key]++
+	if s.gauge != nil {
+		s.gauge.WithLabelValues(key.Phase, key.Template).Inc()
+	}
+}
+
+// remove drops a deleted ProvisioningRequest from the counts
+func (s *ProvisioningSummary) remove(name string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if previous, found := s.entries[name]; found {
+		delete(s.entries, name)
+		s.decrement(previous)
+	}
+}
+
+// decrement lowers a count and drops it once it reaches zero. The caller must hold the lock.
+func (s *ProvisioningSummary) decrement(key ProvisioningSummaryKey) {
+	s.counts[key]--
+	if s.counts[key] <= 0 {
+		delete(s.counts, key)
+		if s.gauge != nil {
+			s.gauge.DeleteLabelValues(key.Phase, key.Template)
+		}
+		return
+	}
+	if s.gauge != nil {
+		s.gauge.WithLabelValues(key.Phase, key.Template).Dec()
+	}
+}
+
+// ProvisioningRequestSummaryReconciler maintains the fleet-wide ProvisioningRequest summary
+type ProvisioningRequestSummaryReconciler struct {
+	client.Client
+	Logger  *slog.Logger
+	Summary *ProvisioningSummary
+}
+
+//+kubebuilder:rbac:groups=o2ims.provisioning.oran.org,resources=provisioningrequests,verbs=get;list;watch
+
+// Reconcile updates the summary with the current phase and template of a ProvisioningRequest
+func (r *ProvisioningRequestSummaryReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
+	result ctrl.Result, err error) {
+	result = doNotRequeue()
+
+	object := &provisioningv1alpha1.ProvisioningRequest{}
+	if err = r.Client.Get(ctx, req.NamespacedName, object); err != nil {
+		if errors.IsNotFound(err) {
+			// The provisioning request has been deleted
+			r.Summary.remove(req.Name)
+			err = nil
+			return
+		}
+		err = fmt.Errorf("failed to get ProvisioningRequest %s: %w", req.Name, err)
+		return
+	}
+
+	phase := string(object.Status.ProvisioningStatus.ProvisioningPhase)
+	if phase == "" {
+		phase = provisioningSummaryPendingPhase
+	}
+	r.Summary.set(object.Name, ProvisioningSummaryKey{
+		Phase:    phase,
+		Template: getClusterTemplateRefName(object.Spec.TemplateName, object.Spec.TemplateVersion),
+	})
+
+	return
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ProvisioningRequestSummaryReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Summary == nil {
+		r.Summary = NewProvisioningSummary(provisioningRequestsGauge)
+	}
+
+	//nolint:wrapcheck
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("o2ims-provisioning-summary").
+		// Watch all events, including status updates, since the phase is part of the status.
+		For(&provisioningv1alpha1.ProvisioningRequest{}).
+		Complete(r)
+}
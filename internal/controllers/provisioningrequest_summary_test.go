@@ -0,0 +1,104 @@
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+)
+
+var _ = Describe("ProvisioningRequestSummaryReconciler", func() {
+	var (
+		ctx        context.Context
+		c          client.Client
+		reconciler *ProvisioningRequestSummaryReconciler
+	)
+
+	newProvisioningRequest := func(name, templateVersion string) *provisioningv1alpha1.ProvisioningRequest {
+		return &provisioningv1alpha1.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: provisioningv1alpha1.ProvisioningRequestSpec{
+				TemplateName:    "sno",
+				TemplateVersion: templateVersion,
+			},
+		}
+	}
+
+	reconcile := func(name string) {
+		result, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: name}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(doNotRequeue()))
+	}
+
+	setPhase := func(name string, phase provisioningv1alpha1.ProvisioningPhase) {
+		pr := &provisioningv1alpha1.ProvisioningRequest{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: name}, pr)).To(Succeed())
+		pr.Status.ProvisioningStatus.ProvisioningPhase = phase
+		Expect(c.Status().Update(ctx, pr)).To(Succeed())
+		reconcile(name)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		c = getFakeClientFromObjects()
+		reconciler = &ProvisioningRequestSummaryReconciler{
+			Client:  c,
+			Logger:  logger,
+			Summary: NewProvisioningSummary(nil),
+		}
+	})
+
+	It("counts new ProvisioningRequests as pending", func() {
+		Expect(c.Create(ctx, newProvisioningRequest("cluster-1", "v1"))).To(Succeed())
+		Expect(c.Create(ctx, newProvisioningRequest("cluster-2", "v1"))).To(Succeed())
+		Expect(c.Create(ctx, newProvisioningRequest("cluster-3", "v2"))).To(Succeed())
+		reconcile("cluster-1")
+		reconcile("cluster-2")
+		reconcile("cluster-3")
+
+		Expect(reconciler.Summary.Counts()).To(Equal(map[ProvisioningSummaryKey]int{
+			{Phase: provisioningSummaryPendingPhase, Template: "sno.v1"}: 2,
+			{Phase: provisioningSummaryPendingPhase, Template: "sno.v2"}: 1,
+		}))
+	})
+
+	It("moves ProvisioningRequests between counts as their phase changes", func() {
+		Expect(c.Create(ctx, newProvisioningRequest("cluster-1", "v1"))).To(Succeed())
+		Expect(c.Create(ctx, newProvisioningRequest("cluster-2", "v1"))).To(Succeed())
+		reconcile("cluster-1")
+		reconcile("cluster-2")
+
+		setPhase("cluster-1", provisioningv1alpha1.StateProgressing)
+		setPhase("cluster-2", provisioningv1alpha1.StateProgressing)
+		Expect(reconciler.Summary.Counts()).To(Equal(map[ProvisioningSummaryKey]int{
+			{Phase: string(provisioningv1alpha1.StateProgressing), Template: "sno.v1"}: 2,
+		}))
+
+		setPhase("cluster-1", provisioningv1alpha1.StateFulfilled)
+		// Reconciling an unchanged ProvisioningRequest does not count it twice
+		reconcile("cluster-1")
+		Expect(reconciler.Summary.Counts()).To(Equal(map[ProvisioningSummaryKey]int{
+			{Phase: string(provisioningv1alpha1.StateProgressing), Template: "sno.v1"}: 1,
+			{Phase: string(provisioningv1alpha1.StateFulfilled), Template: "sno.v1"}:   1,
+		}))
+	})
+
+	It("drops deleted ProvisioningRequests from the counts", func() {
+		pr := newProvisioningRequest("cluster-1", "v1")
+		Expect(c.Create(ctx, pr)).To(Succeed())
+		reconcile("cluster-1")
+		setPhase("cluster-1", provisioningv1alpha1.StateFailed)
+
+		Expect(c.Delete(ctx, pr)).To(Succeed())
+		reconcile("cluster-1")
+		Expect(reconciler.Summary.Counts()).To(BeEmpty())
+	})
+})
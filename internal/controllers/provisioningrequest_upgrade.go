@@ -3,6 +3,8 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/coreos/go-semver/semver"
 	ibgu "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/imagebasedgroupupgrades/v1alpha1"
@@ -69,6 +71,26 @@ func (t *provisioningRequestReconcilerTask) handleUpgrade(
 	ibgu := &ibgu.ImageBasedGroupUpgrade{}
 	err = t.client.Get(ctx, types.NamespacedName{Name: t.object.Name, Namespace: renderedClusterInstance.Namespace}, ibgu)
 	if err != nil && errors.IsNotFound(err) {
+		inProgress, err := t.countUpgradesInProgress(ctx)
+		if err != nil {
+			return requeueWithError(err)
+		}
+		if t.maxParallelUpgrades > 0 && inProgress >= t.maxParallelUpgrades {
+			utils.SetStatusCondition(&t.object.Status.Conditions,
+				provisioningv1alpha1.PRconditionTypes.UpgradeCompleted,
+				provisioningv1alpha1.CRconditionReasons.WaitingForUpgradeSlot,
+				metav1.ConditionFalse,
+				fmt.Sprintf("Waiting for an upgrade slot, %d upgrades are in progress out of %d allowed",
+					inProgress, t.maxParallelUpgrades),
+			)
+			utils.SetProvisioningStateInProgress(t.object, "Cluster upgrade is waiting for an upgrade slot")
+			t.updateUpgradeStatus(clusterTemplate.Spec.Release)
+			if err := utils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
+				return requeueWithError(fmt.Errorf("failed to update ClusterRequest CR status: %w", err))
+			}
+			return requeueWithMediumInterval(), nil
+		}
+
 		ibgu, err = utils.GetIBGUFromUpgradeDefaultsConfigmap(
 			ctx, t.client, clusterTemplate.Spec.Templates.UpgradeDefaults,
 			clusterTemplate.Namespace, utils.UpgradeDefaultsConfigmapKey,
@@ -96,6 +118,7 @@ func (t *provisioningRequestReconcilerTask) handleUpgrade(
 			"Upgrade is initiated",
 		)
 		utils.SetProvisioningStateInProgress(t.object, "Cluster upgrade is initiated")
+		t.updateUpgradeStatus(clusterTemplate.Spec.Release)
 		if err := utils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
 			return requeueWithError(fmt.Errorf("failed to update ClusterRequest CR status: %w", err))
 		}
@@ -104,7 +127,24 @@ func (t *provisioningRequestReconcilerTask) handleUpgrade(
 		return requeueWithError(fmt.Errorf("error getting IBGU: %w", err))
 	}
 
-	if isIBGUProgressing(ibgu) {
+	if rollingBack, message := isIBGURollingBack(ibgu); rollingBack {
+		utils.SetProvisioningStateInProgress(t.object, "Cluster upgrade is rolling back")
+		utils.SetStatusCondition(&t.object.Status.Conditions,
+			provisioningv1alpha1.PRconditionTypes.UpgradeCompleted,
+			provisioningv1alpha1.CRconditionReasons.RollingBack,
+			metav1.ConditionFalse,
+			message,
+		)
+		t.logger.InfoContext(
+			ctx,
+			"Wait for upgrade rollback to be completed",
+		)
+		t.updateUpgradeStatus(clusterTemplate.Spec.Release)
+		if err := utils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
+			return requeueWithError(fmt.Errorf("failed to update ClusterRequest CR status: %w", err))
+		}
+		return requeueWithMediumInterval(), nil
+	} else if isIBGUProgressing(ibgu) {
 		utils.SetProvisioningStateInProgress(t.object, "Cluster upgrade is in progress")
 		utils.SetStatusCondition(&t.object.Status.Conditions,
 			provisioningv1alpha1.PRconditionTypes.UpgradeCompleted,
@@ -116,6 +156,7 @@ func (t *provisioningRequestReconcilerTask) handleUpgrade(
 			ctx,
 			"Wait for upgrade to be completed",
 		)
+		t.updateUpgradeStatus(clusterTemplate.Spec.Release)
 		if err := utils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
 			return requeueWithError(fmt.Errorf("failed to update ClusterRequest CR status: %w", err))
 		}
@@ -124,6 +165,9 @@ func (t *provisioningRequestReconcilerTask) handleUpgrade(
 		// IBGU completed or failed. Collect results if it matches the current template ocp version
 		if clusterTemplate.Spec.Release == ibgu.Spec.IBUSpec.SeedImageRef.Version {
 			if failed, message := isIBGUFailed(ibgu); failed {
+				if isIBGURolledBack(ibgu) {
+					message = "Upgrade rolled back. " + message
+				}
 				utils.SetProvisioningStateFailed(t.object, "Cluster upgrade is failed")
 				utils.SetStatusCondition(&t.object.Status.Conditions,
 					provisioningv1alpha1.PRconditionTypes.UpgradeCompleted,
@@ -144,6 +188,7 @@ func (t *provisioningRequestReconcilerTask) handleUpgrade(
 					return requeueWithError(fmt.Errorf("failed to cleanup IBGU: %w", err))
 				}
 			}
+			t.updateUpgradeStatus(clusterTemplate.Spec.Release)
 			if err := utils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
 				return requeueWithError(fmt.Errorf("failed to update ClusterRequest CR status: %w", err))
 			}
@@ -160,6 +205,7 @@ func (t *provisioningRequestReconcilerTask) handleUpgrade(
 				return requeueWithError(fmt.Errorf("failed to cleanup IBGU: %w", err))
 			}
 			meta.RemoveStatusCondition(&t.object.Status.Conditions, string(provisioningv1alpha1.PRconditionTypes.UpgradeCompleted))
+			t.updateUpgradeStatus("")
 			if err := utils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
 				return requeueWithError(fmt.Errorf("failed to update ClusterRequest CR status: %w", err))
 			}
@@ -169,6 +215,61 @@ func (t *provisioningRequestReconcilerTask) handleUpgrade(
 	return doNotRequeue(), nil
 }
 
+// countUpgradesInProgress returns the number of IBGUs created for ProvisioningRequests that are still progressing
+func (t *provisioningRequestReconcilerTask) countUpgradesInProgress(ctx context.Context) (int, error) {
+	if t.maxParallelUpgrades <= 0 {
+		return 0, nil
+	}
+
+	ibgus := &ibgu.ImageBasedGroupUpgradeList{}
+	if err := t.client.List(ctx, ibgus); err != nil {
+		return 0, fmt.Errorf("failed to list IBGUs: %w", err)
+	}
+
+	inProgress := 0
+	for i := range ibgus.Items {
+		owner := metav1.GetControllerOf(&ibgus.Items[i])
+		if owner == nil || owner.Kind != "ProvisioningRequest" {
+			continue
+		}
+		if isIBGUProgressing(&ibgus.Items[i]) {
+			inProgress++
+		}
+	}
+	return inProgress, nil
+}
+
+// updateUpgradeStatus updates the upgrade status of the ProvisioningRequest to reflect the UpgradeCompleted condition.
+// The upgrade status is removed when there is no such condition.
+func (t *provisioningRequestReconcilerTask) updateUpgradeStatus(targetRelease string) {
+	phase := upgradePhaseFromCondition(meta.FindStatusCondition(
+		t.object.Status.Conditions, string(provisioningv1alpha1.PRconditionTypes.UpgradeCompleted)))
+	if phase == "" {
+		t.object.Status.UpgradeStatus = nil
+		return
+	}
+
+	t.object.Status.UpgradeStatus = &provisioningv1alpha1.UpgradeStatus{
+		UpgradePhase:  phase,
+		TargetRelease: targetRelease,
+	}
+}
+
+// upgradePhaseFromCondition returns the upgrade phase that corresponds to the given UpgradeCompleted condition, or an
+// empty phase if no upgrade has been initiated.
+func upgradePhaseFromCondition(condition *metav1.Condition) provisioningv1alpha1.UpgradePhase {
+	switch {
+	case condition == nil:
+		return ""
+	case condition.Status == metav1.ConditionTrue:
+		return provisioningv1alpha1.UpgradeStateCompleted
+	case condition.Reason == string(provisioningv1alpha1.CRconditionReasons.Failed):
+		return provisioningv1alpha1.UpgradeStateFailed
+	default:
+		return provisioningv1alpha1.UpgradeStateProgressing
+	}
+}
+
 func isIBGUFailed(cr *ibgu.ImageBasedGroupUpgrade) (bool, string) {
 	for _, cluster := range cr.Status.Clusters {
 		if len(cluster.FailedActions) == 0 {
@@ -183,6 +284,47 @@ func isIBGUFailed(cr *ibgu.ImageBasedGroupUpgrade) (bool, string) {
 	return false, ""
 }
 
+// ibguRollbackActions are the IBGU actions reverting a cluster to the release it was running before the upgrade
+var ibguRollbackActions = []string{ibgu.Rollback, ibgu.Abort, ibgu.AbortOnFailure, ibgu.FinalizeRollback}
+
+// isIBGURollingBack checks whether the IBGU is still reverting a cluster after a failed upgrade, as opposed to a
+// terminal failure. A rollback is detected from the action a cluster is running, from a failed action reporting
+// that it initiated a rollback, or from the Progressing condition of the IBGU.
+func isIBGURollingBack(cr *ibgu.ImageBasedGroupUpgrade) (bool, string) {
+	if !isIBGUProgressing(cr) {
+		return false, ""
+	}
+
+	for _, cluster := range cr.Status.Clusters {
+		if cluster.CurrentAction != nil && slices.Contains(ibguRollbackActions, cluster.CurrentAction.Action) {
+			return true, fmt.Sprintf("Upgrade is rolling back: action %s is in progress", cluster.CurrentAction.Action)
+		}
+		for _, action := range cluster.FailedActions {
+			if strings.Contains(strings.ToLower(action.Message), "rollback") {
+				return true, fmt.Sprintf("Upgrade is rolling back: action %s failed: %s", action.Action, action.Message)
+			}
+		}
+	}
+
+	condition := meta.FindStatusCondition(cr.Status.Conditions, "Progressing")
+	if condition != nil && strings.Contains(strings.ToLower(condition.Message), "rollback") {
+		return true, "Upgrade is rolling back: " + condition.Message
+	}
+	return false, ""
+}
+
+// isIBGURolledBack checks whether a rollback action has completed for any of the clusters of the IBGU
+func isIBGURolledBack(cr *ibgu.ImageBasedGroupUpgrade) bool {
+	for _, cluster := range cr.Status.Clusters {
+		for _, action := range cluster.CompletedActions {
+			if slices.Contains(ibguRollbackActions, action.Action) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func isIBGUProgressing(cr *ibgu.ImageBasedGroupUpgrade) bool {
 	condition := meta.FindStatusCondition(cr.Status.Conditions, "Progressing")
 	if condition != nil {
@@ -5,6 +5,13 @@ import (
 	"fmt"
 	"log/slog"
 	"maps"
+	"regexp"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
 	"github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
@@ -12,14 +19,29 @@ import (
 
 // validateProvisioningRequestCR validates the ProvisioningRequest CR
 func (t *provisioningRequestReconcilerTask) validateProvisioningRequestCR(ctx context.Context) error {
+	// Check the requested template version is not below the configured minimum
+	if err := t.validateTemplateVersion(); err != nil {
+		return err
+	}
+
+	// Check the feature flags are known, as an unknown one is most likely misspelled
+	if err := t.object.ValidateFeatureFlags(); err != nil {
+		return utils.NewInputError("%s", err.Error())
+	}
+
 	// Check the referenced cluster template is present and valid
 	clusterTemplate, err := t.object.GetClusterTemplateRef(ctx, t.client)
 	if err != nil {
 		return utils.NewInputError("failed to get the ClusterTemplate for ProvisioningRequest %s: %w ", t.object.Name, err)
 	}
 	t.ctDetails = &clusterTemplateDetails{
-		namespace: clusterTemplate.Namespace,
-		templates: clusterTemplate.Spec.Templates,
+		namespace:         clusterTemplate.Namespace,
+		templates:         clusterTemplate.Spec.Templates,
+		hostnameTemplate:  clusterTemplate.Spec.HostnameTemplate,
+		resourceBarriers:  clusterTemplate.Spec.ResourceBarriers,
+		pollIntervals:     clusterTemplate.Spec.PollIntervals,
+		fulfillmentChecks: clusterTemplate.Spec.FulfillmentChecks,
+		changeWindow:      clusterTemplate.Spec.ConfigurationChangeWindow,
 	}
 
 	if err = t.validateAndLoadTimeouts(ctx, clusterTemplate); err != nil {
@@ -34,6 +56,13 @@ func (t *provisioningRequestReconcilerTask) validateProvisioningRequestCR(ctx co
 		return fmt.Errorf("failed to validate ClusterInstance input: %w", err)
 	}
 
+	// The referenced objects are only needed until the installation starts
+	if !utils.IsClusterProvisionPresent(t.object) {
+		if err = t.validateClusterInstanceReferences(ctx); err != nil {
+			return fmt.Errorf("failed to validate ClusterInstance references: %w", err)
+		}
+	}
+
 	if err = t.validatePolicyTemplateInputMatchesSchema(ctx, clusterTemplate); err != nil {
 		return fmt.Errorf("failed to validate PolicyTemplate input: %w", err)
 	}
@@ -43,9 +72,41 @@ func (t *provisioningRequestReconcilerTask) validateProvisioningRequestCR(ctx co
 	return nil
 }
 
+// validateTemplateVersion checks that the ClusterTemplate version referenced by the ProvisioningRequest
+// is not below the minimum version configured for the controller, if any. Template versions that are not
+// semantic versions are not checked.
+func (t *provisioningRequestReconcilerTask) validateTemplateVersion() error {
+	minVersion := utils.GetMinTemplateVersion()
+	if minVersion == "" {
+		return nil
+	}
+
+	// Templates are not required to use semantic versions; those that don't cannot be compared with the minimum
+	if !utils.IsSemanticTemplateVersion(t.object.Spec.TemplateVersion) {
+		t.logger.Warn(fmt.Sprintf(
+			"Skipping the minimum template version check, template version %s is not a semantic version",
+			t.object.Spec.TemplateVersion), slog.String("name", t.object.Name))
+		return nil
+	}
+
+	tooOld, err := utils.IsTemplateVersionBelow(t.object.Spec.TemplateVersion, minVersion)
+	if err != nil {
+		return utils.NewInputError("failed to compare the template version against the minimum version %s: %w", minVersion, err)
+	}
+	if tooOld {
+		return utils.NewInputError("%w", &utils.TemplateVersionTooOldErr{
+			Version:    t.object.Spec.TemplateVersion,
+			MinVersion: minVersion,
+		})
+	}
+	return nil
+}
+
 // validateAndLoadTimeouts validates and loads timeout values from configmaps for
 // hardware provisioning, cluster provisioning, and configuration into timeouts variable.
-// If a timeout is not defined in the configmap, the default timeout value is used.
+// If a timeout is not defined in the configmap, the default timeout value is used. The timeouts
+// set in the ClusterTemplate spec override both, and the ones set in the ProvisioningRequest spec
+// override all of them.
 func (t *provisioningRequestReconcilerTask) validateAndLoadTimeouts(
 	ctx context.Context, clusterTemplate *provisioningv1alpha1.ClusterTemplate) error {
 	// Initialize with default timeouts
@@ -55,7 +116,7 @@ func (t *provisioningRequestReconcilerTask) validateAndLoadTimeouts(
 
 	// Load hardware provisioning timeout if exists.
 	if !t.isHardwareProvisionSkipped() {
-		hwCmName := clusterTemplate.Spec.Templates.HwTemplate
+		hwCmName := t.getHwTemplateName(clusterTemplate.Spec.Templates)
 		hwTimeout, err := utils.GetTimeoutFromHWTemplate(ctx, t.client, hwCmName)
 		if err != nil {
 			return fmt.Errorf("failed to get timeout from hardware template %s: %w", hwCmName, err)
@@ -97,6 +158,42 @@ func (t *provisioningRequestReconcilerTask) validateAndLoadTimeouts(
 	if ptTimeout != 0 {
 		t.timeouts.clusterConfiguration = ptTimeout
 	}
+
+	// The timeouts set in the ClusterTemplate take precedence over the ones from the ConfigMaps.
+	ctCiTimeout, err := utils.ParseClusterTemplateTimeout(
+		clusterTemplate.Spec.ClusterProvisioningTimeout, "clusterProvisioningTimeout")
+	if err != nil {
+		return fmt.Errorf("failed to get timeout for cluster provisioning from ClusterTemplate %s: %w",
+			clusterTemplate.Name, err)
+	}
+	if ctCiTimeout != 0 {
+		t.timeouts.clusterProvisioning = ctCiTimeout
+	}
+	ctPtTimeout, err := utils.ParseClusterTemplateTimeout(
+		clusterTemplate.Spec.ClusterConfigurationTimeout, "clusterConfigurationTimeout")
+	if err != nil {
+		return fmt.Errorf("failed to get timeout for cluster configuration from ClusterTemplate %s: %w",
+			clusterTemplate.Name, err)
+	}
+	if ctPtTimeout != 0 {
+		t.timeouts.clusterConfiguration = ctPtTimeout
+	}
+
+	// The timeouts set in the ProvisioningRequest take precedence over the ones from the templates.
+	if err := t.object.ValidateTimeouts(); err != nil {
+		return utils.NewInputError("%s", err.Error())
+	}
+	if timeouts := t.object.Spec.Timeouts; timeouts != nil {
+		if timeouts.HardwareProvisioning != nil {
+			t.timeouts.hardwareProvisioning = timeouts.HardwareProvisioning.Duration
+		}
+		if timeouts.ClusterProvisioning != nil {
+			t.timeouts.clusterProvisioning = timeouts.ClusterProvisioning.Duration
+		}
+		if timeouts.ClusterConfiguration != nil {
+			t.timeouts.clusterConfiguration = timeouts.ClusterConfiguration.Duration
+		}
+	}
 	return nil
 }
 
@@ -123,10 +220,170 @@ func (t *provisioningRequestReconcilerTask) validateClusterInstanceInputMatchesS
 		return fmt.Errorf("failed to get merged cluster input data: %w", err)
 	}
 
+	if err = t.applyHostnameTemplate(mergedClusterInstanceData); err != nil {
+		return err
+	}
+
 	t.clusterInput.clusterInstanceData = mergedClusterInstanceData
 	return nil
 }
 
+// validateClusterInstanceReferences checks that the objects referenced by name in the merged ClusterInstance
+// data exist in the ClusterTemplate namespace, from where they are copied to the cluster namespace, so that
+// a missing reference is reported by the validation rather than when the cluster resources are created.
+func (t *provisioningRequestReconcilerTask) validateClusterInstanceReferences(ctx context.Context) error {
+	clusterInstanceData := t.clusterInput.clusterInstanceData
+
+	if pullSecretRef, ok := clusterInstanceData["pullSecretRef"].(map[string]any); ok {
+		if name, _ := pullSecretRef["name"].(string); name != "" {
+			if err := t.validateReferenceExists(ctx, "pullSecretRef.name", name, &corev1.Secret{}, "Secret"); err != nil {
+				return err
+			}
+		}
+	}
+
+	extraManifestsRefs, _ := clusterInstanceData["extraManifestsRefs"].([]any)
+	for i, ref := range extraManifestsRefs {
+		extraManifestsRef, _ := ref.(map[string]any)
+		if name, _ := extraManifestsRef["name"].(string); name != "" {
+			if err := t.validateReferenceExists(
+				ctx, fmt.Sprintf("extraManifestsRefs[%d].name", i), name, &corev1.ConfigMap{}, "ConfigMap"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateReferenceExists returns an input error wrapping a MissingReferenceErr when the object referenced by
+// the given ClusterInstance field does not exist in the ClusterTemplate namespace.
+func (t *provisioningRequestReconcilerTask) validateReferenceExists(
+	ctx context.Context, field, name string, object client.Object, kind string) error {
+
+	exists, err := utils.DoesK8SResourceExist(ctx, t.client, name, t.ctDetails.namespace, object)
+	if err != nil {
+		return fmt.Errorf("failed to check if %s %s exists in namespace %s: %w",
+			field, name, t.ctDetails.namespace, err)
+	}
+	if !exists {
+		return utils.NewInputError("%w", &utils.MissingReferenceErr{
+			Kind:      kind,
+			Name:      name,
+			Field:     utils.TemplateParamClusterInstance + "." + field,
+			Namespace: t.ctDetails.namespace,
+		})
+	}
+	return nil
+}
+
+// applyHostnameTemplate generates the node hostnames of the merged ClusterInstance data
+// from the hostname template of the ClusterTemplate, if one is defined. The {{index}}
+// variable is the position of the node among the nodes sharing the same role.
+func (t *provisioningRequestReconcilerTask) applyHostnameTemplate(clusterInstanceData map[string]any) error {
+	if t.ctDetails.hostnameTemplate == "" {
+		return nil
+	}
+
+	siteID, err := provisioningv1alpha1.ExtractMatchingInput(
+		t.object.Spec.TemplateParameters.Raw, utils.TemplateParamOCloudSiteId)
+	if err != nil {
+		return utils.NewInputError("failed to get %s from templateParameters: %w", utils.TemplateParamOCloudSiteId, err)
+	}
+	clusterName, _ := clusterInstanceData["clusterName"].(string)
+
+	nodes, ok := clusterInstanceData["nodes"].([]any)
+	if !ok {
+		return nil
+	}
+
+	userHostnames := t.getUserNodeHostnames()
+	roleIndexes := make(map[string]int)
+	hostnames := make(map[string]int)
+	for i, n := range nodes {
+		node, ok := n.(map[string]any)
+		if !ok {
+			return utils.NewInputError("unexpected format for node %d in %s", i, utils.TemplateParamClusterInstance)
+		}
+		role, _ := node["role"].(string)
+		index := roleIndexes[role]
+		roleIndexes[role]++
+
+		// A hostname set explicitly in the ProvisioningRequest takes precedence over the template
+		hostname := userHostnames[i]
+		if hostname == "" {
+			hostname, err = renderHostnameTemplate(t.ctDetails.hostnameTemplate, map[string]string{
+				"site":    fmt.Sprintf("%v", siteID),
+				"cluster": clusterName,
+				"role":    role,
+				"index":   strconv.Itoa(index),
+			})
+			if err != nil {
+				return utils.NewInputError("failed to render the hostname of node %d: %w", i, err)
+			}
+			if errs := validation.IsDNS1123Subdomain(hostname); len(errs) != 0 {
+				return utils.NewInputError(
+					"the hostname template %q generates the invalid hostname %q for node %d: %s",
+					t.ctDetails.hostnameTemplate, hostname, i, strings.Join(errs, ", "))
+			}
+		}
+		if j, exists := hostnames[hostname]; exists {
+			return utils.NewInputError(
+				"the hostname template %q generates the same hostname %q for nodes %d and %d",
+				t.ctDetails.hostnameTemplate, hostname, j, i)
+		}
+
+		hostnames[hostname] = i
+		node["hostName"] = hostname
+	}
+
+	return nil
+}
+
+// getUserNodeHostnames returns the hostnames set explicitly for the nodes in the ClusterInstance input of the
+// ProvisioningRequest, indexed by the position of the node. Nodes without a hostname are not included.
+func (t *provisioningRequestReconcilerTask) getUserNodeHostnames() map[int]string {
+	hostnames := make(map[int]string)
+	input, err := provisioningv1alpha1.ExtractMatchingInput(
+		t.object.Spec.TemplateParameters.Raw, utils.TemplateParamClusterInstance)
+	if err != nil {
+		return hostnames
+	}
+	inputMap, _ := input.(map[string]any)
+	nodes, _ := inputMap["nodes"].([]any)
+	for i, n := range nodes {
+		node, _ := n.(map[string]any)
+		if hostname, _ := node["hostName"].(string); hostname != "" {
+			hostnames[i] = hostname
+		}
+	}
+	return hostnames
+}
+
+// hostnameTemplateVariable matches a {{variable}} reference in a hostname template
+var hostnameTemplateVariable = regexp.MustCompile(`{{\s*([^{}\s]*)\s*}}`)
+
+// renderHostnameTemplate substitutes the variables referenced in the hostname template
+// with the given values. Referencing an unknown variable is an error.
+func renderHostnameTemplate(hostnameTemplate string, values map[string]string) (string, error) {
+	var renderErr error
+	hostname := hostnameTemplateVariable.ReplaceAllStringFunc(hostnameTemplate, func(match string) string {
+		name := hostnameTemplateVariable.FindStringSubmatch(match)[1]
+		value, ok := values[name]
+		if !ok && renderErr == nil {
+			renderErr = fmt.Errorf("unknown variable %q in hostname template %q", name, hostnameTemplate)
+		}
+		return value
+	})
+	if renderErr != nil {
+		return "", renderErr
+	}
+	if hostname == "" {
+		return "", fmt.Errorf("hostname template %q renders an empty hostname", hostnameTemplate)
+	}
+	return hostname, nil
+}
+
 // validatePolicyTemplateInputMatchesSchema validates that the merged PolicyTemplate input
 // (from both the ProvisioningRequest and the default configmap) matches the schema defined
 // in the ClusterTemplate. If valid, the merged PolicyTemplate data is stored in clusterInput.
@@ -3,6 +3,10 @@ package controllers
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+	"github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
 )
 
 var _ = Describe("overrideClusterInstanceLabelsOrAnnotations", func() {
@@ -304,3 +308,89 @@ var _ = Describe("overrideClusterInstanceLabelsOrAnnotations", func() {
 		Expect(dstProvisioningRequestInput).To(Equal(expected))
 	})
 })
+
+var _ = Describe("applyHostnameTemplate", func() {
+	var (
+		clusterInstanceData map[string]any
+		task                *provisioningRequestReconcilerTask
+	)
+
+	BeforeEach(func() {
+		clusterInstanceData = map[string]any{
+			"clusterName": "cluster-1",
+			"nodes": []any{
+				map[string]any{"hostName": "node1", "role": "master"},
+				map[string]any{"hostName": "node2", "role": "worker"},
+				map[string]any{"hostName": "node3", "role": "master"},
+			},
+		}
+
+		task = &provisioningRequestReconcilerTask{
+			logger: logger,
+			object: &provisioningv1alpha1.ProvisioningRequest{
+				Spec: provisioningv1alpha1.ProvisioningRequestSpec{
+					TemplateParameters: runtime.RawExtension{
+						Raw: []byte(`{"oCloudSiteId": "site-a"}`),
+					},
+				},
+			},
+			clusterInput: &clusterInput{},
+			ctDetails:    &clusterTemplateDetails{},
+		}
+	})
+
+	It("should keep the hostnames when no template is defined", func() {
+		Expect(task.applyHostnameTemplate(clusterInstanceData)).To(Succeed())
+		nodes := clusterInstanceData["nodes"].([]any)
+		Expect(nodes[0].(map[string]any)["hostName"]).To(Equal("node1"))
+		Expect(nodes[1].(map[string]any)["hostName"]).To(Equal("node2"))
+		Expect(nodes[2].(map[string]any)["hostName"]).To(Equal("node3"))
+	})
+
+	It("should generate the hostnames from the template", func() {
+		task.ctDetails.hostnameTemplate = "{{site}}-{{cluster}}-{{role}}-{{index}}"
+		Expect(task.applyHostnameTemplate(clusterInstanceData)).To(Succeed())
+		nodes := clusterInstanceData["nodes"].([]any)
+		Expect(nodes[0].(map[string]any)["hostName"]).To(Equal("site-a-cluster-1-master-0"))
+		Expect(nodes[1].(map[string]any)["hostName"]).To(Equal("site-a-cluster-1-worker-0"))
+		Expect(nodes[2].(map[string]any)["hostName"]).To(Equal("site-a-cluster-1-master-1"))
+	})
+
+	It("should reject a template generating duplicate hostnames", func() {
+		task.ctDetails.hostnameTemplate = "{{site}}-{{role}}"
+		err := task.applyHostnameTemplate(clusterInstanceData)
+		Expect(err).To(HaveOccurred())
+		Expect(utils.IsInputError(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring(`generates the same hostname "site-a-master" for nodes 0 and 2`))
+	})
+
+	It("should keep the hostnames set in the ProvisioningRequest", func() {
+		task.object.Spec.TemplateParameters.Raw = []byte(`{
+			"oCloudSiteId": "site-a",
+			"clusterInstanceParameters": {"nodes": [{}, {"hostName": "custom-worker"}, {}]}
+		}`)
+		task.ctDetails.hostnameTemplate = "{{site}}-{{cluster}}-{{role}}-{{index}}"
+		Expect(task.applyHostnameTemplate(clusterInstanceData)).To(Succeed())
+		nodes := clusterInstanceData["nodes"].([]any)
+		Expect(nodes[0].(map[string]any)["hostName"]).To(Equal("site-a-cluster-1-master-0"))
+		Expect(nodes[1].(map[string]any)["hostName"]).To(Equal("custom-worker"))
+		Expect(nodes[2].(map[string]any)["hostName"]).To(Equal("site-a-cluster-1-master-1"))
+	})
+
+	It("should reject a template generating an invalid hostname", func() {
+		task.object.Spec.TemplateParameters.Raw = []byte(`{"oCloudSiteId": "Site_A"}`)
+		task.ctDetails.hostnameTemplate = "{{site}}-{{role}}-{{index}}"
+		err := task.applyHostnameTemplate(clusterInstanceData)
+		Expect(err).To(HaveOccurred())
+		Expect(utils.IsInputError(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring(`generates the invalid hostname "Site_A-master-0" for node 0`))
+	})
+
+	It("should reject a template referencing an unknown variable", func() {
+		task.ctDetails.hostnameTemplate = "{{site}}-{{rack}}-{{index}}"
+		err := task.applyHostnameTemplate(clusterInstanceData)
+		Expect(err).To(HaveOccurred())
+		Expect(utils.IsInputError(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring(`unknown variable "rack"`))
+	})
+})
@@ -20,18 +20,22 @@ import (
 	"testing"
 
 	"github.com/go-logr/logr"
+	metal3v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
 	. "github.com/onsi/ginkgo/v2/dsl/core"
 	. "github.com/onsi/gomega"
 	openshiftv1 "github.com/openshift/api/config/v1"
 	openshiftoperatorv1 "github.com/openshift/api/operator/v1"
+	agentv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
 	siteconfig "github.com/stolostron/siteconfig/api/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/klog/v2"
 	clusterv1 "open-cluster-management.io/api/cluster/v1"
+	clusterv1beta1 "open-cluster-management.io/api/cluster/v1beta1"
 	policiesv1 "open-cluster-management.io/governance-policy-propagator/api/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -69,6 +73,8 @@ func getFakeClientFromObjects(objs ...client.Object) client.WithWatch {
 		WithScheme(scheme).
 		WithObjects(objs...).
 		WithObjects([]client.Object{hwmgr}...).
+		WithRESTMapper(newFakeRESTMapper()).
+		WithIndex(&provisioningv1alpha1.ProvisioningRequest{}, nodePoolRefIndex, nodePoolRefIndexer).
 		WithStatusSubresource(&inventoryv1alpha1.Inventory{}).
 		WithStatusSubresource(&provisioningv1alpha1.ClusterTemplate{}).
 		WithStatusSubresource(&provisioningv1alpha1.ProvisioningRequest{}).
@@ -85,6 +91,33 @@ func getFakeClientFromObjects(objs ...client.Object) client.WithWatch {
 		Build()
 }
 
+// clusterScopedKinds lists the kinds of the test scheme that are not namespaced.
+var clusterScopedKinds = map[string]bool{
+	"ClusterRole":                    true,
+	"ClusterRoleBinding":             true,
+	"ClusterVersion":                 true,
+	"ManagedCluster":                 true,
+	"Namespace":                      true,
+	"Node":                           true,
+	"PersistentVolume":               true,
+	"ProvisioningRequest":            true,
+	"StorageClass":                   true,
+	"ValidatingWebhookConfiguration": true,
+}
+
+// newFakeRESTMapper returns a RESTMapper that maps all the kinds of the test scheme to their scope.
+func newFakeRESTMapper() meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	for gvk := range scheme.AllKnownTypes() {
+		scope := meta.RESTScopeNamespace
+		if clusterScopedKinds[gvk.Kind] {
+			scope = meta.RESTScopeRoot
+		}
+		mapper.Add(gvk, scope)
+	}
+	return mapper
+}
+
 // Logger used for tests:
 var logger *slog.Logger
 
@@ -126,13 +159,21 @@ var _ = BeforeSuite(func() {
 	scheme.AddKnownTypes(siteconfig.GroupVersion, &siteconfig.ClusterInstanceList{})
 	scheme.AddKnownTypes(appsv1.SchemeGroupVersion, &hwv1alpha1.HardwareTemplate{})
 	scheme.AddKnownTypes(appsv1.SchemeGroupVersion, &hwv1alpha1.NodePool{})
+	scheme.AddKnownTypes(appsv1.SchemeGroupVersion, &hwv1alpha1.NodePoolList{})
 	scheme.AddKnownTypes(appsv1.SchemeGroupVersion, &hwv1alpha1.Node{})
 	scheme.AddKnownTypes(policiesv1.SchemeGroupVersion, &policiesv1.Policy{})
 	scheme.AddKnownTypes(policiesv1.SchemeGroupVersion, &policiesv1.PolicyList{})
 	scheme.AddKnownTypes(clusterv1.SchemeGroupVersion, &clusterv1.ManagedCluster{})
+	scheme.AddKnownTypes(clusterv1beta1.SchemeGroupVersion, &clusterv1beta1.Placement{})
+	scheme.AddKnownTypes(clusterv1beta1.SchemeGroupVersion, &clusterv1beta1.PlacementList{})
 	scheme.AddKnownTypes(clusterv1.SchemeGroupVersion, &clusterv1.ManagedClusterList{})
 	scheme.AddKnownTypes(openshiftv1.SchemeGroupVersion, &openshiftv1.ClusterVersion{})
 	scheme.AddKnownTypes(openshiftoperatorv1.SchemeGroupVersion, &openshiftoperatorv1.IngressController{})
-	scheme.AddKnownTypes(ibguv1alpha1.SchemeGroupVersion, &ibguv1alpha1.ImageBasedGroupUpgrade{})
+	scheme.AddKnownTypes(ibguv1alpha1.SchemeGroupVersion, &ibguv1alpha1.ImageBasedGroupUpgrade{},
+		&ibguv1alpha1.ImageBasedGroupUpgradeList{})
 	scheme.AddKnownTypes(pluginv1alpha1.GroupVersion, &pluginv1alpha1.HardwareManager{})
+	scheme.AddKnownTypes(agentv1beta1.GroupVersion, &agentv1beta1.Agent{})
+	scheme.AddKnownTypes(agentv1beta1.GroupVersion, &agentv1beta1.AgentList{})
+	scheme.AddKnownTypes(metal3v1alpha1.GroupVersion, &metal3v1alpha1.BareMetalHost{})
+	scheme.AddKnownTypes(metal3v1alpha1.GroupVersion, &metal3v1alpha1.BareMetalHostList{})
 })
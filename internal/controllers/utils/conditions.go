@@ -1,6 +1,9 @@
 package utils
 
 import (
+	"cmp"
+	"slices"
+
 	inventoryv1alpha1 "github.com/openshift-kni/oran-o2ims/api/inventory/v1alpha1"
 	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
 	siteconfigv1alpha1 "github.com/stolostron/siteconfig/api/v1alpha1"
@@ -8,7 +11,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// SetStatusCondition is a convenience wrapper for meta.SetStatusCondition that takes in the types defined here and converts them to strings
+// SetStatusCondition is a convenience wrapper for meta.SetStatusCondition that takes in the types defined here and converts them to strings.
+// The conditions are kept in the canonical order of the ProvisioningRequest conditions.
 func SetStatusCondition(
 	existingConditions *[]metav1.Condition,
 	conditionType provisioningv1alpha1.ConditionType,
@@ -26,6 +30,42 @@ func SetStatusCondition(
 			LastTransitionTime: metav1.Now(),
 		},
 	)
+	SortProvisioningRequestConditions(*existingConditions)
+}
+
+// provisioningRequestConditionOrder is the canonical order of the ProvisioningRequest conditions, which follows the
+// order of the provisioning steps.
+var provisioningRequestConditionOrder = []provisioningv1alpha1.ConditionType{
+	provisioningv1alpha1.PRconditionTypes.Validated,
+	provisioningv1alpha1.PRconditionTypes.ClusterInstanceRendered,
+	provisioningv1alpha1.PRconditionTypes.ClusterResourcesCreated,
+	provisioningv1alpha1.PRconditionTypes.HardwareTemplateRendered,
+	provisioningv1alpha1.PRconditionTypes.HardwareProvisioned,
+	provisioningv1alpha1.PRconditionTypes.HardwareNodeConfigApplied,
+	provisioningv1alpha1.PRconditionTypes.HardwareConfigured,
+	provisioningv1alpha1.PRconditionTypes.HardwarePluginUnavailable,
+	provisioningv1alpha1.PRconditionTypes.ClusterInstanceProcessed,
+	provisioningv1alpha1.PRconditionTypes.ClusterProvisioned,
+	provisioningv1alpha1.PRconditionTypes.ConfigurationApplied,
+	provisioningv1alpha1.PRconditionTypes.HardwareHealthy,
+	provisioningv1alpha1.PRconditionTypes.PolicyBindingMismatch,
+	provisioningv1alpha1.PRconditionTypes.UpgradeCompleted,
+}
+
+// SortProvisioningRequestConditions sorts the conditions of a ProvisioningRequest in their canonical order, so that
+// the status is the same regardless of the order in which the conditions were set. Unknown condition types are
+// kept after the known ones, in their original order.
+func SortProvisioningRequestConditions(conditions []metav1.Condition) {
+	rank := func(condition metav1.Condition) int {
+		index := slices.Index(provisioningRequestConditionOrder, provisioningv1alpha1.ConditionType(condition.Type))
+		if index < 0 {
+			return len(provisioningRequestConditionOrder)
+		}
+		return index
+	}
+	slices.SortStableFunc(conditions, func(a, b metav1.Condition) int {
+		return cmp.Compare(rank(a), rank(b))
+	})
 }
 
 // SetProvisioningStateInProgress updates the provisioning state to progressing with detailed message
@@ -56,6 +96,13 @@ func SetProvisioningStateDeleting(cr *provisioningv1alpha1.ProvisioningRequest)
 	cr.Status.ProvisioningStatus.UpdateTime = metav1.Now()
 }
 
+// SetProvisioningStateCancelled updates the provisioning state to cancelled with detailed message
+func SetProvisioningStateCancelled(cr *provisioningv1alpha1.ProvisioningRequest, message string) {
+	cr.Status.ProvisioningStatus.ProvisioningPhase = provisioningv1alpha1.StateCancelled
+	cr.Status.ProvisioningStatus.ProvisioningDetails = message
+	cr.Status.ProvisioningStatus.UpdateTime = metav1.Now()
+}
+
 // IsProvisioningStateFulfilled checks if the provisioning status is fulfilled
 func IsProvisioningStateFulfilled(cr *provisioningv1alpha1.ProvisioningRequest) bool {
 	return cr.Status.ProvisioningStatus.ProvisioningPhase == provisioningv1alpha1.StateFulfilled
@@ -103,6 +150,13 @@ func IsClusterProvisionFailed(cr *provisioningv1alpha1.ProvisioningRequest) bool
 	return condition != nil && condition.Reason == string(provisioningv1alpha1.CRconditionReasons.Failed)
 }
 
+// IsClusterMissing checks if the ManagedCluster of the provisioned cluster has been reported missing
+func IsClusterMissing(cr *provisioningv1alpha1.ProvisioningRequest) bool {
+	condition := meta.FindStatusCondition(cr.Status.Conditions,
+		string(provisioningv1alpha1.PRconditionTypes.ClusterProvisioned))
+	return condition != nil && condition.Reason == string(provisioningv1alpha1.CRconditionReasons.ClusterMissing)
+}
+
 // IsSmoRegistrationCompleted checks if registration with SMO has been completed
 func IsSmoRegistrationCompleted(cr *inventoryv1alpha1.Inventory) bool {
 	condition := meta.FindStatusCondition(cr.Status.Conditions,
@@ -186,6 +186,9 @@ const (
 const (
 	TLSSkipVerifyEnvName      = "INSECURE_SKIP_VERIFY"
 	TLSSkipVerifyDefaultValue = false
+	ChaosTestingEnvName       = "ORAN_O2IMS_CHAOS_TESTING"
+	ChaosTestingDefaultValue  = false
+	MinTemplateVersionEnvName = "ORAN_O2IMS_MIN_TEMPLATE_VERSION"
 )
 
 // Label specific to ACM child policies.
@@ -195,6 +198,12 @@ const (
 	ChildPolicyClusterNamespaceLabel = "policy.open-cluster-management.io/cluster-namespace"
 )
 
+// Labels through which the hub reports the availability of the add-on agents deployed on a ManagedCluster.
+const (
+	ManagedClusterAddOnLabelPrefix = "feature.open-cluster-management.io/addon-"
+	ManagedClusterAddOnAvailable   = "available"
+)
+
 // Hardware Manager plugin constants
 const (
 	UnitTestHwmgrID        = "hwmgr"
@@ -14,6 +14,11 @@ func (i *InputError) Error() string {
 	return i.err.Error()
 }
 
+// Unwrap returns the wrapped error so that more specific input errors can be detected
+func (i *InputError) Unwrap() error {
+	return i.err
+}
+
 func NewInputError(format string, args ...interface{}) *InputError {
 	return &InputError{
 		err: fmt.Errorf(format, args...),
@@ -25,3 +30,40 @@ func IsInputError(err error) bool {
 
 	return errors.As(err, &inputErr)
 }
+
+// TemplateVersionTooOldErr represents an error when a ProvisioningRequest references a ClusterTemplate
+// version below the minimum accepted version
+type TemplateVersionTooOldErr struct {
+	Version    string
+	MinVersion string
+}
+
+func (e *TemplateVersionTooOldErr) Error() string {
+	return fmt.Sprintf("template version %s is below the minimum accepted version %s", e.Version, e.MinVersion)
+}
+
+// IsTemplateVersionTooOldErr checks if the given error is of type TemplateVersionTooOldErr
+func IsTemplateVersionTooOldErr(err error) bool {
+	var customErr *TemplateVersionTooOldErr
+	return errors.As(err, &customErr)
+}
+
+// MissingReferenceErr represents an error when an object referenced by the ClusterInstance input of a
+// ProvisioningRequest does not exist yet
+type MissingReferenceErr struct {
+	Kind      string
+	Name      string
+	Field     string
+	Namespace string
+}
+
+func (e *MissingReferenceErr) Error() string {
+	return fmt.Sprintf("the %s %s referenced by %s does not exist in the %s namespace",
+		e.Kind, e.Name, e.Field, e.Namespace)
+}
+
+// IsMissingReferenceErr checks if the given error is of type MissingReferenceErr
+func IsMissingReferenceErr(err error) bool {
+	var customErr *MissingReferenceErr
+	return errors.As(err, &customErr)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -41,6 +43,24 @@ func IsConditionDoesNotExistsErr(err error) bool {
 	return errors.As(err, &customErr)
 }
 
+// PlacementConstraintUnmetErr represents an error when the allocated nodes do not satisfy
+// a placement constraint of the NodePool
+type PlacementConstraintUnmetErr struct {
+	Message string
+}
+
+// Error implements the error interface for PlacementConstraintUnmetErr,
+// returning a formatted error message
+func (e *PlacementConstraintUnmetErr) Error() string {
+	return fmt.Sprintf("Placement constraint unmet: %s", e.Message)
+}
+
+// IsPlacementConstraintUnmetErr checks if the given error is of type PlacementConstraintUnmetErr
+func IsPlacementConstraintUnmetErr(err error) bool {
+	var customErr *PlacementConstraintUnmetErr
+	return errors.As(err, &customErr)
+}
+
 // getBootInterfaceLabel extracts the boot interface label from the NodePool annotations
 func getBootInterfaceLabel(nodePool *hwv1alpha1.NodePool) (string, error) {
 	// Get the annotations from the NodePool
@@ -113,6 +133,128 @@ func CollectNodeDetails(ctx context.Context, c client.Client,
 	return hwNodes, nil
 }
 
+// GetNodePoolNodes returns the Node objects allocated to the NodePool
+func GetNodePoolNodes(ctx context.Context, c client.Client, nodePool *hwv1alpha1.NodePool) ([]*hwv1alpha1.Node, error) {
+	nodes := []*hwv1alpha1.Node{}
+	for _, nodeName := range nodePool.Status.Properties.NodeNames {
+		node := &hwv1alpha1.Node{}
+		exists, err := DoesK8SResourceExist(ctx, c, nodeName, nodePool.Namespace, node)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the Node object %s in namespace %s: %w",
+				nodeName, nodePool.Namespace, err)
+		}
+		if !exists {
+			return nil, fmt.Errorf("the Node object %s in namespace %s does not exist", nodeName, nodePool.Namespace)
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// GetDegradedNodes returns a description of the nodes that the hardware plugin no longer reports as healthy, i.e.
+// whose Provisioned condition is not true anymore or with a condition reporting a failure.
+func GetDegradedNodes(nodes []*hwv1alpha1.Node) []string {
+	var degraded []string
+	for _, node := range nodes {
+		for _, condition := range node.Status.Conditions {
+			if condition.Reason == string(hwv1alpha1.Failed) ||
+				(condition.Type == string(hwv1alpha1.Provisioned) && condition.Status != metav1.ConditionTrue) {
+				degraded = append(degraded, fmt.Sprintf("%s (%s)", node.Name, condition.Message))
+				break
+			}
+		}
+	}
+	return degraded
+}
+
+// ComputeResourceAccounting summarizes the allocated nodes by hardware profile and totals their compute capacity
+// based on the profile capacities defined in the hardware template. Profiles without a defined capacity are counted
+// but do not contribute to the vCPU and memory totals.
+func ComputeResourceAccounting(hwTemplate *hwv1alpha1.HardwareTemplate,
+	nodes []*hwv1alpha1.Node) *provisioningv1alpha1.ResourceAccounting {
+
+	capacities := make(map[string]hwv1alpha1.HwProfileCapacity)
+	for _, capacity := range hwTemplate.Spec.HwProfileCapacities {
+		capacities[capacity.HwProfile] = capacity
+	}
+
+	accounting := &provisioningv1alpha1.ResourceAccounting{
+		NodeCountByProfile: make(map[string]int),
+	}
+	for _, node := range nodes {
+		// The status reflects the profile actually applied, fallback to the requested one
+		profile := node.Status.HwProfile
+		if profile == "" {
+			profile = node.Spec.HwProfile
+		}
+
+		accounting.NodeCount++
+		accounting.NodeCountByProfile[profile]++
+		if capacity, exists := capacities[profile]; exists {
+			accounting.TotalVCPUs += capacity.VCPUs
+			accounting.TotalMemoryGiB += capacity.MemoryGiB
+		}
+	}
+
+	return accounting
+}
+
+// CheckPlacementConstraints verifies that the nodes allocated to the NodePool are spread across
+// the fault domains requested by its placement constraints. A PlacementConstraintUnmetErr is
+// returned if any of the constraints is violated or references a node group that the NodePool
+// does not have.
+func CheckPlacementConstraints(ctx context.Context, c client.Client, nodePool *hwv1alpha1.NodePool) error {
+	if len(nodePool.Spec.PlacementConstraints) == 0 {
+		return nil
+	}
+
+	nodes, err := GetNodePoolNodes(ctx, c, nodePool)
+	if err != nil {
+		return err
+	}
+
+	groupNames := make(map[string]bool)
+	for _, group := range nodePool.Spec.NodeGroup {
+		groupNames[group.NodePoolData.Name] = true
+	}
+
+	for _, constraint := range nodePool.Spec.PlacementConstraints {
+		// A constraint on an unknown node group would otherwise be met by matching no nodes
+		if constraint.NodeGroupName != "" && !groupNames[constraint.NodeGroupName] {
+			return &PlacementConstraintUnmetErr{
+				Message: fmt.Sprintf("the NodePool has no node group %s", constraint.NodeGroupName),
+			}
+		}
+
+		domains := make(map[string]struct{})
+		for _, node := range nodes {
+			if constraint.NodeGroupName != "" && node.Spec.GroupName != constraint.NodeGroupName {
+				continue
+			}
+			domain, exists := node.GetLabels()[constraint.TopologyKey]
+			if !exists || domain == "" {
+				return &PlacementConstraintUnmetErr{
+					Message: fmt.Sprintf("node %s is missing the topology label %s", node.Name, constraint.TopologyKey),
+				}
+			}
+			domains[domain] = struct{}{}
+		}
+
+		if len(domains) < constraint.MinDomains {
+			scope := "the NodePool"
+			if constraint.NodeGroupName != "" {
+				scope = fmt.Sprintf("node group %s", constraint.NodeGroupName)
+			}
+			return &PlacementConstraintUnmetErr{
+				Message: fmt.Sprintf("nodes of %s span %d %s domain(s), at least %d required",
+					scope, len(domains), constraint.TopologyKey, constraint.MinDomains),
+			}
+		}
+	}
+
+	return nil
+}
+
 // copyHwMgrPluginBMCSecret copies the BMC secret from the plugin namespace to the cluster namespace
 func copyHwMgrPluginBMCSecret(ctx context.Context, c client.Client, name, sourceNamespace, targetNamespace string) error {
 
@@ -389,6 +531,31 @@ func GetRoleToGroupNameMap(nodePool *hwv1alpha1.NodePool) map[string]string {
 	return roleToNodeGroupName
 }
 
+// GetHwTemplateNameForSite returns the name of the HardwareTemplate selected by the ClusterTemplate templates
+// for the given site: the one mapped to the site in SiteHwTemplates, or the default HwTemplate otherwise.
+func GetHwTemplateNameForSite(templates provisioningv1alpha1.Templates, siteID string) string {
+	if name, ok := templates.SiteHwTemplates[siteID]; ok && name != "" {
+		return name
+	}
+	return templates.HwTemplate
+}
+
+// GetReferencedHwTemplates returns the sorted names of all the HardwareTemplates referenced by the
+// ClusterTemplate templates, including the default HwTemplate and the per-site ones.
+func GetReferencedHwTemplates(templates provisioningv1alpha1.Templates) []string {
+	names := []string{}
+	if templates.HwTemplate != "" {
+		names = append(names, templates.HwTemplate)
+	}
+	for _, name := range templates.SiteHwTemplates {
+		if name != "" && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
 // GetHardwareTemplate retrieves the hardware template resource for a given name
 func GetHardwareTemplate(ctx context.Context, c client.Client, hwTemplateName string) (*hwv1alpha1.HardwareTemplate, error) {
 	hwTemplate := &hwv1alpha1.HardwareTemplate{}
@@ -443,22 +610,113 @@ func UpdateHardwareTemplateStatusCondition(ctx context.Context, c client.Client,
 	conditionType provisioningv1alpha1.ConditionType, conditionReason provisioningv1alpha1.ConditionReason,
 	conditionStatus metav1.ConditionStatus, message string) error {
 
-	SetStatusCondition(&hardwareTemplate.Status.Conditions,
-		conditionType,
-		conditionReason,
-		conditionStatus,
-		message)
-
-	err := UpdateK8sCRStatus(ctx, c, hardwareTemplate)
+	err := UpdateK8sCRStatusWithMutation(ctx, c, hardwareTemplate, func(latest *hwv1alpha1.HardwareTemplate) {
+		SetStatusCondition(&latest.Status.Conditions,
+			conditionType,
+			conditionReason,
+			conditionStatus,
+			message)
+	})
 	if err != nil {
 		return fmt.Errorf("failed to update status for HardwareTemplate %s: %w", hardwareTemplate.Name, err)
 	}
 	return nil
 }
 
+// ValidateHardwareTemplate checks the spec of the HardwareTemplate without accessing the cluster and returns every
+// problem found, with the path of the offending field. It covers what the CRD schema enforces as well, so that a
+// HardwareTemplate file can be checked before it is applied.
+func ValidateHardwareTemplate(hwTemplate *hwv1alpha1.HardwareTemplate) field.ErrorList {
+	var errs field.ErrorList
+	spec := field.NewPath("spec")
+
+	if hwTemplate.Spec.HwMgrId == "" {
+		errs = append(errs, field.Required(spec.Child("hwMgrId"), "the hardware manager plugin must be set"))
+	}
+	if hwTemplate.Spec.BootInterfaceLabel == "" {
+		errs = append(errs, field.Required(spec.Child("bootInterfaceLabel"), "the boot interface label must be set"))
+	}
+	if _, err := parseHardwareProvisioningTimeout(hwTemplate); err != nil {
+		errs = append(errs, err)
+	}
+
+	nodePoolDataPath := spec.Child("nodePoolData")
+	if len(hwTemplate.Spec.NodePoolData) == 0 {
+		errs = append(errs, field.Required(nodePoolDataPath, "at least one node group must be defined"))
+	}
+	groupNames := map[string]bool{}
+	for i, group := range hwTemplate.Spec.NodePoolData {
+		groupPath := nodePoolDataPath.Index(i)
+		if group.Name == "" {
+			errs = append(errs, field.Required(groupPath.Child("name"), ""))
+		} else if groupNames[group.Name] {
+			errs = append(errs, field.Duplicate(groupPath.Child("name"), group.Name))
+		}
+		groupNames[group.Name] = true
+		if group.Role != "master" && group.Role != "worker" {
+			errs = append(errs, field.NotSupported(groupPath.Child("role"), group.Role, []string{"master", "worker"}))
+		}
+		if group.HwProfile == "" {
+			errs = append(errs, field.Required(groupPath.Child("hwProfile"), ""))
+		}
+		if group.ResourcePoolId == "" {
+			errs = append(errs, field.Required(groupPath.Child("resourcePoolId"), ""))
+		}
+	}
+
+	for i, constraint := range hwTemplate.Spec.PlacementConstraints {
+		constraintPath := spec.Child("placementConstraints").Index(i)
+		if constraint.TopologyKey == "" {
+			errs = append(errs, field.Required(constraintPath.Child("topologyKey"), ""))
+		}
+		if constraint.MinDomains < 1 {
+			errs = append(errs, field.Invalid(constraintPath.Child("minDomains"), constraint.MinDomains,
+				"must be at least 1"))
+		}
+		if constraint.NodeGroupName != "" && !groupNames[constraint.NodeGroupName] {
+			errs = append(errs, field.NotFound(constraintPath.Child("nodeGroupName"), constraint.NodeGroupName))
+		}
+	}
+
+	for i, capacity := range hwTemplate.Spec.HwProfileCapacities {
+		capacityPath := spec.Child("hwProfileCapacities").Index(i)
+		if capacity.HwProfile == "" {
+			errs = append(errs, field.Required(capacityPath.Child("hwProfile"), ""))
+		}
+		if capacity.VCPUs < 0 {
+			errs = append(errs, field.Invalid(capacityPath.Child("vcpus"), capacity.VCPUs, "must not be negative"))
+		}
+		if capacity.MemoryGiB < 0 {
+			errs = append(errs, field.Invalid(capacityPath.Child("memoryGiB"), capacity.MemoryGiB,
+				"must not be negative"))
+		}
+	}
+
+	return errs
+}
+
+// parseHardwareProvisioningTimeout parses the hardware provisioning timeout of a HardwareTemplate, returning 0 if it
+// is not set, or an error naming the offending field if it is not a positive duration string.
+func parseHardwareProvisioningTimeout(hwTemplate *hwv1alpha1.HardwareTemplate) (time.Duration, *field.Error) {
+	timeout := hwTemplate.Spec.HardwareProvisioningTimeout
+	if timeout == "" {
+		return 0, nil
+	}
+
+	path := field.NewPath("spec", "hardwareProvisioningTimeout")
+	duration, err := time.ParseDuration(timeout)
+	if err != nil {
+		return 0, field.Invalid(path, timeout, fmt.Sprintf("not a valid duration string: %s", err.Error()))
+	}
+	if duration <= 0 {
+		return 0, field.Invalid(path, timeout, "must be a positive duration")
+	}
+	return duration, nil
+}
+
 // GetTimeoutFromHWTemplate retrieves the timeout value from the hardware template resource.
-// converting it from duration string to time.Duration. Returns an error if the value is not a
-// valid duration string.
+// converting it from duration string to time.Duration. Returns an error naming the offending
+// field if the value is not a positive duration string.
 func GetTimeoutFromHWTemplate(ctx context.Context, c client.Client, name string) (time.Duration, error) {
 
 	hwTemplate, err := GetHardwareTemplate(ctx, c, name)
@@ -466,21 +724,17 @@ func GetTimeoutFromHWTemplate(ctx context.Context, c client.Client, name string)
 		return 0, err
 	}
 
-	if hwTemplate.Spec.HardwareProvisioningTimeout != "" {
-		timeout, err := time.ParseDuration(hwTemplate.Spec.HardwareProvisioningTimeout)
-		if err != nil {
-			errMessage := fmt.Sprintf("the value of HardwareProvisioningTimeout from hardware template %s is not a valid duration string: %v",
-				name, err)
-			updateErr := UpdateHardwareTemplateStatusCondition(ctx, c, hwTemplate, provisioningv1alpha1.ConditionType(hwv1alpha1.Validation),
-				provisioningv1alpha1.ConditionReason(hwv1alpha1.Failed), metav1.ConditionFalse, errMessage)
-			if updateErr != nil {
-				// nolint: wrapcheck
-				return 0, updateErr
-			}
-			return 0, NewInputError("%s", errMessage)
+	timeout, fieldErr := parseHardwareProvisioningTimeout(hwTemplate)
+	if fieldErr != nil {
+		errMessage := fmt.Sprintf("hardware template %s is invalid: %s", name, fieldErr.Error())
+		updateErr := UpdateHardwareTemplateStatusCondition(ctx, c, hwTemplate, provisioningv1alpha1.ConditionType(hwv1alpha1.Validation),
+			provisioningv1alpha1.ConditionReason(hwv1alpha1.Failed), metav1.ConditionFalse, errMessage)
+		if updateErr != nil {
+			// nolint: wrapcheck
+			return 0, updateErr
 		}
-		return timeout, nil
+		return 0, NewInputError("%s", errMessage)
 	}
 
-	return 0, nil
+	return timeout, nil
 }
@@ -0,0 +1,87 @@
+package utils
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	hwv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
+)
+
+var _ = Describe("ValidateHardwareTemplate", func() {
+	var hwTemplate *hwv1alpha1.HardwareTemplate
+
+	BeforeEach(func() {
+		hwTemplate = &hwv1alpha1.HardwareTemplate{
+			Spec: hwv1alpha1.HardwareTemplateSpec{
+				HwMgrId:                     "hwmgr",
+				BootInterfaceLabel:          "bootable-interface",
+				HardwareProvisioningTimeout: "60m",
+				NodePoolData: []hwv1alpha1.NodePoolData{
+					{Name: "controller", Role: "master", HwProfile: "profile-spr", ResourcePoolId: "xyz"},
+					{Name: "worker", Role: "worker", HwProfile: "profile-spr", ResourcePoolId: "xyz"},
+				},
+				PlacementConstraints: []hwv1alpha1.PlacementConstraint{
+					{TopologyKey: "rack", MinDomains: 2, NodeGroupName: "worker"},
+				},
+			},
+		}
+	})
+
+	fields := func(errs field.ErrorList) []string {
+		result := []string{}
+		for _, err := range errs {
+			result = append(result, err.Field)
+		}
+		return result
+	}
+
+	It("accepts a valid HardwareTemplate", func() {
+		Expect(ValidateHardwareTemplate(hwTemplate)).To(BeEmpty())
+	})
+
+	It("reports every problem with the path of the offending field", func() {
+		hwTemplate.Spec.HwMgrId = ""
+		hwTemplate.Spec.HardwareProvisioningTimeout = "90"
+		hwTemplate.Spec.NodePoolData[0].ResourcePoolId = ""
+		hwTemplate.Spec.NodePoolData[1].Name = "controller"
+		hwTemplate.Spec.NodePoolData[1].HwProfile = ""
+		hwTemplate.Spec.PlacementConstraints[0].NodeGroupName = "storage"
+
+		errs := ValidateHardwareTemplate(hwTemplate)
+		Expect(fields(errs)).To(Equal([]string{
+			"spec.hwMgrId",
+			"spec.hardwareProvisioningTimeout",
+			"spec.nodePoolData[0].resourcePoolId",
+			"spec.nodePoolData[1].name",
+			"spec.nodePoolData[1].hwProfile",
+			"spec.placementConstraints[0].nodeGroupName",
+		}))
+		Expect(errs[1].Error()).To(ContainSubstring("not a valid duration string"))
+		Expect(errs[3].Type).To(Equal(field.ErrorTypeDuplicate))
+	})
+
+	It("rejects a placement constraint on an unknown node group", func() {
+		hwTemplate.Spec.PlacementConstraints[0].NodeGroupName = "wroker"
+
+		errs := ValidateHardwareTemplate(hwTemplate)
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Type).To(Equal(field.ErrorTypeNotFound))
+		Expect(errs[0].Error()).To(Equal(`spec.placementConstraints[0].nodeGroupName: Not found: "wroker"`))
+	})
+
+	It("rejects a timeout that is not a positive duration", func() {
+		hwTemplate.Spec.HardwareProvisioningTimeout = "-5m"
+
+		errs := ValidateHardwareTemplate(hwTemplate)
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Error()).To(Equal(`spec.hardwareProvisioningTimeout: Invalid value: "-5m": must be a positive duration`))
+	})
+
+	It("requires at least one node group", func() {
+		hwTemplate.Spec.NodePoolData = nil
+		hwTemplate.Spec.PlacementConstraints = nil
+
+		Expect(fields(ValidateHardwareTemplate(hwTemplate))).To(Equal([]string{"spec.nodePoolData"}))
+	})
+})
@@ -102,6 +102,55 @@ func ExtractTimeoutFromConfigMap(cm *corev1.ConfigMap, key string) (time.Duratio
 	return 0, nil
 }
 
+// ParseClusterTemplateTimeout parses a timeout set in a field of the ClusterTemplate spec. It returns 0 if
+// the timeout is not set.
+func ParseClusterTemplateTimeout(value, field string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, NewInputError("the value of spec.%s is not a valid duration string: %v", field, err)
+	}
+	if timeout <= 0 {
+		return 0, NewInputError("the value of spec.%s must be a positive duration: %s", field, value)
+	}
+	return timeout, nil
+}
+
+// TimeUntilChangeWindow returns how long to wait, from the given time, for the change window to open. It returns 0
+// if the window is open at that time. A window whose end is earlier than its start spans midnight, and one whose end
+// is equal to its start is always open.
+func TimeUntilChangeWindow(window *provisioningv1alpha1.ChangeWindow, now time.Time) (time.Duration, error) {
+	start, err := time.Parse("15:04", window.Start)
+	if err != nil {
+		return 0, NewInputError("the value of spec.configurationChangeWindow.start is not a valid time: %v", err)
+	}
+	end, err := time.Parse("15:04", window.End)
+	if err != nil {
+		return 0, NewInputError("the value of spec.configurationChangeWindow.end is not a valid time: %v", err)
+	}
+
+	now = now.UTC()
+	sinceMidnight := time.Duration(now.Hour())*time.Hour + time.Duration(now.Minute())*time.Minute +
+		time.Duration(now.Second())*time.Second
+	startOffset := time.Duration(start.Hour())*time.Hour + time.Duration(start.Minute())*time.Minute
+	endOffset := time.Duration(end.Hour())*time.Hour + time.Duration(end.Minute())*time.Minute
+
+	switch {
+	case startOffset == endOffset:
+		return 0, nil
+	case startOffset < endOffset && sinceMidnight >= startOffset && sinceMidnight < endOffset:
+		return 0, nil
+	case startOffset > endOffset && (sinceMidnight >= startOffset || sinceMidnight < endOffset):
+		return 0, nil
+	case sinceMidnight < startOffset:
+		return startOffset - sinceMidnight, nil
+	default:
+		return 24*time.Hour - sinceMidnight + startOffset, nil
+	}
+}
+
 // RenderTemplateForK8sCR returns a rendered K8s resource with an given template and object data
 func RenderTemplateForK8sCR(templateName, templatePath string, templateDataObj map[string]any) (*unstructured.Unstructured, error) {
 	renderedTemplate := &unstructured.Unstructured{}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -550,6 +551,42 @@ var _ = Describe("FindClusterInstanceImmutableFieldUpdates", func() {
 	})
 })
 
+var _ = Describe("TimeUntilChangeWindow", func() {
+	now := time.Date(2024, 10, 1, 23, 30, 0, 0, time.UTC)
+
+	It("returns 0 when the window is open", func() {
+		for _, window := range []provisioningv1alpha1.ChangeWindow{
+			{Start: "22:00", End: "23:45"},
+			{Start: "22:00", End: "02:00"},
+			{Start: "04:00", End: "04:00"},
+		} {
+			untilOpen, err := TimeUntilChangeWindow(&window, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(untilOpen).To(BeZero(), "window %s-%s", window.Start, window.End)
+		}
+	})
+
+	It("returns the time until the window opens when it is closed", func() {
+		untilOpen, err := TimeUntilChangeWindow(&provisioningv1alpha1.ChangeWindow{Start: "02:00", End: "04:00"}, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(untilOpen).To(Equal(150 * time.Minute))
+
+		untilOpen, err = TimeUntilChangeWindow(&provisioningv1alpha1.ChangeWindow{Start: "22:00", End: "23:00"}, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(untilOpen).To(Equal(22*time.Hour + 30*time.Minute))
+
+		untilOpen, err = TimeUntilChangeWindow(&provisioningv1alpha1.ChangeWindow{Start: "23:45", End: "01:00"}, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(untilOpen).To(Equal(15 * time.Minute))
+	})
+
+	It("rejects an invalid window", func() {
+		_, err := TimeUntilChangeWindow(&provisioningv1alpha1.ChangeWindow{Start: "25:00", End: "01:00"}, now)
+		Expect(err).To(MatchError(ContainSubstring("spec.configurationChangeWindow.start is not a valid time")))
+		Expect(IsInputError(err)).To(BeTrue())
+	})
+})
+
 var _ = Describe("ClusterIsReadyForPolicyConfig", func() {
 	var (
 		ctx         context.Context
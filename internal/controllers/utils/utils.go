@@ -15,6 +15,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/coreos/go-semver/semver"
 	"github.com/google/uuid"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
@@ -49,9 +50,33 @@ var (
 	oranUtilsLog = ctrl.Log.WithName("oranUtilsLog")
 )
 
+// UpdateK8sCRStatus updates the status subresource of the object. On a conflict, the latest version of the object is
+// fetched and the status set on the object is applied to it again before retrying. Callers changing only part of the
+// status should use UpdateK8sCRStatusWithMutation instead, so that the status changes made concurrently are kept.
 func UpdateK8sCRStatus(ctx context.Context, c client.Client, object client.Object) error {
+	desired, ok := object.DeepCopyObject().(client.Object)
+	if !ok {
+		return fmt.Errorf("failed to copy object %s", object.GetName())
+	}
+	return UpdateK8sCRStatusWithMutation(ctx, c, object, func(latest client.Object) {
+		copyStatus(desired, latest)
+	})
+}
+
+// UpdateK8sCRStatusWithMutation applies the status mutation to the object and updates its status subresource. On a
+// conflict, the latest version of the object is fetched into the object, and the mutation applied again before
+// retrying. The mutation must therefore only depend on the object it is given.
+func UpdateK8sCRStatusWithMutation[T client.Object](ctx context.Context, c client.Client, object T, mutate func(T)) error {
+	mutate(object)
 	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
-		if err := c.Status().Update(ctx, object); err != nil {
+		err := c.Status().Update(ctx, object)
+		if errors.IsConflict(err) {
+			if getErr := c.Get(ctx, client.ObjectKeyFromObject(object), object); getErr != nil {
+				return fmt.Errorf("failed to refetch object %s after conflict: %w", object.GetName(), getErr)
+			}
+			mutate(object)
+		}
+		if err != nil {
 			return fmt.Errorf("failed to update status: %w", err)
 		}
 		return nil
@@ -64,6 +89,15 @@ func UpdateK8sCRStatus(ctx context.Context, c client.Client, object client.Objec
 	return nil
 }
 
+// copyStatus copies the Status field of the source object to the destination object of the same type
+func copyStatus(source, destination client.Object) {
+	status := reflect.ValueOf(source).Elem().FieldByName("Status")
+	if !status.IsValid() {
+		return
+	}
+	reflect.ValueOf(destination).Elem().FieldByName("Status").Set(status)
+}
+
 // CreateK8sCR creates/updates/patches an object.
 func CreateK8sCR(ctx context.Context, c client.Client,
 	newObject client.Object, ownerObject client.Object,
@@ -642,6 +676,49 @@ func GetTLSSkipVerify() bool {
 	return result
 }
 
+// GetChaosTestingEnabled returns whether the test-only chaos hooks (e.g., forced failures) are enabled
+func GetChaosTestingEnabled() bool {
+	value, ok := os.LookupEnv(ChaosTestingEnvName)
+	if !ok {
+		return ChaosTestingDefaultValue
+	}
+
+	result, err := strconv.ParseBool(value)
+	if err != nil {
+		oranUtilsLog.Error(err, fmt.Sprintf("Error parsing '%s' variable value '%s'",
+			ChaosTestingEnvName, value))
+		return ChaosTestingDefaultValue
+	}
+
+	return result
+}
+
+// GetMinTemplateVersion returns the minimum ClusterTemplate version accepted by ProvisioningRequests, or an empty
+// string if no minimum is enforced
+func GetMinTemplateVersion() string {
+	return strings.TrimSpace(os.Getenv(MinTemplateVersionEnvName))
+}
+
+// IsSemanticTemplateVersion checks whether a template version is a semantic version; the "v" prefix is optional.
+func IsSemanticTemplateVersion(version string) bool {
+	_, err := semver.NewVersion(strings.TrimPrefix(version, "v"))
+	return err == nil
+}
+
+// IsTemplateVersionBelow checks whether a template version is lower than the given minimum version. Both versions
+// must be semantic versions; the "v" prefix is optional.
+func IsTemplateVersionBelow(version, minVersion string) (bool, error) {
+	parsedVersion, err := semver.NewVersion(strings.TrimPrefix(version, "v"))
+	if err != nil {
+		return false, fmt.Errorf("template version %q is not a semantic version: %w", version, err)
+	}
+	parsedMinVersion, err := semver.NewVersion(strings.TrimPrefix(minVersion, "v"))
+	if err != nil {
+		return false, fmt.Errorf("minimum template version %q is not a semantic version: %w", minVersion, err)
+	}
+	return parsedVersion.LessThan(*parsedMinVersion), nil
+}
+
 // loadDefaultCABundles loads the default service account and ingress CA bundles.  This should only be invoked if TLS
 // verification has not been disabled since the expectation is that it will only need to be disabled when testing as a
 // standalone binary in which case the paths to the bundles won't be present.  Otherwise, we always expect the bundles
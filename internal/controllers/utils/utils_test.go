@@ -23,14 +23,18 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	inventoryv1alpha1 "github.com/openshift-kni/oran-o2ims/api/inventory/v1alpha1"
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
 	openshiftv1 "github.com/openshift/api/operator/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
 // Scheme used for the tests:
@@ -269,6 +273,132 @@ var _ = Describe("DoesK8SResourceExist", func() {
 	})
 })
 
+var _ = Describe("UpdateK8sCRStatus", func() {
+	var (
+		ctx       context.Context
+		c         client.Client
+		inventory *inventoryv1alpha1.Inventory
+		attempts  int
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		attempts = 0
+		suitescheme.AddKnownTypes(inventoryv1alpha1.GroupVersion, &inventoryv1alpha1.Inventory{})
+		inventory = &inventoryv1alpha1.Inventory{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "oran-o2ims-sample-1",
+				Namespace: InventoryNamespace,
+			},
+		}
+		c = interceptor.NewClient(getFakeClientFromObjects(inventory), interceptor.Funcs{
+			SubResourceUpdate: func(ctx context.Context, client client.Client, subResourceName string,
+				obj client.Object, opts ...client.SubResourceUpdateOption) error {
+				attempts++
+				return client.SubResource(subResourceName).Update(ctx, obj, opts...)
+			},
+		})
+	})
+
+	It("refetches and retries when the first update conflicts", func() {
+		stale := &inventoryv1alpha1.Inventory{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(inventory), stale)).To(Succeed())
+
+		// A concurrent writer bumps the resourceVersion
+		concurrent := stale.DeepCopy()
+		concurrent.Status.IngressHost = "concurrent"
+		Expect(c.Status().Update(ctx, concurrent)).To(Succeed())
+		attempts = 0
+
+		stale.Status.ClusterID = "desired"
+		Expect(UpdateK8sCRStatus(ctx, c, stale)).To(Succeed())
+		Expect(attempts).To(Equal(2))
+
+		updated := &inventoryv1alpha1.Inventory{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(inventory), updated)).To(Succeed())
+		Expect(updated.Status.ClusterID).To(Equal("desired"))
+	})
+
+	It("applies the mutation again to the latest version when the first update conflicts", func() {
+		stale := &inventoryv1alpha1.Inventory{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(inventory), stale)).To(Succeed())
+
+		// A concurrent writer bumps the resourceVersion
+		concurrent := stale.DeepCopy()
+		concurrent.Status.IngressHost = "concurrent"
+		Expect(c.Status().Update(ctx, concurrent)).To(Succeed())
+		attempts = 0
+
+		Expect(UpdateK8sCRStatusWithMutation(ctx, c, stale, func(latest *inventoryv1alpha1.Inventory) {
+			latest.Status.ClusterID = "desired"
+		})).To(Succeed())
+		Expect(attempts).To(Equal(2))
+
+		updated := &inventoryv1alpha1.Inventory{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(inventory), updated)).To(Succeed())
+		Expect(updated.Status.ClusterID).To(Equal("desired"))
+		Expect(updated.Status.IngressHost).To(Equal("concurrent"))
+		Expect(stale.Status.IngressHost).To(Equal("concurrent"))
+	})
+
+	It("returns the conflict once the retries are exhausted", func() {
+		conflicting := interceptor.NewClient(getFakeClientFromObjects(inventory), interceptor.Funcs{
+			SubResourceUpdate: func(ctx context.Context, client client.Client, subResourceName string,
+				obj client.Object, opts ...client.SubResourceUpdateOption) error {
+				attempts++
+				return errors.NewConflict(inventoryv1alpha1.GroupVersion.WithResource("inventories").GroupResource(),
+					obj.GetName(), fmt.Errorf("the object has been modified"))
+			},
+		})
+		current := &inventoryv1alpha1.Inventory{}
+		Expect(conflicting.Get(ctx, client.ObjectKeyFromObject(inventory), current)).To(Succeed())
+
+		current.Status.ClusterID = "desired"
+		err := UpdateK8sCRStatus(ctx, conflicting, current)
+		Expect(errors.IsConflict(err)).To(BeTrue())
+		Expect(attempts).To(Equal(retry.DefaultRetry.Steps))
+	})
+
+	It("updates once when there is no conflict", func() {
+		current := &inventoryv1alpha1.Inventory{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(inventory), current)).To(Succeed())
+
+		current.Status.ClusterID = "desired"
+		Expect(UpdateK8sCRStatus(ctx, c, current)).To(Succeed())
+		Expect(attempts).To(Equal(1))
+	})
+})
+
+var _ = Describe("SetStatusCondition", func() {
+	It("keeps the conditions in their canonical order regardless of the set order", func() {
+		conditions := []metav1.Condition{}
+		for _, conditionType := range []provisioningv1alpha1.ConditionType{
+			provisioningv1alpha1.PRconditionTypes.ConfigurationApplied,
+			"Custom",
+			provisioningv1alpha1.PRconditionTypes.HardwareProvisioned,
+			provisioningv1alpha1.PRconditionTypes.Validated,
+			provisioningv1alpha1.PRconditionTypes.ClusterProvisioned,
+			provisioningv1alpha1.PRconditionTypes.ClusterInstanceRendered,
+		} {
+			SetStatusCondition(&conditions, conditionType, provisioningv1alpha1.CRconditionReasons.Completed,
+				metav1.ConditionTrue, "")
+		}
+
+		conditionTypes := []string{}
+		for _, condition := range conditions {
+			conditionTypes = append(conditionTypes, condition.Type)
+		}
+		Expect(conditionTypes).To(Equal([]string{
+			string(provisioningv1alpha1.PRconditionTypes.Validated),
+			string(provisioningv1alpha1.PRconditionTypes.ClusterInstanceRendered),
+			string(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned),
+			string(provisioningv1alpha1.PRconditionTypes.ClusterProvisioned),
+			string(provisioningv1alpha1.PRconditionTypes.ConfigurationApplied),
+			"Custom",
+		}))
+	})
+})
+
 var _ = Describe("GetIngressDomain", func() {
 
 	It("If ingress controller does not exist, return error", func() {
@@ -679,3 +809,35 @@ func Test_mapKeysToSlice(t *testing.T) {
 		})
 	}
 }
+
+var _ = Describe("IsSemanticTemplateVersion", func() {
+	It("accepts semantic versions with or without the v prefix", func() {
+		Expect(IsSemanticTemplateVersion("v1.0.0")).To(BeTrue())
+		Expect(IsSemanticTemplateVersion("4.16.1")).To(BeTrue())
+	})
+
+	It("rejects other versions", func() {
+		Expect(IsSemanticTemplateVersion("v4-16")).To(BeFalse())
+	})
+})
+
+var _ = Describe("IsTemplateVersionBelow", func() {
+	It("compares semantic versions with or without the v prefix", func() {
+		below, err := IsTemplateVersionBelow("v1.0.0", "1.1.0")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(below).To(BeTrue())
+
+		below, err = IsTemplateVersionBelow("1.1.0", "v1.1.0")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(below).To(BeFalse())
+
+		below, err = IsTemplateVersionBelow("v1.10.0", "v1.9.0")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(below).To(BeFalse())
+	})
+
+	It("fails on versions that are not semantic versions", func() {
+		_, err := IsTemplateVersionBelow("v4-16", "v1.0.0")
+		Expect(err).To(MatchError(ContainSubstring(`template version "v4-16" is not a semantic version`)))
+	})
+})
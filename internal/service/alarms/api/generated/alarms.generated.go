@@ -141,9 +141,15 @@ type AlarmEventRecord struct {
 	// PerceivedSeverity This is an enumerated set of values which identify the perceived severity of the alarm.
 	PerceivedSeverity PerceivedSeverity `json:"perceivedSeverity"`
 
+	// ProbableCause Human readable hint about the probable cause of the alarm.
+	ProbableCause *string `json:"probableCause,omitempty"`
+
 	// ProbableCauseId A reference to the ProbableCause of the Alarm.
 	ProbableCauseId openapi_types.UUID `json:"probableCauseId"`
 
+	// ProposedRepairActions Human readable hint about the actions proposed to repair the alarm condition.
+	ProposedRepairActions *string `json:"proposedRepairActions,omitempty"`
+
 	// ResourceTypeID A reference to the type of resource which caused the alarm.
 	ResourceTypeID openapi_types.UUID `json:"resourceTypeID"`
 }
@@ -159,7 +165,8 @@ type AlarmEventRecordModifications struct {
 
 // AlarmServiceConfiguration defines model for AlarmServiceConfiguration.
 type AlarmServiceConfiguration struct {
-	// Extensions List of metadata key-value pairs used to associate meaningful metadata to the related alarm service
+	// Extensions List of metadata key-value pairs used to associate meaningful metadata to the related alarm service.
+	// The "maxResolvedAlarms" key limits the number of resolved alarms retained; the oldest are purged first.
 	Extensions *map[string]string `json:"extensions,omitempty"`
 
 	// RetentionPeriod Number of days for alarm history to be retained.
@@ -242,6 +249,33 @@ type HardwareAlert = map[string]interface{}
 // PerceivedSeverity This is an enumerated set of values which identify the perceived severity of the alarm.
 type PerceivedSeverity int
 
+// TestAlarm defines model for TestAlarm.
+type TestAlarm struct {
+	// AlarmExpirationTime Date/Time stamp at which the test alarm is cleared.
+	AlarmExpirationTime time.Time `json:"alarmExpirationTime"`
+
+	// AlarmRaisedTime Date/Time stamp at which the test alarm was raised.
+	AlarmRaisedTime time.Time `json:"alarmRaisedTime"`
+
+	// Fingerprint Unique identifier of the injected test alarm.
+	Fingerprint string `json:"fingerprint"`
+}
+
+// TestAlarmRequest defines model for TestAlarmRequest.
+type TestAlarmRequest struct {
+	// ExpirationSeconds Number of seconds after which the test alarm is cleared. Defaults to 300.
+	ExpirationSeconds *int `json:"expirationSeconds,omitempty"`
+
+	// Extensions Additional properties to set in the extensions of the test alarm.
+	Extensions *map[string]string `json:"extensions,omitempty"`
+
+	// ObjectId Identifier of the cluster the test alarm is raised against, if any.
+	ObjectId *openapi_types.UUID `json:"objectId,omitempty"`
+
+	// PerceivedSeverity This is an enumerated set of values which identify the perceived severity of the alarm.
+	PerceivedSeverity *PerceivedSeverity `json:"perceivedSeverity,omitempty"`
+}
+
 // GetSubscriptionsParams defines parameters for GetSubscriptions.
 type GetSubscriptionsParams struct {
 	// ExcludeFields Comma separated list of field references to exclude from the result.
@@ -427,6 +461,9 @@ type UpdateAlarmServiceConfigurationJSONRequestBody = AlarmServiceConfiguration
 // CreateSubscriptionJSONRequestBody defines body for CreateSubscription for application/json ContentType.
 type CreateSubscriptionJSONRequestBody = AlarmSubscriptionInfo
 
+// InjectTestAlarmJSONRequestBody defines body for InjectTestAlarm for application/json ContentType.
+type InjectTestAlarmJSONRequestBody = TestAlarmRequest
+
 // PatchAlarmJSONRequestBody defines body for PatchAlarm for application/json ContentType.
 type PatchAlarmJSONRequestBody = AlarmEventRecordModifications
 
@@ -465,6 +502,9 @@ type ServerInterface interface {
 	// Retrieve the list of alarms
 	// (GET /o2ims-infrastructureMonitoring/v1/alarms)
 	GetAlarms(w http.ResponseWriter, r *http.Request, params GetAlarmsParams)
+	// Inject a test alarm
+	// (POST /o2ims-infrastructureMonitoring/v1/alarms/test)
+	InjectTestAlarm(w http.ResponseWriter, r *http.Request)
 	// Retrieve exactly one alarm
 	// (GET /o2ims-infrastructureMonitoring/v1/alarms/{alarmEventRecordId})
 	GetAlarm(w http.ResponseWriter, r *http.Request, alarmEventRecordId openapi_types.UUID)
@@ -730,6 +770,20 @@ func (siw *ServerInterfaceWrapper) GetAlarms(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
+// InjectTestAlarm operation middleware
+func (siw *ServerInterfaceWrapper) InjectTestAlarm(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.InjectTestAlarm(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetAlarm operation middleware
 func (siw *ServerInterfaceWrapper) GetAlarm(w http.ResponseWriter, r *http.Request) {
 
@@ -925,6 +979,7 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc("DELETE "+options.BaseURL+"/o2ims-infrastructureMonitoring/v1/alarmSubscriptions/{alarmSubscriptionId}", wrapper.DeleteSubscription)
 	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureMonitoring/v1/alarmSubscriptions/{alarmSubscriptionId}", wrapper.GetSubscription)
 	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureMonitoring/v1/alarms", wrapper.GetAlarms)
+	m.HandleFunc("POST "+options.BaseURL+"/o2ims-infrastructureMonitoring/v1/alarms/test", wrapper.InjectTestAlarm)
 	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureMonitoring/v1/alarms/{alarmEventRecordId}", wrapper.GetAlarm)
 	m.HandleFunc("PATCH "+options.BaseURL+"/o2ims-infrastructureMonitoring/v1/alarms/{alarmEventRecordId}", wrapper.PatchAlarm)
 	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureMonitoring/v1/api_versions", wrapper.GetMinorVersions)
@@ -1363,6 +1418,41 @@ func (response GetAlarms500ApplicationProblemPlusJSONResponse) VisitGetAlarmsRes
 	return json.NewEncoder(w).Encode(response)
 }
 
+type InjectTestAlarmRequestObject struct {
+	Body *InjectTestAlarmJSONRequestBody
+}
+
+type InjectTestAlarmResponseObject interface {
+	VisitInjectTestAlarmResponse(w http.ResponseWriter) error
+}
+
+type InjectTestAlarm201JSONResponse TestAlarm
+
+func (response InjectTestAlarm201JSONResponse) VisitInjectTestAlarmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InjectTestAlarm400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response InjectTestAlarm400ApplicationProblemPlusJSONResponse) VisitInjectTestAlarmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InjectTestAlarm500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response InjectTestAlarm500ApplicationProblemPlusJSONResponse) VisitInjectTestAlarmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetAlarmRequestObject struct {
 	AlarmEventRecordId openapi_types.UUID `json:"alarmEventRecordId"`
 }
@@ -1539,6 +1629,9 @@ type StrictServerInterface interface {
 	// Retrieve the list of alarms
 	// (GET /o2ims-infrastructureMonitoring/v1/alarms)
 	GetAlarms(ctx context.Context, request GetAlarmsRequestObject) (GetAlarmsResponseObject, error)
+	// Inject a test alarm
+	// (POST /o2ims-infrastructureMonitoring/v1/alarms/test)
+	InjectTestAlarm(ctx context.Context, request InjectTestAlarmRequestObject) (InjectTestAlarmResponseObject, error)
 	// Retrieve exactly one alarm
 	// (GET /o2ims-infrastructureMonitoring/v1/alarms/{alarmEventRecordId})
 	GetAlarm(ctx context.Context, request GetAlarmRequestObject) (GetAlarmResponseObject, error)
@@ -1888,6 +1981,37 @@ func (sh *strictHandler) GetAlarms(w http.ResponseWriter, r *http.Request, param
 	}
 }
 
+// InjectTestAlarm operation middleware
+func (sh *strictHandler) InjectTestAlarm(w http.ResponseWriter, r *http.Request) {
+	var request InjectTestAlarmRequestObject
+
+	var body InjectTestAlarmJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.InjectTestAlarm(ctx, request.(InjectTestAlarmRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "InjectTestAlarm")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(InjectTestAlarmResponseObject); ok {
+		if err := validResponse.VisitInjectTestAlarmResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetAlarm operation middleware
 func (sh *strictHandler) GetAlarm(w http.ResponseWriter, r *http.Request, alarmEventRecordId openapi_types.UUID) {
 	var request GetAlarmRequestObject
@@ -1974,94 +2098,105 @@ func (sh *strictHandler) GetMinorVersions(w http.ResponseWriter, r *http.Request
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9a3PbOLL2X0Fxt2qSfUXdb/ZbU6c8jpNoJ3F8bGen6kSpMUQ0JUxIQAFAO9qM//sp",
-	"XHgTqYsdZ+Lsib9MJIHNRvfT3U8DIOezF/B4yRkwJb3Dz94SCxyDAmE+BTyOOfsdL+nvfAlM/xc+BVFC",
-	"4DmFiJgxBGQg6FJRzrxD75jHMUYStBwFBEVUKsRDFOrxSEAIAlgAEimOnCgUCh4jtQAkQCaRak7ZlJ3g",
-	"YLF+EaISYfclwzE0EBdI3+xjYn7ObqN/lAUlZiskIywXIJvoORdTBp9wvIygUdRCK3AV8IQpsbpCMplZ",
-	"WTy0v8AnBUxSzuSVvcuhVvPq6kpLMxJ+N1/Ln/ORLSfOjZuy3xbAkFpQiTI7IyrZTwolEghi3E3ghkYR",
-	"mkGqGzEmsSZH1Ekwll0fiOAaGKJG5xXCQv+yjGhAVbRClLlBiaRsrodM2ZVV+ipXqDllXsNzFvIOPWPp",
-	"6py8hke1wz8mYD7oYd6hV7aF1/BksIAYa6Co1VKPkEpQNvdubxt18AofAFduntZS3whVc1AWN/oqhxiE",
-	"GfkCmDl4bfDHvhjDUWTuZKVlABKgEsEM0r7A+/f3eqRAVL1+AVgECxQIqkBQbHx4zJnClEnEGWhXxVwA",
-	"kuWBjTU3QUwDHnEmm8hAYG24gcCUqWQZAQqsfB0hmCG+BIEVF40MIzlwtDuLSlzjKNFguFxAdh0KMJuy",
-	"mR68Sp0c8ijiN/oG1irS+PhP9Ca95k/0GrDR4D5/f07Zn372V/jnPf60LA1Xpq60ZPQaq2AB0mUYZ5Eg",
-	"9Yj+yhhho17oCj5e2U/1sqhE8DHBkY6hLeKsrLnaJWsuAOsAUAvMNslLZcHVHWRxUaunlUXZLr0MbML8",
-	"SrnRXtHOOUYg5dYJFmTtmmMua32CuWwrizlQbJBFOEjEuErBsUE3J8uBYrNeWtIuXDhZzvjbZe2y/586",
-	"Ii+zq0rFQl+k850WUJDjEqr7xGd/QKCqtWTK0kvd+I31BBXLSSJrCIrvpsQkJTBlu+uHTrI/P4GPNQm9",
-	"cfLfT7MScpmbRVMILRiLeRJrkphN0CWrdV2NEh+vCgmQx0ssQE5ZsIDgQ+YP60G+M/ibqUYmrHTOtT5O",
-	"byCRTJZLLhSKk0hRncLTRLxuRaNAev/MlFO2bssNpdjoR9UCBLo6ubjSvr16e1E1MGW1Br5ovL14Wi7T",
-	"zshpjOjKiGUjhYG+gVxiw2o0nWMARE9jBkgmQvCEEQcbyuYRoI8JVyCbU7Z93kVG4uBs6xC6ilcoiBKp",
-	"QFzV4sawgZ/yUT+tzSfzQFZZN9RhgyvNRxqGkFgUxChOpEKxjlsUcmEZqsZPBMoUZkI1MdBTMoNqsJfX",
-	"VsNs6mZO5ZQVZ4r+gRn5x1p4ZQ7UJtLe3tMe/39TeK27fjdDs7x1N0XLFMn1eLqRnxmetY2f3aY/GhJ+",
-	"FGERn1wDU6dc0ZAG2DKzdaJmxiEzEBVHIqm/UVynKz1+phvLhrcUOnoVBXMTrC8+Cj4wfhMBmcMl1cqu",
-	"3+IZVtDSPyGpcLx0+eNG21Jzq1I6ydU+h4ALghZYohlohHJCQwqkWTJht93v+u2R3+1cdruHve5hd/w/",
-	"XsMLuYix8g49ghX4SqvVWDdYo6I+qer+C+cRYBfviDJizMPmWl+MYszwHHR2RXIlFcRGXVyQaFOwvk9J",
-	"byUSyBSa2XtkGh0vMJsD+bbG7NzLmM8gpMyE+uRZDdYK5dgVCgu//DIkrKaOd7ifaaB/w2KFsJQ8oCYt",
-	"3VC1cOHmhBJ0DpInIoDL1RLKc8M9PGgPgpE/7pPA7wdh6M9wOPZ7w7DXm/Vg1A6D4lyThJKN0yzYdFID",
-	"Gl3z3p6/Ks2x6AZLMcr6DcLRcAzjA7+LoeP3RyPiHxyMxn5n1GsPhp1BnwzI3vqdYyrvAaDtmAkMi94C",
-	"mfZdIRNwJpMYxIVNMUbDTfa0ai4Fv6audmptUwkpXmRBUlnRHiFBp9Md+7jTJ35/SMAftwn2h/1Of9Y5",
-	"6AZwEO5j37xCmARIbGHD0VkpMVYuq0xIgq2kTC4hMLGInmh+KxVmBAtC/w3kKcrTLXryAVbyKbpZ0GBh",
-	"yR2mERe5La6BES409ZiyjMOZZTIFbvmCMjs/HWiZKfGMJ3bJ441/HPGEWAzYYuUmYhGrJzKP+AxHZlxd",
-	"iGtX2SEoMLIoAaarCggdu3TOcoUvXr9pQslJBPeHB+MZ9oMxHvn97gHxMXSGfn/cDzuDsDvuBoN9nMQK",
-	"hcxg+dKMWFe2VO/AlEAtypE7rRlLYu/wXbvRaXQbvfcFVdvZXSlTMDel+ZOvx/vXWJgFJ+/wnXd68pvX",
-	"8I5fHp2+ONH/eHVydO41vKPjX0/f/Pbq5NmLE+/9bcOZ9xzCh8klCy6VVqEZ8LjFuzSWPmWhwFKJJFCJ",
-	"gNecUcW1tVrXnZZJGbI1C3vhOOyCPwx7I78/Hnf9gyEmftCeHQQQDsOg3a8z9hJEAPQayAVcg6BqpSfx",
-	"d6En4/2tlS9Stxw7aZ1VLrg1xGKGZxEc40TCnsXjrHhNqeiV7THrBaQT6NwPg8Dvd0PwMQ4H/uAgJAd9",
-	"6PXwGO8DK+Gqy57qpcN1b6Kw/sEGb4ANW6xnBl5vFoadUY/4+IAM/H5nMPLHg3Hohwe9fjgMCQT9wV2U",
-	"1dDfU2EDfh7miu+j70GHDEin3/YhHHT9fmc48mdDEvgHowEmnfHBKOz2dutrFP6YUKFJ2Lu1LLMpoGur",
-	"cWXmJb/V8ZQq+KpltIaa1fHHumgo1Yz3NSl1PabNbs5Wnl1TI01rglMaWqCgEmFm/dZAVLm+UdN7w+4v",
-	"z9+erLUlW7lpUYl6fnGp26OsR13yZRLldA2jHfTD3KTQMNIynd5IPjrje/HVrVT7/jPJmfiUbeLiVOYs",
-	"fK0vLM7r4H7zigCLv8xDgb3blmncnRuuhyn5lu0EMv3E2vQGveFBCO2hjwnM/P7BcOjPxmHo90d4GPZJ",
-	"P4D9yMo+HcUkZ1G68WQImBKr0rQKEppoyl7xAEfRCiWMftTuo2qRcuSA2zSPWcb40gq1PsfRoBv0odv1",
-	"24MR0cm9q7uSmR/gHnRD0g/DQe9BupIvw2RdcO1qVzqju0Ly/zr1/xpEjzws0esPwmE/BH8IZOz3+2Pi",
-	"j8Nw5IeDcb/dPmjj9ug/nzvVcqJqOq16YiPruRvFqZhvH87z2pRCS/DkvQhQ4deM8ZSsHeJI1hKbh8D1",
-	"7aY5XoC4pgEccxbSeSKyVdjy/B4ktbxyJytiUJhghdEHWPlu3QRTIe0iteJ51UOx3a0Okyi/KutebAa2",
-	"9V7aadSlBQFKFyfOzkBQXuOZ0ySe2cJF8Eqa/QErdEGl4mLldkYEKEztKrmpBlbzBZYowEznxxkY1hrx",
-	"m3Qvt4OeELx6ula1etXmfD1G1nXeCNHS0hQLedV1uszOcPChvnUPE12EPyY4son+7fnEeCBfuVoKHgBJ",
-	"RJ4xmP1OSoTRGbc+LVe54sJFs5QgBP2ShbYCzXC7OLmePLRLJNLuDJAkS30XGxfdOkMyCINB3w8A2n5/",
-	"0Ov6B+Pu0O9q6jTu9tvQme2TjzedMjlON6u0tk47a0XCzW5xYeNNwJILDWguso0ZKzevo8XlQzRlrLwf",
-	"YiLAHBIREHIBDURDc7gksvtA5o5ZbTVdFo6iVC9dt1MdmlM2UcbTa0pku28zrGOVs1JtKemTb0RbeExZ",
-	"hQbasLBrWHstRRV9Z8dXXFE0UV1prIGQZd0llCAT4YW1Qd3nRREP0g3HAid5EEgJwOQNi1Zr7e2GEprF",
-	"dH1eAKHszo/YtbeWjyw7b4lXEccEYYnsJTMgSOMKLZRaysNWayl4DGoBiWxS3iI8kC2sxVE2b+nELFUr",
-	"KNaU1t9uYLbg/MPv9mvj+/UiCsKeFaUK4roqqxGfl+C9y1DFRMCIPFJ67H7MOqRsDmIpKFNVKz7PfzRn",
-	"BC1oVo5TgVB1EufALA1+e/5qC0jtJrr+oLRArMqEzQrflV0jPIPoCy0mFRbqTjaTCqtE7qIqm8B6Ya+u",
-	"5S3uCywEXnnZsb+jhwKHFffqAYymWZNgOKr18S84+BBR9iFfGsgtsYdT54Iny19hVRX8K6wyELrTuMiM",
-	"Nj21iTH0BJrzpr4zAZIsI210eLrxNg9hCwGGkora0Q+DlSz5z+pq8ZulVbxwYKDYdLpiWfxRU8GEkUqe",
-	"SrPvWxFVb+P2QvQYU2BMWs38UFBwl4trkS8SZkrQUZYqy3d/yW9QjNkq9fMCX4PdHc0uTZnR1Ivxp9/t",
-	"uKnnVSlpw7sGIV3h2F6P0oEFWGZOLbi+kab4u5Stiwwb+xav7MYptdD9XnRtesOQmgm8r4H6SyzIDRZg",
-	"BFfvdxbhABY8Io45LNxwa2rkzr3UTOysrn2rWV2i0i6caTZrHKWbCR6mR7ksbyxVl6wxRNKJTql43l2W",
-	"dggb/cagSKI6++0SHp9PLifHR6+8hvf66J9vNC17PTk1//3t6Px0cvrCa3iT02cnlyfnryenR5cZgTt5",
-	"ZvYPaw5mH51N/pXDa638FdaC7BIQRg5iWa9xNmnWUIgCYguUrNlutvcLsK2Kyv00TR8gcLrIHSrjJS3K",
-	"z9R+V5iNm8Lt+0ZOjbZlyu32rimhiaBnAkL6qWy52s3ZCdMUnotV67pzb6ueCT6LIH6me+qojuplheZI",
-	"KUFniYIvWXo4YivEsjY/F4JwJr1hyW6oW3xEzV6VXf5M+xmheT9miGrjxMBU1t5WJkzMtOrW5RZJjJmv",
-	"2T6eRfYZGsxc2nK3s4SASsSDIBEifVTEBLy1WrnjOOaMQZAeXyRYYd2cIc3LCOJJLf1M19PrVHx7Piku",
-	"H2rSSct8NNN0s4bIdJAxXqGVWTQPE2GOtxaXeWmICOS7GTY4dvEeuaEcXC4Avby8PHPZHwWcgOvwdpky",
-	"u6Wm93V1UFEV1ZpKLrhQjXWnyiSOsVit3cl0yU00UfqqJCL2DLvZ37PPqRV0VHyzxg3zWBgslZndMhFL",
-	"LsEkGd2eRvTfFpZoEtq+nEo0p9dm048gbpxg1qamnklYh7MIsw9Tr2ENlcUDkgtNYXAkzepEumxQWnpf",
-	"p3u7sIQD3fYbLsTR5OTyOTp/fox6B+Mhetd7Xwu1ivGoRMACnghsTy9iuz+gb+R0lFO25hDCgyQL2Kzt",
-	"T0VbLmyeXHt5+frVU7ttU0Imyg/WxhDPiqspIIGpxpRRla4IaitKmcTZys6apdf3sAodtUFkwYbNgMf7",
-	"EMUiF8vYj0tCVa51W2hNnvGgJpje+OdHpwgvNQXW0DSfm7+9GDbfdCevL/zJ6eXJ+fOj4xP/vN3u+dft",
-	"YbPdRk/+mTBA3Xa3r1uJRLPjbHKlXCqb3BeYNbmYtwi/YRHH5L8o+Xk46tvkZNcxzeH1wFAxd8r4HAh6",
-	"iVVF+s3NTVMAWWBlLFZN/2cT43ajPZqUyhnKDxvZlSBtOxfx3n4X6NLuNarluuG5cucder1mu9nTxR+r",
-	"hbF4SycY7YLWdacVYCx9S47tMkraCOrCyGUNHT23pFqmTN/4qcSJTby7pidaOYoMsnhg2vQ815hGOkx0",
-	"KrSbc2711TuKS+tHFmcg1S+crFIHgV0SwUvbRVLOWn9Iy8Dyo+D36eosTnNkK5GA3fpaciZt1e+22ztO",
-	"zbnegyCZBAFIada7tWf6dZf+gglyc9RjBnVjJs5tZsEVBAIhuF3Ad3k/dw7a2KMYjOG5YdcpDrz3WkgJ",
-	"FmmPkULj8+LmX2Yr9RTHcFsER9lxL2/WHFd8+PrdZ3uCXyMxP8BflOyt233bsf73XwcW5W7svlh4WW7S",
-	"vi0ayh3jFgjsOBKp+fN1oWWYQ21+UIlwT05mD7qkrYnOh1l7kvHZwj6D20swlaoMrRegjqIo61jqnfAg",
-	"CNjRixlIrD3UW/Aq4jO7XVdvgXT2eoq5/zfo7Srz//ti/de6npop1GPu2+tVi/PmGtBfgCpBq4DwdPd2",
-	"P4Snh343bU47xFeQWTv+K0J08w76VnSiVKEf0Lsv9CopVgkK11A4AehyGArWwJAiUta57f2tYWjBogqv",
-	"M/31Zod/LXK0FWB7VcRHgfTmY4W6VazTfRyKnQnIT66GmEZAmt9dMJqzUivzMOI1JQmO1p5gd/vtLj5L",
-	"aGruFaBJTfZ/uyRYwY/4/BGfP+Jzv/g0O5QPGJh34XWFszZyK6ErDaz0sXXmy4e0dr5k7LZxHxnhl11s",
-	"jorZtvkLksFe+0D1RwQr+z8/uOo346ppP+o46xrcs4grfW9qoFv3Sc8iGCjwwhE7/fnz39MkOuNk9bdW",
-	"vvLXLJxhKK0jfbUiWX3Vw33XdI6d6ukp1L9iOefWKFtOT8fmAY5idH1VklGJ4n3M1/krlNiYOswjLm7H",
-	"+bGmjn774HHopctpRIPvL5/ZOEAYMbipyWNb0th9GUPrc+W7Cbm10RyBgiqVeGa+X4vV3YviNbfZuja+",
-	"60GY9/vkt0IAmek8+gDqPw69TrlyB/a+twiy6ETwCQcqWplXv+0ZQY29mHMV64UnKMftcYeMB/5g1Bv6",
-	"/W5n4OMQz/zRqNsfdgb9Pgzb6TufvklwfLPq9T0Q3x/B93B0/B7hd4cCtrXNzc4d/Ohv797fFt9M8Z/X",
-	"2vbbvceh13MuZpQQYM3vb2Nyc8td7LLdF3cLa8dFS88z3+6M9a9Ykatvm3m0BbkUuT9q8UPpdZkf0QVS",
-	"fffEDbZnF025/p6DuViwsYuqSizvsZ/7/QTj11ybq3vPwbfYxNquzPe+kfXo+HrzUS6C/dj1+2q7fqy4",
-	"MW+X6+xLqL6ACd31QGJKwWLKuNh8GjF7fCPGf3Cx8RGwCsV6rcU+6iOKP04dPuypwyqQNpw91FcaWbbQ",
-	"1z324M70X5hhpUcNDlst84zNgkt1OG637RN57jb1Ly7P38C9xgxsf113Se3+e3517e77JlmlF4bU6VJe",
-	"0aiKyTwAjCw5ZUqm78aQSOG5fS+GNP8bCV2F3WsNKJujIKLAlHkOy4apu2N21vn2/e3/BgAA//+aUSyo",
-	"JGwAAA==",
+	"H4sIAAAAAAAC/+xdeXPbOLL/KijuVs1knyjrPry19crjOBPtJo6f7exUvSgVQ0RTwpoEFAC0o834u7/C",
+	"wUukDjvOxNmX+WdiCWw0Gn38utGgPnsBj5ecAVPSO/zsLbHAMSgQ5q+AxzFnH/CSfuBLYPr/8CmIEgIv",
+	"KETEjCEgA0GXinLmHXrHPI4xkqDpKCAoolIhHqJQj0cCQhDAApBIceRIoVDwGKkFIAEyiVRzyqbsBAeL",
+	"9YcQlQi7DxmOoYG4QHqyj4n5moeFL2WBidkKyQjLBcgmesHFlMEnHC8jaBS50AxcBTxhSqyukExmlhYP",
+	"7TfwSQGTlDN5ZWc51GxeXV1NmaPwwXws/5aPPHDk3Lgp+20BDKkFlSiTM6KS/aRQIoEgxt0CbmkUoRmk",
+	"vBEjEityRB0FI9n1gQhugCFqeF4hLPQ3y4gGVEUrRJkblEjK5nrIlF1Zpq9yhppT5jU8JyHv0DOSrq7J",
+	"a3hUb/jHBMwfeph36JVl4TU8GSwgxlpR1GqpR0glKJt7d3eNOvUKH0Gv3DqtpL6RVs1BWb3RTzmNQZiR",
+	"L1Azp14b9mNfHcNRZGay1DIFEqASwYB82e4/fNcjBaK66xeARbBAgaAKBMVmD485U5gyiTgDvVUxF4Bk",
+	"eWBjbZsgpgGPOJNNZFRgbbhRgSlTyTICFFj62kIwQ3wJAisuGghXFEdvZ5GJGxwlWhkuF5A9hwLMpmym",
+	"B6/STQ55FPFbPYGVijR7/Dt6kz7zO3oN2HDwkP9+n7Lf/ey/wj8f8J+mpdWVqStNGb3GKliAdB7GSSRI",
+	"d0QtnBA28oWu4OMVQptpUYngY4IjbUNbyFlac7WL1lwA1gagFphtopfSgqt70OKilk9Li7JdfBm1CfMn",
+	"5UZ5RTvXGIGUWxdYoAVX+9JaX2BO29JiTik20CIcJGJcpcqxgTdHyynFZr40pV164WhRtgetXfL/XVvk",
+	"5QIqNk+tlmt/pwkU6DiH6v7is39BoKqxZMrSR934jfEEFcNJImsAiu+WxCQlMGW744d2sn/7GT7WOPTG",
+	"yf88y0LIZS4WLOzEWMyTGJjKF+ic1TqvhomPVwUHyOMlFiCnLFhAcJ3th91BvtP4mylHxqy0z7V7nE4g",
+	"kUyWSy4UipNI0WXknquRomEgnT8T5ZSty3JDKDb8UbUAga5OLq703l69vagKmLJaAV803l48K4dpJ+TU",
+	"RnRkxLKRqoGeQC6xQTUazjEAopcxAyQTIXjCiFMbyuYRoI8JVyCbU7Z93UVE4tTZxiF0Fa9QECVSgbiq",
+	"1Rv9aOOnfNRPa+vJdiCLrBvisNErjUcaBpBYLYhRnEiFYm23KOTCIlStPxEoE5gJVZQzvSQzqEb38thq",
+	"kE3dyqmcsuJK0V8wI39ZM69sA7WI9G7vKY+/bjKvi2f3RWgWt+6GaBkjOR/PNuIzzfpWfHaXfmlA+FGE",
+	"RXxyA0ydckVDGmCLzNaBmhmHzEBUHImk/kRx7a70+BkIDQ+XQluvomAmwfrho+Ca8dsIyBwuaQzVKZ5j",
+	"BQf6KyQVjpfOf9xqWWpsVXInOdvnEHBB0AJLNAOtoZzQkAJplkTYafU6fmvod9qXnc5ht3PYGf2v1/BC",
+	"LmKsvEOPYAW+0mw11gXWqLBPqrz/wnkE2Nk7oowY8bC55hejGDM8B+1dkVxJBbFhFxcoWhes5ynxrUQC",
+	"GUMzO0fG0fECszmQbyvM9oOE+RxCyoypT57X6FohHCues4jyx5CwnFJW/JoG+jssVghLyQNq3NItVQtn",
+	"bo4oQecgeSICuFwtobw23MX9Vj8Y+qMeCfxeEIb+DIcjvzsIu91ZF4atMCiuNUko2bjMgkwnNUqjY97b",
+	"81elNRa3wUKMMn/9cDgYwWjsdzC0/d5wSPzxeDjy28Nuqz9o93ukT/bm7xxT+QAF2q4zgUHRW1SmdV+V",
+	"CTiTSQziIpllHG6Sp2VzKfgNdbFTc5tSSPVFFiiVGe0SErTbnZGP2z3i9wYE/FGLYH/Qa/dm7XEngHG4",
+	"j3zzCGEcILGBDUdnJcdYeayyIAk2kjK5hMDYIvqZcaU3hREsCP03kGcod7fo52tYyWfodkGDhXlUYRpx",
+	"kcviBhjhAnGBpizDcKZMpsCVLyiz69OGlokSz3hiSx5v/OOIJ8TqgA1WbiFWY/VC5hGf4ciMmzyv3yo7",
+	"BAWGFiXAdFQBoW2XzlnO8MXrN00obRLBvcF4NMN+MMJDv9cZEx9De+D3Rr2w3Q87o07Q32eTWCGQGV2+",
+	"NCPWmS3FO11/U0iTcuBOc8aS2Dt812q0G51G932B1VY2K2UK5iY0f/L1eP8GC1Nw8g7feacnv3kN7/jl",
+	"0emvJ/ofr06Ozr2Gd3T8j9M3v706ef7riff+ruHEew7h4/iSBZdKs9AMeHzAOzSWPmWhwFKJJFCJgNec",
+	"UcW1tA5u2gfGZciDWdgNR2EH/EHYHfq90ajjjweY+EFrNg4gHIRBq1cn7CWIAOgNkAu4AUHVSi/iz0Iv",
+	"xvvTQV6kPnDo5OCs8sCdARYzPIvgGCcS9gweZ8VnSkGvLI9ZNyDtQPt+6Ad+rxOCj3HY9/vjkIx70O3i",
+	"Ed5HrYSLLnuylw5HlGmrDsAZb4ANWqxHBl53FobtYZf4eEz6fq/dH/qj/ij0w3G3Fw5CAkGvfx9mterv",
+	"ybBRfh7mjO/D77hN+qTda/kQ9jt+rz0Y+rMBCfzxsI9JezQehp3ubn4Nwx8TKjQIe7fmZTYZdG00rqy8",
+	"tG91OKWqfNUwWgPN6vBjnTWUYsb7Gpe6btPmNGcrzq6JkSY1wSkMLUBQiTCz+9ZAVLm8kSEJBt1fnr89",
+	"WUtLtmLTIhP1+OJSp0dZjrrkyyTK4RpGO+CHmaSQMNIynN4IPtqjB+HVrVD74SvJkfiUbcLiVOYofMo2",
+	"rmv8sHVFgMUftkOBnW3LMu6PDdfNlHzLdAKZfGJtef3uYBxCa+BjAjO/Nx4M/NkoDP3eEA/CHukFsB9Y",
+	"2SejmOQoSieeDIGuTZSWVaDQRFP2igc4ilYoYfSj3j6qFm60DLh185hliC+NUOtrHPY7QQ86Hb/VHxLt",
+	"3Ds6K5n5Ae5CJyS9MOx3HyUr+TKdrDOuXelKe3hflfz/Dv2/AtCrKsLLJMbabDHRg9CCMlVgM33YYpIU",
+	"8tWgEg2bGSem7J8wAThYmOeyjoVFMktrbs1aVFuCBORx8WivHw56IfgDICO/1xsRfxSGQz/sj3qt1riF",
+	"W8O9PIdWES6BnMMSU3FkvJq8r0ixfQylxPRihCFY5+7LC/knCBpaFdTC/kkiBuqWi2v9AINA0RuqVqb0",
+	"v+S3ILSKK2j+x8HVWhhajWBVrdoINO+HKivi2wdmvuYkw9TyQZiz8G0GMkvSDnEka7HkY7iSu01rvABx",
+	"QwM45iyk80Rkhe/y+h7Fm79yzSwxKEywwugaVr4rVWEqpD0XUDwHGii2DQJhEuVPZQmjDXrW5qRdRtOe",
+	"oU29GH/SeCS6AWKWKaeeng5FNKbKHW4m8cxCBOFGWloSCVCYMiB/NeN4REAqE0qWidBl6pAKqdai/+fq",
+	"lN6h1261Wl6d7AUoYFouZyAor1GX04w7glfSnBPZlS6oVFys3AlZyqpZOJUu1usyZIAZ40qPkaBQZDyK",
+	"OfJuo58JXj1b479bLdKsG+46zxvtplSiZCGv6pOGWzMcXNeXcMJEgzF9RGoD/tvziVGLvIK5FDwAkojc",
+	"jTH7mZQIozNuFa2MdooFrGbJawn6JQXXAtx0p3k5nzy0pTJpT4hIkvnji43F1/aA9MOg3/MDgJbf63c7",
+	"/njUGfgdDaFHnV4L2rN9At6mbqPj9NBSc+u4s1Ik3HQNFA5gBSy50FbGRXZAZ+nmeKpYRkZTxsrnYtYs",
+	"dbOQgJALaCAaIuyIpH0KGcYy2TaOopQvLHIemlM2UWan15jITmFnWDsQzkoBr8RP3pBg1WPKKunAlOW1",
+	"zL1KksW9s+MrW1EU0eT5Xipk+CprCTIWXqgRU6llxYP04LmATR9FpTQKesOi1VqZY0Ncz2y63i+AUPYE",
+	"UOw6Y81HljdviVcRxwRhiewjMyBI6xVaKLWUhwcHS8FjUAtIZJPyA8IDeYA1OV271dFCqoOgGOgO/nQL",
+	"swXn1x/sx2bv1yM7CNszTBXEdaFfazxW946NFREBI/JI6bH7ZVghZXMQS0GZqkrxRf6lNmWnNCsH9ECo",
+	"OopzYDYdenv+aouS2vCp/1CaIFZlFGmJ7/KuEZ5B9IUSkwoLdS+ZSYVVInfhp03KemGfrgVT7gMsBF55",
+	"Wfvn0WMphyX36hGEpqGcYDiq3eNfcHAdUXadl4hySeyxqXPBk+U/YFUl/A9YZUrourKRGW1qK8bG0M/Q",
+	"nDf1zARIsoy00OHZxmkeQxYCDE4WtaMfR1cy5z+ri8VvlpbxQuNIsfjggmXxSw0FE0Yqfir1vm9FVJ3G",
+	"nYnpMSbAMF7chwKDu7a4VvNFwkwIOspc5VpKzW9RrKu8bp8X+AbsKXn2aIqMDHD/YMdNPa8KSRveDQjp",
+	"Asf2eJQOLKhltqmFrW+kLv4+Yesi0419g1c2cQot0qRDy5yaBbyvUfWXWJBbLMAQrs53FuEAFjpDschh",
+	"4YZbUSOrpHXlqbO6nLKmykilLaBqNGs2SoKB1q6lz+LGUnTJslUkHelq5al0UtzoNfpFENXe77T4+Hxy",
+	"OTk+euU1vNdHf3+jYdnryan5/29H56eT01+9hjc5fX5yeXL+enJ6dJkBuJPn5hz5EqQyKGtDOn/yaUkt",
+	"TNivNwQrJw69VmVSRk2mWPPfVl4d3r/if5/OlU3c3WKJhKHziMXfrdDkrS2y01KV3lZdtXYCKbBX5kl/",
+	"7rdghDtBv+v3cH/s98LhwJ91g77fC9q4NesEfdJu76xBFRncWFZaU4A6/5Dp0LnufZaqrnKSErkAXRiU",
+	"21J9aYcgHCoQO7VJH91g0zupOOq2Ws1yPt9qaGdKY21r3YH5kzL7Z7vOsT5Kjecoe6xYk1fc+A13ppJP",
+	"lO58ecMrUrb/2n3Uo0m5MnWN2KyWIzzHlEllQytbrTWW4TG0+rjlj2Ec+j3SwT4e4Z7fn/XDFm5DF4Z7",
+	"Nb59rZJdzaWio7PJP/OQuCaewjmGLWJj5MJiVh85mzRr0p5ClC2kkc1Ws7UfKNjKqNyP0/Tym+NF7mAZ",
+	"L2mRfsb2u8Jq3BLu3jfydG7btmyXdw3sTwQ9ExDST2XJ1TYWTZguO3CxOrhpP1iq+hglgvg5KEyjuvQ0",
+	"M8gjpQSdJQq+yL7ZqlA4zYkgnFFv2AQ9pAzMWTJG7ugurcEIXavADFEtnBiYykpylQUTs6y6A46FPqfx",
+	"s3Maff8TMztBOp1NYqhEPAgSIdJrjunRWARr4eXYHcPY1nuCFdYFJaSDHEE8qU2Z07PgOhZ12bJwDqMT",
+	"ZVrOoTNON3OITNUrxiu0Mge+YSLUopwlaE9GIJvJlbB25WpyA4TVBdiXl5dnDrGigBNwValdosymtGG1",
+	"GmIUVVGtqOSCC9VY31SZxDEWq7WZTGWviSZKP5VExN6/Mr0p9sSywKPimzlumCvNsFRmdctELLkE42Qi",
+	"HuCI/tuqJZqEZkZzN47emIYVgrhapPX0qWcc1uEswux66jWsoDJ7QHKh0y4cSVNRTUudpWPj9RR1ly7h",
+	"QJcqTf7G0eTk8gU6f3GMuuPRAL3rvq9VtYrwqETAAp4IbDvvsT3h1BM5HuWUrW0I4UGSGWxWqkxJ2/zd",
+	"3Lp+efn61TPbclDSTJRfCokhnhUrwCCBqcaUUZWeYmgpSl1HT6vRa5Je778oVAGNRhZkqJs790luixgx",
+	"y9icE6riv7tCOeU5D2qM6Y1/fnSK8JJKq5rm7+Zvvw6abzqT1xf+5PTy5PzF0fGJf95qdf2b1qDZaqGf",
+	"/54wQJ1Wp6fLH4mICosr+VLZ5L7ArMnF/IDwW6bLo/9Nyd8Gw551TvbsxVy8Cgw4dTdkzoGgl1hVqN/e",
+	"3jYFkAVWRmJV9382MdtuuEeTUjhDeaMscgdgmcV7+z2gQ7vXqIbrhufCnW75bLaa+tB5idXCSPyAMrsF",
+	"uj03wFj6NqE/wIVkXA9cclmTjpzbQoBMqxNmn0p5vLF3V6iJVi6tB1m87GPA5A2mkTYT7QptY4k7MfKO",
+	"4lLN2+oZSPULJ6t0g8DmSnhpK1+6Ov0vaRFYfo3pIZUoq6e5ZiuRgPlALjmTNup3Wq0dHd+uXkKQTAJ9",
+	"zGbO6PTO9Ooe/QUT5Naox/TrxkzctplDIhAIhOD20NH5/Xxz0Ma6itExPDcVgVQPvPeaSEkt0rpIqhqf",
+	"F7f/NG1ApziGu6JylDfu5e3axhVfHPLus719pjUxv3xWpOyty33blbT3X0ctyhWkh+rCy3Jh6dtqQ7nK",
+	"tUUFdrTza/x8U0gZ5lDrH1Qi3K3/7JJmmppof5hSyPFs4Ww0a0uo+IRfQR1FUZax1G/Co2jAjlzMqMTa",
+	"CykKu4r4zLYY1EsgXb1eYr7/G/h2kfm/vpj/taynZgn1Ovft+arV8+aaov8KqqRaBQ1P22D20/D0wsqm",
+	"Lh+n8RXNrB3/FVV0cyvSVu1EKUM/VO+hqldxsUpQuIFCO6PzYShYU4ZUI2XdtukC+xKrYFFVrzP98eYN",
+	"/1rgaKuC7RURn4SmN5+qqlvG2p2nwdiZgPzWRYhppM83vjdjNE2nK3OR/oaSBEdrb19xPULOPkva1NzL",
+	"QJMa7/92SbCCH/b5wz5/2Od+9omjxzXM++C6Qn+g3AroSgMreWyd+PIhBztfkHnXeAiN8MseNu2tNm3+",
+	"Amew1zlQfVtz5fznB1b9Zlg1zUcdZl1T98ziSp+bGOjqPmn/lFEFXmgL1n9//nPqRGecrP50kFf+moW+",
+	"q1Id6asFyeprih5a0zl2rKed839EOefOMFt2T8fm8mHRur4qyKhY8T7ia/8RTGx0HeZ6pjtxfqquo9ca",
+	"Pw2+dDiNaPD9+TNrBwgjBrc1fmyLG3soYjj4XPlsQu6sNUegoAolnpvP12x1d1G8ZpqttfFdNwrf7+Pf",
+	"CgZklvPkDaj3NPg65co1GX9vFmS1E8EnHChduWawrwU19kLOVV0v3P4ftUZtMur7/WF34Pc67b6PQzzz",
+	"h8NOT7+SrAeDltf4hsbxzaLX9wB8fxjf48HxB5jfPQLY1jQ36zv4kd/eP78tvlXpPy+17bW6T4OvF1zM",
+	"KCHAmt/fweTmlLuYZbsP7mfWByrtn69t05mYywHSvLWLqQUoGtiJURjhuW5hw/rLvOW7YRqVbnBEiX0J",
+	"bXY7Uf8hEjbj/Foi88oXrv9nLilPWdYvLpV5awxmJG35Iem1uGLnT0Sv3c8emJZAN3f2gqSpZwTwQTP2",
+	"wXw59aYsa4FPXy029XSU192Dej6qCn3+CCeKx1hR+44ibt7PrpC5WACyrrXAyiq/3/J1sunK3Yc/OJHO",
+	"11ejzZfVWy7p9ZKnXaH/vvyB1bSS3X2xH/hcfUHM3c6Y/xWRefWNiU8WmJci+A9M/lh8Xeat+kCq70+7",
+	"xbaH2cD27zmoF4H7Rlsu9HVsfMGR+U0HE76KL9R0PypReVeSHly5MOXa5meQ/57XLFFazlM242rRRDkJ",
+	"9yNGhrBrfpcIRwIwWZVfML8wW4UgDM3PlqS/VKVobH/nKJHZTbIp44LOqRZegYR5fT0WgK5hqZrIvDpy",
+	"6/RpEKcSCXD3G917+gJXqKwL4XmTzPfj2b7mgUfdW7i+RWfAdma+9+6AJ1cEaT7Jk4UfrRRfrZWCFbud",
+	"rEe1b6X9Alh53y7vNK+NKeNic4t3dicuxv/iIh1TuaRawauvNdkn3ff9o5X7cVu5q4q0oaFbP2lo2UBf",
+	"d5fMXZS6MMNK97cODw7MxcUFl+pwZF48+D6bpv6XjPKf5FlDBrZoWfdIbVNT/nRtS9MmWqU3x9XxUi4T",
+	"V8lkOwCMLDllSqYvSZNI4TkyOEya35XTUdi930rjtSCiwJS53GrN1M2YXSC5e3/3fwMA8kzvUzV4AAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -38,6 +38,7 @@ type AlarmsServerConfig struct {
 	utils.CommonServerConfig
 	Address       string
 	GlobalCloudID string
+	HintRulesFile string
 }
 
 type AlarmsServer struct {
@@ -55,6 +56,25 @@ type AlarmsServer struct {
 	Notifier *notifier.Notifier
 	// ServiceConfig config needed to manage ServiceConfig
 	ServiceConfig serviceconfig.Config
+	// HintRules used to annotate incoming alarms with probable cause and remediation hints
+	HintRules *alertmanager.HintRules
+	// testAlarmTimers holds the timers clearing the injected test alarms, by fingerprint, so that they can be stopped
+	// on shutdown
+	testAlarmTimers   map[string]*time.Timer
+	testAlarmTimersMu sync.Mutex
+}
+
+// alarmPatchRepository defines the repository operations used to acknowledge or clear an alarm
+type alarmPatchRepository interface {
+	GetAlarmEventRecord(ctx context.Context, id uuid.UUID) (*models.AlarmEventRecord, error)
+	GetAlarmDefinition(ctx context.Context, id uuid.UUID) (*models.AlarmDefinition, error)
+	PatchAlarmEventRecordACK(ctx context.Context, id uuid.UUID, record *models.AlarmEventRecord) (*models.AlarmEventRecord, error)
+}
+
+// testAlarmRepository defines the repository operations used by the test alarms
+type testAlarmRepository interface {
+	UpsertAlarmEventRecord(ctx context.Context, records []models.AlarmEventRecord) error
+	ResolveTestAlarm(ctx context.Context, fingerprint string, raisedTime, clearedTime time.Time) (bool, error)
 }
 
 // AlarmsServer implements StrictServerInterface. This ensures that we've conformed to the `StrictServerInterface` with a compile-time check
@@ -254,8 +274,14 @@ func (a *AlarmsServer) GetAlarm(ctx context.Context, request api.GetAlarmRequest
 
 // PatchAlarm handles an API request to patch an Alarm Event Record
 func (a *AlarmsServer) PatchAlarm(ctx context.Context, request api.PatchAlarmRequestObject) (api.PatchAlarmResponseObject, error) {
+	return a.patchAlarm(ctx, a.AlarmsRepository, request)
+}
+
+// patchAlarm acknowledges or clears an alarm through the given repository
+func (a *AlarmsServer) patchAlarm(ctx context.Context, repository alarmPatchRepository,
+	request api.PatchAlarmRequestObject) (api.PatchAlarmResponseObject, error) {
 	// Fetch the Alarm Event Record to be patched
-	record, err := a.AlarmsRepository.GetAlarmEventRecord(ctx, request.AlarmEventRecordId)
+	record, err := repository.GetAlarmEventRecord(ctx, request.AlarmEventRecordId)
 	if errors.Is(err, utils.ErrNotFound) {
 		// Nothing found
 		return api.PatchAlarm404ApplicationProblemPlusJSONResponse(common.ProblemDetails{
@@ -309,36 +335,50 @@ func (a *AlarmsServer) PatchAlarm(ctx context.Context, request api.PatchAlarmReq
 			}), nil
 		}
 
-		// Check if associated alarm definition has clearing type "manual". If not, return 409.
-		alarmDefinition, err := a.AlarmsRepository.GetAlarmDefinition(ctx, *record.AlarmDefinitionID)
-		if errors.Is(err, utils.ErrNotFound) {
-			return api.PatchAlarm404ApplicationProblemPlusJSONResponse(common.ProblemDetails{
+		// Check if the Alarm Event Record has already been cleared
+		if record.PerceivedSeverity == perceivedSeverity {
+			// Nothing to patch
+			return api.PatchAlarm409ApplicationProblemPlusJSONResponse(common.ProblemDetails{
 				AdditionalAttributes: &map[string]string{
 					"alarmEventRecordId": request.AlarmEventRecordId.String(),
 				},
-				Detail: "associated Alarm Definition not found",
-				Status: http.StatusNotFound,
+				Detail: "Alarm record is already cleared",
+				Status: http.StatusConflict,
 			}), nil
 		}
 
-		if alarmDefinition.ClearingType != string(apiresources.MANUAL) {
+		// Alarms without a definition, such as the test alarms, are only cleared automatically
+		if record.AlarmDefinitionID == nil {
 			return api.PatchAlarm409ApplicationProblemPlusJSONResponse(common.ProblemDetails{
 				AdditionalAttributes: &map[string]string{
 					"alarmEventRecordId": request.AlarmEventRecordId.String(),
 				},
-				Detail: "cannot clear an alarm with clearing type other than MANUAL",
+				Detail: "cannot clear an alarm without an Alarm Definition",
 				Status: http.StatusConflict,
 			}), nil
 		}
 
-		// Check if the Alarm Event Record has already been cleared
-		if record.PerceivedSeverity == perceivedSeverity {
-			// Nothing to patch
+		// Check if associated alarm definition has clearing type "manual". If not, return 409.
+		alarmDefinition, err := repository.GetAlarmDefinition(ctx, *record.AlarmDefinitionID)
+		if errors.Is(err, utils.ErrNotFound) {
+			return api.PatchAlarm404ApplicationProblemPlusJSONResponse(common.ProblemDetails{
+				AdditionalAttributes: &map[string]string{
+					"alarmEventRecordId": request.AlarmEventRecordId.String(),
+				},
+				Detail: "associated Alarm Definition not found",
+				Status: http.StatusNotFound,
+			}), nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Alarm Definition: %w", err)
+		}
+
+		if alarmDefinition.ClearingType != string(apiresources.MANUAL) {
 			return api.PatchAlarm409ApplicationProblemPlusJSONResponse(common.ProblemDetails{
 				AdditionalAttributes: &map[string]string{
 					"alarmEventRecordId": request.AlarmEventRecordId.String(),
 				},
-				Detail: "Alarm record is already cleared",
+				Detail: "cannot clear an alarm with clearing type other than MANUAL",
 				Status: http.StatusConflict,
 			}), nil
 		}
@@ -365,36 +405,127 @@ func (a *AlarmsServer) PatchAlarm(ctx context.Context, request api.PatchAlarmReq
 			}), nil
 		}
 
-		// Check if the Alarm Event Record has already been acknowledged
-		if record.AlarmAcknowledged == alarmAcknowledged {
-			// Nothing to patch
-			return api.PatchAlarm400ApplicationProblemPlusJSONResponse(common.ProblemDetails{
-				AdditionalAttributes: &map[string]string{
-					"alarmEventRecordId": request.AlarmEventRecordId.String(),
-				},
-				Detail: "Alarm record is already acknowledged",
-				Status: http.StatusConflict,
-			}), nil
+		// Acknowledging is idempotent, an already acknowledged Alarm Event Record keeps its original acknowledgement
+		if record.AlarmAcknowledged {
+			return api.PatchAlarm200JSONResponse{AlarmAcknowledged: request.Body.AlarmAcknowledged}, nil
 		}
 
 		// Patch the Alarm Event Record
 		record.AlarmAcknowledged = alarmAcknowledged
 		currentTime := time.Now()
 		record.AlarmAcknowledgedTime = &currentTime
+		if user := api2.RemoteUserFromContext(ctx); user != "" {
+			record.AlarmAcknowledgedBy = &user
+		}
 	}
 
 	// Update the Alarm Event Record
-	updated, err := a.AlarmsRepository.PatchAlarmEventRecordACK(ctx, request.AlarmEventRecordId, record)
+	updated, err := repository.PatchAlarmEventRecordACK(ctx, request.AlarmEventRecordId, record)
 	if err != nil {
 		return nil, fmt.Errorf("failed to patch Alarm Event Record: %w", err)
 	}
 
 	slog.Debug("Alarm acknowledged/cleared", "alarmEventRecordId", updated.AlarmEventRecordID, "alarmAcknowledged", updated.AlarmAcknowledged, "alarmAcknowledgedTime", updated.AlarmAcknowledgedTime,
-		"alarmClearedTime", updated.AlarmClearedTime, "perceivedSeverity", updated.PerceivedSeverity, "alarmChangedTime", updated.AlarmChangedTime)
+		"alarmAcknowledgedBy", updated.AlarmAcknowledgedBy, "alarmClearedTime", updated.AlarmClearedTime, "perceivedSeverity", updated.PerceivedSeverity, "alarmChangedTime", updated.AlarmChangedTime)
 
 	return api.PatchAlarm200JSONResponse{AlarmAcknowledged: request.Body.AlarmAcknowledged, PerceivedSeverity: request.Body.PerceivedSeverity}, nil
 }
 
+// InjectTestAlarm handles an API request to inject a synthetic alarm flagged as a test alarm. The alarm goes
+// through the same conversion, storage and subscriber notification as the alertmanager alerts, and is cleared
+// once it expires.
+func (a *AlarmsServer) InjectTestAlarm(ctx context.Context, request api.InjectTestAlarmRequestObject) (api.InjectTestAlarmResponseObject, error) {
+	return a.injectTestAlarm(ctx, a.AlarmsRepository, request)
+}
+
+// injectTestAlarm injects a test alarm through the given repository
+func (a *AlarmsServer) injectTestAlarm(ctx context.Context, repository testAlarmRepository,
+	request api.InjectTestAlarmRequestObject) (api.InjectTestAlarmResponseObject, error) {
+	now := time.Now().UTC().Truncate(time.Second)
+	am, err := alertmanager.NewTestAlarmNotification(*request.Body, now)
+	if err != nil {
+		return api.InjectTestAlarm400ApplicationProblemPlusJSONResponse(common.ProblemDetails{
+			Detail: err.Error(),
+			Status: http.StatusBadRequest,
+		}), nil
+	}
+
+	// Test alarms have no definition in the dictionary, so only the hint rules may annotate them
+	records := alertmanager.ConvertAmToAlarmEventRecordModels(am, nil, a.getClusterIDToNodeClusterTypeID(), a.HintRules)
+	if err := repository.UpsertAlarmEventRecord(ctx, records); err != nil {
+		return nil, fmt.Errorf("failed to insert the test alarm: %w", err)
+	}
+	a.notifySubscribers()
+
+	record := records[0]
+	expiration, err := alertmanager.GetTestAlarmExpiration(record.Extensions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the test alarm expiration: %w", err)
+	}
+	a.scheduleTestAlarmClearing(repository, record.Fingerprint, record.AlarmRaisedTime, expiration.Sub(now))
+
+	slog.Info("Injected test alarm", "fingerprint", record.Fingerprint, "expiration", expiration)
+	return api.InjectTestAlarm201JSONResponse(api.TestAlarm{
+		Fingerprint:         record.Fingerprint,
+		AlarmRaisedTime:     record.AlarmRaisedTime,
+		AlarmExpirationTime: expiration,
+	}), nil
+}
+
+// scheduleTestAlarmClearing clears the test alarm once it expires, unless the timers are stopped before. The
+// clearing is tracked as a background task of the server.
+func (a *AlarmsServer) scheduleTestAlarmClearing(repository testAlarmRepository, fingerprint string,
+	raisedTime time.Time, expiration time.Duration) {
+	a.testAlarmTimersMu.Lock()
+	defer a.testAlarmTimersMu.Unlock()
+	if a.testAlarmTimers == nil {
+		a.testAlarmTimers = make(map[string]*time.Timer)
+	}
+	a.testAlarmTimers[fingerprint] = time.AfterFunc(expiration, func() {
+		a.testAlarmTimersMu.Lock()
+		_, scheduled := a.testAlarmTimers[fingerprint]
+		delete(a.testAlarmTimers, fingerprint)
+		if scheduled {
+			a.Wg.Add(1)
+		}
+		a.testAlarmTimersMu.Unlock()
+		if !scheduled {
+			return
+		}
+
+		defer a.Wg.Done()
+		a.clearTestAlarm(repository, fingerprint, raisedTime)
+	})
+}
+
+// StopTestAlarmTimers stops the timers clearing the test alarms. It must be called on shutdown before waiting for
+// the background tasks. The test alarms that are not cleared are resolved with the next alertmanager notification
+// after their expiration instead.
+func (a *AlarmsServer) StopTestAlarmTimers() {
+	a.testAlarmTimersMu.Lock()
+	defer a.testAlarmTimersMu.Unlock()
+	for fingerprint, timer := range a.testAlarmTimers {
+		timer.Stop()
+		delete(a.testAlarmTimers, fingerprint)
+	}
+}
+
+// clearTestAlarm resolves an expired test alarm and notifies the subscribers of the change. Only the clearing
+// columns of the stored alarm are updated, and nothing is done if it was already resolved.
+func (a *AlarmsServer) clearTestAlarm(repository testAlarmRepository, fingerprint string, raisedTime time.Time) {
+	resolved, err := repository.ResolveTestAlarm(context.Background(), fingerprint, raisedTime, time.Now().UTC())
+	if err != nil {
+		slog.Error("failed to clear the test alarm", "fingerprint", fingerprint, "error", err)
+		return
+	}
+	if !resolved {
+		return
+	}
+	a.notifySubscribers()
+
+	slog.Info("Cleared expired test alarm", "fingerprint", fingerprint)
+}
+
 // GetServiceConfiguration handles an API request to fetch the Alarm Service Configuration
 func (a *AlarmsServer) GetServiceConfiguration(ctx context.Context, _ api.GetServiceConfigurationRequestObject) (api.GetServiceConfigurationResponseObject, error) {
 	records, err := a.AlarmsRepository.GetServiceConfigurations(ctx)
@@ -444,6 +575,14 @@ func (a *AlarmsServer) PatchAlarmServiceConfiguration(ctx context.Context, reque
 		serviceConfigRecord.Extensions = *request.Body.Extensions
 	}
 
+	// Check if the retention limits set through the extensions are valid
+	if _, err := serviceconfig.GetMaxResolvedAlarms(&serviceConfigRecord); err != nil {
+		return api.PatchAlarmServiceConfiguration400ApplicationProblemPlusJSONResponse(common.ProblemDetails{
+			Detail: err.Error(),
+			Status: http.StatusBadRequest,
+		}), nil
+	}
+
 	// Patch the Alarm Service Configuration
 	patched, err := a.AlarmsRepository.UpdateServiceConfiguration(ctx, serviceConfigRecord.ID, &serviceConfigRecord)
 	if err != nil {
@@ -488,6 +627,14 @@ func (a *AlarmsServer) UpdateAlarmServiceConfiguration(ctx context.Context, requ
 		serviceConfigRecord.Extensions = *request.Body.Extensions
 	}
 
+	// Check if the retention limits set through the extensions are valid
+	if _, err := serviceconfig.GetMaxResolvedAlarms(&serviceConfigRecord); err != nil {
+		return api.UpdateAlarmServiceConfiguration400ApplicationProblemPlusJSONResponse(common.ProblemDetails{
+			Detail: err.Error(),
+			Status: http.StatusBadRequest,
+		}), nil
+	}
+
 	// Update the Alarm Service Configuration
 	updated, err := a.AlarmsRepository.UpdateServiceConfiguration(ctx, serviceConfigRecord.ID, &serviceConfigRecord)
 	if err != nil {
@@ -517,13 +664,7 @@ func (a *AlarmsServer) AmNotification(ctx context.Context, request api.AmNotific
 	}
 
 	// Get NodeCluster NodeClusterType mapping
-	var clusterIDToNodeClusterTypeID map[uuid.UUID]uuid.UUID
-	for i := range a.Infrastructure.Clients {
-		if a.Infrastructure.Clients[i].Name() == clusterserver.Name {
-			clusterIDToNodeClusterTypeID = a.Infrastructure.Clients[i].(*clusterserver.ClusterServer).GetClusterIDToResourceTypeID()
-			break
-		}
-	}
+	clusterIDToNodeClusterTypeID := a.getClusterIDToNodeClusterTypeID()
 
 	// Get the definition data based on current set of Alert names and managed cluster ID
 	alarmDefinitions, err := a.AlarmsRepository.GetAlarmDefinitions(ctx, request.Body, clusterIDToNodeClusterTypeID)
@@ -534,7 +675,7 @@ func (a *AlarmsServer) AmNotification(ctx context.Context, request api.AmNotific
 	}
 
 	// Combine possible definitions with events
-	aerModels := alertmanager.ConvertAmToAlarmEventRecordModels(request.Body, alarmDefinitions, clusterIDToNodeClusterTypeID)
+	aerModels := alertmanager.ConvertAmToAlarmEventRecordModels(request.Body, alarmDefinitions, clusterIDToNodeClusterTypeID, a.HintRules)
 
 	// Insert and update AlarmEventRecord
 	if err := a.AlarmsRepository.UpsertAlarmEventRecord(ctx, aerModels); err != nil {
@@ -544,6 +685,24 @@ func (a *AlarmsServer) AmNotification(ctx context.Context, request api.AmNotific
 	}
 
 	// Return 200 before subscription processing to free up AM conn
+	a.notifySubscribers()
+
+	slog.Info("Successfully handled all alertmanager alerts")
+	return api.AmNotification200Response{}, nil
+}
+
+// getClusterIDToNodeClusterTypeID returns the NodeCluster to NodeClusterType mapping collected from the cluster server
+func (a *AlarmsServer) getClusterIDToNodeClusterTypeID() map[uuid.UUID]uuid.UUID {
+	for i := range a.Infrastructure.Clients {
+		if a.Infrastructure.Clients[i].Name() == clusterserver.Name {
+			return a.Infrastructure.Clients[i].(*clusterserver.ClusterServer).GetClusterIDToResourceTypeID()
+		}
+	}
+	return nil
+}
+
+// notifySubscribers sends the pending alarm notifications to the subscribers in the background
+func (a *AlarmsServer) notifySubscribers() {
 	a.Wg.Add(1)
 	go func() {
 		defer a.Wg.Done()
@@ -558,9 +717,6 @@ func (a *AlarmsServer) AmNotification(ctx context.Context, request api.AmNotific
 			a.Notifier.Notify(subCtx, &notification)
 		}
 	}()
-
-	slog.Info("Successfully handled all alertmanager alerts")
-	return api.AmNotification200Response{}, nil
 }
 
 func (a *AlarmsServer) HwNotification(ctx context.Context, request api.HwNotificationRequestObject) (api.HwNotificationResponseObject, error) {
@@ -0,0 +1,297 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	api "github.com/openshift-kni/oran-o2ims/internal/service/alarms/api/generated"
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/alertmanager"
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/models"
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/infrastructure"
+	commonapi "github.com/openshift-kni/oran-o2ims/internal/service/common/api"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/notifier"
+	commonutils "github.com/openshift-kni/oran-o2ims/internal/service/common/utils"
+	apiresources "github.com/openshift-kni/oran-o2ims/internal/service/resources/api/generated"
+)
+
+// fakeAlarmsRepository is an in-memory implementation of the repository operations used by the alarm handlers
+type fakeAlarmsRepository struct {
+	mu sync.Mutex
+	// records are the alarm event records by fingerprint
+	records     map[string]models.AlarmEventRecord
+	definitions map[uuid.UUID]models.AlarmDefinition
+}
+
+func (f *fakeAlarmsRepository) GetAlarmEventRecord(_ context.Context, id uuid.UUID) (*models.AlarmEventRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, record := range f.records {
+		if record.AlarmEventRecordID == id {
+			return &record, nil
+		}
+	}
+	return nil, commonutils.ErrNotFound
+}
+
+func (f *fakeAlarmsRepository) GetAlarmDefinition(_ context.Context, id uuid.UUID) (*models.AlarmDefinition, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	definition, found := f.definitions[id]
+	if !found {
+		return nil, commonutils.ErrNotFound
+	}
+	return &definition, nil
+}
+
+func (f *fakeAlarmsRepository) PatchAlarmEventRecordACK(_ context.Context, id uuid.UUID,
+	record *models.AlarmEventRecord) (*models.AlarmEventRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for fingerprint, stored := range f.records {
+		if stored.AlarmEventRecordID != id {
+			continue
+		}
+		stored.AlarmAcknowledged = record.AlarmAcknowledged
+		stored.AlarmAcknowledgedTime = record.AlarmAcknowledgedTime
+		stored.AlarmAcknowledgedBy = record.AlarmAcknowledgedBy
+		stored.PerceivedSeverity = record.PerceivedSeverity
+		stored.AlarmClearedTime = record.AlarmClearedTime
+		stored.AlarmChangedTime = record.AlarmChangedTime
+		f.records[fingerprint] = stored
+		return &stored, nil
+	}
+	return nil, commonutils.ErrNotFound
+}
+
+func (f *fakeAlarmsRepository) UpsertAlarmEventRecord(_ context.Context, records []models.AlarmEventRecord) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, record := range records {
+		f.records[record.Fingerprint] = record
+	}
+	return nil
+}
+
+func (f *fakeAlarmsRepository) ResolveTestAlarm(_ context.Context, fingerprint string, raisedTime,
+	clearedTime time.Time) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	record, found := f.records[fingerprint]
+	if !found || !record.AlarmRaisedTime.Equal(raisedTime) || record.AlarmStatus == string(api.Resolved) {
+		return false, nil
+	}
+	record.AlarmStatus = string(api.Resolved)
+	record.AlarmClearedTime = &clearedTime
+	record.PerceivedSeverity = api.CLEARED
+	f.records[fingerprint] = record
+	return true, nil
+}
+
+func (f *fakeAlarmsRepository) get(fingerprint string) models.AlarmEventRecord {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.records[fingerprint]
+}
+
+func (f *fakeAlarmsRepository) update(fingerprint string, change func(*models.AlarmEventRecord)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	record := f.records[fingerprint]
+	change(&record)
+	f.records[fingerprint] = record
+}
+
+// fakeNotificationProvider has no pending notifications
+type fakeNotificationProvider struct{}
+
+func (fakeNotificationProvider) GetNotifications(_ context.Context) ([]notifier.Notification, error) {
+	return nil, nil
+}
+
+func (fakeNotificationProvider) DeleteNotification(_ context.Context, _ uuid.UUID) error {
+	return nil
+}
+
+var _ = Describe("Test alarm injection", func() {
+	var (
+		ctx        context.Context
+		repository *fakeAlarmsRepository
+		server     *AlarmsServer
+	)
+
+	inject := func(request api.TestAlarmRequest) api.TestAlarm {
+		response, err := server.injectTestAlarm(ctx, repository, api.InjectTestAlarmRequestObject{Body: &request})
+		Expect(err).ToNot(HaveOccurred())
+
+		rec := httptest.NewRecorder()
+		Expect(response.VisitInjectTestAlarmResponse(rec)).To(Succeed())
+		Expect(rec.Code).To(Equal(http.StatusCreated))
+		result := api.TestAlarm{}
+		Expect(json.Unmarshal(rec.Body.Bytes(), &result)).To(Succeed())
+		return result
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		repository = &fakeAlarmsRepository{records: map[string]models.AlarmEventRecord{}}
+		server = &AlarmsServer{
+			Infrastructure:       &infrastructure.Infrastructure{},
+			NotificationProvider: fakeNotificationProvider{},
+		}
+		DeferCleanup(func() {
+			server.StopTestAlarmTimers()
+			server.Wg.Wait()
+		})
+	})
+
+	It("stores the test alarm and clears it once it expires, keeping the changes made since", func() {
+		result := inject(api.TestAlarmRequest{
+			ExpirationSeconds: ptr.To(1),
+			PerceivedSeverity: ptr.To(api.MAJOR),
+		})
+		Expect(result.AlarmExpirationTime).To(Equal(result.AlarmRaisedTime.Add(time.Second)))
+
+		record := repository.get(result.Fingerprint)
+		Expect(record.AlarmStatus).To(Equal(string(api.Firing)))
+		Expect(record.PerceivedSeverity).To(Equal(api.MAJOR))
+		Expect(alertmanager.IsTestAlarm(record.Extensions)).To(BeTrue())
+
+		// The alarm is acknowledged before it expires
+		repository.update(result.Fingerprint, func(record *models.AlarmEventRecord) {
+			record.AlarmAcknowledged = true
+		})
+
+		Eventually(func() string {
+			return repository.get(result.Fingerprint).AlarmStatus
+		}, 3*time.Second, 100*time.Millisecond).Should(Equal(string(api.Resolved)))
+		record = repository.get(result.Fingerprint)
+		Expect(record.PerceivedSeverity).To(Equal(api.CLEARED))
+		Expect(record.AlarmClearedTime).ToNot(BeNil())
+		Expect(record.AlarmAcknowledged).To(BeTrue())
+	})
+
+	It("does not clear the test alarms once the timers are stopped", func() {
+		result := inject(api.TestAlarmRequest{ExpirationSeconds: ptr.To(1)})
+		server.StopTestAlarmTimers()
+
+		Consistently(func() string {
+			return repository.get(result.Fingerprint).AlarmStatus
+		}, 1500*time.Millisecond, 100*time.Millisecond).Should(Equal(string(api.Firing)))
+	})
+
+	It("rejects an out of range expiration", func() {
+		response, err := server.injectTestAlarm(ctx, repository, api.InjectTestAlarmRequestObject{
+			Body: &api.TestAlarmRequest{ExpirationSeconds: ptr.To(0)},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		rec := httptest.NewRecorder()
+		Expect(response.VisitInjectTestAlarmResponse(rec)).To(Succeed())
+		Expect(rec.Code).To(Equal(http.StatusBadRequest))
+		Expect(repository.records).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Alarm patching", func() {
+	var (
+		ctx          context.Context
+		repository   *fakeAlarmsRepository
+		server       *AlarmsServer
+		id           uuid.UUID
+		definitionID uuid.UUID
+	)
+
+	patch := func(modifications api.AlarmEventRecordModifications) *httptest.ResponseRecorder {
+		response, err := server.patchAlarm(ctx, repository, api.PatchAlarmRequestObject{
+			AlarmEventRecordId: id,
+			Body:               &modifications,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		rec := httptest.NewRecorder()
+		Expect(response.VisitPatchAlarmResponse(rec)).To(Succeed())
+		return rec
+	}
+
+	BeforeEach(func() {
+		ctx = commonapi.RemoteUserIntoContext(context.Background(), "operator-1")
+		id = uuid.New()
+		definitionID = uuid.New()
+		repository = &fakeAlarmsRepository{
+			records: map[string]models.AlarmEventRecord{
+				"fingerprint": {
+					AlarmEventRecordID: id,
+					AlarmDefinitionID:  &definitionID,
+					AlarmRaisedTime:    time.Now().Add(-time.Hour),
+					PerceivedSeverity:  api.MAJOR,
+					AlarmStatus:        string(api.Firing),
+					Fingerprint:        "fingerprint",
+				},
+			},
+			definitions: map[uuid.UUID]models.AlarmDefinition{
+				definitionID: {AlarmDefinitionID: definitionID, ClearingType: string(apiresources.MANUAL)},
+			},
+		}
+		server = &AlarmsServer{}
+	})
+
+	It("records who acknowledged the alarm and keeps the first acknowledgement", func() {
+		rec := patch(api.AlarmEventRecordModifications{AlarmAcknowledged: ptr.To(true)})
+		Expect(rec.Code).To(Equal(http.StatusOK))
+
+		record := repository.get("fingerprint")
+		Expect(record.AlarmAcknowledged).To(BeTrue())
+		Expect(record.AlarmAcknowledgedBy).To(Equal(ptr.To("operator-1")))
+		Expect(record.AlarmAcknowledgedTime).ToNot(BeNil())
+		acknowledgedTime := *record.AlarmAcknowledgedTime
+
+		// Acknowledging again by another user succeeds without changing the acknowledgement
+		ctx = commonapi.RemoteUserIntoContext(context.Background(), "operator-2")
+		rec = patch(api.AlarmEventRecordModifications{AlarmAcknowledged: ptr.To(true)})
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		result := api.AlarmEventRecordModifications{}
+		Expect(json.Unmarshal(rec.Body.Bytes(), &result)).To(Succeed())
+		Expect(result.AlarmAcknowledged).To(Equal(ptr.To(true)))
+
+		record = repository.get("fingerprint")
+		Expect(record.AlarmAcknowledgedBy).To(Equal(ptr.To("operator-1")))
+		Expect(*record.AlarmAcknowledgedTime).To(Equal(acknowledgedTime))
+	})
+
+	It("clears an alarm once and rejects clearing it again", func() {
+		rec := patch(api.AlarmEventRecordModifications{PerceivedSeverity: ptr.To(api.CLEARED)})
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		record := repository.get("fingerprint")
+		Expect(record.PerceivedSeverity).To(Equal(api.CLEARED))
+		Expect(record.AlarmClearedTime).ToNot(BeNil())
+
+		rec = patch(api.AlarmEventRecordModifications{PerceivedSeverity: ptr.To(api.CLEARED)})
+		Expect(rec.Code).To(Equal(http.StatusConflict))
+		Expect(rec.Body.String()).To(ContainSubstring("Alarm record is already cleared"))
+	})
+
+	It("rejects clearing an alarm without a definition", func() {
+		repository.update("fingerprint", func(record *models.AlarmEventRecord) {
+			record.AlarmDefinitionID = nil
+		})
+
+		rec := patch(api.AlarmEventRecordModifications{PerceivedSeverity: ptr.To(api.CLEARED)})
+		Expect(rec.Code).To(Equal(http.StatusConflict))
+		Expect(repository.get("fingerprint").PerceivedSeverity).To(Equal(api.MAJOR))
+	})
+
+	It("reports an unknown alarm", func() {
+		id = uuid.New()
+		rec := patch(api.AlarmEventRecordModifications{AlarmAcknowledged: ptr.To(true)})
+		Expect(rec.Code).To(Equal(http.StatusNotFound))
+	})
+})
@@ -0,0 +1,13 @@
+package api
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAlarmsAPI(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Alarms API Suite")
+}
@@ -0,0 +1,196 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"k8s.io/utils/ptr"
+
+	"github.com/openshift-kni/oran-o2ims/internal/cmd/server"
+	api "github.com/openshift-kni/oran-o2ims/internal/service/alarms/api/generated"
+	common "github.com/openshift-kni/oran-o2ims/internal/service/common/api/generated"
+)
+
+// Names of the patch-alarm command line flags
+const (
+	acknowledgeFlagName           = "acknowledge"
+	clearFlagName                 = "clear"
+	caBundleFileFlagName          = "ca-bundle-file"
+	insecureSkipTLSVerifyFlagName = "insecure-skip-tls-verify"
+)
+
+// patchAlarmTimeout is the maximum time to wait for the alarms server to answer
+const patchAlarmTimeout = 30 * time.Second
+
+// alarmsPatch represents the command acknowledging or clearing an alarm
+var alarmsPatch = &cobra.Command{
+	Use:   "patch-alarm ALARM_EVENT_RECORD_ID",
+	Short: "Acknowledge or clear an alarm",
+	Long: `Acknowledge or clear an alarm through the API of a running alarms server. Acknowledging an alarm that is
+already acknowledged has no effect, while clearing an alarm that is already cleared is rejected.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := runPatchAlarm(cmd, args[0]); err != nil {
+			slog.Error("failed to patch alarm", "alarmEventRecordId", args[0], "err", err)
+			os.Exit(1)
+		}
+	},
+}
+
+// runPatchAlarm gathers the flags of the patch-alarm command and sends the corresponding request
+func runPatchAlarm(cmd *cobra.Command, id string) error {
+	alarmEventRecordID, err := uuid.Parse(id)
+	if err != nil {
+		return fmt.Errorf("invalid alarm event record identifier: %w", err)
+	}
+
+	flags := cmd.Flags()
+	acknowledge, _ := flags.GetBool(acknowledgeFlagName)
+	clearAlarm, _ := flags.GetBool(clearFlagName)
+	if acknowledge == clearAlarm {
+		return fmt.Errorf("exactly one of the '--%s' and '--%s' flags must be provided",
+			acknowledgeFlagName, clearFlagName)
+	}
+
+	var modifications api.AlarmEventRecordModifications
+	if acknowledge {
+		modifications.AlarmAcknowledged = ptr.To(true)
+	} else {
+		modifications.PerceivedSeverity = ptr.To(api.CLEARED)
+	}
+
+	serverURL, _ := flags.GetString(server.BackendURLFlagName)
+	if serverURL == "" {
+		return fmt.Errorf("the '--%s' flag must be provided", server.BackendURLFlagName)
+	}
+	token, err := server.GetTokenFlag(cmd.Context(), flags, slog.Default())
+	if err != nil {
+		return fmt.Errorf("failed to get the token: %w", err)
+	}
+
+	caBundleFile, _ := flags.GetString(caBundleFileFlagName)
+	insecureSkipTLSVerify, _ := flags.GetBool(insecureSkipTLSVerifyFlagName)
+	client, err := NewPatchAlarmClient(caBundleFile, insecureSkipTLSVerify)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), patchAlarmTimeout)
+	defer cancel()
+	result, err := PatchAlarm(ctx, client, serverURL, token, alarmEventRecordID, modifications)
+	if err != nil {
+		return err
+	}
+
+	slog.Info("Alarm patched", "alarmEventRecordId", alarmEventRecordID,
+		"alarmAcknowledged", result.AlarmAcknowledged, "perceivedSeverity", result.PerceivedSeverity)
+	return nil
+}
+
+// NewPatchAlarmClient returns the HTTP client used to send the requests to the alarms server. The certificate of the
+// server is verified against the system CA bundle, extended with the given CA bundle file if any, unless the
+// verification is disabled.
+func NewPatchAlarmClient(caBundleFile string, insecureSkipTLSVerify bool) (*http.Client, error) {
+	if caBundleFile != "" && insecureSkipTLSVerify {
+		return nil, fmt.Errorf("the '--%s' and '--%s' flags are mutually exclusive",
+			caBundleFileFlagName, insecureSkipTLSVerifyFlagName)
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if insecureSkipTLSVerify {
+		tlsConfig.InsecureSkipVerify = true // nolint: gosec // explicitly requested by the user
+	}
+	if caBundleFile != "" {
+		data, err := os.ReadFile(caBundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the CA bundle file: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no certificates found in the CA bundle file '%s'", caBundleFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+		},
+	}, nil
+}
+
+// PatchAlarm sends a request to acknowledge or clear an alarm to the alarms server at the given URL and returns the
+// modifications applied.  The problem details returned by the server are included in the error if it rejects the
+// request.
+func PatchAlarm(ctx context.Context, client *http.Client, serverURL, token string, id uuid.UUID,
+	modifications api.AlarmEventRecordModifications) (*api.AlarmEventRecordModifications, error) {
+	body, err := json.Marshal(modifications)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the alarm modifications: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/o2ims-infrastructureMonitoring/v1/alarms/%s", strings.TrimSuffix(serverURL, "/"), id)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send the request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var problem common.ProblemDetails
+		if err := json.Unmarshal(data, &problem); err != nil || problem.Detail == "" {
+			return nil, fmt.Errorf("unexpected response status %d: %s", resp.StatusCode, strings.TrimSpace(string(data)))
+		}
+		return nil, fmt.Errorf("request rejected with status %d: %s", resp.StatusCode, problem.Detail)
+	}
+
+	var result api.AlarmEventRecordModifications
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the response: %w", err)
+	}
+	return &result, nil
+}
+
+func init() {
+	flags := alarmsPatch.Flags()
+	flags.Bool(acknowledgeFlagName, false, "Acknowledge the alarm.")
+	flags.Bool(clearFlagName, false, "Clear the alarm, its alarm definition must have a MANUAL clearing type.")
+	flags.String(server.BackendURLFlagName, "", "URL of the alarms server.")
+	flags.String(caBundleFileFlagName, "",
+		"File containing the CA certificates trusted, in addition to the system ones, to verify the alarms server.")
+	flags.Bool(insecureSkipTLSVerifyFlagName, false,
+		"Do not verify the certificate of the alarms server. This is insecure and should only be used for testing.")
+	server.AddTokenFlags(flags)
+	AlarmRootCmd.AddCommand(alarmsPatch)
+}
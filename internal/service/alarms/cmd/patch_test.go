@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	api "github.com/openshift-kni/oran-o2ims/internal/service/alarms/api/generated"
+	common "github.com/openshift-kni/oran-o2ims/internal/service/common/api/generated"
+)
+
+var _ = Describe("PatchAlarm", func() {
+	var (
+		ctx      context.Context
+		id       uuid.UUID
+		srv      *httptest.Server
+		received *http.Request
+		body     api.AlarmEventRecordModifications
+		status   int
+		response any
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		id = uuid.New()
+		srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+			w.WriteHeader(status)
+			Expect(json.NewEncoder(w).Encode(response)).To(Succeed())
+		}))
+		DeferCleanup(srv.Close)
+	})
+
+	It("acknowledges an alarm", func() {
+		status = http.StatusOK
+		response = api.AlarmEventRecordModifications{AlarmAcknowledged: ptr.To(true)}
+
+		result, err := PatchAlarm(ctx, srv.Client(), srv.URL+"/", "my-token", id,
+			api.AlarmEventRecordModifications{AlarmAcknowledged: ptr.To(true)})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.AlarmAcknowledged).To(Equal(ptr.To(true)))
+
+		Expect(received.Method).To(Equal(http.MethodPatch))
+		Expect(received.URL.Path).To(Equal("/o2ims-infrastructureMonitoring/v1/alarms/" + id.String()))
+		Expect(received.Header.Get("Authorization")).To(Equal("Bearer my-token"))
+		Expect(body.AlarmAcknowledged).To(Equal(ptr.To(true)))
+		Expect(body.PerceivedSeverity).To(BeNil())
+	})
+
+	It("reports the problem details of a rejected request", func() {
+		status = http.StatusConflict
+		response = common.ProblemDetails{
+			Detail: "Alarm record is already cleared",
+			Status: http.StatusConflict,
+		}
+
+		_, err := PatchAlarm(ctx, srv.Client(), srv.URL, "my-token", id,
+			api.AlarmEventRecordModifications{PerceivedSeverity: ptr.To(api.CLEARED)})
+		Expect(err).To(MatchError("request rejected with status 409: Alarm record is already cleared"))
+		Expect(body.PerceivedSeverity).To(Equal(ptr.To(api.CLEARED)))
+	})
+})
+
+var _ = Describe("NewPatchAlarmClient", func() {
+	var (
+		ctx context.Context
+		id  uuid.UUID
+		srv *httptest.Server
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		id = uuid.New()
+		srv = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			Expect(json.NewEncoder(w).Encode(api.AlarmEventRecordModifications{AlarmAcknowledged: ptr.To(true)})).
+				To(Succeed())
+		}))
+		DeferCleanup(srv.Close)
+	})
+
+	acknowledge := func(client *http.Client) error {
+		_, err := PatchAlarm(ctx, client, srv.URL, "", id,
+			api.AlarmEventRecordModifications{AlarmAcknowledged: ptr.To(true)})
+		return err
+	}
+
+	It("verifies the server certificate against the given CA bundle", func() {
+		client, err := NewPatchAlarmClient("", false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(acknowledge(client)).To(MatchError(ContainSubstring("certificate")))
+
+		caBundleFile := filepath.Join(GinkgoT().TempDir(), "ca.crt")
+		Expect(os.WriteFile(caBundleFile, pem.EncodeToMemory(&pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: srv.Certificate().Raw,
+		}), 0o600)).To(Succeed())
+		client, err = NewPatchAlarmClient(caBundleFile, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(acknowledge(client)).To(Succeed())
+	})
+
+	It("skips the verification of the server certificate when requested", func() {
+		client, err := NewPatchAlarmClient("", true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(acknowledge(client)).To(Succeed())
+	})
+
+	It("rejects a CA bundle without certificates", func() {
+		caBundleFile := filepath.Join(GinkgoT().TempDir(), "ca.crt")
+		Expect(os.WriteFile(caBundleFile, []byte("not a certificate"), 0o600)).To(Succeed())
+		_, err := NewPatchAlarmClient(caBundleFile, false)
+		Expect(err).To(MatchError(ContainSubstring("no certificates found")))
+	})
+})
@@ -6,6 +6,8 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+
+	toolcmd "github.com/openshift-kni/oran-o2ims/internal/cmd"
 )
 
 // AlarmRootCmd represents the root command for working alarms server
@@ -24,6 +26,11 @@ func GetAlarmRootCmd() *cobra.Command {
 	return AlarmRootCmd
 }
 
+func init() {
+	// Report the build information of the server
+	AlarmRootCmd.AddCommand(toolcmd.Version())
+}
+
 func configureAlarmLogger() {
 	l := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
 		Level:     slog.LevelDebug,
@@ -49,6 +49,12 @@ func setServerFlags(cmd *cobra.Command) error {
 		utils.DefaultOCloudID,
 		"The global O-Cloud identifier.",
 	)
+	flags.StringVar(
+		&config.HintRulesFile,
+		server.AlarmHintRulesFileFlagName,
+		"",
+		"Path to a YAML file with the rules used to annotate alarms with probable cause and remediation hints.",
+	)
 	return nil
 }
 
@@ -0,0 +1,13 @@
+package cmd
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAlarmsCmd(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Alarms Commands Suite")
+}
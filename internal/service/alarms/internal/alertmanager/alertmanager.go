@@ -78,8 +78,9 @@ func Setup(ctx context.Context) error {
 	return nil
 }
 
-// ConvertAmToAlarmEventRecordModels get alarmEventRecords based on the alertmanager notification and AlarmDefinition
-func ConvertAmToAlarmEventRecordModels(am *api.AlertmanagerNotification, aDefinitionRecords []models.AlarmDefinition, clusterIDToObjectTypeID map[uuid.UUID]uuid.UUID) []models.AlarmEventRecord {
+// ConvertAmToAlarmEventRecordModels get alarmEventRecords based on the alertmanager notification and AlarmDefinition.
+// The records are annotated with the probable cause and remediation hints of the first matching hint rule, if any.
+func ConvertAmToAlarmEventRecordModels(am *api.AlertmanagerNotification, aDefinitionRecords []models.AlarmDefinition, clusterIDToObjectTypeID map[uuid.UUID]uuid.UUID, hints *HintRules) []models.AlarmEventRecord {
 	records := make([]models.AlarmEventRecord, 0, len(am.Alerts))
 	for _, alert := range am.Alerts {
 		record := models.AlarmEventRecord{
@@ -118,6 +119,16 @@ func ConvertAmToAlarmEventRecordModels(am *api.AlertmanagerNotification, aDefini
 			}
 		}
 
+		// Annotate with hints from the first matching rule
+		if rule := hints.Match(*alert.Labels, *alert.Annotations); rule != nil {
+			if rule.ProbableCause != "" {
+				record.ProbableCause = &rule.ProbableCause
+			}
+			if rule.ProposedRepairActions != "" {
+				record.ProposedRepairActions = &rule.ProposedRepairActions
+			}
+		}
+
 		// Anything else that's not mentioned explicitly will be handled by DB such ID generation and default values as needed.
 		records = append(records, record)
 	}
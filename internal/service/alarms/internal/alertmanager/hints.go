@@ -0,0 +1,100 @@
+package alertmanager
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+// HintRule annotates the alarms matching all of its matchers with probable cause and remediation hints
+type HintRule struct {
+	// Name identifies the rule in logs and errors
+	Name string `yaml:"name"`
+	// Matchers maps an alert label or annotation name to a regular expression that must fully match its value
+	Matchers map[string]string `yaml:"matchers"`
+	// ProbableCause is the probable cause hint set on matching alarms
+	ProbableCause string `yaml:"probableCause"`
+	// ProposedRepairActions is the remediation hint set on matching alarms
+	ProposedRepairActions string `yaml:"proposedRepairActions"`
+}
+
+// compiledHintRule is a HintRule with its matchers compiled
+type compiledHintRule struct {
+	rule     HintRule
+	matchers map[string]*regexp.Regexp
+}
+
+// HintRules holds the ordered set of hint rules. The first matching rule wins.
+type HintRules struct {
+	rules []compiledHintRule
+}
+
+// NewHintRules validates and compiles the given rules
+func NewHintRules(rules []HintRule) (*HintRules, error) {
+	result := &HintRules{rules: make([]compiledHintRule, 0, len(rules))}
+	for i, rule := range rules {
+		if len(rule.Matchers) == 0 {
+			return nil, fmt.Errorf("hint rule %d (%s) has no matchers", i, rule.Name)
+		}
+		if rule.ProbableCause == "" && rule.ProposedRepairActions == "" {
+			return nil, fmt.Errorf("hint rule %d (%s) has neither a probable cause nor proposed repair actions", i, rule.Name)
+		}
+
+		compiled := compiledHintRule{rule: rule, matchers: make(map[string]*regexp.Regexp, len(rule.Matchers))}
+		for name, expression := range rule.Matchers {
+			re, err := regexp.Compile("^(?:" + expression + ")$")
+			if err != nil {
+				return nil, fmt.Errorf("hint rule %d (%s) has an invalid matcher for %s: %w", i, rule.Name, name, err)
+			}
+			compiled.matchers[name] = re
+		}
+		result.rules = append(result.rules, compiled)
+	}
+
+	return result, nil
+}
+
+// LoadHintRules reads the hint rules from a YAML file containing a list of rules
+func LoadHintRules(path string) (*HintRules, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read hint rules file %s: %w", path, err)
+	}
+
+	var rules []HintRule
+	if err := yaml.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("failed to parse hint rules file %s: %w", path, err)
+	}
+
+	return NewHintRules(rules)
+}
+
+// Match returns the first rule matching the given alert labels and annotations, or nil if none matches
+func (h *HintRules) Match(labels, annotations map[string]string) *HintRule {
+	if h == nil {
+		return nil
+	}
+
+	for i := range h.rules {
+		if h.rules[i].matches(labels, annotations) {
+			return &h.rules[i].rule
+		}
+	}
+	return nil
+}
+
+// matches checks whether every matcher of the rule matches a label, or failing that an annotation, of the alert
+func (r *compiledHintRule) matches(labels, annotations map[string]string) bool {
+	for name, re := range r.matchers {
+		value, ok := labels[name]
+		if !ok {
+			value, ok = annotations[name]
+		}
+		if !ok || !re.MatchString(value) {
+			return false
+		}
+	}
+	return true
+}
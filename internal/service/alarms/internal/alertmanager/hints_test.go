@@ -0,0 +1,126 @@
+package alertmanager
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/openshift-kni/oran-o2ims/internal/service/alarms/api/generated"
+)
+
+var _ = Describe("HintRules", func() {
+	const notification = `{
+		"receiver": "oran_alarm_receiver",
+		"status": "firing",
+		"groupKey": "{}:{}",
+		"alerts": [
+			{
+				"status": "firing",
+				"labels": {"alertname": "NodeNotReady", "severity": "critical", "namespace": "openshift-monitoring"},
+				"annotations": {"summary": "Node is not ready"},
+				"startsAt": "2024-10-01T10:00:00Z",
+				"endsAt": "0001-01-01T00:00:00Z",
+				"fingerprint": "a1"
+			},
+			{
+				"status": "firing",
+				"labels": {"alertname": "KubePodCrashLooping", "severity": "warning"},
+				"annotations": {"summary": "Pod is crash looping"},
+				"startsAt": "2024-10-01T10:00:00Z",
+				"endsAt": "0001-01-01T00:00:00Z",
+				"fingerprint": "b2"
+			}
+		]
+	}`
+
+	var am *api.AlertmanagerNotification
+
+	BeforeEach(func() {
+		am = &api.AlertmanagerNotification{}
+		Expect(json.Unmarshal([]byte(notification), am)).To(Succeed())
+	})
+
+	It("enriches the alarms matching a rule with the expected hints", func() {
+		hints, err := NewHintRules([]HintRule{
+			{
+				Name:                  "node-not-ready",
+				Matchers:              map[string]string{"alertname": "Node.*Ready", "severity": "critical"},
+				ProbableCause:         "The node is unreachable",
+				ProposedRepairActions: "Check the node network and power",
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		records := ConvertAmToAlarmEventRecordModels(am, nil, nil, hints)
+		Expect(records).To(HaveLen(2))
+
+		Expect(records[0].ProbableCause).ToNot(BeNil())
+		Expect(*records[0].ProbableCause).To(Equal("The node is unreachable"))
+		Expect(records[0].ProposedRepairActions).ToNot(BeNil())
+		Expect(*records[0].ProposedRepairActions).To(Equal("Check the node network and power"))
+
+		Expect(records[1].ProbableCause).To(BeNil())
+		Expect(records[1].ProposedRepairActions).To(BeNil())
+	})
+
+	It("uses the first matching rule and matches annotations", func() {
+		hints, err := NewHintRules([]HintRule{
+			{
+				Name:          "crash-loop",
+				Matchers:      map[string]string{"summary": ".*crash looping"},
+				ProbableCause: "The container exits repeatedly",
+			},
+			{
+				Name:          "catch-all",
+				Matchers:      map[string]string{"alertname": ".+"},
+				ProbableCause: "Unknown",
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		records := ConvertAmToAlarmEventRecordModels(am, nil, nil, hints)
+		Expect(*records[0].ProbableCause).To(Equal("Unknown"))
+		Expect(*records[1].ProbableCause).To(Equal("The container exits repeatedly"))
+		Expect(records[1].ProposedRepairActions).To(BeNil())
+	})
+
+	It("requires the matchers to match the whole value", func() {
+		hints, err := NewHintRules([]HintRule{
+			{
+				Name:          "partial",
+				Matchers:      map[string]string{"alertname": "Node"},
+				ProbableCause: "Should not match",
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hints.Match(map[string]string{"alertname": "NodeNotReady"}, nil)).To(BeNil())
+	})
+
+	It("rejects invalid rules", func() {
+		_, err := NewHintRules([]HintRule{{Name: "no-matchers", ProbableCause: "x"}})
+		Expect(err).To(MatchError(ContainSubstring("has no matchers")))
+
+		_, err = NewHintRules([]HintRule{{Name: "bad-regex", Matchers: map[string]string{"alertname": "("}, ProbableCause: "x"}})
+		Expect(err).To(MatchError(ContainSubstring("invalid matcher")))
+	})
+
+	It("loads the rules from a file", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "hints.yaml")
+		Expect(os.WriteFile(path, []byte(`
+- name: node-not-ready
+  matchers:
+    alertname: NodeNotReady
+  probableCause: The node is unreachable
+  proposedRepairActions: Check the node network and power
+`), 0o600)).To(Succeed())
+
+		hints, err := LoadHintRules(path)
+		Expect(err).ToNot(HaveOccurred())
+		rule := hints.Match(map[string]string{"alertname": "NodeNotReady"}, nil)
+		Expect(rule).ToNot(BeNil())
+		Expect(rule.Name).To(Equal("node-not-ready"))
+	})
+})
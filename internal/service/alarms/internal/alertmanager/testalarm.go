@@ -0,0 +1,119 @@
+package alertmanager
+
+import (
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/google/uuid"
+	api "github.com/openshift-kni/oran-o2ims/internal/service/alarms/api/generated"
+)
+
+const (
+	// TestAlarmLabel flags the alarms injected for runbook validation. It is kept in the alarm extensions so that
+	// the consumers can tell them apart from the real alarms.
+	TestAlarmLabel = "o2ims_test_alarm"
+	// TestAlarmExpirationLabel holds the time, in RFC3339 format, at which a test alarm is cleared
+	TestAlarmExpirationLabel = "o2ims_test_alarm_expiration"
+	// TestAlarmName is the alert name of the test alarms
+	TestAlarmName = "O2IMSTestAlarm"
+
+	// DefaultTestAlarmExpiration is the lifetime of a test alarm when the request doesn't set one
+	DefaultTestAlarmExpiration = 5 * time.Minute
+	// MaxTestAlarmExpiration is the longest lifetime allowed for a test alarm
+	MaxTestAlarmExpiration = time.Hour
+
+	testAlarmFingerprintPrefix = "test-"
+)
+
+// NewTestAlarmNotification builds an alertmanager notification with a single firing alert flagged as a test
+// alarm, so that it can go through the same conversion as the alerts received from alertmanager.
+func NewTestAlarmNotification(request api.TestAlarmRequest, now time.Time) (*api.AlertmanagerNotification, error) {
+	expiration := DefaultTestAlarmExpiration
+	if request.ExpirationSeconds != nil {
+		expiration = time.Duration(*request.ExpirationSeconds) * time.Second
+		if expiration <= 0 || expiration > MaxTestAlarmExpiration {
+			return nil, fmt.Errorf("expirationSeconds must be between 1 and %d", int(MaxTestAlarmExpiration.Seconds()))
+		}
+	}
+
+	severity := "warning"
+	if request.PerceivedSeverity != nil {
+		var err error
+		if severity, err = perceivedSeverityToSeverity(*request.PerceivedSeverity); err != nil {
+			return nil, err
+		}
+	}
+
+	labels := map[string]string{}
+	if request.Extensions != nil {
+		maps.Copy(labels, *request.Extensions)
+	}
+	labels["alertname"] = TestAlarmName
+	labels["severity"] = severity
+	labels[TestAlarmLabel] = "true"
+	labels[TestAlarmExpirationLabel] = now.Add(expiration).UTC().Format(time.RFC3339)
+	if request.ObjectId != nil {
+		labels["managed_cluster"] = request.ObjectId.String()
+	}
+
+	annotations := map[string]string{
+		"summary": "Test alarm injected for runbook validation",
+	}
+	status := api.Firing
+	fingerprint := testAlarmFingerprintPrefix + uuid.New().String()
+	endsAt := time.Time{}
+
+	am := &api.AlertmanagerNotification{
+		Receiver: "test",
+		Status:   api.Firing,
+	}
+	am.Alerts = make([]struct {
+		Annotations  *map[string]string                  `json:"annotations,omitempty"`
+		EndsAt       *time.Time                          `json:"endsAt,omitempty"`
+		Fingerprint  *string                             `json:"fingerprint,omitempty"`
+		GeneratorURL *string                             `json:"generatorURL,omitempty"`
+		Labels       *map[string]string                  `json:"labels,omitempty"`
+		StartsAt     *time.Time                          `json:"startsAt,omitempty"`
+		Status       *api.AlertmanagerNotificationStatus `json:"status,omitempty"`
+	}, 1)
+	am.Alerts[0].Annotations = &annotations
+	am.Alerts[0].EndsAt = &endsAt
+	am.Alerts[0].Fingerprint = &fingerprint
+	am.Alerts[0].Labels = &labels
+	am.Alerts[0].StartsAt = &now
+	am.Alerts[0].Status = &status
+	return am, nil
+}
+
+// IsTestAlarm reports whether the given alarm extensions flag the alarm as a test alarm
+func IsTestAlarm(extensions map[string]string) bool {
+	return extensions[TestAlarmLabel] == "true"
+}
+
+// GetTestAlarmExpiration returns the time at which the test alarm with the given extensions is cleared
+func GetTestAlarmExpiration(extensions map[string]string) (time.Time, error) {
+	expiration, err := time.Parse(time.RFC3339, extensions[TestAlarmExpirationLabel])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse the test alarm expiration: %w", err)
+	}
+	return expiration, nil
+}
+
+// perceivedSeverityToSeverity is the reverse of severityToPerceivedSeverity for the severities a test alarm can be raised with
+func perceivedSeverityToSeverity(ps api.PerceivedSeverity) (string, error) {
+	switch ps {
+	case api.CRITICAL:
+		return "critical", nil
+	case api.MAJOR:
+		return "major", nil
+	case api.MINOR:
+		return "minor", nil
+	case api.WARNING:
+		return "warning", nil
+	case api.INDETERMINATE:
+		return "indeterminate", nil
+	default:
+		return "", fmt.Errorf("a test alarm cannot be raised with the perceived severity %d", ps)
+	}
+}
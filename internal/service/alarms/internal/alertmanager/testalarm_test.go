@@ -0,0 +1,107 @@
+package alertmanager
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/openshift-kni/oran-o2ims/internal/service/alarms/api/generated"
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/models"
+)
+
+var _ = Describe("Test alarms", func() {
+	var now time.Time
+
+	BeforeEach(func() {
+		now = time.Date(2024, 10, 1, 10, 0, 0, 0, time.UTC)
+	})
+
+	It("propagates a test alarm to the alarm records and the notifications", func() {
+		clusterID := uuid.New()
+		clusterTypeID := uuid.New()
+		severity := api.MAJOR
+		expiration := 60
+		am, err := NewTestAlarmNotification(api.TestAlarmRequest{
+			PerceivedSeverity: &severity,
+			ObjectId:          &clusterID,
+			ExpirationSeconds: &expiration,
+			Extensions:        &map[string]string{"runbook": "node-down"},
+		}, now)
+		Expect(err).ToNot(HaveOccurred())
+
+		records := ConvertAmToAlarmEventRecordModels(am, nil, map[uuid.UUID]uuid.UUID{clusterID: clusterTypeID}, nil)
+		Expect(records).To(HaveLen(1))
+		record := records[0]
+		Expect(record.AlarmStatus).To(Equal(string(api.Firing)))
+		Expect(record.AlarmRaisedTime).To(Equal(now))
+		Expect(record.AlarmClearedTime).To(BeNil())
+		Expect(record.PerceivedSeverity).To(Equal(api.MAJOR))
+		Expect(record.Fingerprint).To(HavePrefix(testAlarmFingerprintPrefix))
+		Expect(*record.ObjectID).To(Equal(clusterID))
+		Expect(*record.ObjectTypeID).To(Equal(clusterTypeID))
+		Expect(record.Extensions).To(HaveKeyWithValue("runbook", "node-down"))
+		Expect(record.Extensions).To(HaveKeyWithValue("alertname", TestAlarmName))
+
+		Expect(IsTestAlarm(record.Extensions)).To(BeTrue())
+		expiresAt, err := GetTestAlarmExpiration(record.Extensions)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(expiresAt).To(Equal(now.Add(time.Minute)))
+
+		// The flag is visible to the API clients and the subscribers
+		Expect(models.ConvertAlarmEventRecordModelToApi(record).Extensions).To(
+			HaveKeyWithValue(TestAlarmLabel, "true"))
+		Expect(models.ConvertAlarmEventRecordModelToAlarmEventNotification(record, uuid.New()).Extensions).To(
+			HaveKeyWithValue(TestAlarmLabel, "true"))
+	})
+
+	It("does not let the extensions override the test alarm flag", func() {
+		am, err := NewTestAlarmNotification(api.TestAlarmRequest{
+			Extensions: &map[string]string{TestAlarmLabel: "false", "alertname": "NodeNotReady"},
+		}, now)
+		Expect(err).ToNot(HaveOccurred())
+
+		record := ConvertAmToAlarmEventRecordModels(am, nil, nil, nil)[0]
+		Expect(IsTestAlarm(record.Extensions)).To(BeTrue())
+		Expect(record.Extensions).To(HaveKeyWithValue("alertname", TestAlarmName))
+		Expect(record.PerceivedSeverity).To(Equal(api.WARNING))
+
+		expiresAt, err := GetTestAlarmExpiration(record.Extensions)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(expiresAt).To(Equal(now.Add(DefaultTestAlarmExpiration)))
+	})
+
+	It("distinguishes the real alarms from the test alarms", func() {
+		am := &api.AlertmanagerNotification{}
+		Expect(json.Unmarshal([]byte(`{
+			"receiver": "oran_alarm_receiver",
+			"status": "firing",
+			"groupKey": "{}:{}",
+			"alerts": [{
+				"status": "firing",
+				"labels": {"alertname": "NodeNotReady", "severity": "critical"},
+				"annotations": {"summary": "Node is not ready"},
+				"startsAt": "2024-10-01T10:00:00Z",
+				"endsAt": "0001-01-01T00:00:00Z",
+				"fingerprint": "a1"
+			}]
+		}`), am)).To(Succeed())
+
+		record := ConvertAmToAlarmEventRecordModels(am, nil, nil, nil)[0]
+		Expect(IsTestAlarm(record.Extensions)).To(BeFalse())
+		_, err := GetTestAlarmExpiration(record.Extensions)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects invalid test alarm requests", func() {
+		expiration := int(MaxTestAlarmExpiration.Seconds()) + 1
+		_, err := NewTestAlarmNotification(api.TestAlarmRequest{ExpirationSeconds: &expiration}, now)
+		Expect(err).To(MatchError(ContainSubstring("expirationSeconds must be between 1 and 3600")))
+
+		severity := api.CLEARED
+		_, err = NewTestAlarmNotification(api.TestAlarmRequest{PerceivedSeverity: &severity}, now)
+		Expect(err).To(MatchError(ContainSubstring("cannot be raised with the perceived severity")))
+	})
+})
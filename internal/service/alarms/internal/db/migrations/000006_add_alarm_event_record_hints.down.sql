@@ -0,0 +1,4 @@
+-- Drop the hint columns from alarm_event_record
+ALTER TABLE alarm_event_record
+    DROP COLUMN IF EXISTS probable_cause,
+    DROP COLUMN IF EXISTS proposed_repair_actions;
@@ -0,0 +1,4 @@
+-- Adds the human readable hints derived from the alarm hint rules
+ALTER TABLE alarm_event_record
+    ADD COLUMN IF NOT EXISTS probable_cause TEXT, -- Probable cause hint matched from the hint rules
+    ADD COLUMN IF NOT EXISTS proposed_repair_actions TEXT; -- Remediation hint matched from the hint rules
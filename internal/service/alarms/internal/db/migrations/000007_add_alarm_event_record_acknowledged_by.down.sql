@@ -0,0 +1,3 @@
+-- Drop the acknowledging user column from alarm_event_record
+ALTER TABLE alarm_event_record
+    DROP COLUMN IF EXISTS alarm_acknowledged_by;
@@ -0,0 +1,3 @@
+-- Records who acknowledged an alarm
+ALTER TABLE alarm_event_record
+    ADD COLUMN IF NOT EXISTS alarm_acknowledged_by TEXT; -- From PATCH api request, the authenticated user when known
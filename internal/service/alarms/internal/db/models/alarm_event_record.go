@@ -17,6 +17,7 @@ type AlarmEventRecord struct {
 	AlarmClearedTime      *time.Time                            `db:"alarm_cleared_time"`
 	AlarmAcknowledgedTime *time.Time                            `db:"alarm_acknowledged_time"`
 	AlarmAcknowledged     bool                                  `db:"alarm_acknowledged"`
+	AlarmAcknowledgedBy   *string                               `db:"alarm_acknowledged_by"` // Authenticated user that acknowledged the alarm, when known
 	PerceivedSeverity     generated.PerceivedSeverity           `db:"perceived_severity"`
 	Extensions            map[string]string                     `db:"extensions"`
 	ObjectID              *uuid.UUID                            `db:"object_id"`      // nullable since ACM may not provide the cluster ID. please manually track them and let ACM know about this.
@@ -25,6 +26,8 @@ type AlarmEventRecord struct {
 	AlarmStatus           string                                `db:"alarm_status"`
 	Fingerprint           string                                `db:"fingerprint"`
 	AlarmSequenceNumber   int64                                 `db:"alarm_sequence_number"`
+	ProbableCause         *string                               `db:"probable_cause"`          // Hint derived from the alarm hint rules
+	ProposedRepairActions *string                               `db:"proposed_repair_actions"` // Hint derived from the alarm hint rules
 }
 
 // TableName returns the name of the table in the database
@@ -21,6 +21,8 @@ func ConvertAlarmEventRecordModelToApi(aerModel AlarmEventRecord) api.AlarmEvent
 		AlarmRaisedTime:       aerModel.AlarmRaisedTime,
 		PerceivedSeverity:     aerModel.PerceivedSeverity,
 		Extensions:            aerModel.Extensions,
+		ProbableCause:         aerModel.ProbableCause,
+		ProposedRepairActions: aerModel.ProposedRepairActions,
 	}
 
 	if aerModel.AlarmDefinitionID != nil {
@@ -33,7 +33,7 @@ func (ar *AlarmsRepository) GetAlarmEventRecords(ctx context.Context) ([]models.
 }
 
 func (ar *AlarmsRepository) PatchAlarmEventRecordACK(ctx context.Context, id uuid.UUID, record *models.AlarmEventRecord) (*models.AlarmEventRecord, error) {
-	return utils.Update[models.AlarmEventRecord](ctx, ar.Db, id, *record, "AlarmAcknowledged", "AlarmAcknowledgedTime", "PerceivedSeverity", "AlarmClearedTime", "AlarmChangedTime")
+	return utils.Update[models.AlarmEventRecord](ctx, ar.Db, id, *record, "AlarmAcknowledged", "AlarmAcknowledgedTime", "AlarmAcknowledgedBy", "PerceivedSeverity", "AlarmClearedTime", "AlarmChangedTime")
 }
 
 // GetAlarmEventRecord grabs a row of alarm_event_record using a primary key
@@ -223,6 +223,7 @@ func buildAlarmEventRecordUpsertQuery(records []models.AlarmEventRecord) (string
 		"AlarmAcknowledged", "PerceivedSeverity", "Extensions",
 		"ObjectID", "ObjectTypeID", "AlarmStatus",
 		"Fingerprint", "AlarmDefinitionID", "ProbableCauseID",
+		"ProbableCause", "ProposedRepairActions",
 	})
 
 	// Set values
@@ -233,6 +234,7 @@ func buildAlarmEventRecordUpsertQuery(records []models.AlarmEventRecord) (string
 			record.AlarmAcknowledged, record.PerceivedSeverity, record.Extensions,
 			record.ObjectID, record.ObjectTypeID, record.AlarmStatus,
 			record.Fingerprint, record.AlarmDefinitionID, record.ProbableCauseID,
+			record.ProbableCause, record.ProposedRepairActions,
 		)))
 	}
 	query.Apply(values...)
@@ -249,6 +251,8 @@ func buildAlarmEventRecordUpsertQuery(records []models.AlarmEventRecord) (string
 		im.SetExcluded(dbTags["ObjectTypeID"]),
 		im.SetExcluded(dbTags["AlarmDefinitionID"]),
 		im.SetExcluded(dbTags["ProbableCauseID"]),
+		im.SetExcluded(dbTags["ProbableCause"]),
+		im.SetExcluded(dbTags["ProposedRepairActions"]),
 	))
 
 	return query.Build() //nolint:wrapcheck
@@ -303,6 +307,24 @@ func getGetAlertNameObjectTypeIDAndSeverity(am *api.AlertmanagerNotification, cl
 
 // ResolveNotificationIfNotInCurrent find and only keep the alerts that are available in the current payload
 func (ar *AlarmsRepository) ResolveNotificationIfNotInCurrent(ctx context.Context, am *api.AlertmanagerNotification) error {
+	sql, params, err := buildResolveNotificationQuery(am, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to build AlarmEventRecord update query when processing AM notification: %w", err)
+	}
+	records, err := utils.ExecuteCollectRows[models.AlarmEventRecord](ctx, ar.Db, sql, params)
+	if err != nil {
+		return err
+	}
+
+	if len(records) > 0 {
+		slog.Info("Successfully resolved alarms that no longer exist", "records", len(records))
+	}
+	return nil
+}
+
+// buildResolveNotificationQuery builds the query resolving the alarms that are not in the alertmanager payload, except
+// for the test alarms that have not expired at the given time
+func buildResolveNotificationQuery(am *api.AlertmanagerNotification, now time.Time) (string, []any, error) {
 	m := models.AlarmEventRecord{}
 	dbTags := utils.GetAllDBTagsFromStruct(m)
 	var (
@@ -313,6 +335,7 @@ func (ar *AlarmsRepository) ResolveNotificationIfNotInCurrent(ctx context.Contex
 		alarmStatus        = dbTags["AlarmStatus"]
 		perceivedSeverity  = dbTags["PerceivedSeverity"]
 		alarmEventRecordID = dbTags["AlarmEventRecordID"]
+		extensions         = dbTags["Extensions"]
 	)
 
 	updateClearedTimeCase := fmt.Sprintf(
@@ -320,31 +343,58 @@ func (ar *AlarmsRepository) ResolveNotificationIfNotInCurrent(ctx context.Contex
 		clearedTime, clearedTime, clearedTime,
 	)
 
+	// Test alarms are never part of the alertmanager payload, they are only resolved here once expired
+	notActiveTestAlarm := fmt.Sprintf(
+		"NOT (COALESCE(%s->>?, '') = 'true' AND (%s->>?)::timestamptz > ?)",
+		extensions, extensions,
+	)
+
 	query := psql.Update(
 		um.Table(tableName),
 		um.SetCol(alarmStatus).ToArg(api.Resolved),
-		um.Set(psql.Raw(updateClearedTimeCase, time.Now())),
+		um.Set(psql.Raw(updateClearedTimeCase, now)),
 		um.SetCol(perceivedSeverity).ToArg(api.CLEARED),
 		um.Where(
 			psql.Group(psql.Quote(fingerprint), psql.Quote(raisedTime)).
 				NotIn(getGetAlertFingerPrintAndStartAt(am)...),
 		),
+		um.Where(psql.Raw(notActiveTestAlarm,
+			alertmanager.TestAlarmLabel, alertmanager.TestAlarmExpirationLabel, now)),
 		um.Returning(psql.Quote(alarmEventRecordID)),
 	)
 
-	sql, params, err := query.Build()
+	return query.Build() //nolint:wrapcheck
+}
+
+// ResolveTestAlarm resolves an expired test alarm. Only the clearing columns of the current record are updated, so
+// that the changes made to the alarm since it was injected are kept. It returns whether the alarm was still active.
+func (ar *AlarmsRepository) ResolveTestAlarm(ctx context.Context, fingerprint string, raisedTime, clearedTime time.Time) (bool, error) {
+	sql, params, err := buildResolveTestAlarmQuery(fingerprint, raisedTime, clearedTime)
 	if err != nil {
-		return fmt.Errorf("failed to build AlarmEventRecord update query when processing AM notification: %w", err)
+		return false, fmt.Errorf("failed to build query for test alarm resolution: %w", err)
 	}
-	records, err := utils.ExecuteCollectRows[models.AlarmEventRecord](ctx, ar.Db, sql, params)
+
+	r, err := ar.Db.Exec(ctx, sql, params...)
 	if err != nil {
-		return err
+		return false, fmt.Errorf("failed to execute test alarm resolution query: %w", err)
 	}
+	return r.RowsAffected() > 0, nil
+}
 
-	if len(records) > 0 {
-		slog.Info("Successfully resolved alarms that no longer exist", "records", len(records))
-	}
-	return nil
+// buildResolveTestAlarmQuery builds the query resolving the active test alarm with the given fingerprint and raised time
+func buildResolveTestAlarmQuery(fingerprint string, raisedTime, clearedTime time.Time) (string, []any, error) {
+	m := models.AlarmEventRecord{}
+	dbTags := utils.GetAllDBTagsFromStruct(m)
+	query := psql.Update(
+		um.Table(m.TableName()),
+		um.SetCol(dbTags["AlarmStatus"]).ToArg(api.Resolved),
+		um.SetCol(dbTags["AlarmClearedTime"]).ToArg(clearedTime),
+		um.SetCol(dbTags["PerceivedSeverity"]).ToArg(api.CLEARED),
+		um.Where(psql.Quote(dbTags["Fingerprint"]).EQ(psql.Arg(fingerprint))),
+		um.Where(psql.Quote(dbTags["AlarmRaisedTime"]).EQ(psql.Arg(raisedTime))),
+		um.Where(psql.Quote(dbTags["AlarmStatus"]).NE(psql.Arg(api.Resolved))),
+	)
+	return query.Build() //nolint:wrapcheck
 }
 
 func getGetAlertFingerPrintAndStartAt(am *api.AlertmanagerNotification) []bob.Expression {
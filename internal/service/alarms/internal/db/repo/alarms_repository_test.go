@@ -0,0 +1,79 @@
+package repo
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/openshift-kni/oran-o2ims/internal/service/alarms/api/generated"
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/alertmanager"
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/models"
+)
+
+var _ = Describe("Test alarm queries", func() {
+	var (
+		now        time.Time
+		raisedTime time.Time
+		extensions map[string]string
+	)
+
+	BeforeEach(func() {
+		now = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+		raisedTime = now.Add(-time.Minute)
+		extensions = map[string]string{
+			alertmanager.TestAlarmLabel:           "true",
+			alertmanager.TestAlarmExpirationLabel: now.Add(4 * time.Minute).Format(time.RFC3339),
+		}
+	})
+
+	It("upserts a test alarm with its extensions", func() {
+		sql, args, err := buildAlarmEventRecordUpsertQuery([]models.AlarmEventRecord{{
+			AlarmRaisedTime:   raisedTime,
+			PerceivedSeverity: api.WARNING,
+			Extensions:        extensions,
+			AlarmStatus:       string(api.Firing),
+			Fingerprint:       "test-fingerprint",
+		}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sql).To(ContainSubstring("INSERT INTO alarm_event_record"))
+		Expect(sql).To(ContainSubstring("ON CONFLICT ON CONSTRAINT unique_fingerprint_alarm_raised_time DO UPDATE"))
+		Expect(args).To(ContainElements(raisedTime, api.WARNING, extensions, string(api.Firing), "test-fingerprint"))
+	})
+
+	It("does not resolve the test alarms that have not expired with an alertmanager notification", func() {
+		fingerprint := "fingerprint"
+		am := &api.AlertmanagerNotification{}
+		am.Alerts = append(am.Alerts, struct {
+			Annotations  *map[string]string                  `json:"annotations,omitempty"`
+			EndsAt       *time.Time                          `json:"endsAt,omitempty"`
+			Fingerprint  *string                             `json:"fingerprint,omitempty"`
+			GeneratorURL *string                             `json:"generatorURL,omitempty"`
+			Labels       *map[string]string                  `json:"labels,omitempty"`
+			StartsAt     *time.Time                          `json:"startsAt,omitempty"`
+			Status       *api.AlertmanagerNotificationStatus `json:"status,omitempty"`
+		}{Fingerprint: &fingerprint, StartsAt: &raisedTime})
+
+		sql, args, err := buildResolveNotificationQuery(am, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sql).To(ContainSubstring(`("fingerprint", "alarm_raised_time") NOT IN (($4, $5))`))
+		Expect(sql).To(ContainSubstring(
+			"NOT (COALESCE(extensions->>$6, '') = 'true' AND (extensions->>$7)::timestamptz > $8)"))
+		Expect(args).To(HaveLen(8))
+		Expect(args[5:]).To(Equal([]any{
+			alertmanager.TestAlarmLabel, alertmanager.TestAlarmExpirationLabel, now}))
+	})
+
+	It("only updates the clearing columns of an active test alarm", func() {
+		clearedTime := now.Add(5 * time.Minute)
+		sql, args, err := buildResolveTestAlarmQuery("test-fingerprint", raisedTime, clearedTime)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sql).To(Equal("UPDATE alarm_event_record SET\n" +
+			"\"alarm_status\" = $1,\n" +
+			"\"alarm_cleared_time\" = $2,\n" +
+			"\"perceived_severity\" = $3\n" +
+			"WHERE (\"fingerprint\" = $4) AND (\"alarm_raised_time\" = $5) AND (\"alarm_status\" <> $6)"))
+		Expect(args).To(Equal([]any{
+			api.Resolved, clearedTime, api.CLEARED, "test-fingerprint", raisedTime, api.Resolved}))
+	})
+})
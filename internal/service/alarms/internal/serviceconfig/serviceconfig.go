@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"strconv"
+	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/utils/ptr"
@@ -17,8 +18,10 @@ import (
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/models"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/clients/k8s"
 	serverutils "github.com/openshift-kni/oran-o2ims/internal/service/common/utils"
+	"github.com/stephenafamo/bob"
 	"github.com/stephenafamo/bob/dialect/psql"
 	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -31,6 +34,10 @@ const (
 	cleanupConfigMapName = "alarms-server-events-cleanup-sql"
 	cleanScriptDir       = "/scripts"
 	cleanScriptName      = "cleanup.sql"
+
+	// MaxResolvedAlarmsExtension is the service configuration extension that limits the number of resolved alarms
+	// retained. The most recently cleared alarms are kept when the limit is exceeded.
+	MaxResolvedAlarmsExtension = "maxResolvedAlarms"
 )
 
 var (
@@ -116,6 +123,22 @@ func (c *Config) generateConfigMapWithSql(sc *models.ServiceConfiguration) (core
 	}, nil
 }
 
+// GetMaxResolvedAlarms returns the maximum number of resolved alarms to retain as set in the service configuration
+// extensions. Zero means that the number of resolved alarms is not limited.
+func GetMaxResolvedAlarms(sc *models.ServiceConfiguration) (int, error) {
+	value, found := sc.Extensions[MaxResolvedAlarmsExtension]
+	if !found {
+		return 0, nil
+	}
+
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 1 {
+		return 0, fmt.Errorf("%s must be a positive integer, got %q", MaxResolvedAlarmsExtension, value)
+	}
+
+	return limit, nil
+}
+
 // getCleanUpPgSQL returns sql string to do alarms events cleanup based on service config
 func getCleanUpPgSQL(sc *models.ServiceConfiguration) (string, error) {
 	slog.Info("Using service config to generating sql", "serviceConfig", sc)
@@ -126,31 +149,56 @@ func getCleanUpPgSQL(sc *models.ServiceConfiguration) (string, error) {
 		return "", fmt.Errorf("invalid retention period: %d", sc.RetentionPeriod)
 	}
 
-	// Currently not supported
-	if len(sc.Extensions) != 0 {
-		slog.Warn("Service configuration extension is currently ignored")
+	maxResolvedAlarms, err := GetMaxResolvedAlarms(sc)
+	if err != nil {
+		return "", fmt.Errorf("invalid service configuration extension: %w", err)
 	}
 
 	aer := models.AlarmEventRecord{}
 	dbTag := serverutils.GetAllDBTagsFromStruct(aer)
-	query := psql.Delete(
-		dm.From(aer.TableName()),
-		dm.Where(
-			psql.Quote(dbTag["AlarmClearedTime"]).LT(
-				psql.Raw("now() - interval '"+strconv.Itoa(sc.RetentionPeriod)+" days'"),
+	resolved := psql.Quote(dbTag["AlarmStatus"]).EQ(psql.S(string(api.Resolved)))
+
+	// Remove the resolved alarms that were cleared before the retention period
+	queries := []bob.Query{
+		psql.Delete(
+			dm.From(aer.TableName()),
+			dm.Where(
+				psql.Quote(dbTag["AlarmClearedTime"]).LT(
+					psql.Raw("now() - interval '"+strconv.Itoa(sc.RetentionPeriod)+" days'"),
+				),
 			),
+			dm.Where(resolved),
 		),
-		dm.Where(
-			psql.Quote(dbTag["AlarmStatus"]).EQ(psql.S(string(api.Resolved))),
-		),
-	)
+	}
 
-	sql, _, err := query.Build()
-	if err != nil {
-		return "", fmt.Errorf("failed to build query for alarms events cleanup: %w", err)
+	// Remove the oldest resolved alarms beyond the configured limit
+	if maxResolvedAlarms > 0 {
+		queries = append(queries, psql.Delete(
+			dm.From(aer.TableName()),
+			dm.Where(
+				psql.Quote(aer.PrimaryKey()).In(
+					psql.Select(
+						sm.Columns(psql.Quote(aer.PrimaryKey())),
+						sm.From(aer.TableName()),
+						sm.Where(resolved),
+						sm.OrderBy(psql.Quote(dbTag["AlarmClearedTime"])).Desc(),
+						sm.Offset(psql.Raw(strconv.Itoa(maxResolvedAlarms))),
+					),
+				),
+			),
+		))
+	}
+
+	statements := make([]string, 0, len(queries))
+	for _, query := range queries {
+		sql, _, err := bob.Build(query)
+		if err != nil {
+			return "", fmt.Errorf("failed to build query for alarms events cleanup: %w", err)
+		}
+		statements = append(statements, sql+";")
 	}
 
-	return sql, nil
+	return strings.Join(statements, "\n"), nil
 }
 
 // generateCronJob generates a new CR for cronjob that use our current PG to run psql commands
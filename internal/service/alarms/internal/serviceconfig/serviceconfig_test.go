@@ -0,0 +1,82 @@
+package serviceconfig
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/models"
+)
+
+var _ = Describe("Alarms events cleanup", func() {
+	Describe("GetMaxResolvedAlarms", func() {
+		It("does not limit the resolved alarms by default", func() {
+			limit, err := GetMaxResolvedAlarms(&models.ServiceConfiguration{RetentionPeriod: 1})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(limit).To(Equal(0))
+		})
+
+		It("returns the limit set in the extensions", func() {
+			limit, err := GetMaxResolvedAlarms(&models.ServiceConfiguration{
+				RetentionPeriod: 1,
+				Extensions:      map[string]string{MaxResolvedAlarmsExtension: "100"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(limit).To(Equal(100))
+		})
+
+		DescribeTable("rejects invalid limits",
+			func(value string) {
+				_, err := GetMaxResolvedAlarms(&models.ServiceConfiguration{
+					RetentionPeriod: 1,
+					Extensions:      map[string]string{MaxResolvedAlarmsExtension: value},
+				})
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("zero", "0"),
+			Entry("negative", "-1"),
+			Entry("not a number", "many"),
+		)
+	})
+
+	Describe("getCleanUpPgSQL", func() {
+		It("purges the resolved alarms cleared before the retention period", func() {
+			sql, err := getCleanUpPgSQL(&models.ServiceConfiguration{RetentionPeriod: 7})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(strings.Count(sql, "DELETE FROM alarm_event_record")).To(Equal(1))
+			Expect(sql).To(ContainSubstring(`"alarm_cleared_time" < now() - interval '7 days'`))
+			Expect(sql).To(ContainSubstring(`"alarm_status" = 'resolved'`))
+		})
+
+		It("purges the oldest resolved alarms beyond the limit", func() {
+			sql, err := getCleanUpPgSQL(&models.ServiceConfiguration{
+				RetentionPeriod: 7,
+				Extensions:      map[string]string{MaxResolvedAlarmsExtension: "500"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			statements := strings.Split(sql, ";\n")
+			Expect(statements).To(HaveLen(2))
+			Expect(statements[0]).To(ContainSubstring(`interval '7 days'`))
+			// The most recently cleared alarms are the ones retained
+			Expect(statements[1]).To(ContainSubstring(`"alarm_event_record_id" IN`))
+			Expect(statements[1]).To(ContainSubstring(`"alarm_status" = 'resolved'`))
+			Expect(statements[1]).To(ContainSubstring(`ORDER BY "alarm_cleared_time" DESC`))
+			Expect(statements[1]).To(ContainSubstring("OFFSET 500"))
+		})
+
+		It("rejects an invalid retention period", func() {
+			_, err := getCleanUpPgSQL(&models.ServiceConfiguration{RetentionPeriod: 0})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("rejects an invalid limit", func() {
+			_, err := getCleanUpPgSQL(&models.ServiceConfiguration{
+				RetentionPeriod: 7,
+				Extensions:      map[string]string{MaxResolvedAlarmsExtension: "0"},
+			})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
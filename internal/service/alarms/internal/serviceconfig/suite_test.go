@@ -0,0 +1,13 @@
+package serviceconfig
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestServiceConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Service Configuration Suite")
+}
@@ -100,12 +100,22 @@ func Serve(config *api.AlarmsServerConfig) error {
 		}
 	}
 
+	// Load the optional alarm hint rules
+	var hintRules *alertmanager.HintRules
+	if config.HintRulesFile != "" {
+		hintRules, err = alertmanager.LoadHintRules(config.HintRulesFile)
+		if err != nil {
+			return fmt.Errorf("failed to load alarm hint rules: %w", err)
+		}
+	}
+
 	// Init server
 	// Create the handler
 	alarmServer := api.AlarmsServer{
 		GlobalCloudID:    globalCloudID,
 		AlarmsRepository: alarmRepository,
 		Infrastructure:   infrastructureClients,
+		HintRules:        hintRules,
 	}
 
 	// Start a new notifier
@@ -148,6 +158,7 @@ func Serve(config *api.AlarmsServerConfig) error {
 		Middlewares: []generated.MiddlewareFunc{ // Add middlewares here
 			common.OpenAPIValidation(swagger),
 			common.ResponseFilter(filterAdapter),
+			common.RemoteUser(),
 			common.LogDuration(),
 		},
 		ErrorHandlerFunc: common.GetOranReqErrFunc(),
@@ -200,6 +211,9 @@ func Serve(config *api.AlarmsServerConfig) error {
 
 // gracefulShutdownWithTasks Server may have background tasks running when SIGTERM is received. Let them continue.
 func gracefulShutdownWithTasks(srv *http.Server, alarmsServer *api.AlarmsServer) error {
+	// The pending test alarms are resolved after the restart instead
+	alarmsServer.StopTestAlarmTimers()
+
 	done := make(chan struct{})
 	go func() {
 		alarmsServer.Wg.Wait()
@@ -727,54 +727,53 @@ func (sh *strictHandler) GetManagedInfrastructureTemplate(w http.ResponseWriter,
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xaWZPbNhL+K13crYqd1TFX7ERbeZiM7bWq4njWM84+mK4IIpsiEhLgAOCMlbH++1YD",
-	"4CGJEufw1vohfrFFAo3ur7++QN8GkcwLKVAYHUxug4IplqNBZX9FMs+l+I0V/DdZoKC/8VOUlTG+4pjF",
-	"dk2MOlK8MFyKYBKcyTxnoJHkGIwh49qATCCh9aAwQYUiQg1GghcFiZI5mBRBoS4zMwpFKF6yKN3cBFwD",
-	"8w8Fy3EAUgEddlXa1/Ux9FK3lJgvQWdMp6hH8EqqUOAnlhcZDtpakAKzSJbCqOUMdDl3smTi3uAng0Jz",
-	"KfTMnTIhNWezGUmzEn6zj/WPzcqxF+fXheI/KQowKddQ4wxci28MlBpjENIbcMOzDOZY6RZbSBzkwL0E",
-	"i+zmQsBrFMCtzktgit4UGY+4yZbAhV9Uai4WtCQUM6f0rFFoFIpgEHiEgklgkd62KRgEnBx+VaL9QcuC",
-	"SbCORTAIdJRizogoZlnQCm0UF4tgtRp00Sv5Arzydjqk/k+sWqBxvKFdnjHARPwImnl67fDHXTnGssye",
-	"5KTVBFJoSiUs0x7h/Yd7PTOotr1+gUxFKUSKG1ScWR+eSWEYFxqkQHJVLhWCXl842HAT5jySmRR6BJYC",
-	"G8stBUJhyiJDiJx8ihAmQBaomJFqUHOkIQ65s63ENctKIsNlivU+iJgIxZwWLysnJzLL5A0d4FDR1sef",
-	"4W215zO8QWY1eMifz6H4PKz/tP75gD8ki+gqzIwkwxtmohS1zzAekajyCD2yIOzUC2Z4NXO/umVxDXhV",
-	"soxiaI84J2th+mQtFDIKAJMysUteJQtn95AlVaeeThYXfXpZ2iTNTr0Tr6zXxgy13mtgS1afjY2sTQMb",
-	"2U6W8KTYISuWqEFIU5Fjh25elifFbr1IUh8vvCwP/n5Zffh/poi8rHetFQvaRPmOBLTk+ITqf8n57xiZ",
-	"7VoSimqrX7+znkC7nJS6o0EZepOE5jGGor9+UJL98QledST0wct/P61LyGUDC7UQJJipRZlTk1gb6JPV",
-	"pq5WiatZKwHKvGAKdSiiFKM/an84D8re4B9VGtmwopzrfFwdoEGXRSGVgbzMDKcUXiXiTRStAtX5NZSh",
-	"2MRyRym2+nGTooLZy4sZ+Xb2/mIbYC46Ab4YvL94ul6mPchVjFBlZHpQ0YAO0AWzXQ21cwIxJjPmCLpU",
-	"SpYi9rThYpEhXJXSoB6FYr/d7Y7E09nVIZjlS4iyUhtUs07e2G7gm2bVNxv21B6oK+uOOmx5Rf3IwDYk",
-	"jgU55KU2kFPcQiKV61CJPxkaW5hjTo0BmWQXdXCvqa22s+mynOtQtC2Fb5mIv90Ir9qBBBF5+454/HNX",
-	"eG26vr9Dc31rf4tWK9Lo8XRnf2b7rP39Wc4EW2A8FYli2qgyMqXCS8yLjBmcxttd2nvBr0oEHqMwPOGo",
-	"yJ8MvBzga4LAeEldneboGhXhVqlfMJM22vcpNggUXpVcYRxMjCpxn5mr6qWdNd7sk7xt8FQkUuXMcpHN",
-	"ZWnuam2hKGsZjvZUpgxPWGTeoZalijqxnTag+ojoPQkuiVotb1B3m2UyqsKQhLwdnmWyjEdrLkjY0cnR",
-	"8+c/DOMEk+HJ4QkO50fzw+Hx/Pl38bPk2fzZd4SyMz6YBGXJ6fcGtoN1EzYteoGG8Ux74EiXqFQKhek1",
-	"bE3XF5hw4TJjE0bO0BuMLVg+GKBQ8poTrUi9DnWbcLVeiV2WYdn5mrf2WxlcpqjRpTWhC4wI+hieULOh",
-	"DRMxUzH/E+On0HAAnvyBS/0UblIepa7SMp5J1XjpGkUsFdWBUNQF1d5ZGPSzJG9x0RpKNaHB9tRzDCqS",
-	"bYTdbaANM6WmEdvBVdH+V5bx2FImZRrOfBaOJ3a8qaCtfEOVjFaTFrqMIsQYYwLJo+baIXrgYnmTFK/L",
-	"nAlQyGI2zxBaLymX9BBjjRdayKFiYhiXXa6umXJRZ4ZNdnbSqkosllcbQLmBr0nlMYmwFNKgU1lmsa9K",
-	"LvAKFKfnU/j1GFwCsi1N65bGbsfWibZP3MyWt6EACFsJJQwmYB/SY++eqSDqRXheG2JXrQbVOiFj9Nb8",
-	"wnJsyaC3hB49Cj2AYeDeNfulTSIXnNLvvTcXMuPRskJxp44VEPT4QyN/S/VB825drdaL3Ue2Fu0Bzy36",
-	"SH+tQrGyPtlieFXDJrctf12fDA+fD4+Hh9usXLXr1oeuqtDIXM8623z28bWW0z526NhxB3N6Pv210by/",
-	"4HmVqg7+9HzaVeM6wTgcHYwOOoG4n6L6bppWd4VeF92jMit4W36t9oeWNd6E1cdBwA3mduHfFSbBJPjb",
-	"uLlRH/seY7wf78ZwphRb0u9S8XOFCf+0jtxYHvFcD9cT4VRcozBSLcfXhw9G9VzJeYa5r872U8A6KnVZ",
-	"PDVG8Xlp8DHl8lQsQZT53DeLtRBgtfQBMO2zIaV+mqNdXY2ce6WiMskEcAKHhlP7fNQVk7E1a5sup5BS",
-	"5RnWlYcSMRO+kPnj3DjKNcjItSpRPbcWDrX11uRMCoFRNanEzLA5o/md5xiDLE1XYeI+23Sp+P7dtHUD",
-	"YVJmmu7Oz2+Vprs1hFBMqcVawtJOfkmp7CTb7h94AjHWJ/lBo+n2FO/SvOofbrcbInh9eXkObgFEMkbf",
-	"w/ZBWR/JRQssLgwuUNlo4SbrhEqnUpnBplN1medMLTdOApI7gqmpqrS9rkqZWPhPUi0djdyt8cB+AcLC",
-	"WOuKUhVSo00y1HNn/E9HS5gm9kR7lcivUbj7Y+sEe+MWBjZhTeYZE3+EwcABVccD6JQmQ5ZpewNQ9Xqj",
-	"dhFqvOIe9HGJRZFUsf0YJGH68vIVvHt1Bsc/fP8MPhx/7KTaFnhcA4pIlsq2aHYLraODvI46FBsOiWVU",
-	"1gFbDzaV6Cc4WozcR6rXl29+pgYZxTozoZmhc7RZxF/sFQo1CjMIBTfa3zERilqXeX17soH0Zm+VGlPo",
-	"yXhcMbKF4SiSeW9MbBR0HyB1Etquxys/hCjBshcy6gimt8N3p7/AW0r+MBUGVcIihIt2PgyoaGQUeD+9",
-	"cBklke4brjAsMvRPP0e/wxheM1NvqOy9ubkZKYxTZqyZ2zn7fGp99fZo+uYC1ofletAgQzMeodDYOvK0",
-	"YFGKcGTL/vapzL4eSbUY+716/PP07OUvFy+HR6ODUWryrBX3wX4NqLC3eqa6WA8CX+yCSXA8Ohgd2wbK",
-	"pBbvzspaixxTibxudQULNNteemdvaFyc1NdWVfdB6NUdSF2ymllPo7rm1Xzmhj0uxTQOJsG/0JxmWd2U",
-	"EL10IQkl0uHo4KDyMwrjOhiaJuz+8e/adV/NVciD+xTtmLrxiY6mPa2TMsuWIOeG2XLdiUBlPZm4GgQn",
-	"e/X2wfePR+u/0dh0mPATi+24hdr2Ct99LXrZQKeeiKiBClApqUY2v/iK5rixRi1KR2yhKfHkaBh1H8FH",
-	"2tJD8OvD+3O8cmzOhVS7CV4X/Zz9LtXOwWGL829I7FfN+r+I/GWJvE2kR9B573XxPQm+/wJK610M3q/C",
-	"YO2/W33oRr5ZMu7971irwUNkJI/bbD8p0CT8qPi80xi9/55+a4y+T7W6q6v/Kl5fOuZ7AG9ngP3h9EXy",
-	"wvi25zPT6k6ZI/ZfOe7+Jex+yePeuaPv49nDov9Lpp+P/8MK35M47pMoWp69y9e4rztdnBycfB16XTa3",
-	"WBgDCsPNEm6YG+oTWYp49Fd6s9LteS7km0F6Mh7by6ZUajP5/uDAXU17wf135PuK3d0+v1P8rv4bAAD/",
-	"/5Zn7q1NLgAA",
+	"H4sIAAAAAAAC/+xa3XPbNhL/V3Z4N9OkR0mO7SatbvrgOslFM03ji53eQ+ipIHIpoiUBGgDtqI7+95sF",
+	"wA9JlOSP3Fwe6hdbIrDY/e1vv0DfBrEsSilQGB2Mb4OSKVagQWU/xbIopPiNlfw3WaKg3/gpzqsEX3PM",
+	"E7smQR0rXhouRTAOTmVRMNBIcgwmkHNtQKaQ0npQmKJCEaMGI8GLglTJAkyGoFBXuRlGIhKvWJytbwKu",
+	"gfkvBSswBKmADruq7GOZdh7qjhKzBeic6Qz1EF5LFQn8xIoyx7CrBSkwjWUljFpMQVczJ0um7gl+Mig0",
+	"l0JP3SljUnM6nUbCS/jNfq1/bFeOvDi/LhL/yVCAybiGBmfgWnxjoNKYgJDegBue5zDDWrfEQuIgB+4l",
+	"WGTXFwJeowBudV4AU/SkzHnMTb4ALvyiSnMxpyWRmDqlp61Cw0gEYeARCsaBRXrTpiAMODn8qkL7gZYF",
+	"42AViyAMdJxhwYgoZlHSCm0UF/NguQz76JV+AV55Ox1S/ydWzdE43tAuzxhgInkEzTy9tvjjrhxjeW5P",
+	"ctIaAik0lRKYPM77D/d6blBtev0cmYoziBU3qDizPjyVwjAuNEiB5KpCKgS9ujBccxMWPJa5FHoIlgJr",
+	"yy0FImGqMkeInXyKECZAlqiYkSoEtkEccmdXiWuWV0SGiwybfRAzEYkZLV7UTk5lnssbOsChoq2PP8O7",
+	"es9neIvMavCQn8+R+Dxofjp/PuCHZBFdhZmSZHjLTJyh9hnGIxLXHjGZB2GrXjDFqynAdllcA15VLKcY",
+	"2iHOyZqbfbLmCplBBSZjYpu8WhZO7yFLql49nSwu9ullaZO2O/VWvPK9Nuao9U4DO7JweldZ6wa2sp0s",
+	"4UmxRVYiUYOQpibHFt28LE+K7XqRpH288LK4uIOsffh/poi8yHAj5rljuWCFFdCR4xOq/yRnv2NsNmtJ",
+	"JOqtfv3WegLdclLpngZl4E0SmicYif31IzeofnyCVz0JPXz176dNCbloYWHKHczUvCpQmNZAn6zWdbVK",
+	"XE07CVAWJVOoIxFnGP/R+MN5UO4N/mGtkQ0ryrnOx/UBGnRVllIZKKrc8DL3+3pQtArU5zdQRmIdyy2l",
+	"2OrHTYYKpq/Op+Tb6YfzTYC56AX4PPxw/nS1THuQ6xihysh0WNOADtAls10NtXMCMSEzZgi6UkpWIvG0",
+	"4WKeI1xV0qAeRmK33d2OxNPZ1SGYFguI80obVNNe3tDW8Jt21Tdr9jQeaCrrljpseUX9SGgbEseCAopK",
+	"GygobiGVynWoxJ8cjS3MCafGgEyyi3q419ZW29n0Wc51JLqWwrdMJN+uhVfjQIKIvH1HPP65LbzOn963",
+	"Q3N96/4WrVGk1ePp1v6MVN/TnxVMsDkmE5Eqpo2qYlMpvMCizJnBSbLZpX0Q/KpC4AkKw1OOivzJwMsB",
+	"viIIjJfU12kOr1ERbrX6JTNZq/0+xcJA4VXFFSbB2KgKd5m5rB/aWePtLsmbBk9EKlXB6BOwmazMXa0t",
+	"FWUtw9GeypThKYvNe9SyUnEvtpMWVB8Re0+CC6JWxxtcE6dkXIchCXk3OM1llQxXXJCyw+PDFy9+GCQp",
+	"poPjZ8c4mB3Ong2OZi++S56nz2fPvyOUnfHBOKgqTp/XsA1XTVi36CUaxnPtgSNd4kopFGavYSu6vsSU",
+	"C5cZ2zByht5gYsHywQClktecaEXq9ajbhqv1SuKyDMvPVry128rgIkONLq0JXWJM0CfwREgD2jCRMJXw",
+	"PzF5Ci0H4MkfuNBP4SbjcWa3EjJStV66RpFIRXUgEk1BtXcWBv0syTtctIZSTWixPfEcg5pka2F3G2jD",
+	"TKVpxHZw1bT/leU8sZTJmIZTn4WTsR1vamhr38C1W01a6CqOERNMCCSPmmuHgmUdy+ukeFMVTIBClrBZ",
+	"jtB5SLlkDzFWeKGFHCgmBknV5+qGKedNZlhnZy+t6sRiebUGlBv4msWQkAhLIQ06k1We+KrkAq9EcXI2",
+	"gV+PwCUg29J0bmnsduycaPvE9Wx5GwmAqJNQomAM9kv62rtnIoh6MZ41hthVy7BeJ2SC3ppfWIEdGfSU",
+	"0KOvIg9gFLhn7X5pk8g5p/R7782lzHm8qFHcqmMNBH39sZW/oXrYPltVq/Ng+5GdRTvAc4su6dcyEkvr",
+	"kw2G1zVsfNvx1/Xx4NmLwdHg2SYrl9269bGvKrQyV7POJp99fK3ktMseHXvuYE7OJr+2mu8veF6luoM/",
+	"OZv01bheMJ4ND4YHvUDcT1F9N03ru0Kvi96jMit5V36j9seONd6E5WUYcIOFXfh3hWkwDv42am/UR77H",
+	"GO3GuzWcKcUW9LlS/Exhyj+tIjeSh7zQg9VEOBHXKIxUi9H1swejeqbkLMfCV2c6dQ2VpiyeGKP4rDL4",
+	"mHJ5IhYgqmLmm8VGCLBGeghM+2xIqR8Y+LoaO/dKRWWSCeAEToHC2O+HfTGZWLM26XICGVWeQVN5KBEz",
+	"4Q6oj3PjKNcgY9eqxM3cWjrUVluTUykExvWkkjDDZkwjGF5gArIyfYWJ+2zTp+KH95PODYTJmGm7Oz+/",
+	"1Zpu1xAiMTFQsAUs7OSXVspOst3+gaeQYHOSHzTabk/xPs3r/uF2syGCNxcXZ+AWQCzpVY9Ud4CyOZKL",
+	"DlhcGJyjstHCTd4Llc6kMuG6U3VVFEwt1k4CkjuEiamrtL2uypiY+1dSHR2N3K5xaN8AYWmsdWWlSqnR",
+	"JhnquXP+p6MlTFJ7or1K5PSaxt4fWyfYG7cosAlrPMuZ+CMKQgdUEw+gM5oMWa7tDUDd6w0j0ecV98U+",
+	"LrE4liqxL4MkTF5dvIb3r0/h6Ifvn8PHo8teqm2AxzWgiGWlbItmt9A6OsjrqCOx5pBExlUTsM1gU4t+",
+	"gsP50L2kenPx9mdqkFGsMhPaGbpAm0X8xV6pUKMwYSS40f6OiVDUuiqa25M1pNd7q8yYUo9Ho5qRHQyH",
+	"sSz2xsRaQfcB0iShzXq89EOIEix/KeOeYHo3eH/yC7yj5A8TYVClLEY47+bDgIpGToH300uXUVLp3uEK",
+	"w2JDf/o5+j0m8IaZZkNt783NzVBhkjFjzdzM2WcT66t3h5O357A6LDeDBhma8xiFxs6RJyWLM4TD4UHv",
+	"qcw+Hko1H/m9evTz5PTVL+evBofDg2FmirwT98FuDaiwB+FmsQ4DX+yCcXA0PBge2QbKZBbv3sraiBxR",
+	"ibzudAVzNJteem9vaFycNNdWdfdB6NUS2pLVznoa1TWv5zM37HEp6E4g+BeakzxvmhKily4loUQ6HB4c",
+	"1H5GYVwHQ9OE3T/6Xbvuq70KeXCfoh1T117R0bSndVrl+QLkzDBbrnsRqK0nE5dhcLxTbx98/3i0/muN",
+	"TY8JP7HEjluoba/w3deilw10wXJLDVSASkk1tPnFVzTHjRVqUTpic02Jp0DDqPsILmnLHoJfP7s/x2vH",
+	"FlxItZ3gTdEv2O9SbR0cNjj/lsR+1az/i8hflsibRHoEnXdeF9+T4LsvoLTexuDdKoQr/271sR/5dslo",
+	"779jLcOHyEgft9m+UlhePjI+7zRG78Rzc4y+T7W6q6v/Kl5fOub3AN7NALvD6YvkhdHtntdMyztlDtfr",
+	"63u8Cbtf8rh37thj1QOj/0umn8v/YYXfkzjukyg6nr3L27ivO10cHxx/HXpdtLdYmAAKw80Cbpgb6lP6",
+	"V4fhX+nNSrfnuZBvB+nxaGQvmzKpzfj7gwN3Ne0F778j31Xs7vb6neJ3+d8BAJZn7q1NLgAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -6,6 +6,8 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+
+	toolcmd "github.com/openshift-kni/oran-o2ims/internal/cmd"
 )
 
 // artifactsRootCmd represents the root command for working artifacts server
@@ -24,6 +26,11 @@ func GetArtifactsRootCmd() *cobra.Command {
 	return artifactsRootCmd
 }
 
+func init() {
+	// Report the build information of the server
+	artifactsRootCmd.AddCommand(toolcmd.Version())
+}
+
 func configureArtifactsLogger() {
 	l := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
 		Level:     slog.LevelDebug,
@@ -2241,76 +2241,75 @@ func (sh *strictHandler) GetSubscription(w http.ResponseWriter, r *http.Request,
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xdeXPbuJL/KijuVk3yVpfPcbw1tZXnOG9UNYmzsbNbW1EqhsimhQkJ0ADoRC/xd99q",
-	"AKR4QLcyk6kn/zMRBTS6G939++Gg5msQijQTHLhWwfnXIKOSpqBBmk9hkisN8i0okcsQhhE+jECFkmWa",
-	"CR6cB+84u8+BsAi4ZjEDSURMKHE9iXRdeyMedAL4QtMsgeA8ODqLwvHp0bg7PogH3ePoMOyenY3j7snp",
-	"8fHp6c8DiAcHQSdgOERG9SToBJym2LOtUyeQcJ8zCVFwrmUOnUCFE0gpKhsLmVIdnAd5zrClnmYoRGnJ",
-	"+F3w+NhpyruZZtvYSXCAlrEH9PTZyc8n3aP42aB7DOOT7vgspt2z+AwOj+Jnz8J4sJKxTrktDRZpKvhH",
-	"mrGPIgOO/4UvYZJH8JJBEqm27RciTSlRgMGhISIJUxrNj7E9kRCDBB6CIloQJ4rEUqRETwA9kye6N+Ij",
-	"fknDSbMTYYpQ9xCN7hAhCQ52n5uvy2HwS1VRYjwlKqFqAqpHXgo54s7fnaoWqMBtKHKu5fSWqHxsZYnY",
-	"fgNfNHDFBFe3dpRzVPP29halGQkfzWP1y6xl34lz7Ub8fyfAiZ4wRcrkIUzxnzTJFUSEC2fAZ5YkZAyF",
-	"bpFxiXU5YU6C8WyzIYEH4IQZnaeESvwmS1jIdDIljLtGuWL8DpuM+K1V+namUDMijafbNhVBeJ+D+eCi",
-	"sO6LoBpuK4VXvIO4cnZaT/1JUXUH2sYN9nIRQyiPtggzF15z5mPVGKNJYkay0soAkqBzyU2kbTH7m896",
-	"okG2Z/0aqAwnJJRMg2TUzOGF4JoyrojggFOVCglE1Rt2GtMEKQtFIrjqERMCjeYmBEZc51kCJLTyMUMo",
-	"JyIDSbWQnTJGZoGD01lV4oEmOQbDzQTKfiSkfMTH2HhaTHIskkR8xgGsV5SZ42/kqujzjbwCajTY5O/b",
-	"iH/rln+Vf27wh7IwXLm+RcnkFdXhBJSrMM4jYTEj+Mg4Ya5e5Bbub+0nvyymCNznNMEcWiDOyrrTy2Td",
-	"SaCYAHpC+Tx5hSy4XUOWkF49rSzGl+llwiae9VRz/ZUstTEBpRYaWJG1zMaZrKaBM9lWFndBMUdWJEAR",
-	"LnQRHHN0c7JcUMzXCyUtiwsnyzl/saxl/v+GGXlT9qqBBXbCeocCKnJcQXWfxPh3CHUbS0a86Oraz8UT",
-	"UoWTXHkISteZxBWLYMSX4wcW2V+ewL2noHcu//tpCSE3M7cghUDBVN7lKTL/0kBXrJq6GiXubysFUKQZ",
-	"laBGPJxA+KmcDzuDYmny9wqNTFphzbVzXAygiMqzTEhN0jzRDEt4UYibXjQKFOOXrhzxpi/nQLHRj+kJ",
-	"SHJ7eX2Lc3v77rrtYMa9Dr7uvLt+Wodp5+QiRxAZqeoUYYADqIwaVoN0jgNEaMYYiMqlFDmPXNgwfpcA",
-	"uc+FBtUb8cV2VxmJC2eLQ+Q2nRarlFtv3Bg28NOs1U8Ne8oZKJF1Dg6buEI+0jGExEZBStJcaZJi3pJY",
-	"SMtQMX4S0AaYI4bEAE0yjTyxN8NWw2x8ljM14lVLyd8oj/7WSK9yAtFFONsr+uM/56VXc+qXMzTLW5dT",
-	"tFKRmR5P5/Izw7MW8zMuIriwctZY3WKvYvimhqcnh4cHJ6fH3bPx4KR7fHBKu+M4POqGhyeDcXh6DAeU",
-	"+he1dV22W8xWZK25cq/a5l+1b7xF0VZqOyNVPi4NWsPCaremcZSeHUWDMe3SE4DucXwQd8dwdtyNj46O",
-	"x4cHB6enYew3rqHMNpY9Fo3N2tB57GJC+R28FmhJSK2FTYOH3IrGVKZjkWtk9Yw/ANdCTkloRCAnmMno",
-	"BJlEdNEM7NaW4CpPQV4v8W0JmyST4oG54oypXEgolqRVxxhvLzEfg3em4CUqf2NaNFW4qvCRshJalDkn",
-	"A9IloSGxHXJAuiQVEYunHXJIuiQCrLF25nmeBufvB52DzuGHUhXGNdyBbOri88NzkreiTAsiIZOggGtb",
-	"+6pSzLaFXs0Tllm9hdg/Ae/e/lbQB9sS12NMFcSviMACE7x+xcaH5MmLy98uby6f9sjQbbRkgqH2YsSF",
-	"z88R1dSUB0UiiBnHUTgJE4rs7ah32DstdwBmhNIItpBnaDYOj8TS6K6Qm2RZwqyoTDIhr8w315pqswLt",
-	"C0kyoXTlsU3gluMarXzus35a2UcD8uTi7eXzm8unSFUOyJNXVy+GL//vqTGzvsqpe2nEl7tpoWMWeaNo",
-	"PeLGy9KSJsZJGTk1B9mnxkENgTvwUMUnQlZiapGHRnzFQFruofqMb+mgx2r9ft+sAvNK1AePoy/qW9Ur",
-	"FW3iOpGyV7NMU6lZTFH/Yrvfs484LEuSKigmKTo+UU/J5wkz7jST7PbpmSJjisyswEamIVUroFb5gEpJ",
-	"p0H7BGE1hC70bLgNFyua8hBWq5srHl4Ml45rYtt+V/FSbzUtaoM1x/41TyknEmhExwmQypdFkvjPilqj",
-	"zAi4FyQU2PUHVxmEaGtEniA4oDsjKiP2T4iekll0kSefYFoGh1kSU5YITBwzkq3pbBa0I14yABu+FTeW",
-	"ATynCqWQjkFeeeCt2PMWZgnamBVV0S5h/JNJ6jvApo2gXRqklr01R3/t9i180+CfBbkgyj1hNiNlZebN",
-	"ycaUZpmxb5WYa5Qt35mgMbgem50F52llzxVqm5+mrVLfiOna4qKbHkEuSub1CsmWKey186+cx20DZtGw",
-	"PJW80nzeWBzHZXh6YtkXpq9n687VwhM7FMquAL0rZbyGNEsMMXH9qwlf0bCNwCugHQOuX6+7lVFoVhvc",
-	"ZQehSrE77lnVfZ4IQwlBaaRqWtll1sqLOzeXLxg+GufY8cUaSabhiy6MmLqTXZKCnghzrhhVxOLnFnCI",
-	"B5Dk6iIReUSumd1CXIFB/EOKPPMk5pX5B03sGa3ZO7kzTYmeUG32gyVz+9lNIClQzNw0cW0qc7Fr9uUv",
-	"K+XhcuvGBquwR2NMsY1pdlkFV1rmoW7F77asccuK29Lkh6uzxFto5+m9TnldbjvfQY3YAEVX2I0cLh/T",
-	"BKGnXPY2YEbNvVZfCV3MleYVsI53m9MDGt4UXQJfqzOsKoT52dUOEq02wF8u3eZrv27S1SQtzbz1eew2",
-	"2Tc/8NelUdUt4bX3ntub7g2uT5NkTMNPfpCK8ySZkvucJjZE3r0dIgrRGS3JpAghymWxjgopt8+UIpS8",
-	"ERbk0JkjPiy0Mjs31S315nHAROtMnff7KhU997QXihQ/9x8O+iJEHvGxtPKjGCuQD4Y0thnFijvrnkpY",
-	"Wiliu3usiNlbjnIo9n+rUnur1OR515AuitNMHNwNZl0aCbOrXDmZlZAJiTRQyPLkzsqdEcfqxJMRr+2E",
-	"o7NYCOYWkYRYSOgQFpvbR4mjwjhimb96AtwcGTq9MP0LHeaUH7W+t2uudBvqs1ZMoQYiLM55scdV1xDK",
-	"3iYHgc2Jwpp7xZNpcWy0ZGVUpI0vYT2Xz56/Gf4PSLVqBpMH27jInedvhr7kfZiJnNl/0Bv0Bt56tJ6i",
-	"ajVNCx7rdFFLVKYZq8ov1X5fscaZ8Pihwmf/XUIcnAf/1p/dD++7w7r+Yn97uG4u2RsJMftS91xfHLJU",
-	"dRmPJbUsO5dQFq3+w8HGXn0jxTiB9AUgaNqL7XWvRPbWAU2ea8tums/f1Nov3i8JnvMp4Xk6dievpRBC",
-	"S+kdQmsb/5Q4IuBKhJCYZAgl6JwUuKZFiWsZHBmzfGd0E6Qz3ZLOwJcsodzVIDecLaRMERGGuZTFdVgM",
-	"ocx6rZ7eF4JzCIsrGhHVFFftRLMU62GufcWowG2fighps5Myw3XLquMurhSaztcQwU2TlE7J1Fx5iXNp",
-	"9k+rfIjFJIJypKjJniXzllFNdT5nBfnrzc0bYhuQENlQuV++0JXlkIxXnFU5etVMJ15XqYmQutOcVJWn",
-	"KZXTxkj2CgMZauyVJ5G9p2cPws1d/IqOWszXuGOuvkOmjXVZLnHJbooMYkHC/mnDkgxjezbFFLljDwhX",
-	"PHKb2Oaq4SgwBet8nFD+aRR0rKPKfCBqgvhGE2UAtkC+OeCmvcuBZizRMBQyMnslggwvb16Sty8vyNGz",
-	"s1Py/uiDN9RazmOKAA9FLumdwWFqaTQO5HRUI96YkEiEeZmwJboWop9A765nb+f/evPqN2T0wOuRSWaX",
-	"h+xBwYwQmLP1zogzrdw1BPSiQpZUkJOGp+dRuyIiKz5Eirc0JxpA7BKkLEJtPH50iyHJafJChL7NpO7b",
-	"56/JFRZ/MuQaZExDINe1evhzb0CeXIVaoDsOB4fHTwPEkaRKVqsdVE90JeU9Ie/6kfjME0Gj/2LRLz8f",
-	"P7MVKRaeCHozNPN1dTh8dU2GNRwi1e2phIXAlYlBdw3meUbDCZBDA/11vT5//tyj5mujjeur+r8NLy5f",
-	"X192D3uD3kSnSSX3g0UaILQHnTZcdwIHd8F5cORISEb1xHjci61OYB8h8qHCCu5At53z1lxNU46Yu/t6",
-	"BftAz5UMpISsCht2jNd4z97qdMQ0+Afo50lSkhJz+JIJ9BDqcDgYuOs5Gri2DCZL3CT3f1eWfc3uGG3M",
-	"U5SN1Ma7CXmIKym7DBNjTQ1cez1QWI8mPnaC44V6u+T7j631bxAbjwl/p1Gxb416nfwoeplER05kV44E",
-	"pBSy5+63GUSzsVELLSxH9E5h4UlBU2QfwQfssjC8Hw7Wj/BiWlPGhZwf3iXkp/R3IecuG1oR/wrF/tAx",
-	"vw/j3YZxO5A2DmbPseB6Qe19S1XNCdUL32id2pvB7/2OnTXpL33J9LGziYx4u85mJwiXuVul30prZN+V",
-	"gdbKeB0AWj6X+/TdMH092ev3ciWDZS09Nk7j/lfvmf/jSultKbhaeJ99lfReP7u99xS2Tqy182md/Kk4",
-	"y97u9LjrB86g48Hxj6HXzWyHBiKCq2k9JZ+pXbDGIudR768J2J4U2mm+bwfZK6L1Hqm3Rervg9J7gP7O",
-	"AL1bbG7j8kaY7L/ZvCyHt4XjPxiKt4Jhn4f2KPwvjcLNkNgusRv3QNYD4RYhmIfCr5uj7FF4TRRuXv7a",
-	"CQr7528Pw7uD4baHd5uu/a+ti1zfcW3cDMJ1s7h96ey7AnErZ/br4T0S/7jr4Up2bA7DKyDwHn23Qd/d",
-	"I+8edL8j6O4Ob+tYuzXOLs/TbeD1D4TWncHqHlH3iFoJh+0St3rfe7Od5ZqEOdl6XRtlD6trwmrtTZKd",
-	"4Gpr0va4usMEVY1wL/Kz/vyD+90d3zslQDUoQrk3y3xJZrvUAsVeuASl/y6i6c4QrR6L9WudWubw2EqI",
-	"g+849oK4t79mFbVeqtlH+w6j3YbdygG/LiD1v9bfR3q0uZKA7weYXpjnyvuLdfVksS0bybIeJjXek5qH",
-	"Awvi05qxID73NOr7xa2NgJrfF9bp9dYvy+KvwYi2Dr7On02hvucSaq2iv2gN9RcCgX3y/5EUbRFgYUcj",
-	"yibm7A2N837fvMk0EUqfnw0G9r1HJ2f5C5ievcj2r77atFxHVPV00f8rsqvJnHNtyP+/k9lMpEdV7wXl",
-	"dWR71qKen5vFgvX4/wEAAP//+dMK0rJoAAA=",
+	"H4sIAAAAAAAC/+xdeXPbuJL/Kl3arZrkrS6f43hraivPcd6oahJnY2e3tqJUBJFNCxMSYADQjl7i776F",
+	"gxQPSKIsZSZTT/5nIgpodDd+3b/GQc3XTsCTlDNkSnbOv3ZSIkiCCoX5FMSZVCjeouSZCHAU6ochykDQ",
+	"VFHOOuedd4x+zhBoiEzRiKIAHgEB1xOE69ofs063g19IksbYOe8cnYXB9PRo2pseRMPecXgY9M7OplHv",
+	"5PT4+PT05yFGw4NOt0P1EClRs063w0iiezZ16nYEfs6owLBzrkSG3Y4MZpgQrWzERUJU57yTZVS3VPNU",
+	"C5FKUHbbeXjo1uXdzNNt7AQ9QMPYA3L67OTnk95R9GzYO8bpSW96FpHeWXSGh0fRs2dBNGxlrFNuS4N5",
+	"knD2kaT0I0+R6f/ilyDOQnxJMQ5l0/YLniQEJGpwKAwhplJp8yPdHgRGKJAFKEFxcKIgEjwBNUPtmSxW",
+	"/TEbs0sSzOqdgEog7qE2ugtcgB7sc2a+5lHpS1lSYjoHGRM5Q9mHl1yMmfN3t6yFVmAS8IwpMZ+AzKZW",
+	"Fo/sN/hFIZOUMzmxo5xrNSeTyZg5CR/NY/nLouXAiXPtxux/Z8hAzaiEIniASvaTgkxiCIw7A+5pHMMU",
+	"c91C4xLrcqBOgvFsvSHgHTKgRuc5EKG/SWMaUBXPgTLXKJOU3eomYzaxSk8WCtURaTzdtCkH4ecMzQeH",
+	"wqovOmW4tYJXtANcOTutp/4kVN2isrjRvRxigLBwC5g5eC2Zj7YYI3FsRrLSCgAJVJlgGG43+4+f9Vih",
+	"aM76NRIRzCAQVKGgxMzhBWeKUCaBM9RTlXCBIKsNu7VpwoQGPOZM9sFAoNbcQGDMVJbGCIGVryOEMOAp",
+	"CqK46AJpAEdPZ1mJOxJnGgw3Myz6QUDYmE1143k+yRGPY36vB7BekWaOv8FV3ucbvEJiNHjM37cx+9Yr",
+	"/kr/fMSflqXhytRES4ZXRAUzlC7DOI8E+YyomXPCUr1ggp8nAMtlUQn4OSOxjqEV4qysW7VO1q1AogNA",
+	"zQhbJi+XhZMNZHHh1dPKomydXgY20aKnXOqveK2NMUq50sCSLJy0lVU3cCHbymIOFEtkhRwlMK5ycCzR",
+	"zclyoFiul5a0DhdOFmUtZK3z/zcdkTczbMQ8tSjX+U4LKMlxCdV94tPfMVBNLhmzvKtrv5RPoEwnmfQU",
+	"KD1nEpM0xDFbzx86yf7yBD97Enr38r+fFhRys3ALEXZgIm6zBJlaGOiSVV1Xo8TnSSkB8iQlAuWYBTMM",
+	"PhXzYWeQrw3+fq6RCSudc+0c5wNIkFmacqEgyWJF09j183jRKJCPX7hyzOq+XELFRj+qZihgcnk90XM7",
+	"eXfddDBlXgdfd99dP63StHNyHiOaGYns5jDQA8iUmKpGl3MMMdRmTBFkJgTPWOhgQ9ltjPA54wplf8xW",
+	"212uSBycLQ/BJJnnq5SJFze6a/enRaufavYUM1Aw6xIeNrjS9UjXFCQWBQkkmVSQ6LiFiAtboWr8xKgM",
+	"MYdUUc60SaaRB3sLbjWVjc9yKsesbCn8jbDwb7XwKiZQu0jPdkt//Oey8Lp+ummFZuvW9SVaochCj6dL",
+	"6zOt+pr6jPEQL6ycDVa3ulc+fF3D05PDw4OT0+Pe2XR40js+OCW9aRQc9YLDk+E0OD3GA0L8i9qqLtst",
+	"ZkuyNly5l23zr9ofvUXRVGo7I2U2LQzawMJyt7pxhJwdhcMp6ZETxN5xdBD1pnh23IuOjo6nhwcHp6dB",
+	"5Deupsw2lj3kjc3a0HnsYkbYLb7m2pKAWAvrBo+YFa1DmUx5poAwoOwOmeJiDoERAawso9tJhWYXRdGM",
+	"FnAmswTF9RrfFrQJqeB31CVnHcq5hHxJWnaM8fYa87udsoKXWvkb06KuwlWpHikyoWWZcxhCDwJTxHbh",
+	"AHqQ8JBG8y4cQg9C1DnWzjzLks75+2H3oHv4oVCFMoW3KOq6+PzwHLIGyhQHgalAiUzZ3FeWYrYtVDtP",
+	"2MrqLUb+CXj39re8fLAt9XqMyrzwyxGYc4LXr7rxITx5cfnb5c3l0z6M3EZLyqnWno8Z9/k5JIqY9CAh",
+	"xIgyPQqDICaZRDjqH/ZPix2ARUFpBFvK01/o7lqy1V3q2iRNY2pFpYJycWW+uVZEmRXogAtIuVSlxzaA",
+	"G46rtfK5z/qptY+G8OTi7eXzm8unwAUcwJNXVy9GL//vqTGzusqpemnM1rtppWNWeSNvPWbGy8IWTZRB",
+	"gZyKg+xT46CawB14qOQTLkqYWuWhMWsJpPUeqs74lg56KOfv9/UssCxFffA4+qK6Vd0qaYPrBEWvepom",
+	"QtGIBCpvMPLtI46KlCTzEhPyjk/kU7ifUeNOM8lWjp6nKdGVWc6NVGEiW7BW8YAIQead5glCO4bO9ay5",
+	"DSiTirAA2+XNlocXo7Xj6p7uu5KX+u20qAxWH/vXLCEMBJKQTGOE0pd5kPjPihqjLApwL0lItOsPJlMM",
+	"tK0hPGFcgXZnSERI/4nhU1igC558wnkBDt1VERpzHThmJJvT6QK0Y1ZUABa+JTfCW5/ui+BIMJmiuPLQ",
+	"W77nzc0StDYrsqRdTNknE9S3qJvWQLsWpLZ6q4/+2u1b+KbBPwtiBco9MFsUZXnHZdGYkDQ19rXBXC1t",
+	"+c4EjcFVbHZXnKcVPVvkNn+Z1ia/genaqEUfewS5Kpg3SyRbhrDXzr9yHDcNWKBhfSh5pY3Zpjgu4OnB",
+	"sg+mrxfrznbw1B1yZVtQb6uIV5iksSlMXP9ywJc0bDJwC7ajyNTrTbcycs0qg7voACIlvWWeVd39jJuS",
+	"EKXSpZqSdpnVenHn5vIF1Y+mme74YoMgU/hF5UbM3ckuJKhm3JwrhiWx+nODOPgdCri6iHkWwjW1W4gt",
+	"Koh/CJ6lnsC8Mv8gsT2jNXsnt6apPlhQZj9YULefXSeSnMV0Iy6xPhe7rr78aaU4XK5rV0KKMybfxjS7",
+	"rJxJJbJANfC7bdW4ZcZtaPLD5VnwJtplem+SXtfbznaQIx7Boi12I0frxzQg9KTL/iMqo/peqy+Frq6V",
+	"liWwrneb00Ma3hBdQ1/tK6wyhfmrqx0EWmWAv1y4Ldd+06CrSFobeZvXsdtE33Lgb1pGlbeEN957bm66",
+	"12p9EsdTEnzyk1SUxfEc9HGphci7tyNQHMiiLEkFDzDMRL6OCgizz6QEAm+4JTntzDEb5VqZnZvylnr9",
+	"OGCmVCrPBwOZ8L572g94oj8P7g4GPNB1xMfCyo98KlHcmaKxWVG03Fn3ZMLCSh7Z3WMJZm85zDDf/y1L",
+	"7bfJycuuIV3kp5l6cDeYdWnIza5y6WRWYMqFLgO5KE7urNxF4VieeBizyk64dhYN0NwiEhhxgV2gERAn",
+	"JN/HLuJXzZCZI0OnFxELHZakH7m5tyuudBvqi1ZUag14kJ/z6h5XPVNQ9h9zEFifKJ1zr1g8z4+N1qyM",
+	"8rDxBazn8tnzN6P/QSHbRjDc2cZ57Dx/M/IF791C5ML+g/6wP/Tmo80Ule00zetYp4tcozJJaVl+ofb7",
+	"kjXOhIcPpXr234U+jOn822BxP3zgDusGq/3tqXUzQd8IjOiXqucG/JAmskdZJIitsjOBRdIa3B082qtv",
+	"BJ/GmLxATZrGnppXQnvrgMTPla1u6s/fVNqv3i/pPGdzYJne4jMnr4UQIIX0LpDKxj8BVwi4FMGFDjJN",
+	"Jdo5CTJF8hTXMDg0ZvnO6Ga6nOkV5Yy+J0yYHSAfziZSKoEHQSZEfh1WQyi1XquG9wVnDIP8ioY+xdCr",
+	"dlA00fkwU75klPO2T0VNaYuTMlPrFlnHXVzJNV2uoSY3BQmZw9xceYkyYfZPy/UQjSDEYqSwXj0L6k2j",
+	"iqhsyQry15ubN2AbQKCroWK/fKUriyEpUx3f0auiKva6Ss64UN36pMosSYiY10ayVxj0qaac8SwO7T09",
+	"exBu7uKXdFR8ucZdc/UdU2WsSzORcokmyWguiOk/LSxhFJkRzR1Kqu+nm4uzZhLMVcNxxySs82lM2Kdx",
+	"p2sdVcQDyJnmNxJLQ7A58y0hNzVPW2CJBAEXodkr4TC6vHkJb19ewNGzs1N4f/TBC7WG86gEZAHPBLnF",
+	"MF+JoRnI6SjHrDYhIQ+yImALds1FP8H+bd/ezv/15tVvuqJHVkUmLC4P2YOCRUFgzta7Y0aVdNcQtBel",
+	"rpLy4qTm6WWlXY7Ikg91ibc2JmpE7AKkSEJNPn5wiyHBSPyCB77NpN7b56/hSid/GDGFIiIBwnUlH/7c",
+	"H8KTq0Bx7Y7D4eGxvv6UibhkUSWByj7vCcL6XNwOQn7PYk7C/6LhLz8fP7MZKeIeBL0Zmfm6Ohy9uoZR",
+	"hYegvD0V0wCZNBh012CepySYIRz2hw297u/v+8R8bbRxfeXgt9HF5evry95hf9ifqSQuxX5nlQaa2jvd",
+	"Jl13O47u9GUlV4TouzrG415udQIHmiLvSlXBLaqmc96aq2nSFebuvl5efWjP5RIWlFWqhl3Fa7xnb3W6",
+	"wrTzD1TP47goSszhS8q1h7QOh8Ohu56jkClbwaSxm+TB79JWX4s7Ro+uU6RFau3dhCwIUEq7DONTRQxd",
+	"ez2QW69NfOh2jlfq7YLvP7bWv1bYeEz4OwnzfWut18mPopcJdF0T2ZUjoBBc9N39NsNoFhsVaOl0RG6l",
+	"TjwJKqKrj84H3WUlvO8ONkd4Pq0JZVwsh3dB+Qn5nYuly4YG4l9psT805vcw3i2Mm0B6NJg9x4Kbgdr7",
+	"lqpcAtUL32jdypvB7/2OXTQZrH3J9KH7GBnRdp3NTtDDhy3Dr9Ua2ePF5sp4EwJaP5f78H1k+Hqi1+/l",
+	"UgSLSng8OowHX71n/g+twtuW4HLlffY24b15dPt03j6wNo6nTeKn5Cw1Q7+7fuAIOh4e/xh63Sx2aDAE",
+	"ZIqqOdwTu2CNeMbC/l+TsD0htNN4346yW7L1nqm3Zervw9J7gv7OBL1bbm7y8qM42X+zeV0Mb0vHfzAV",
+	"b0XDPg/tWfhfmoXrkNgusGv3QDYj4UZBsIyFX9dH2bPwhixc8+BuWNg/f3sa3h0NNz2823AdfG1c5PqO",
+	"a+M6CDeN4oau35eIGzGzXw/vmfjHXQ+XouPxNNyCgffsuw377p5596T7HUl3d3xb5dqteXZ9nG5Dr38g",
+	"te6MVveMumfUEhy2C9zyfe/H7SxXJCyJ1uvKKHta3ZBWy+7bDa82Jm3PqzsMUFmDex6f1ecf3O/u+N4p",
+	"QaJQAmHeKPMFme1SAYq9cIlS/Z2H850xWhWL1WudSmT40AiIg+849grc21+zChsv1ezRvkO0W9i1Bvym",
+	"hDT4Wn0f6cHGSoy+H2B6YZ5LIGuDxbasBctmnFTVaykPrMCnNWMFPvdl1PfDrUVAxe8r8/Rm65d1+KtV",
+	"RFuDr/tnl1Dfcwm1UdJftYb6C5HAPvj/yBJtFWHpjkaUDczFGxrng4F5k2nGpTo/Gw7te49OzvoXMD17",
+	"kc1ffbVhuYmo8umi/1dk28lccm3I/7+TeZxIj6reC8qbyPasRT0/N6sT1sP/DwD50wrSsmgAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -6,6 +6,8 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+
+	toolcmd "github.com/openshift-kni/oran-o2ims/internal/cmd"
 )
 
 // clusterRootCmd represents the root command for working resource server
@@ -24,6 +26,11 @@ func GetClusterRootCmd() *cobra.Command {
 	return clusterRootCmd
 }
 
+func init() {
+	// Report the build information of the server
+	clusterRootCmd.AddCommand(toolcmd.Version())
+}
+
 func configureDefaultLogger() {
 	l := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
 		Level:     slog.LevelDebug,
@@ -0,0 +1,188 @@
+package api
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ResponseCacheConfig defines the behavior of the response cache
+type ResponseCacheConfig struct {
+	// TTL defines how long a response is served from the cache. Caching is disabled when zero.
+	TTL time.Duration
+	// MaxEntries defines the maximum number of cached responses. The least recently used responses are evicted
+	// first.
+	MaxEntries int
+}
+
+// cachedResponse holds a successful response along with the group of resources it was built from
+type cachedResponse struct {
+	key     string
+	group   string
+	header  http.Header
+	body    []byte
+	expires time.Time
+}
+
+// ResponseCache caches the successful responses to GET requests keyed by the request URI and the credentials of
+// the client so that clients never share responses.  Responses are grouped by the type of resource they are built
+// from so that they can be invalidated when that type of resource is written.
+type ResponseCache struct {
+	config    ResponseCacheConfig
+	groupFunc func(path string) string
+	lock      sync.Mutex
+	entries   map[string]*list.Element
+	lru       *list.List
+}
+
+// NewResponseCache creates a new response cache.  The group function maps a request path to the group of resources
+// its response is built from.
+func NewResponseCache(config ResponseCacheConfig, groupFunc func(path string) string) *ResponseCache {
+	return &ResponseCache{
+		config:    config,
+		groupFunc: groupFunc,
+		entries:   make(map[string]*list.Element),
+		lru:       list.New(),
+	}
+}
+
+// Invalidate drops all the cached responses of a group
+func (c *ResponseCache) Invalidate(group string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for element := c.lru.Front(); element != nil; {
+		next := element.Next()
+		if element.Value.(*cachedResponse).group == group {
+			c.remove(element)
+		}
+		element = next
+	}
+}
+
+// InvalidatePath drops all the cached responses of the group the given path belongs to
+func (c *ResponseCache) InvalidatePath(path string) {
+	c.Invalidate(c.groupFunc(path))
+}
+
+// get returns the cached response for a key if it has not expired
+func (c *ResponseCache) get(key string) *cachedResponse {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	element, found := c.entries[key]
+	if !found {
+		return nil
+	}
+	entry := element.Value.(*cachedResponse)
+	if time.Now().After(entry.expires) {
+		c.remove(element)
+		return nil
+	}
+	c.lru.MoveToFront(element)
+	return entry
+}
+
+// put adds a response to the cache, evicting the least recently used responses if the cache is full
+func (c *ResponseCache) put(entry *cachedResponse) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if element, found := c.entries[entry.key]; found {
+		c.remove(element)
+	}
+	c.entries[entry.key] = c.lru.PushFront(entry)
+	for c.config.MaxEntries > 0 && c.lru.Len() > c.config.MaxEntries {
+		c.remove(c.lru.Back())
+	}
+}
+
+// remove drops a cached response.  The caller must hold the lock.
+func (c *ResponseCache) remove(element *list.Element) {
+	c.lru.Remove(element)
+	delete(c.entries, element.Value.(*cachedResponse).key)
+}
+
+// cacheKey builds the key of a request from its URI and a digest of its credentials
+func cacheKey(r *http.Request) string {
+	scope := sha256.Sum256([]byte(r.Header.Get("Authorization")))
+	return hex.EncodeToString(scope[:]) + " " + r.URL.RequestURI()
+}
+
+// cacheRecorder implements the http.ResponseWriter interface so that a response can be recorded while it is
+// written to the original ResponseWriter.
+type cacheRecorder struct {
+	original   http.ResponseWriter
+	statusCode int
+	header     http.Header
+	body       bytes.Buffer
+}
+
+// Header returns the headers stored in the underlying original ResponseWriter
+func (c *cacheRecorder) Header() http.Header {
+	return c.original.Header()
+}
+
+// WriteHeader records the status code and headers of the response before writing them
+func (c *cacheRecorder) WriteHeader(statusCode int) {
+	if c.statusCode != 0 {
+		return
+	}
+	c.statusCode = statusCode
+	c.header = c.original.Header().Clone()
+	c.original.WriteHeader(statusCode)
+}
+
+// Write records the data of the response before writing it
+func (c *cacheRecorder) Write(data []byte) (int, error) {
+	if c.statusCode == 0 {
+		c.WriteHeader(http.StatusOK)
+	}
+	c.body.Write(data)
+	return c.original.Write(data) //nolint:wrapcheck
+}
+
+// Middleware serves repeated GET requests from the cache and invalidates the cached responses of the group of
+// resources written by any other successful request.
+func (c *ResponseCache) Middleware() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				recorder := &cacheRecorder{original: w}
+				next.ServeHTTP(recorder, r)
+				if recorder.statusCode < http.StatusBadRequest && r.Method != http.MethodHead {
+					c.InvalidatePath(r.URL.Path)
+				}
+				return
+			}
+
+			key := cacheKey(r)
+			if entry := c.get(key); entry != nil {
+				slog.Debug("serving response from cache", "uri", r.URL.RequestURI())
+				for name, values := range entry.header {
+					w.Header()[name] = values
+				}
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(entry.body)
+				return
+			}
+
+			recorder := &cacheRecorder{original: w}
+			next.ServeHTTP(recorder, r)
+			if recorder.statusCode == http.StatusOK {
+				c.put(&cachedResponse{
+					key:     key,
+					group:   c.groupFunc(r.URL.Path),
+					header:  recorder.header,
+					body:    recorder.body.Bytes(),
+					expires: time.Now().Add(c.config.TTL),
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ResponseCache", func() {
+	const (
+		poolsPath = "/o2ims-infrastructureInventory/v1/resourcePools"
+		typesPath = "/o2ims-infrastructureInventory/v1/resourceTypes"
+	)
+
+	var (
+		cache   *ResponseCache
+		handled int
+	)
+
+	groupFunc := func(path string) string {
+		group, _, _ := strings.Cut(strings.TrimPrefix(path, "/o2ims-infrastructureInventory/v1/"), "/")
+		return group
+	}
+
+	serve := func(method, target, token string) *httptest.ResponseRecorder {
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handled++
+			if r.URL.Query().Has("fail") {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"count":%d}`, handled)))
+		})
+
+		req := httptest.NewRequest(method, target, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		cache.Middleware()(next).ServeHTTP(rec, req)
+		return rec
+	}
+
+	BeforeEach(func() {
+		handled = 0
+		cache = NewResponseCache(ResponseCacheConfig{TTL: time.Minute, MaxEntries: 10}, groupFunc)
+	})
+
+	It("serves a second identical GET from the cache", func() {
+		first := serve(http.MethodGet, poolsPath+"?filter=(eq,name,a)", "alice")
+		second := serve(http.MethodGet, poolsPath+"?filter=(eq,name,a)", "alice")
+
+		Expect(handled).To(Equal(1))
+		Expect(second.Code).To(Equal(http.StatusOK))
+		Expect(second.Body.String()).To(Equal(first.Body.String()))
+		Expect(second.Header().Get("Content-Type")).To(Equal("application/json"))
+	})
+
+	It("keys the responses by query and credentials", func() {
+		serve(http.MethodGet, poolsPath, "alice")
+		serve(http.MethodGet, poolsPath+"?filter=(eq,name,a)", "alice")
+		serve(http.MethodGet, poolsPath, "bob")
+		serve(http.MethodGet, poolsPath, "")
+
+		Expect(handled).To(Equal(4))
+	})
+
+	It("does not cache unsuccessful responses", func() {
+		serve(http.MethodGet, poolsPath+"?fail", "alice")
+		serve(http.MethodGet, poolsPath+"?fail", "alice")
+
+		Expect(handled).To(Equal(2))
+	})
+
+	It("invalidates the responses of the written resource type", func() {
+		serve(http.MethodGet, poolsPath, "alice")
+		serve(http.MethodGet, typesPath, "alice")
+
+		serve(http.MethodPost, poolsPath+"/import", "alice")
+		Expect(handled).To(Equal(3))
+
+		serve(http.MethodGet, poolsPath, "alice")
+		serve(http.MethodGet, typesPath, "alice")
+		Expect(handled).To(Equal(4))
+	})
+
+	It("invalidates the responses of a group on demand", func() {
+		serve(http.MethodGet, poolsPath+"/1234/resources", "alice")
+		cache.InvalidatePath(poolsPath + "/1234/resources/5678")
+
+		serve(http.MethodGet, poolsPath+"/1234/resources", "alice")
+		Expect(handled).To(Equal(2))
+	})
+
+	It("expires the responses past their TTL", func() {
+		cache = NewResponseCache(ResponseCacheConfig{TTL: time.Nanosecond, MaxEntries: 10}, groupFunc)
+		serve(http.MethodGet, poolsPath, "alice")
+		time.Sleep(time.Millisecond)
+		serve(http.MethodGet, poolsPath, "alice")
+
+		Expect(handled).To(Equal(2))
+	})
+
+	It("evicts the least recently used responses when full", func() {
+		cache = NewResponseCache(ResponseCacheConfig{TTL: time.Minute, MaxEntries: 2}, groupFunc)
+		serve(http.MethodGet, poolsPath+"/1", "alice")
+		serve(http.MethodGet, poolsPath+"/2", "alice")
+		serve(http.MethodGet, poolsPath+"/1", "alice")
+		serve(http.MethodGet, poolsPath+"/3", "alice")
+		Expect(handled).To(Equal(3))
+
+		serve(http.MethodGet, poolsPath+"/1", "alice")
+		Expect(handled).To(Equal(3))
+		serve(http.MethodGet, poolsPath+"/2", "alice")
+		Expect(handled).To(Equal(4))
+	})
+})
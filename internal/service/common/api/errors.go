@@ -1,10 +1,13 @@
 package api
 
 import (
+	"bytes"
 	"encoding/json"
 	"net/http"
 	"strings"
 
+	"k8s.io/utils/ptr"
+
 	common "github.com/openshift-kni/oran-o2ims/internal/service/common/api/generated"
 )
 
@@ -77,3 +80,89 @@ func (e *ErrorJsonifier) ServeHTTP(writer http.ResponseWriter, request *http.Req
 		original: writer,
 	}, request)
 }
+
+// problemDetailsWriter implements the http.ResponseWriter interface so that problem+json error responses can be
+// buffered and completed before being written to the original ResponseWriter.  Any other response is passed through
+// untouched.
+type problemDetailsWriter struct {
+	original   http.ResponseWriter
+	statusCode int
+	buffered   bool
+	body       bytes.Buffer
+}
+
+// Header returns the headers stored in the underlying original ResponseWriter
+func (p *problemDetailsWriter) Header() http.Header {
+	return p.original.Header()
+}
+
+// WriteHeader starts buffering the response if it is a problem+json response, otherwise it passes the status code
+// through to the original ResponseWriter.
+func (p *problemDetailsWriter) WriteHeader(statusCode int) {
+	if p.statusCode != 0 {
+		return
+	}
+	p.statusCode = statusCode
+	if strings.Contains(p.original.Header().Get("Content-Type"), "application/problem+json") {
+		p.buffered = true
+		return
+	}
+	p.original.WriteHeader(statusCode)
+}
+
+// Write buffers the data of problem+json responses and passes any other data through to the original ResponseWriter
+func (p *problemDetailsWriter) Write(data []byte) (int, error) {
+	if p.statusCode == 0 {
+		p.WriteHeader(http.StatusOK)
+	}
+	if p.buffered {
+		return p.body.Write(data) //nolint:wrapcheck
+	}
+	return p.original.Write(data) //nolint:wrapcheck
+}
+
+// flush completes the buffered problem+json response, if any, and writes it to the original ResponseWriter
+func (p *problemDetailsWriter) flush(r *http.Request) {
+	if !p.buffered {
+		return
+	}
+
+	out := p.body.Bytes()
+	var problem common.ProblemDetails
+	if err := json.Unmarshal(out, &problem); err == nil {
+		completeProblemDetails(&problem, p.statusCode, r)
+		out, _ = json.Marshal(problem)
+	}
+
+	p.original.Header().Del("Content-Length")
+	p.original.WriteHeader(p.statusCode)
+	_, _ = p.original.Write(out)
+}
+
+// completeProblemDetails fills in the RFC 7807 members that were left unset by the handler which produced the error
+func completeProblemDetails(problem *common.ProblemDetails, statusCode int, r *http.Request) {
+	if problem.Status == 0 {
+		problem.Status = statusCode
+	}
+	if problem.Type == nil {
+		problem.Type = ptr.To("about:blank")
+	}
+	if problem.Title == nil {
+		problem.Title = ptr.To(http.StatusText(problem.Status))
+	}
+	if problem.Instance == nil {
+		problem.Instance = ptr.To(r.URL.RequestURI())
+	}
+}
+
+// ProblemDetails completes the problem+json error responses so that they carry all of the RFC 7807 members (type,
+// title, status, detail, and instance) regardless of which handler or middleware produced them.
+func ProblemDetails() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			writer := &problemDetailsWriter{original: w}
+			next.ServeHTTP(writer, r)
+			writer.flush(r)
+		})
+	}
+}
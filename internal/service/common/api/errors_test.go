@@ -0,0 +1,72 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ProblemDetails", func() {
+	var router *ErrorJsonifier
+
+	serve := func(target string) (*httptest.ResponseRecorder, map[string]any) {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		rec := httptest.NewRecorder()
+		ProblemDetails()(router).ServeHTTP(rec, req)
+
+		var body map[string]any
+		if rec.Code != http.StatusOK {
+			Expect(json.Unmarshal(rec.Body.Bytes(), &body)).To(Succeed())
+		}
+		return rec, body
+	}
+
+	BeforeEach(func() {
+		router = NewErrorJsonifier(http.NewServeMux())
+		router.HandleFunc("GET /o2ims-infrastructureInventory/v1/resourcePools", func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Has("filter") {
+				GetOranReqErrFunc()(w, r, errors.New("invalid filter"))
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`[]`))
+		})
+	})
+
+	It("returns a complete problem+json response for an unknown path", func() {
+		rec, body := serve("/o2ims-infrastructureInventory/v1/unknown")
+		Expect(rec.Code).To(Equal(http.StatusNotFound))
+		Expect(rec.Header().Get("Content-Type")).To(HavePrefix("application/problem+json"))
+		Expect(body).To(Equal(map[string]any{
+			"type":     "about:blank",
+			"title":    "Not Found",
+			"status":   float64(http.StatusNotFound),
+			"detail":   "404 page not found",
+			"instance": "/o2ims-infrastructureInventory/v1/unknown",
+		}))
+	})
+
+	It("returns a complete problem+json response for a bad request", func() {
+		rec, body := serve("/o2ims-infrastructureInventory/v1/resourcePools?filter=bad")
+		Expect(rec.Code).To(Equal(http.StatusBadRequest))
+		Expect(rec.Header().Get("Content-Type")).To(HavePrefix("application/problem+json"))
+		Expect(body).To(Equal(map[string]any{
+			"type":     "about:blank",
+			"title":    "Bad Request",
+			"status":   float64(http.StatusBadRequest),
+			"detail":   "invalid filter",
+			"instance": "/o2ims-infrastructureInventory/v1/resourcePools?filter=bad",
+		}))
+	})
+
+	It("passes successful responses through untouched", func() {
+		rec, _ := serve("/o2ims-infrastructureInventory/v1/resourcePools")
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Body.String()).To(Equal(`[]`))
+	})
+})
@@ -14,6 +14,7 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/getkin/kin-openapi/routers"
 	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/google/uuid"
 
 	"github.com/openshift-kni/oran-o2ims/internal/data"
 	"github.com/openshift-kni/oran-o2ims/internal/search"
@@ -27,6 +28,9 @@ const (
 	filter        = "filter"
 )
 
+// totalCountHeader is the response header containing the total number of items of a list, across all the pages
+const totalCountHeader = "X-Total-Count"
+
 // FilterAdapter is an abstraction that wraps the search projector/selector functionality so that
 // these objects can be created once at server initialization time and re-used in the ResponseFilter
 // middleware.
@@ -137,6 +141,79 @@ func (a *FilterAdapter) ParseFilter(query string) (*search.Selector, error) {
 	return selector, nil
 }
 
+// FilterIDs returns the identifiers that the `eq` and `in` search criteria of a filter require a field to match, so
+// that these criteria can be applied when querying the database.  Nil is returned if no such criteria apply to the
+// field, and an empty list if the criteria cannot all be matched.  The other search criteria are ignored as they are
+// applied to the response by the ResponseFilter middleware.
+func FilterIDs(query *string, field string) ([]uuid.UUID, error) {
+	selector, err := parseFilterQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []uuid.UUID
+	for _, term := range selector.Terms {
+		if !isIDTerm(term, field) {
+			continue
+		}
+
+		ids := make([]uuid.UUID, 0, len(term.Values))
+		for _, value := range term.Values {
+			id, err := uuid.Parse(fmt.Sprint(value))
+			if err != nil {
+				return nil, fmt.Errorf("invalid value '%v' of the '%s' filter: %w", value, field, err)
+			}
+			ids = append(ids, id)
+		}
+
+		// All the criteria must match, so only the identifiers accepted by all of them remain
+		if result != nil {
+			ids = slices.DeleteFunc(ids, func(id uuid.UUID) bool { return !slices.Contains(result, id) })
+		}
+		result = ids
+	}
+	return result, nil
+}
+
+// FilterPushedDown returns true if all the search criteria of a filter are `eq` and `in` criteria on the given
+// fields, so that they are entirely applied when querying the database with the identifiers returned by FilterIDs.
+// The other search criteria are only applied to the response, so they cannot be combined with paging.
+func FilterPushedDown(query *string, fields ...string) (bool, error) {
+	selector, err := parseFilterQuery(query)
+	if err != nil {
+		return false, err
+	}
+
+	for _, term := range selector.Terms {
+		if !slices.ContainsFunc(fields, func(field string) bool { return isIDTerm(term, field) }) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// parseFilterQuery parses the value of the filter query parameter, which may be absent
+func parseFilterQuery(query *string) (*search.Selector, error) {
+	if query == nil || *query == "" {
+		return &search.Selector{}, nil
+	}
+
+	parser, err := search.NewSelectorParser().SetLogger(slog.Default()).Build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build selector parser: %w", err)
+	}
+	selector, err := parser.Parse(*query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse filter: %w", err)
+	}
+	return selector, nil
+}
+
+// isIDTerm returns true if the search criterion requires a field to match a list of identifiers
+func isIDTerm(term *search.Term, field string) bool {
+	return slices.Equal(term.Path, []string{field}) && (term.Operator == search.Eq || term.Operator == search.In)
+}
+
 // getOperation attempts to lookup the OpenAPI Operation for the given request
 func (a *FilterAdapter) getOperation(r *http.Request) (*openapi3.Operation, error) {
 	route, _, err := a.router.FindRoute(r)
@@ -355,6 +432,14 @@ func (i *FilterResponseInterceptor) Flush(r *http.Request) error {
 			items = listResult
 		}
 
+		// The criteria of the filter that are not applied when querying the database cannot be combined with paging,
+		// so the removed items are part of the total count of a complete list and are deducted from it.
+		if removed := len(listResult) - len(items); removed > 0 {
+			if total, err := strconv.Atoi(i.original.Header().Get(totalCountHeader)); err == nil {
+				i.original.Header().Set(totalCountHeader, strconv.Itoa(total-removed))
+			}
+		}
+
 		if !i.projector.Empty() {
 			// Apply the projector to reduce the attributes included in each item down to only those of interest to the
 			// caller.  Mandatory fields cannot be excluded so we force them to be included.
@@ -60,39 +60,49 @@ type Fields = string
 // Filter defines model for filter.
 type Filter = string
 
+// Limit defines model for limit.
+type Limit = int
+
+// NextpageOpaqueMarker defines model for nextpageOpaqueMarker.
+type NextpageOpaqueMarker = string
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8RYbW/jxhH+KwO2QHIBLV3ugKJVkQ+G60MExI0b+9oPx0O1IofiNstZel/sU2P/92J2",
-	"lxRNSZabFKg+ieTu7DPPPPNC/pKVuu00ITmbLX7JOmFEiw5NuMIvpfIVfpCoqnCjQlsa2TmpKVtkF7pt",
-	"BVjkTQ4rUNI60DXUvB4M1miQSrTgNCRTUBvdgmsQDFqv3Kyggi5F2Uw3gbQg0k0SLeagDfBhdz48Ho7h",
-	"h3YEYr0Fq4Rt0M7ggzYF4RfRdgrzMQoGsCq1J2e2K7B+HW3pOj7BLw7JSk12FU9ZMMzVasXWgoV/htv2",
-	"u93KeTKX1hX0jwYJXCMtDKSCtPSVA2+xAtLJgQepFKyxx1YFSiLlIJOFwOx0IeA9EsiAeQvC8JNOyVI6",
-	"tQVJaZG3kja8pKBVBL3aAZoVlOVZYihbZIHpfZ+yPJMc8DuP4YKXZYvsORdZntmywVawUNy24xXWGUmb",
-	"7Okpz+r/gYiSU5GW/5OENuiiSHhXkgcIqn6DppKWjpD/WkEJpcJJ0dqgFoPOGwqy+g2hfmWIlUOzH+Ib",
-	"FKZsoDTSoZEiBOxCkxOSLGhCjkurDYJ9vjCfxARbWWqlyc4gxHuyPMS7IOc7hVBG+6x9QaA7NMJpkw+C",
-	"2KmEYzcGcS+U58jfNjjsg1JQQWtevO0jWmul9AMfECmwIaCP8GO/5xGuUAQEv+b3WNDj2fAb/f0VP7bF",
-	"2iS3YstwJVzZoE21IzFS9hHhW4GEo7hghXereHXYlrSAd14oTpgXzEVbG3fK1sagYLW7RtAxe70tXP0X",
-	"trQ5iDPaknQKV5BNvdtpj/KlTvqo0NoXHRzZOuXjztbUwZ3taIuSKI7YqjRaIO16cRzBlmwlURzHxZZO",
-	"6SLZSuS/bOsU/4+ckbfDrmedgTdxcWMDIzupeqYrvf4Xlm6/cRTUb03rjzYPGPcObw+MHmfJJbKywoJO",
-	"Nwsust99jXcHqnd++bc3Q7+43dHCwwEbFmbjW571BgdTsZpiDSDuVqMCqNtOGLQFlQ2WPw/xiBHUJ5N/",
-	"1iMKacU1N8a4P8CC9V2njYPWKye5hPeFeMpiANCfP1BZ0JTLI3034JOuQQOry5sVx3b18WafYEkHCb7J",
-	"P968ed6TE8l9jpQ80di8lwEfYDsRRhge1AixYjfWCNYboz1VSTaSNgrhzmuHdlbQy36Px48k59iHYNVu",
-	"oVTeOjSrg7oJrf+r3aqvJv4MERg665E+HHTFw0cepo+oghZabx20nLdQaxNnT9aPQhcacyV5MGCXwqID",
-	"2tv11jDGHPJc2oLGnsI3gqpvJuk1BJAp4mi/ko8/H0uvaehPj2NxSD09jw1AdjjeHB3Gwpz10jD21D8M",
-	"E/f59fLvaGyYxqbD2ZJqbVoRIiLW2jsQcB8X92l9fr2MaDvD6eokBqv3O5M7N76dvZ29zfID02G6E0tq",
-	"9pSPUNnXwepfDdLB9gQ+0cmx/QHjpxH0hPfpc55Jh21Y+HuDdbbIfjffvRrPE5nzEZM7l4QxYsvX3shr",
-	"g7X88pyTuX4nW3smqTbCOuNL5w0u6R7JabOd33/7Or6ujV4rbP+CTkgV39af+1vFxBLq3Dkj195N718/",
-	"Wz85Mp9E4Jy2QL5do2Ged0ZADNZzEBYqrCUhF13ukR2WspZlDJw2XC8EgWQmuPGE+7PsgHdVcGtfCOfQ",
-	"+FbQmUFRibWKL7mC4gH9cbHV8ERWlt6Y/vWOxdFF1mbPku1CE2HZV6FKOLEW3JtlixVo7/YDwplonaAS",
-	"D0H8+NNyNF24RjiQFZKTtcRUm3ukxxFCQUuum1vYhqpeexO6lBylgqyhwuGkVETi42zB+juE3Drh/IEc",
-	"43b8/e3tNcQFUOoKU8U+ReVwpKQRWZIcbtCE1JBOHaTKNtq4fBpU69tWmO3kJGC7M1g63uX5TZ1H0UbQ",
-	"Jn1IGmF0+jjiPHy3wc4F7zpvOm0xlA+lS6Hkv6MsYVmHE8NrgrxHiu+GIQhhmi6yUIoWayXo5yLLI1FD",
-	"PoBtuOoLZUN374y+l1UfpL2oxBuntCTKUpsqfMLRsLy8/QA/fbiA93/64x/g0/vPB6W2Rx4PPFRqb8SG",
-	"Rw/ewuv4oITRFjQJSKVLPyRs38YH01/jbDOLn5a+v7364Q08cDN8pkzY9ccWQxVJQ3tn0CK5vCDpbJof",
-	"mUVrfTtMRhOmp82yca6zi/m8V+SIw1mp25M58ZRnBu+8NFhli099ggxF6PNefXoKBaDW+wH78d3y6gYu",
-	"dNtqgitdobJBNtfDl9SAXckSyYaApy5+3omyQXgX2qU3auTWw8PDTITHM20287TXzn9YXlz+9eby7N3s",
-	"7axxrRol2itwZPl+38sz3SGJTmaL7H1q3Z1wDVeLp6f/BAAA//82Lvq7HhYAAA==",
+	"H4sIAAAAAAAC/8RZ+2/byBH+VwZsgbscGEpxgEOrg1EYroMTEPfcs9P+EAbhihyK2yxn6X3YVs/+34t9",
+	"kKIpKfI9gPqniNyd/eabbx7c/JKUsu0kIRmdLH5JOqZYiwaV/4UPpbAVvuMoKv+gQl0q3hkuKVkk57Jt",
+	"GWh0mwxWILg2IGuo3XpQWKNCKlGDkRBNQa1kC6ZBUKitMFlOOV2wspluAq6BxYfEWkxBKnCH3Vr/Wtaj",
+	"l3oEYrUBLZhuUGfwTqqc8IG1ncB0jMIBKEppyahNAdqugi1Zhzf4YJA0l6SLcMrCwSyKIqdo4bN/rE+3",
+	"K2fRXFyX078bJDAN1zCQClzTNwasxgpIRgfuuRCwwh5b5SkJlAOPFjyz04WAd0jAPeYNMOXedIKX3IgN",
+	"cIqLrOa0dktyKgLoYgsoyylJk8hQskg807s+JWnCXcBvLfofblmySJ5zkaSJLhtsmROK2XRuhTaK0zp5",
+	"ekqT+g8QUXQq0PJ/ktAaTRCJ2xXlAYyq36GpqKUD5L9UUEwIf1KwNqhFobGKsPp9oX5hiIVBtRvia2Sq",
+	"bKBU3KDizAfsXJJhnDRIQheXVioE/XxhOokJtryUQpLOwMd7stzHOydjO4FQBvtO+4xAdqiYkSoFtqMS",
+	"F7sxiDsmrIv8TYPDPigZ5bRyizd9RGsphLx3BwQKtA/oI/zU73mES2QewW/5e8zp8fXwN/rnb/hztpw2",
+	"yRTOMlwyUzaoY+2IjJR9REwTSTiICwq8LQAO2+Ia8NYyAUZ+zVywtTbHbK0VMoMKTMPokL3eFha/wpZU",
+	"e3EGW5yO4fKyqbc79UG+xFEfBWr9VQdHtrB4qa2pg1vbwRZFURywVUnUQNL04jiALdqKojiMy1k6poto",
+	"i9MLbB3j/9Fl5E2DOznPg8pdcXMGRnZi9Yy/5Oo/WJrdxpFTvzWuP9g8YNw7rN4zeryOLpHmFeZ0vFm4",
+	"Inv6Ld7uqd7pxT9fDf3iZksLU+Fgpta2RTJbB2OxmmL1IG6LUQGUbccU6pzKBssvQzxCBOXR5M96RD6t",
+	"XM0NMe4P0KBt10lloLXC8E7EfXtY9AD68wcqc5pyeaDvenzcNKiguLguXGyLD9e7BHPaS/B1+uH61fOe",
+	"HEnuc6R0E41Oexm4A3TH/AjjBjVCrJwbKwRtlZKWqigbTmuBcGulQZ3l9HW/x+NHlHPoQ1C0GyiF1QZV",
+	"sVc3vvV/s131zcSfIQJDZz3Qh72u3PCR+ukjqKCF1moDrctbqKUKs6fTj0DjG3PFDZfkXPKL9mhv21v9",
+	"GLPPc65zGnsK3zGqvpuk1xBAR5GL9gv5+OFQel2/+rXjWBhSj89jA5AtjlcHhzEH/cgwJnjLze4sdske",
+	"eGtbINuuULmQcYOtn64DMuAEDDq29lkd0Wcjh1Ghj7sflvzmtHe0k6Sxn9I1MCjec/pSQIOsQgX33DQx",
+	"hPhgClAomIOVU1+Z46cKuPcewzZeztICcjufvy1n8oS3+jWnWjFtlC2NVbikOyQj1WZ292amUEurSryS",
+	"Uui/eS5O38znbvvJ9866M/5ZduzW4ueWqS+oTrMs8+bxB4fsNE/cujz5owIeiI1J7o6fKODNfL4/3CGS",
+	"42i3IYh+zzxNWk7xZ9oLgZPBNSqvhN7dn7y3l97ZXWGEtxC46Av6RAZblaTANHRK3vEqeObD+izawURO",
+	"gy5iO1CujYZvPH+Ewjsure4pOcpzaDRKB4EcyKt9PO4P+1fT6Kl/6T9cz66W/0KlPV9T+pZUS9V6OQNb",
+	"SWuAwV1Y3Ht6drUM4Drlup7h6K3ebU1uq8GbbJ7Nk3RPXscnYTJJntIRKv0yWP0XdjxYH8HHOj62P2D8",
+	"OIIe8T59ShNfEdzCPyusk0Xyp9n2hmkWyZyNmNy6xJRiG/fbKn6lsOYPzzk5mvUv4+tKyZXA9u9oGBfh",
+	"0uu5v1XoT0ycGaP4yprp86tn6ydHppMInNFmVGy3RoAN1n0yVVjzbZXosOQ1L0PgpHJtlxFwx0SLZPzz",
+	"LNnjXeXd2hXCGTS2ZfRaIavYSoS7IkbhgP64kKJcgyxLq1R/SxLS1LOWPetZ55IIy76ZV8ywFdMIhrdY",
+	"gbRmNyAuM7VhVOI+iB9+Xo6GdNMwA7xCMrzmGEecHulhhJDT0o0fG9j44ai2yg97fJQKvIYKh5NizQiv",
+	"k4XT3z7k2jBj9+SYm2p/vLm5grAASllhHHyOUTkcyckku8U7TQw3Yi9VupHKpNOgatu2TG0mJ4Gzm8HS",
+	"uF1WVOGLrmG0jvexI4xGHkac+utP7Iz3rrOqkxp9+RCyZIL/N8gSlrU/0X9t8zukcMXig+A/SvPEl6LF",
+	"SjD6kidpIGrIB9CN66VMaD8k910mBGknKuHBMS2xspSq8uOFhOXFzTv4+d05vP3rX76Hj28/7ZXaDnlc",
+	"A1IprWJr34ZYuAh0B0WMOqdJQCpZ2iFh+2l4MP0tZuss3ND+eHP5/hXcN0jPlQnbbtiiryLx27dTqJFM",
+	"mhM3On6GORa1tu3wgTFhejpzNsZ0ejGb9YoccZiVsj2aE09p4ro5V1gli499ggxF6NNOfXryBaCWe8aP",
+	"k+XlNbjLYElwKSsU2svmavgPCY9d8BJJ+4DHrn7WsbJBOPHt0ioxcuv+/j5j/nUm1XoW9+rZ++X5xT+u",
+	"L16fZPOsMa0YJdoLcCTpbt9LE9khsY4ni+RtbN0dM42rFk9P/xsAhqXAOGUZAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
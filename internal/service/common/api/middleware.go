@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
@@ -17,6 +19,12 @@ import (
 
 type Middleware = func(http.Handler) http.Handler
 
+func init() {
+	// The request validation only knows how to decode the content types registered with openapi3filter, which do not
+	// include the JSON merge patches
+	openapi3filter.RegisterBodyDecoder("application/merge-patch+json", openapi3filter.JSONBodyDecoder)
+}
+
 // LogDuration log time taken to complete a request.
 // TODO: This is just get started with middleware but should be replaced with something that's more suitable for production i.e OpenTelemetry
 // https://github.com/open-telemetry/opentelemetry-go-contrib/blob/main/examples/prometheus/main.go
@@ -30,6 +38,112 @@ func LogDuration() Middleware {
 	}
 }
 
+// CORSConfig defines the cross-origin requests accepted by the CORS middleware
+type CORSConfig struct {
+	// AllowedOrigins lists the origins allowed to make cross-origin requests; "*" allows any origin. Cross-origin
+	// requests are not allowed when empty.
+	AllowedOrigins []string
+	// AllowedMethods lists the methods allowed for cross-origin requests
+	AllowedMethods []string
+	// AllowedHeaders lists the request headers allowed for cross-origin requests
+	AllowedHeaders []string
+}
+
+// CORS adds the CORS response headers to the requests coming from an allowed origin and answers their preflight
+// requests. Requests from any other origin are passed through untouched, and so are rejected by the browser.
+func CORS(config CORSConfig) Middleware {
+	allowAnyOrigin := slices.Contains(config.AllowedOrigins, "*")
+	allowedMethods := strings.Join(config.AllowedMethods, ", ")
+	allowedHeaders := strings.Join(config.AllowedHeaders, ", ")
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			origin := r.Header.Get("Origin")
+			if origin == "" || (!allowAnyOrigin && !slices.Contains(config.AllowedOrigins, origin)) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			w.Header().Add("Vary", "Origin")
+			requestedMethod := r.Header.Get("Access-Control-Request-Method")
+			if r.Method == http.MethodOptions && requestedMethod != "" {
+				// Preflight request; answer it here since the API does not route OPTIONS requests
+				w.Header().Add("Vary", "Access-Control-Request-Method")
+				w.Header().Add("Vary", "Access-Control-Request-Headers")
+				if slices.Contains(config.AllowedMethods, requestedMethod) {
+					w.Header().Set("Access-Control-Allow-Origin", origin)
+					w.Header().Set("Access-Control-Allow-Methods", allowedMethods)
+					if allowedHeaders != "" {
+						w.Header().Set("Access-Control-Allow-Headers", allowedHeaders)
+					}
+				}
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			if slices.Contains(config.AllowedMethods, r.Method) {
+				w.Header().Set("Access-Control-Allow-Origin", origin)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RemoteUserHeader is the request header where the kube-rbac-proxy in front of the servers passes the name of the
+// authenticated user
+const RemoteUserHeader = "X-Remote-User"
+
+// remoteUserKey is the type used to store the remote user in the context
+type remoteUserKey struct{}
+
+// RemoteUser stores the name of the authenticated user passed by the kube-rbac-proxy in the request context, so that
+// the handlers can record who performed an operation.
+func RemoteUser() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if user := r.Header.Get(RemoteUserHeader); user != "" {
+				r = r.WithContext(RemoteUserIntoContext(r.Context(), user))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RemoteUserIntoContext creates a new context that contains the given remote user.
+func RemoteUserIntoContext(ctx context.Context, user string) context.Context {
+	return context.WithValue(ctx, remoteUserKey{}, user)
+}
+
+// RemoteUserFromContext returns the remote user stored in the context, or an empty string if it is unknown.
+func RemoteUserFromContext(ctx context.Context) string {
+	user, _ := ctx.Value(remoteUserKey{}).(string)
+	return user
+}
+
+// requestPathKey is the type used to store the request path in the context
+type requestPathKey struct{}
+
+// RequestPath stores the path of the request in the request context, so that the handlers can build links relative
+// to it (e.g., to the next page of a list).
+func RequestPath() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(RequestPathIntoContext(r.Context(), r.URL.Path)))
+		})
+	}
+}
+
+// RequestPathIntoContext creates a new context that contains the given request path.
+func RequestPathIntoContext(ctx context.Context, path string) context.Context {
+	return context.WithValue(ctx, requestPathKey{}, path)
+}
+
+// RequestPathFromContext returns the request path stored in the context, or an empty string if it is unknown.
+func RequestPathFromContext(ctx context.Context) string {
+	path, _ := ctx.Value(requestPathKey{}).(string)
+	return path
+}
+
 // OpenAPIValidation to validate all incoming requests as specified in the spec
 func OpenAPIValidation(swagger *openapi3.T) Middleware {
 	// Clear out the servers array in the swagger spec, that skips validating
@@ -0,0 +1,190 @@
+package api
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CORS", func() {
+	var (
+		config  CORSConfig
+		handled bool
+	)
+
+	serve := func(method, origin string, headers map[string]string) *httptest.ResponseRecorder {
+		handled = false
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handled = true
+			w.WriteHeader(http.StatusOK)
+		})
+
+		req := httptest.NewRequest(method, "/o2ims-infrastructureInventory/v1/resourcePools", nil)
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
+		rec := httptest.NewRecorder()
+		CORS(config)(next).ServeHTTP(rec, req)
+		return rec
+	}
+
+	BeforeEach(func() {
+		config = CORSConfig{
+			AllowedOrigins: []string{"https://dashboard.example.com"},
+			AllowedMethods: []string{http.MethodGet, http.MethodHead},
+			AllowedHeaders: []string{"Authorization", "Content-Type"},
+		}
+	})
+
+	It("answers preflight requests from an allowed origin", func() {
+		rec := serve(http.MethodOptions, "https://dashboard.example.com", map[string]string{
+			"Access-Control-Request-Method": http.MethodGet,
+		})
+		Expect(handled).To(BeFalse())
+		Expect(rec.Code).To(Equal(http.StatusNoContent))
+		Expect(rec.Header().Get("Access-Control-Allow-Origin")).To(Equal("https://dashboard.example.com"))
+		Expect(rec.Header().Get("Access-Control-Allow-Methods")).To(Equal("GET, HEAD"))
+		Expect(rec.Header().Get("Access-Control-Allow-Headers")).To(Equal("Authorization, Content-Type"))
+	})
+
+	It("does not allow preflight requests for a method that is not allowed", func() {
+		rec := serve(http.MethodOptions, "https://dashboard.example.com", map[string]string{
+			"Access-Control-Request-Method": http.MethodDelete,
+		})
+		Expect(rec.Code).To(Equal(http.StatusNoContent))
+		Expect(rec.Header().Get("Access-Control-Allow-Origin")).To(BeEmpty())
+		Expect(rec.Header().Get("Access-Control-Allow-Methods")).To(BeEmpty())
+	})
+
+	It("adds the allow origin header to actual requests from an allowed origin", func() {
+		rec := serve(http.MethodGet, "https://dashboard.example.com", nil)
+		Expect(handled).To(BeTrue())
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Header().Get("Access-Control-Allow-Origin")).To(Equal("https://dashboard.example.com"))
+		Expect(rec.Header().Values("Vary")).To(ContainElement("Origin"))
+	})
+
+	It("does not add CORS headers to requests from other origins", func() {
+		rec := serve(http.MethodGet, "https://evil.example.com", nil)
+		Expect(handled).To(BeTrue())
+		Expect(rec.Header().Get("Access-Control-Allow-Origin")).To(BeEmpty())
+
+		rec = serve(http.MethodOptions, "https://evil.example.com", map[string]string{
+			"Access-Control-Request-Method": http.MethodGet,
+		})
+		Expect(handled).To(BeTrue())
+		Expect(rec.Header().Get("Access-Control-Allow-Origin")).To(BeEmpty())
+	})
+
+	It("does not add CORS headers by default", func() {
+		config = CORSConfig{}
+		rec := serve(http.MethodGet, "https://dashboard.example.com", nil)
+		Expect(handled).To(BeTrue())
+		Expect(rec.Header().Get("Access-Control-Allow-Origin")).To(BeEmpty())
+	})
+
+	It("allows any origin when configured with a wildcard", func() {
+		config.AllowedOrigins = []string{"*"}
+		rec := serve(http.MethodGet, "https://other.example.com", nil)
+		Expect(rec.Header().Get("Access-Control-Allow-Origin")).To(Equal("https://other.example.com"))
+	})
+
+	It("passes requests without an origin through untouched", func() {
+		rec := serve(http.MethodGet, "", nil)
+		Expect(handled).To(BeTrue())
+		Expect(rec.Header().Get("Access-Control-Allow-Origin")).To(BeEmpty())
+		Expect(rec.Header().Values("Vary")).To(BeEmpty())
+	})
+})
+
+var _ = Describe("RemoteUser", func() {
+	serve := func(user string) string {
+		var result string
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			result = RemoteUserFromContext(r.Context())
+		})
+
+		req := httptest.NewRequest(http.MethodPatch, "/o2ims-infrastructureMonitoring/v1/alarms/id", nil)
+		if user != "" {
+			req.Header.Set(RemoteUserHeader, user)
+		}
+		RemoteUser()(next).ServeHTTP(httptest.NewRecorder(), req)
+		return result
+	}
+
+	It("stores the user passed by the proxy in the context", func() {
+		Expect(serve("system:serviceaccount:oran-o2ims:alarm-operator")).To(
+			Equal("system:serviceaccount:oran-o2ims:alarm-operator"))
+	})
+
+	It("leaves the user unknown when the proxy does not pass it", func() {
+		Expect(serve("")).To(BeEmpty())
+	})
+})
+
+var _ = Describe("RequestPath", func() {
+	It("stores the path of the request in the context", func() {
+		var result string
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			result = RequestPathFromContext(r.Context())
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/o2ims-infrastructureInventory/v1/resourcePools?limit=10", nil)
+		RequestPath()(next).ServeHTTP(httptest.NewRecorder(), req)
+		Expect(result).To(Equal("/o2ims-infrastructureInventory/v1/resourcePools"))
+	})
+})
+
+var _ = Describe("ResponseFilter", func() {
+	const spec = `
+openapi: 3.0.0
+info:
+  title: Test
+  version: 1.0.0
+paths:
+  /pools:
+    get:
+      responses:
+        '200':
+          description: The pools.
+`
+
+	serve := func(target string) *httptest.ResponseRecorder {
+		swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+		Expect(err).ToNot(HaveOccurred())
+		adapter, err := NewFilterAdapter(slog.New(slog.NewTextHandler(GinkgoWriter, nil)), swagger)
+		Expect(err).ToNot(HaveOccurred())
+
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("X-Total-Count", "3")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`[{"name":"pool-1"},{"name":"pool-2"},{"name":"pool-3"}]`))
+		})
+
+		rec := httptest.NewRecorder()
+		ResponseFilter(adapter)(next).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+		return rec
+	}
+
+	It("deducts the items removed by the filter from the total count", func() {
+		rec := serve("/pools?filter=" + url.QueryEscape("(neq,name,pool-2)"))
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Body.String()).To(MatchJSON(`[{"name":"pool-1"},{"name":"pool-3"}]`))
+		Expect(rec.Header().Get("X-Total-Count")).To(Equal("2"))
+	})
+
+	It("keeps the total count when the filter removes no items", func() {
+		rec := serve("/pools?filter=" + url.QueryEscape("(neq,name,pool-4)"))
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Header().Get("X-Total-Count")).To(Equal("3"))
+	})
+})
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+// Pagination query parameters definitions
+const (
+	limit                = "limit"
+	nextPageOpaqueMarker = "nextpage_opaque_marker"
+)
+
+// ErrInvalidNextPageMarker is returned when the next page marker provided by a client cannot be decoded
+var ErrInvalidNextPageMarker = errors.New("invalid next page marker")
+
+// nextPageMarker is the content of the opaque marker of the next page of a list ordered by identifier
+type nextPageMarker struct {
+	After uuid.UUID `json:"after"`
+}
+
+// EncodeNextPageMarker returns the opaque marker of the page following the item with the given identifier
+func EncodeNextPageMarker(after uuid.UUID) string {
+	data, _ := json.Marshal(nextPageMarker{After: after})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeNextPageMarker returns the identifier of the last item of the previous page from an opaque marker, or nil
+// if there is no marker.  ErrInvalidNextPageMarker is returned if the marker cannot be decoded.
+func DecodeNextPageMarker(marker *string) (*uuid.UUID, error) {
+	if marker == nil || *marker == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(*marker)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidNextPageMarker, err.Error())
+	}
+	var result nextPageMarker
+	if err = json.Unmarshal(data, &result); err != nil || result.After == uuid.Nil {
+		return nil, ErrInvalidNextPageMarker
+	}
+	return &result.After, nil
+}
+
+// ListParams defines the query parameters of a request listing items that are preserved in the link to the next
+// page
+type ListParams struct {
+	ExcludeFields *string
+	Fields        *string
+	Filter        *string
+	Limit         *int
+}
+
+// NextPageLink returns the value of the Link header referencing the next page of a list.  The query parameters of
+// the current request are preserved, other than its next page marker which is replaced by the given one.  An empty
+// string is returned if there is no next page.
+func NextPageLink(path string, params ListParams, marker string) string {
+	if marker == "" {
+		return ""
+	}
+
+	query := url.Values{}
+	for name, value := range map[string]*string{
+		excludeFields: params.ExcludeFields,
+		fields:        params.Fields,
+		filter:        params.Filter,
+	} {
+		if value != nil {
+			query.Set(name, *value)
+		}
+	}
+	if params.Limit != nil {
+		query.Set(limit, strconv.Itoa(*params.Limit))
+	}
+	query.Set(nextPageOpaqueMarker, marker)
+
+	return fmt.Sprintf(`<%s?%s>; rel="next"`, path, query.Encode())
+}
@@ -0,0 +1,99 @@
+package api
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("Pagination", func() {
+	It("decodes the next page marker it encodes", func() {
+		id := uuid.New()
+		after, err := DecodeNextPageMarker(ptr.To(EncodeNextPageMarker(id)))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(after).To(Equal(&id))
+
+		after, err = DecodeNextPageMarker(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(after).To(BeNil())
+	})
+
+	It("rejects an invalid next page marker", func() {
+		for _, marker := range []string{"not a marker", "e30", EncodeNextPageMarker(uuid.Nil)} {
+			_, err := DecodeNextPageMarker(ptr.To(marker))
+			Expect(err).To(MatchError(ErrInvalidNextPageMarker), marker)
+		}
+	})
+
+	It("links to the next page preserving the query parameters", func() {
+		const path = "/o2ims-infrastructureInventory/v1/resourcePools"
+		params := ListParams{
+			Fields: ptr.To("name"),
+			Filter: ptr.To("(eq,name,'my pool')"),
+			Limit:  ptr.To(10),
+		}
+		Expect(NextPageLink(path, params, "")).To(BeEmpty())
+
+		link := NextPageLink(path, params, "marker")
+		Expect(link).To(HavePrefix("<" + path + "?"))
+		Expect(link).To(HaveSuffix(`>; rel="next"`))
+		target, err := url.Parse(strings.TrimSuffix(strings.TrimPrefix(link, "<"), `>; rel="next"`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(target.Query()).To(Equal(url.Values{
+			"fields":                 {"name"},
+			"filter":                 {"(eq,name,'my pool')"},
+			"limit":                  {"10"},
+			"nextpage_opaque_marker": {"marker"},
+		}))
+	})
+})
+
+var _ = Describe("FilterIDs", func() {
+	var (
+		first  = uuid.New()
+		second = uuid.New()
+	)
+
+	It("returns nil when no criteria apply to the field", func() {
+		Expect(FilterIDs(nil, "resourcePoolId")).To(BeNil())
+		Expect(FilterIDs(ptr.To("(eq,name,pool);(neq,resourcePoolId,"+first.String()+")"), "resourcePoolId")).
+			To(BeNil())
+	})
+
+	It("returns the identifiers accepted by all the eq and in criteria", func() {
+		query := "(in,resourcePoolId," + first.String() + "," + second.String() + ");(eq,name,pool)"
+		Expect(FilterIDs(ptr.To(query), "resourcePoolId")).To(Equal([]uuid.UUID{first, second}))
+
+		query += ";(eq,resourcePoolId," + second.String() + ")"
+		Expect(FilterIDs(ptr.To(query), "resourcePoolId")).To(Equal([]uuid.UUID{second}))
+
+		query += ";(eq,resourcePoolId," + first.String() + ")"
+		Expect(FilterIDs(ptr.To(query), "resourcePoolId")).To(BeEmpty())
+	})
+
+	It("rejects invalid identifiers", func() {
+		_, err := FilterIDs(ptr.To("(eq,resourceTypeId,server)"), "resourceTypeId")
+		Expect(err).To(MatchError(ContainSubstring("invalid value 'server' of the 'resourceTypeId' filter")))
+	})
+})
+
+var _ = Describe("FilterPushedDown", func() {
+	id := uuid.NewString()
+
+	It("accepts a filter made of eq and in criteria on the given fields", func() {
+		Expect(FilterPushedDown(nil, "resourcePoolId")).To(BeTrue())
+		Expect(FilterPushedDown(ptr.To("(eq,resourcePoolId,"+id+");(in,resourceTypeId,"+id+")"),
+			"resourcePoolId", "resourceTypeId")).To(BeTrue())
+	})
+
+	It("rejects a filter with other criteria", func() {
+		Expect(FilterPushedDown(ptr.To("(eq,resourcePoolId,"+id+");(eq,name,pool)"), "resourcePoolId")).
+			To(BeFalse())
+		Expect(FilterPushedDown(ptr.To("(neq,resourcePoolId,"+id+")"), "resourcePoolId")).To(BeFalse())
+		Expect(FilterPushedDown(ptr.To("(eq,resourceTypeId,"+id+")"), "resourcePoolId")).To(BeFalse())
+	})
+})
@@ -0,0 +1,214 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net/http"
+	"slices"
+	"strings"
+
+	common "github.com/openshift-kni/oran-o2ims/internal/service/common/api/generated"
+)
+
+// acceptVersionParameter is the media type parameter of the Accept header used to negotiate the API version (e.g.,
+// "application/json; version=v2").
+const acceptVersionParameter = "version"
+
+// APIVersion describes one of the versions of an API.  All versions are served from the same storage: the responses
+// are built by the handlers of the current version and converted to the representation of the requested version.
+type APIVersion struct {
+	// Name is the version as it appears in the paths (e.g., v1)
+	Name string
+	// Convert converts the body of a successful response from the representation of the current version to the
+	// representation of this version.  It is not used for the current version.
+	Convert func(path string, body []byte) ([]byte, error)
+}
+
+// VersionNegotiator routes the requests for any of the supported versions of an API to the handlers of the current
+// version.  The version is selected by the path (e.g., /o2ims-infrastructureInventory/v2/resourcePools) or, for the
+// paths of the current version, by the version parameter of the Accept header.
+type VersionNegotiator struct {
+	prefix   string
+	current  string
+	versions map[string]APIVersion
+}
+
+// NewVersionNegotiator creates a new version negotiator for the API served under the given prefix (e.g.,
+// /o2ims-infrastructureInventory).
+func NewVersionNegotiator(prefix string, current APIVersion, others ...APIVersion) *VersionNegotiator {
+	versions := map[string]APIVersion{current.Name: current}
+	for _, version := range others {
+		versions[version.Name] = version
+	}
+	return &VersionNegotiator{
+		prefix:   strings.TrimSuffix(prefix, "/"),
+		current:  current.Name,
+		versions: versions,
+	}
+}
+
+// Versions returns the names of the supported versions sorted alphabetically
+func (v *VersionNegotiator) Versions() []string {
+	names := make([]string, 0, len(v.versions))
+	for name := range v.versions {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// splitPath splits a path into the version and the remainder of the path after the version.  It returns false if
+// the path is not under the prefix of the API.
+func (v *VersionNegotiator) splitPath(path string) (string, string, bool) {
+	rest, found := strings.CutPrefix(path, v.prefix+"/")
+	if !found {
+		return "", "", false
+	}
+	name, rest, _ := strings.Cut(rest, "/")
+	if rest != "" {
+		rest = "/" + rest
+	}
+	return name, rest, true
+}
+
+// acceptedVersion returns the version requested through the Accept header, if any
+func acceptedVersion(r *http.Request) string {
+	for _, value := range r.Header.Values("Accept") {
+		for _, mediaType := range strings.Split(value, ",") {
+			_, params, err := mime.ParseMediaType(strings.TrimSpace(mediaType))
+			if err != nil {
+				continue
+			}
+			if version, found := params[acceptVersionParameter]; found {
+				return version
+			}
+		}
+	}
+	return ""
+}
+
+// versionRecorder implements the http.ResponseWriter interface so that a response can be buffered and converted
+// before it is written to the original ResponseWriter.
+type versionRecorder struct {
+	header     http.Header
+	statusCode int
+	body       bytes.Buffer
+}
+
+// Header returns the headers of the buffered response
+func (v *versionRecorder) Header() http.Header {
+	return v.header
+}
+
+// WriteHeader records the status code of the response
+func (v *versionRecorder) WriteHeader(statusCode int) {
+	if v.statusCode == 0 {
+		v.statusCode = statusCode
+	}
+}
+
+// Write buffers the data of the response
+func (v *versionRecorder) Write(data []byte) (int, error) {
+	if v.statusCode == 0 {
+		v.statusCode = http.StatusOK
+	}
+	return v.body.Write(data) //nolint:wrapcheck
+}
+
+// writeVersionError writes a problem+json response
+func writeVersionError(w http.ResponseWriter, details string, status int) {
+	out, _ := json.Marshal(common.ProblemDetails{
+		Detail: details,
+		Status: status,
+	})
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	_, _ = w.Write(out)
+}
+
+// convertLinks replaces the paths of the current version referenced by the Link header with the paths of the given
+// version, as the handlers build the links from the paths of the requests they serve.
+func (v *VersionNegotiator) convertLinks(header http.Header, name string) {
+	links := header.Values("Link")
+	if len(links) == 0 {
+		return
+	}
+
+	converted := make([]string, len(links))
+	for i, link := range links {
+		converted[i] = strings.ReplaceAll(link, "<"+v.prefix+"/"+v.current+"/", "<"+v.prefix+"/"+name+"/")
+	}
+	header["Link"] = converted
+}
+
+// Middleware serves the requests for the other versions with the handlers of the current version and converts their
+// successful responses to the representation of the requested version.
+func (v *VersionNegotiator) Middleware() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			name, rest, found := v.splitPath(r.URL.Path)
+			if !found {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// Paths of unknown versions are left to the router, which rejects them
+			version, found := v.versions[name]
+			if !found {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if accepted := acceptedVersion(r); accepted != "" && name == v.current {
+				version, found = v.versions[accepted]
+				if !found {
+					writeVersionError(w, fmt.Sprintf("unsupported API version '%s', supported versions are: %s",
+						accepted, strings.Join(v.Versions(), ", ")), http.StatusNotAcceptable)
+					return
+				}
+			}
+
+			if version.Name == v.current || version.Convert == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// Serve the request with the handlers of the current version
+			request := r.Clone(r.Context())
+			request.URL.Path = v.prefix + "/" + v.current + rest
+			request.URL.RawPath = ""
+			request.RequestURI = request.URL.RequestURI()
+
+			recorder := &versionRecorder{header: make(http.Header)}
+			next.ServeHTTP(recorder, request)
+
+			body := recorder.body.Bytes()
+			if recorder.statusCode < http.StatusBadRequest && len(body) > 0 {
+				converted, err := version.Convert(request.URL.Path, body)
+				if err != nil {
+					slog.Error("failed to convert response", "version", version.Name, "path", r.URL.Path,
+						"error", err)
+					writeVersionError(w, "failed to convert response to the requested API version",
+						http.StatusInternalServerError)
+					return
+				}
+				body = converted
+			}
+
+			for key, values := range recorder.header {
+				w.Header()[key] = values
+			}
+			if name != v.current {
+				v.convertLinks(w.Header(), name)
+			}
+			w.Header().Del("Content-Length")
+			if recorder.statusCode != 0 {
+				w.WriteHeader(recorder.statusCode)
+			}
+			_, _ = w.Write(body)
+		})
+	}
+}
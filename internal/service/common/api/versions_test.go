@@ -0,0 +1,112 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("VersionNegotiator", func() {
+	const prefix = "/o2ims-infrastructureInventory"
+
+	var (
+		negotiator *VersionNegotiator
+		paths      []string
+	)
+
+	// The second version renames the name of the resources to displayName
+	convertToV2 := func(path string, body []byte) ([]byte, error) {
+		object := map[string]any{}
+		if err := json.Unmarshal(body, &object); err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+		object["displayName"] = object["name"]
+		delete(object, "name")
+		return json.Marshal(object) //nolint:wrapcheck
+	}
+
+	serve := func(target, accept string) *httptest.ResponseRecorder {
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			paths = append(paths, r.URL.Path)
+			if strings.HasSuffix(r.URL.Path, "/missing") {
+				w.Header().Set("Content-Type", "application/problem+json")
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"status":404,"detail":"not found"}`))
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("Link", "<"+r.URL.Path+`?nextpage_opaque_marker=abc>; rel="next"`)
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"resourcePoolId":"1234","name":"pool-1"}`))
+		})
+
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		rec := httptest.NewRecorder()
+		negotiator.Middleware()(next).ServeHTTP(rec, req)
+		return rec
+	}
+
+	BeforeEach(func() {
+		paths = nil
+		negotiator = NewVersionNegotiator(prefix,
+			APIVersion{Name: "v1"},
+			APIVersion{Name: "v2", Convert: convertToV2})
+	})
+
+	It("serializes the same resource under both versions selected by path", func() {
+		v1 := serve(prefix+"/v1/resourcePools/1234", "")
+		v2 := serve(prefix+"/v2/resourcePools/1234", "")
+
+		Expect(paths).To(Equal([]string{prefix + "/v1/resourcePools/1234", prefix + "/v1/resourcePools/1234"}))
+		Expect(v1.Code).To(Equal(http.StatusOK))
+		Expect(v1.Body.String()).To(MatchJSON(`{"resourcePoolId":"1234","name":"pool-1"}`))
+		Expect(v2.Code).To(Equal(http.StatusOK))
+		Expect(v2.Body.String()).To(MatchJSON(`{"resourcePoolId":"1234","displayName":"pool-1"}`))
+		Expect(v2.Header().Get("Content-Type")).To(Equal("application/json"))
+	})
+
+	It("links to the pages of the requested version", func() {
+		v1 := serve(prefix+"/v1/resourcePools", "")
+		v2 := serve(prefix+"/v2/resourcePools", "")
+
+		Expect(v1.Header().Get("Link")).To(Equal("<" + prefix + `/v1/resourcePools?nextpage_opaque_marker=abc>; rel="next"`))
+		Expect(v2.Header().Get("Link")).To(Equal("<" + prefix + `/v2/resourcePools?nextpage_opaque_marker=abc>; rel="next"`))
+	})
+
+	It("selects the version through the Accept header", func() {
+		v1 := serve(prefix+"/v1/resourcePools/1234", "application/json; version=v1")
+		v2 := serve(prefix+"/v1/resourcePools/1234", "application/problem+json, application/json; version=v2")
+
+		Expect(v1.Body.String()).To(MatchJSON(`{"resourcePoolId":"1234","name":"pool-1"}`))
+		Expect(v2.Body.String()).To(MatchJSON(`{"resourcePoolId":"1234","displayName":"pool-1"}`))
+	})
+
+	It("rejects unsupported versions requested through the Accept header", func() {
+		rec := serve(prefix+"/v1/resourcePools/1234", "application/json; version=v3")
+
+		Expect(rec.Code).To(Equal(http.StatusNotAcceptable))
+		Expect(rec.Body.String()).To(ContainSubstring("supported versions are: v1, v2"))
+		Expect(paths).To(BeEmpty())
+	})
+
+	It("passes the error responses through unconverted", func() {
+		rec := serve(prefix+"/v2/resourcePools/missing", "")
+
+		Expect(rec.Code).To(Equal(http.StatusNotFound))
+		Expect(rec.Body.String()).To(MatchJSON(`{"status":404,"detail":"not found"}`))
+	})
+
+	It("leaves the paths outside of the versions untouched", func() {
+		serve(prefix+"/api_versions", "")
+		serve(prefix+"/v3/resourcePools", "")
+
+		Expect(paths).To(Equal([]string{prefix + "/api_versions", prefix + "/v3/resourcePools"}))
+	})
+})
@@ -87,6 +87,101 @@ func Search[T db.Model](ctx context.Context, db DBQuery, whereExpr bob.Expressio
 	return ExecuteCollectRows[T](ctx, db, sql, args)
 }
 
+// Page defines a page of tuples ordered by primary key.
+type Page struct {
+	// After is the primary key of the last tuple of the previous page. The first page is retrieved when nil.
+	After *uuid.UUID
+	// Limit is the maximum number of tuples in the page. All the remaining tuples are retrieved when zero.
+	Limit int
+}
+
+// SearchPage retrieves a page of the tuples from the database table specified using a custom expression.
+// The `whereExpr` argument is a custom expression to filter the records.
+// The `page` argument defines the page to retrieve; tuples are ordered by primary key.
+// The tuples of the page are returned along with whether more tuples follow it.
+func SearchPage[T db.Model](ctx context.Context, db DBQuery, whereExpr bob.Expression, page Page) ([]T, bool, error) {
+	sql, args, err := buildSearchPageQuery[T](whereExpr, page)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	records, err := ExecuteCollectRows[T](ctx, db, sql, args)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if page.Limit > 0 && len(records) > page.Limit {
+		return records[:page.Limit], true, nil
+	}
+	return records, false, nil
+}
+
+// buildSearchPageQuery builds the query retrieving a page of the tuples matching a custom expression. The tuples
+// following the previous page are selected by primary key, and one more tuple than the page holds is requested so
+// that the caller can tell whether a next page exists.
+func buildSearchPageQuery[T db.Model](whereExpr bob.Expression, page Page) (string, []any, error) {
+	var record T
+	tags := GetAllDBTagsFromStruct(record)
+
+	conditions := []bob.Expression{psql.RawQuery("1=1")}
+	if whereExpr != nil {
+		conditions = append(conditions, whereExpr)
+	}
+	if page.After != nil {
+		conditions = append(conditions, psql.Quote(record.PrimaryKey()).GT(psql.Arg(*page.After)))
+	}
+
+	query := psql.Select(
+		sm.Columns(tags.Columns()...),
+		sm.From(record.TableName()),
+		sm.Where(psql.And(conditions...)),
+		sm.OrderBy(psql.Quote(record.PrimaryKey())),
+	)
+	if page.Limit > 0 {
+		// Fetch one more tuple to determine whether there is a next page
+		query.Apply(sm.Limit(page.Limit + 1))
+	}
+
+	sql, args, err := query.Build()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to build query: %w", err)
+	}
+	return sql, args, nil
+}
+
+// Count returns the number of tuples from the database table specified matching a custom expression.
+// The `whereExpr` argument is a custom expression to filter the records.
+func Count[T db.Model](ctx context.Context, db DBQuery, whereExpr bob.Expression) (int, error) {
+	sql, args, err := buildCountQuery[T](whereExpr)
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var count int
+	if err = db.QueryRow(ctx, sql, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+	return count, nil
+}
+
+// buildCountQuery builds the query counting the tuples matching a custom expression
+func buildCountQuery[T db.Model](whereExpr bob.Expression) (string, []any, error) {
+	var record T
+	if whereExpr == nil {
+		whereExpr = psql.RawQuery("1=1")
+	}
+
+	sql, args, err := psql.Select(
+		sm.Columns(psql.Raw("count(*)")),
+		sm.From(record.TableName()),
+		sm.Where(whereExpr),
+	).Build()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to build query: %w", err)
+	}
+	return sql, args, nil
+}
+
 // Delete deletes a specific tuple from the database table specified using a custom expression.
 // The `whereExpr` argument is a custom expression to filter the records.
 // The number of rows affected is returned on success; otherwise an error is returned.
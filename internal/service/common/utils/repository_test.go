@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stephenafamo/bob/dialect/psql"
+)
+
+type mockPageModel struct {
+	RecordID uuid.UUID `db:"record_id"`
+	Name     string    `db:"name"`
+}
+
+func (m mockPageModel) TableName() string {
+	return "mock_page_table"
+}
+
+func (m mockPageModel) PrimaryKey() string {
+	return "record_id"
+}
+
+func (m mockPageModel) OnConflict() string {
+	return "record_id"
+}
+
+var _ = Describe("Repository queries", func() {
+	Describe("Page queries", func() {
+		It("retrieves all the tuples ordered by primary key without a page limit", func() {
+			sql, args, err := buildSearchPageQuery[mockPageModel](nil, Page{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sql).To(ContainSubstring("FROM mock_page_table"))
+			Expect(sql).To(ContainSubstring(`WHERE ((1=1))`))
+			Expect(sql).To(ContainSubstring(`ORDER BY "record_id"`))
+			Expect(sql).ToNot(ContainSubstring("LIMIT"))
+			Expect(args).To(BeEmpty())
+		})
+
+		It("requests one more tuple than the page limit", func() {
+			sql, args, err := buildSearchPageQuery[mockPageModel](nil, Page{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sql).To(ContainSubstring("LIMIT 11"))
+			Expect(args).To(BeEmpty())
+		})
+
+		It("selects the tuples following the previous page by primary key", func() {
+			after := uuid.New()
+			sql, args, err := buildSearchPageQuery[mockPageModel](nil, Page{After: &after, Limit: 5})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sql).To(ContainSubstring(`WHERE ((1=1) AND ("record_id" > $1))`))
+			Expect(sql).To(ContainSubstring(`ORDER BY "record_id"`))
+			Expect(sql).To(ContainSubstring("LIMIT 6"))
+			Expect(args).To(Equal([]any{after}))
+		})
+
+		It("pushes the filter down to the database along with the previous page", func() {
+			after := uuid.New()
+			first, second := uuid.New(), uuid.New()
+			whereExpr := psql.Quote("name").In(psql.Arg(first, second))
+			sql, args, err := buildSearchPageQuery[mockPageModel](whereExpr, Page{After: &after, Limit: 2})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sql).To(ContainSubstring(`WHERE ((1=1) AND ("name" IN ($1, $2)) AND ("record_id" > $3))`))
+			Expect(sql).To(ContainSubstring("LIMIT 3"))
+			Expect(args).To(Equal([]any{first, second, after}))
+		})
+
+		It("pushes a filter matching no tuples down to the database", func() {
+			sql, args, err := buildSearchPageQuery[mockPageModel](psql.RawQuery("1=0"), Page{Limit: 2})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sql).To(ContainSubstring(`WHERE ((1=1) AND (1=0))`))
+			Expect(args).To(BeEmpty())
+		})
+	})
+
+	Describe("Count queries", func() {
+		It("counts all the tuples without a filter", func() {
+			sql, args, err := buildCountQuery[mockPageModel](nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sql).To(ContainSubstring("count(*)"))
+			Expect(sql).To(ContainSubstring("FROM mock_page_table"))
+			Expect(sql).To(ContainSubstring("WHERE (1=1)"))
+			Expect(sql).ToNot(ContainSubstring("LIMIT"))
+			Expect(args).To(BeEmpty())
+		})
+
+		It("counts the tuples matching the filter regardless of the page", func() {
+			first := uuid.New()
+			sql, args, err := buildCountQuery[mockPageModel](psql.Quote("name").In(psql.Arg(first)))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sql).To(ContainSubstring(`WHERE ("name" IN ($1))`))
+			Expect(sql).ToNot(ContainSubstring("ORDER BY"))
+			Expect(sql).ToNot(ContainSubstring("LIMIT"))
+			Expect(args).To(Equal([]any{first}))
+		})
+	})
+})
@@ -180,12 +180,18 @@ type GetProvisioningRequestsParams struct {
 	Filter *externalRef0.Filter `form:"filter,omitempty" json:"filter,omitempty"`
 }
 
+// PatchProvisioningRequestTemplateParametersApplicationMergePatchPlusJSONBody defines parameters for PatchProvisioningRequestTemplateParameters.
+type PatchProvisioningRequestTemplateParametersApplicationMergePatchPlusJSONBody = map[string]interface{}
+
 // CreateProvisioningRequestJSONRequestBody defines body for CreateProvisioningRequest for application/json ContentType.
 type CreateProvisioningRequestJSONRequestBody = ProvisioningRequest
 
 // UpdateProvisioningRequestJSONRequestBody defines body for UpdateProvisioningRequest for application/json ContentType.
 type UpdateProvisioningRequestJSONRequestBody = ProvisioningRequest
 
+// PatchProvisioningRequestTemplateParametersApplicationMergePatchPlusJSONRequestBody defines body for PatchProvisioningRequestTemplateParameters for application/merge-patch+json ContentType.
+type PatchProvisioningRequestTemplateParametersApplicationMergePatchPlusJSONRequestBody = PatchProvisioningRequestTemplateParametersApplicationMergePatchPlusJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Get API versions
@@ -209,6 +215,9 @@ type ServerInterface interface {
 	// Update a provisioning request
 	// (PUT /o2ims-infrastructureProvisioning/v1/provisioningRequests/{provisioningRequestId})
 	UpdateProvisioningRequest(w http.ResponseWriter, r *http.Request, provisioningRequestId ProvisioningRequestId)
+	// Patch the template parameters of a provisioning request
+	// (PATCH /o2ims-infrastructureProvisioning/v1/provisioningRequests/{provisioningRequestId}/templateParameters)
+	PatchProvisioningRequestTemplateParameters(w http.ResponseWriter, r *http.Request, provisioningRequestId ProvisioningRequestId)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -380,6 +389,31 @@ func (siw *ServerInterfaceWrapper) UpdateProvisioningRequest(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
+// PatchProvisioningRequestTemplateParameters operation middleware
+func (siw *ServerInterfaceWrapper) PatchProvisioningRequestTemplateParameters(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "provisioningRequestId" -------------
+	var provisioningRequestId ProvisioningRequestId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provisioningRequestId", r.PathValue("provisioningRequestId"), &provisioningRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provisioningRequestId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchProvisioningRequestTemplateParameters(w, r, provisioningRequestId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -507,6 +541,7 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc("DELETE "+options.BaseURL+"/o2ims-infrastructureProvisioning/v1/provisioningRequests/{provisioningRequestId}", wrapper.DeleteProvisioningRequest)
 	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureProvisioning/v1/provisioningRequests/{provisioningRequestId}", wrapper.GetProvisioningRequest)
 	m.HandleFunc("PUT "+options.BaseURL+"/o2ims-infrastructureProvisioning/v1/provisioningRequests/{provisioningRequestId}", wrapper.UpdateProvisioningRequest)
+	m.HandleFunc("PATCH "+options.BaseURL+"/o2ims-infrastructureProvisioning/v1/provisioningRequests/{provisioningRequestId}/templateParameters", wrapper.PatchProvisioningRequestTemplateParameters)
 
 	return m
 }
@@ -790,6 +825,60 @@ func (response UpdateProvisioningRequest500ApplicationProblemPlusJSONResponse) V
 	return json.NewEncoder(w).Encode(response)
 }
 
+type PatchProvisioningRequestTemplateParametersRequestObject struct {
+	ProvisioningRequestId ProvisioningRequestId `json:"provisioningRequestId"`
+	Body                  *PatchProvisioningRequestTemplateParametersApplicationMergePatchPlusJSONRequestBody
+}
+
+type PatchProvisioningRequestTemplateParametersResponseObject interface {
+	VisitPatchProvisioningRequestTemplateParametersResponse(w http.ResponseWriter) error
+}
+
+type PatchProvisioningRequestTemplateParameters200JSONResponse ProvisioningRequest
+
+func (response PatchProvisioningRequestTemplateParameters200JSONResponse) VisitPatchProvisioningRequestTemplateParametersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProvisioningRequestTemplateParameters400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response PatchProvisioningRequestTemplateParameters400ApplicationProblemPlusJSONResponse) VisitPatchProvisioningRequestTemplateParametersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProvisioningRequestTemplateParameters404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response PatchProvisioningRequestTemplateParameters404ApplicationProblemPlusJSONResponse) VisitPatchProvisioningRequestTemplateParametersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProvisioningRequestTemplateParameters409ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response PatchProvisioningRequestTemplateParameters409ApplicationProblemPlusJSONResponse) VisitPatchProvisioningRequestTemplateParametersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProvisioningRequestTemplateParameters500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response PatchProvisioningRequestTemplateParameters500ApplicationProblemPlusJSONResponse) VisitPatchProvisioningRequestTemplateParametersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 	// Get API versions
@@ -813,6 +902,9 @@ type StrictServerInterface interface {
 	// Update a provisioning request
 	// (PUT /o2ims-infrastructureProvisioning/v1/provisioningRequests/{provisioningRequestId})
 	UpdateProvisioningRequest(ctx context.Context, request UpdateProvisioningRequestRequestObject) (UpdateProvisioningRequestResponseObject, error)
+	// Patch the template parameters of a provisioning request
+	// (PATCH /o2ims-infrastructureProvisioning/v1/provisioningRequests/{provisioningRequestId}/templateParameters)
+	PatchProvisioningRequestTemplateParameters(ctx context.Context, request PatchProvisioningRequestTemplateParametersRequestObject) (PatchProvisioningRequestTemplateParametersResponseObject, error)
 }
 
 type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
@@ -1034,63 +1126,100 @@ func (sh *strictHandler) UpdateProvisioningRequest(w http.ResponseWriter, r *htt
 	}
 }
 
+// PatchProvisioningRequestTemplateParameters operation middleware
+func (sh *strictHandler) PatchProvisioningRequestTemplateParameters(w http.ResponseWriter, r *http.Request, provisioningRequestId ProvisioningRequestId) {
+	var request PatchProvisioningRequestTemplateParametersRequestObject
+
+	request.ProvisioningRequestId = provisioningRequestId
+
+	var body PatchProvisioningRequestTemplateParametersApplicationMergePatchPlusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchProvisioningRequestTemplateParameters(ctx, request.(PatchProvisioningRequestTemplateParametersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchProvisioningRequestTemplateParameters")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchProvisioningRequestTemplateParametersResponseObject); ok {
+		if err := validResponse.VisitPatchProvisioningRequestTemplateParametersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xae3PbNrb/KhjeO9Okl3rZipPoTv9w3WSjmSbx2k53ZqNMBZGHIloQoAHQjprou+/g",
-	"wYcoSFTsdOvMNP/EIoGD8/idc34A+CmIeJZzBkzJYPIpyLHAGSgQ5lfEs4yzX3FOfuU5MP0/fIxoEcNL",
-	"AjQ2Y2KQkSC5IpwFk+CMZxlGErQcBTGiRCrEE5To8UhAAgJYBBIpjpwolAieIZUCEiALqvozNmMvcJS2",
-	"JyEiEXYPGc4gRFwgvdh1YV5Xy+iXsqHEYoUkxTIF2UcvuZgx+IiznELY1EIrMI94wZRYzZEsFlYWT+wb",
-	"+KiAScKZnNtVJlrN+XyupRkJv5rH8od65MCJc+Nm7F8pMKRSIlHlZ0Qk+06hQkKMGHcG3BJK0QJK3WLj",
-	"EutyRJwE49n2QAQ3wBAxOq8QFvpNTklEFF0hwtygQhK21ENmbG6VntcK9WcsCAPnoWASGE9v2xSEAdEB",
-	"vy7A/NDDgkmw6YsgDGSUQoY1UNQq1yOkEoQtg/U69MEr+Qq4cnZaT/1FqFqCsrjRsxxiEGbxPWDm4LUj",
-	"HodiDFNqVrLSKgAJUIVgBmn3iP7do04ViO2oXwIWUYoiQRQIgk0MzzhTmDCJOAMdqowLQHJzYNgKE2Qk",
-	"4pQz2UcGAq3hBgIzpoqcAoqsfJ0hmCGeg8CKi7DCSA0cHc6mEjeYFhoMVylU81CE2Ywt9OBVGeSEU8pv",
-	"9QLWK9LE+DN6W875jF4DNhrc5d/nGfvcq/41/rzDPy1Lw5WpuZaMXmMVpSBdhXEeicqI6EfGCTv1QnO4",
-	"nttffllEIrguMNU5tEeclbVUXbKWArBOAJVitkteKQvmXyCLC6+eVhZhXXoZ2CT1TLnTX7TTRgpS7jWw",
-	"IavLxlpW28BatpXFHCh2yIo5SMS4KsGxQzcny4Fit15aUhcunCzn/P2yuvz/WWfkVTVro1noSbreaQEN",
-	"Oa6gul988RtEaruXzFg51Y3f2U9Qs50U0kNQes4kJkkMM9bdP3SR/eERXHsKevjin4+rFnJVu0VTCC0Y",
-	"i2WRaZJYGeiKVVtXo8T1vFEAeZZjAXLGohSi36t42AjyzuTvlxqZtNI118a4XEAiWeQ5FwplBVVEl/Cy",
-	"ELe9aBQo169cOWNtX+5oxUY/olIQaP7icq5jO393ue1gwrwOvgzfXT7ebNPOyWWO6M6IZVjCQC8gc2xY",
-	"jaZzDCDWZiwAyUIIXrDYwYawJQV0XXAFsj9j++1uMhIHZ9uH0DxboYgWUoGYe3Fj2MB39ajvWvZUEag6",
-	"644+bHCl+UhoCIlFQYayQiqU6bxFCReWoWr8UFCmMcdEEwNtkhnkwV7dWw2z8VlO5Iw1LUXfYxZ/30qv",
-	"KoDaRTraB/rj/3elVzv03QzN8tZuilYpUuvxeCc/MzxrPz/LBb8hWnvClhe6ckk1jbe52TtGrgtAJAam",
-	"SEJA6Chi1JyNhJ3e1nh0dAzjJydPe/Ds+aI3OoqPe3j85KQ3Pjo5GY1HT8fD4bC0IMcqrQ3w6xYGeiEi",
-	"IA4mShTQtC/hIsMqmARFQfTItr3rcrDZdJyX8iG+AMkLEcElKM9+5MoGyIwwHVOhFN8AWgAwJIsoAimT",
-	"gtJV7RAdWhNyVVa9DV/lgutJ1le50OVNETBLMx7DmY2sLxLTjRBowW/qCaVu0qrW0Ka/ERM8Gj99Fi+e",
-	"906ejJLeOD4e9p6fxCe9ZzgZHY+fjeKnT58HYac7qye2AQbrsPZpHTOPDcxK1hmHF7xQe6G06Z4NSW3B",
-	"r4oMMyQAx3hBATVeeqNQLrPhG5Oreo/AymKzc1LLH1pKWQu8IJJgSyGTOUQ6hjF6pAmKVJjFWMTkD4gf",
-	"o9pe9Oh3WMnH6DYlUWq7MyaUC1tgTdMEFnOhe8eMVU3YnHMocPtP0nC2MUT3Eet1/fpt74zyIkbNwKEL",
-	"fyp/WgeekNts7QhFSYQOi4E0f/SaA3tuYBAGGWE/A1uqNJiMPEE4sKQ1Esm1H79i6MoAoh6tsSElWbI6",
-	"DJev396x7HWkWBhIhVVh4PS/ApJgEvzPoD7EG7hqNvCk3aWdqJMUspxiBW+8gXrTiEw50vamvW7xxauc",
-	"3h2jcuT5xglku0rkhUL1CFvcIm7wXLLGtzmw0/Mp+uUYWV+gGBKiQ+O2IeVK/QOQXI79BYT01hf34r7u",
-	"uhn3Rk97x71Rl6PWzWb3fmdDNBkYbujaivq2bd4YfDisol9WmGyd4JjnXWm+Wc/z3U34IMC3prXy//KL",
-	"s6dMmz2ud0O6nLXLSz+BruKyUYPlHfyWgZR46cnn1/aFa34Ld/h74Bo1RktGQZhUmFLbP4g5kc4FXwqQ",
-	"Mugovucplh4FzwohgJmup7p7Aisy439gsV7CrGBWt7+SgiaEUtBpkGBi/4iBgtLvPzQt2py4pXmRx1jB",
-	"FfHVSP1UKpzliLCYRFiVXqVYKqRIBgf6GN1iiexKW9T+aHg07g1HvdGTq6Ph5PhocvTs380OoSf19FqH",
-	"MTHPyevp+XRnafNxspvNcnd6PvUxsptaZKP99Yf94VdQVB6maXlD4HSRHSrjnDTlV2q/b1jjTFh/CAOi",
-	"IOssIvv9XRuOhcArAzhBzgUk5OOm5wb8iGSyR1gisFSiiFQhYMpugCkuVoOb0Z29ei74gkLm6o8rvk2v",
-	"xHbLjempUoIsCtV+fr4xfiuFNuN0ylaIFdnCbRYrIQhX0kO9SWo0bIwcM45seLnQDAszRLRzMmDKPO/7",
-	"mndszNqGyylKNRXtVVQUPuYUM7tAuZylE0QiHkWmPEXNyqS91qqOnDGIyvOJGCu8wBJMJYgRL5SvwJhK",
-	"yiLwqfjuYto4dzREp2Kc7tSm1HS3hmjGpgpleIVW5rwnKYQ5v2ruAEiCYqhWcjWoJqGC7Oeg2/viV1dX",
-	"52Xhi3gMjgZ1ubJakrCGswhTsARhsoUo6nWVTLlQYTuossgyLFatlZCW20dTpWcVNLaH1ClmS3cR3dBR",
-	"8d0ah+beF3JlrMsLkXMJpshQHmFK/rCwRNPErGguEMgNMHtrZIJgztlngSlYkwXF7PdZEFpHVfmAZIop",
-	"RZhKc+5X7tZskLY5tHnQhSUcRVzEpl9xNH1x9RJdvDxDx8+fnaD3xx+8UNtyHpEIWMQLgZcQ2yl6nF7I",
-	"6ShnrBWQmEdFlbBNbmxEP4L+sm+vpl9dvf5Zb3GBbSIT1SdnGZgq4o7zcwESmApnjCjpTpbtnqzIqjPT",
-	"lqfbvTZVKpeTwaBEZMOH/YhnnTnRYocuQaoitE0N1+50QDBMf+KRJ5ne9i5O36C3uvijKVMgEhwBumzW",
-	"w0A3DaoT78efbEVJuP1ygykcmYMWd3R2ATF6hVU1obT39va2LyBOsTJmbtfs86mJ1duj6etLNN3oQRtH",
-	"BEEYUBIBswTPrXqa4ygFdGQ6//bC2Lzuc7EcuLly8PP07MWbyxe9o/6wn6qMNlI/6FRCt/cg3G7ZYeBa",
-	"XjAJjvvD/rEmAFilxuve/tqUOtC98qZBD5bgOcO6MAe0NmGqU+uShmg3VlSk6l31eYEEcUMisKi05zaE",
-	"s2kcTIJ/gDqltGInGmcy59pXWoej4bAMODBlqUxOHTwGv0lLw+oD0TsTFmkh29rfNY86+UJh07e9Hiit",
-	"1yauw2C8V2+Xhf93b/1bDMdjwo84Lrm41uvJQ9HLZLwmRxoaIBAIwUXfFBrX2iw2NqCl6xJeSl2BMlBY",
-	"05Dgg57SjfGb0ZfDvIxtRhgXuzFeEYAM/8bFzk3EFuxfa7EPGvh/Y/nrYnkbSPdDtOd87MuQ7duyyx1w",
-	"PfctFm58U/ne79x6yKDzm8t1eBcZyf0mm3tDvfG9VwoetGv23Rdt7ZW/pBN1hvLvDP6KGez1ciOJvRn5",
-	"YR0GOfddDJ6Zr64kwojB7Z4rwc1ktLN8SLIsHaT6kcerr9Y/vJjd3BIoUcB6K39Gf74Ke9IkMm6K9xy3",
-	"PuDUGA+fPwy9zjhLKIm+wXy1abLjqr07Z+/ThAefvFdXa1sB9MbBd02in8u9nwZs1gE7w18Hvqwt+y/a",
-	"dnXEPTlnjevMufHDgNFVfc4I8e77C8YVSnjB4v63lwQWI3dOgrCbS8bueq/jA6lD+OSfjNu/rBNtELaG",
-	"v3ZmycOmbX/n73+XdO6CyUG8s/Cmb07Nl7fmmy8ilftE76DUfWduc//s7H1ALPavrR3u8vxbZbHfcqUY",
-	"j44ehvbnAurvw+1XH99gKbOF4+58XAsz4m1xqe9bJoOBuZZMuVSTZ8Oh/YjBiev+mmLPIdj2h9lNndYf",
-	"1v8JAAD//wsZWINiPAAA",
+	"H4sIAAAAAAAC/+xbbXPbOJL+K128q5pkj3qzHcfR1X7weDIXXcWxz3b2qi5yjSCyJWFCAjQAytEm+u9X",
+	"eKFIUZCo2MmOU5V8iSUCjcbTT7+wAX0OIp5mnCFTMuh/DjIiSIoKhfkU8TTl7A+S0T94hkz/j5+iJI/x",
+	"d4pJbMbEKCNBM0U5C/rBGU9TAhK1HIUxJFQq4BOY6PEgcIICWYQSFAcnCiaCp6BmCAJlnqj2kA3ZaxLN",
+	"6pOASiDuS0ZSDIEL0Ivd5eYxn1QeyooS4wXIhMgZyjb8zsWQ4SeSZgmGVS20AqOI50yJxQhkPray+MQ+",
+	"wU8KmaScyZFdpa/VHI1GQ+Yk/GG+ln8vR3acODduyP53hgzUjEpY4QxUsl8U5BJjYNxt4J4mCYyx0C02",
+	"kFjIgToJBtn6QMA5MqBG5wUQoZ9kCY2oShZAmRuUS8qmesiQjazSo1Kh9pAFYeAQCvqBQXpzT0EYUG3w",
+	"uxzNBz0s6AfrWARhIKMZpkQTRS0yPUIqQdk0WC5DH70m34BXbp8Wqb+IVVNUljd6lmMMEBY/gmaOXlvs",
+	"sS/HSJKYlay0FYEEqlwwjB9n/YdbPVEoNq1+jUREM4gEVSgoMTY840wRyiRwhtpUKRcIcn1gWDMTpjTi",
+	"CWeyDYYCteGGAkOm8ixBiKx87SGEAc9QEMVFCGSDONqcVSXmJMk1GW5muJoHEWFDNtaDF4WRJzxJ+L1e",
+	"wKIijY2/wEUx5wucIzEaPOTflyH70lr9q/z5gH9alqYrUyMtGc6JimYoXYRxiESFRdTMgbBVLxjh3Qhg",
+	"uywqAe9ykmgf2iHOypqqJllTgUQ7gJoRtk1eIQtHXyGLC6+eVhZlTXoZ2kzKmXIrXknjHhOUcucGK7Jw",
+	"tK+s+gZL2VYWc6TYIivmKIFxVZBji25OliPFdr20pCZeOFmU7SGrCf8v2iNvZrjh89SyXMc7LaAixwVU",
+	"94mP/8RIbeaSISumuvFb8wlU00kuPQVKy22JSRrjkDXnDx1k//4M7zwBPXz9P89XKeSmhIUIuzAR0zxF",
+	"psoNumBV19UocTeqBECeZkSgHLJohtHHlT2sBXmj87cLjYxb6ZhrbVwsIEHmWcaFgjRPFM0SN8+DolGg",
+	"WH8F5ZDVsdySio1+VM1QwOj19UjbdvT+ehNgyrwAX4fvr5+vp2kHcuEjOjMSGRY00AvIjJiqRpdzDDHW",
+	"2xgjyFwInrPY0YayaYJwl3OFsj1ku/ddrUgcnW0eglG6gCjJpUIx8vJGTw1/KUf9UtvPygKrzLolDxte",
+	"6XokNAWJZUEKaS4VpNpvYcKFrVA1fxJUJjHHVFHO9JbMIA/3ytxqKhvfzqkcsupO4W+ExX+rudfKgBoi",
+	"be098fjPbe51/fxrKzRbtzaXaCtFSj2eb63PtOoN9Vkm+Jxq7SmbXunIJdUg3qzN3jN6lyPQGJmiE4pC",
+	"W5FAdTYIO72uce/gEI9eHL9s4cmrcat3EB+2yNGL49bRwfFx76j38qjb7RY7yIialRvw6xYGeiEqMA76",
+	"SuRY3d+Ei5SooB/kOdUj6/tdFoPNS8dlIR/jK5Q8FxFeo/K8j9xYA5kRJmMqmJE5whiRgcyjCKWc5Emy",
+	"KAHRpjUmV0XUW8MqE1xPslhlQoc3RdEszXiMZ9ayPksM1kygBb8rJxS6SataRZv2mk1I7+jlSTx+1Tp+",
+	"0Zu0juLDbuvVcXzcOiGT3uHRSS9++fJVEDbCufrGJsBgGQaXmzbz7IFZydrjyJjnaieV1uFZk1QX/CZP",
+	"CQOBJCbjBKHy0GuFYpk1bIyvUgmEFcFm66QaHmFQxgIviSTaUMhkhpG2YQzPGFcgFWExETH9J8bPodwv",
+	"PPuIC/kc7mc0mpmpitCECxtgTdJEFnOhc8eQrZKw6XModO+ftAK22YjOIxZ1/fiidZbwPIaq4eDK78qf",
+	"l4HH5NZbG0xRFEL72UCaP1rVgS03MAiDlLK3yKZqFvR7HiPsGdIqjuTSj18xuDGEKEdrbkhJp6w0w/X5",
+	"xQPDXoOLhYFUROWGTv8ucBL0g3/rlE28jotmHY/bXduJ2kkxzRKi8J3XUO8qlilG2ty0ExafvYrpzTYq",
+	"Rl6udSDrUSLLFZQjbHCLuOFzUTVeZMhOLwfwj0OwWECME6pN415DipXaezC5GPsPFNIbX9yDx8I1P2r1",
+	"XrYOW70moJbVZPdha0I0Hhiu6Vqz+ubevDa43S+iX684WevgmO+b3Hw9nmfbk/BehK9Nq/n/9Vd7T+E2",
+	"O6B3Q5rA2obSb6ijuKzEYPkA3FKUkkw9/nxuH7jkN3bN3z3XKDlaVBSUSUWSxOYPajrSmeBTgVIGDcH3",
+	"ckakR8GzXAhkJuup5pzA8tTgjyzWS4RBsbr9NMmTCU0S1G4wIdT+EWOCSj+/re5ofeKG5nkWE4U31Bcj",
+	"9bdSkTQDymIaEVWgmhCpQNEU98QY7okEu9JGaX/QPThqdXut3oubg27/8KB/cPJ/1QyhJ7X0WvtVYp7O",
+	"6+nlYGto89Vk8/Vwd3o58FVk81JkJf21u+3uN1BU7qdpcULgdJENKpOMVuWv1P5Q2Y3bwvI2DKjCtDGI",
+	"7Ma73DgRgiwM4QS9FDihn9aR6/ADmsoWZRNBpBJ5pHKBAzZHprhYdOa9B6N6Kfg4wdTFHxd8q6jE9pWb",
+	"JKdKCTrOVf37y7XxGy60bqdTtgCWp2P3srgSAmQlPQQiqwmbgKuMI2teLnSFRRhQDU6KTJnv277kHZtt",
+	"bdLlFGa6FG2tSlF9SEaYXaBYzpYTVAKPIhOeompk0qjVoiNnDKOiPxETRcZEookEMfBc+QKMiaQsQp+K",
+	"768Glb6jKXRWFafr2hSabtcQhmygICULWJh+zyQXpn9VfQOgE4hxtZKLQWURKujuGnTzvfjNzc1lEfgi",
+	"HqMrg5qgXC1JWQUsyhROURhvoSrxQiVnXKiwblSZpykRi9pKoOW2YaD0rDyJbZN6RtjUHURXdFR8u8ah",
+	"OffFTJndZbnIuEQTZBIekYT+09ISBhOzojlAoHNk9tTIGMH02YeBCVj9cULYx2EQWqBW/gBypvtBJJGm",
+	"71e8rVkjbdbQi8wL0DqXSBRxEZt8xWHw+uZ3uPr9DA5fnRzDh8NbL9U2wKMSkEU8F2SKsZ2ix+mFnI5y",
+	"yGoGiXmUrxy2Whsb0c+wPW3bo+k3N+dv9SsusnVmQtk5S9FEEdfOzwRKZCocMqqk6yzbd7I8XfVMa0jX",
+	"c+1MqUz2O52CkRUM2xFPG32iVh06B1kFoc3ScOm6A4KR5DceeZzponV1+g4udPCHAVMoJiRCuK7Gw0An",
+	"jUQ73q+/2Ygy4fbmBlMkMo0W1zq7whjeELWaUOz3/v6+LTCeEWW2uRmzLwfGVhcHg/NrGKzloLUWQRAG",
+	"CY2QSaysepqRaIZw0O56FybmcZuLacfNlZ23g7PX765ftw7a3fZMpUnF9YNGJXR6D8LNlB0GLuUF/eCw",
+	"3W0f6gKAqJlB3Ztfq1I7OlfOK+XBFD09rCvToLUOs+paF2WIhrGQUOausl8gUcxphJaVtm9DOdNdiuC/",
+	"UJ0myao60TyTGddYaR0Out3C4MiULWX0lQ8zv/OntGVY2RB9cMEiLWVr73fVVicfK2LytheBYvd6i8sw",
+	"ONqpt/PC/3i0/rUKx7OFX0lc1OJarxdPRS/j8bo40tRAASgEF20TaFxqs9xYo5aOS2QqdQRKURFdhgS3",
+	"ekozx+e9r6d5YduUMi62c3xVAKTkTy62vkRs0P5ci33SxP/J5W/L5U0iPY7Rnv7Y1zHb98out9D10rdY",
+	"uHan8oMf3HJIp/HO5TJ8iIzJ4yabc8Pl7SNdcK+3Zg+Km+/KX5OJGk3504O/oQd7Ua44sdcjb3WfkPsO",
+	"Bs/MrSsJBBje7zgSXHdGO8vHJFulo1S/8njxzfKHl7PLZf1gernhP73vr8ION4kMTPGOdusTdo2j7qun",
+	"odcZZ5OERj+gv1o32XLU3uyzj0nCnc/eo6uljQAJKvQdkyRoY8G+ccDO8MeBr0vLXm23ZsQdPmc31+hz",
+	"R0+DRjdlnxHj7ecXjCuY6Atp7R/PCSxHHuwEYXMtaVtAsumC1D715Hfm7V+WidYKtgpeW73kaZdtP/33",
+	"X1t0bqPJXnVn7nXfLDE3b82dLyqVu6K3l+u+N6e539t7n1AV+9fGDnd4/qNWsT9ypDjqHTwN7S8FlvfD",
+	"7a2PHzCU2cDxpOrxjv9eXqbv3/tuGompKdD/+/riHaT6E5ih8EyfMb48PDl+DpQVvylyost78LZGag65",
+	"mqz6Imz19K+43mfXIwLtAzFH+8tLomeARAWKA8uTBKgEgSmf66PFm9WNe/MDgTlJqI0qZEook+5WlmHA",
+	"xl2/MU64+52M129o7YLPera41Pp64t7NJvD/6vRhDNgygHp8ZN30GxY3guzp6xZj1y5gDgP384WBuxZR",
+	"7nwY9OHzUB+XCvKWjDEpvjknTJ9Au6tpxTA2138NtZ/EQ+2NnrudTz+vGRgx3obe7ntyP1Pe90p53VdP",
+	"R3uvzh8xUzBG/VXKY7u5iDN7kUUlix8wMZoYuTNnPDRl6mXMwjagllcU+p2Ouckz41L1T/TV/OXtSlzz",
+	"BcQd50abv2Wq6rS8Xf7/AAO23c6VQwAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
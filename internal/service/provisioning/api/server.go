@@ -3,10 +3,12 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
 
+	jsonpatch "github.com/evanphx/json-patch/v5"
 	"github.com/google/uuid"
 
 	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
@@ -17,6 +19,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -200,6 +203,71 @@ func (r *ProvisioningServer) UpdateProvisioningRequest(ctx context.Context, requ
 	return api.UpdateProvisioningRequest200JSONResponse(*request.Body), nil
 }
 
+// PatchProvisioningRequestTemplateParameters handles an API request to merge a JSON merge patch into the template
+// parameters of a provisioning request
+func (r *ProvisioningServer) PatchProvisioningRequestTemplateParameters(ctx context.Context, request api.PatchProvisioningRequestTemplateParametersRequestObject) (api.PatchProvisioningRequestTemplateParametersResponseObject, error) {
+	patch, err := json.Marshal(request.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the template parameters patch: %w", err)
+	}
+
+	// The patch is merged again into the latest template parameters whenever the ProvisioningRequest is modified
+	// between the read and the update, so that the concurrent changes of the other keys are never overwritten.
+	var provisioningRequest *provisioningv1alpha1.ProvisioningRequest
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		provisioningRequest = &provisioningv1alpha1.ProvisioningRequest{}
+		if err := r.HubClient.Get(ctx, types.NamespacedName{Name: request.ProvisioningRequestId.String()}, provisioningRequest); err != nil {
+			return err
+		}
+		original := provisioningRequest.DeepCopy()
+		if err := r.mergeTemplateParameters(ctx, provisioningRequest, patch); err != nil {
+			return err
+		}
+		// The optimistic lock makes the update fail with a conflict if the resourceVersion changed
+		return r.HubClient.Patch(ctx, provisioningRequest,
+			client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{}))
+	})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return api.PatchProvisioningRequestTemplateParameters404ApplicationProblemPlusJSONResponse(common.ProblemDetails{
+				AdditionalAttributes: &map[string]string{
+					"provisioningRequestId": request.ProvisioningRequestId.String(),
+				},
+				Detail: "requested ProvisioningRequest not found",
+				Status: http.StatusNotFound,
+			}), nil
+		}
+		if k8serrors.IsConflict(err) {
+			return api.PatchProvisioningRequestTemplateParameters409ApplicationProblemPlusJSONResponse(common.ProblemDetails{
+				AdditionalAttributes: &map[string]string{
+					"provisioningRequestId": request.ProvisioningRequestId.String(),
+				},
+				Detail: fmt.Sprintf("the ProvisioningRequest kept being modified concurrently: %s", err.Error()),
+				Status: http.StatusConflict,
+			}), nil
+		}
+		// Invalid patches, schema violations, API server and webhook validation errors
+		if k8serrors.IsForbidden(err) || k8serrors.IsBadRequest(err) || k8serrors.IsInvalid(err) {
+			return api.PatchProvisioningRequestTemplateParameters400ApplicationProblemPlusJSONResponse(common.ProblemDetails{
+				AdditionalAttributes: &map[string]string{
+					"provisioningRequestId": request.ProvisioningRequestId.String(),
+				},
+				Detail: err.Error(),
+				Status: http.StatusBadRequest,
+			}), nil
+		}
+		return nil, fmt.Errorf("failed to patch the templateParameters of ProvisioningRequest (%s): %w",
+			request.ProvisioningRequestId.String(), err)
+	}
+
+	slog.Info("Patched the templateParameters of ProvisioningRequest", "provisioningRequestId", request.ProvisioningRequestId.String())
+	object, err := convertProvisioningRequestCRToApi(request.ProvisioningRequestId, *provisioningRequest)
+	if err != nil {
+		return nil, err
+	}
+	return api.PatchProvisioningRequestTemplateParameters200JSONResponse(object), nil
+}
+
 // DeleteProvisioningRequest handles an API request to delete a provisioning request
 func (r *ProvisioningServer) DeleteProvisioningRequest(ctx context.Context, request api.DeleteProvisioningRequestRequestObject) (api.DeleteProvisioningRequestResponseObject, error) {
 	err := r.HubClient.Delete(ctx, &provisioningv1alpha1.ProvisioningRequest{
@@ -223,6 +291,44 @@ func (r *ProvisioningServer) DeleteProvisioningRequest(ctx context.Context, requ
 	return api.DeleteProvisioningRequest200Response{}, nil
 }
 
+// mergeTemplateParameters merges the JSON merge patch into the templateParameters of the ProvisioningRequest and
+// validates the result against the schema of its ClusterTemplate. A BadRequest error is returned when the patched
+// templateParameters are invalid.
+func (r *ProvisioningServer) mergeTemplateParameters(ctx context.Context,
+	provisioningRequest *provisioningv1alpha1.ProvisioningRequest, patch []byte) error {
+	templateParameters := provisioningRequest.Spec.TemplateParameters.Raw
+	if len(templateParameters) == 0 {
+		templateParameters = []byte("{}")
+	}
+	patchedTemplateParameters, err := jsonpatch.MergePatch(templateParameters, patch)
+	if err != nil {
+		return k8serrors.NewBadRequest(fmt.Sprintf("failed to merge the patch into the templateParameters: %s", err.Error()))
+	}
+	provisioningRequest.Spec.TemplateParameters = runtime.RawExtension{Raw: patchedTemplateParameters}
+
+	clusterTemplate, err := provisioningRequest.GetClusterTemplateRef(ctx, r.HubClient)
+	if err != nil {
+		var notFoundErr *provisioningv1alpha1.ClusterTemplateNotFoundError
+		if errors.As(err, &notFoundErr) {
+			// Same as for the full updates, the templateParameters are validated by the controller once the
+			// ClusterTemplate is available
+			return nil
+		}
+		return err
+	}
+	// Removing a required key is reported here, before anything is written. As in the webhook, the
+	// policyTemplateParameters are not validated since their schema is not just for the ProvisioningRequest.
+	if err := provisioningRequest.ValidateTemplateInputMatchesSchema(clusterTemplate); err != nil {
+		return k8serrors.NewBadRequest(fmt.Sprintf(
+			"the patched templateParameters are invalid: %s", err.Error()))
+	}
+	if _, err := provisioningRequest.ValidateClusterInstanceInputMatchesSchema(clusterTemplate); err != nil {
+		return k8serrors.NewBadRequest(fmt.Sprintf(
+			"the patched templateParameters are invalid: %s", err.Error()))
+	}
+	return nil
+}
+
 // convertProvisioningRequestCRToApi converts a ProvisioningRequest CR to an API model
 func convertProvisioningRequestCRToApi(id uuid.UUID, provisioningRequest provisioningv1alpha1.ProvisioningRequest) (api.ProvisioningRequest, error) {
 	var status api.ProvisioningRequestStatus
@@ -0,0 +1,165 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+	api "github.com/openshift-kni/oran-o2ims/internal/service/provisioning/api/generated"
+)
+
+const testTemplateParameterSchema = `{
+  "properties": {
+    "nodeClusterName": {"type": "string"},
+    "oCloudSiteId": {"type": "string"},
+    "clusterInstanceParameters": {
+      "properties": {
+        "clusterName": {"type": "string"},
+        "extraLabels": {"type": "object"}
+      },
+      "required": ["clusterName"],
+      "type": "object"
+    },
+    "policyTemplateParameters": {"type": "object"}
+  },
+  "required": ["nodeClusterName", "oCloudSiteId", "clusterInstanceParameters"],
+  "type": "object"
+}`
+
+var _ = Describe("PatchProvisioningRequestTemplateParameters", func() {
+	var (
+		ctx        context.Context
+		prID       uuid.UUID
+		hubClient  client.Client
+		server     *ProvisioningServer
+		getPatched func() map[string]any
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		prID = uuid.New()
+
+		scheme := runtime.NewScheme()
+		Expect(provisioningv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		ct := &provisioningv1alpha1.ClusterTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "sno.v1", Namespace: "sno-v1"},
+			Spec: provisioningv1alpha1.ClusterTemplateSpec{
+				Name:                    "sno",
+				Version:                 "v1",
+				TemplateParameterSchema: runtime.RawExtension{Raw: []byte(testTemplateParameterSchema)},
+			},
+			Status: provisioningv1alpha1.ClusterTemplateStatus{
+				Conditions: []metav1.Condition{{
+					Type:   string(provisioningv1alpha1.CTconditionTypes.Validated),
+					Reason: string(provisioningv1alpha1.CTconditionReasons.Completed),
+					Status: metav1.ConditionTrue,
+				}},
+			},
+		}
+		pr := &provisioningv1alpha1.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: prID.String()},
+			Spec: provisioningv1alpha1.ProvisioningRequestSpec{
+				Name:            "cluster-1",
+				TemplateName:    "sno",
+				TemplateVersion: "v1",
+				TemplateParameters: runtime.RawExtension{Raw: []byte(`{"nodeClusterName": "cluster-1",
+					"oCloudSiteId": "site-1", "clusterInstanceParameters": {"clusterName": "sno1"}}`)},
+			},
+		}
+		hubClient = fake.NewClientBuilder().WithScheme(scheme).WithObjects(ct, pr).Build()
+		server = &ProvisioningServer{HubClient: hubClient}
+
+		getPatched = func() map[string]any {
+			patched := &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(hubClient.Get(ctx, types.NamespacedName{Name: prID.String()}, patched)).To(Succeed())
+			templateParameters := map[string]any{}
+			Expect(json.Unmarshal(patched.Spec.TemplateParameters.Raw, &templateParameters)).To(Succeed())
+			return templateParameters
+		}
+	})
+
+	patchRequest := func(patch string) api.PatchProvisioningRequestTemplateParametersRequestObject {
+		body := api.PatchProvisioningRequestTemplateParametersApplicationMergePatchPlusJSONRequestBody{}
+		Expect(json.Unmarshal([]byte(patch), &body)).To(Succeed())
+		return api.PatchProvisioningRequestTemplateParametersRequestObject{ProvisioningRequestId: prID, Body: &body}
+	}
+
+	It("merges the patch and preserves the unspecified nested keys", func() {
+		response, err := server.PatchProvisioningRequestTemplateParameters(ctx, patchRequest(
+			`{"oCloudSiteId": "site-2", "clusterInstanceParameters": {"extraLabels": {"env": "prod"}}}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response).To(BeAssignableToTypeOf(api.PatchProvisioningRequestTemplateParameters200JSONResponse{}))
+		Expect(response.(api.PatchProvisioningRequestTemplateParameters200JSONResponse).TemplateParameters).To(
+			HaveKeyWithValue("oCloudSiteId", "site-2"))
+
+		Expect(getPatched()).To(Equal(map[string]any{
+			"nodeClusterName": "cluster-1",
+			"oCloudSiteId":    "site-2",
+			"clusterInstanceParameters": map[string]any{
+				"clusterName": "sno1",
+				"extraLabels": map[string]any{"env": "prod"},
+			},
+		}))
+	})
+
+	It("rejects the patches removing required keys", func() {
+		for _, patch := range []string{`{"oCloudSiteId": null}`, `{"clusterInstanceParameters": {"clusterName": null}}`} {
+			response, err := server.PatchProvisioningRequestTemplateParameters(ctx, patchRequest(patch))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(
+				api.PatchProvisioningRequestTemplateParameters400ApplicationProblemPlusJSONResponse{}))
+			Expect(response.(api.PatchProvisioningRequestTemplateParameters400ApplicationProblemPlusJSONResponse).Status).To(
+				Equal(http.StatusBadRequest))
+		}
+
+		Expect(getPatched()).To(HaveKeyWithValue("oCloudSiteId", "site-1"))
+		Expect(getPatched()).To(HaveKeyWithValue("clusterInstanceParameters", HaveKey("clusterName")))
+	})
+
+	It("merges the patch again when the ProvisioningRequest is modified concurrently", func() {
+		concurrentUpdate := true
+		server.HubClient = interceptor.NewClient(hubClient.(client.WithWatch), interceptor.Funcs{
+			Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+				if concurrentUpdate {
+					// Another operator changes another key between the read and the update
+					concurrentUpdate = false
+					other := &provisioningv1alpha1.ProvisioningRequest{}
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(obj), other)).To(Succeed())
+					other.Spec.TemplateParameters.Raw = []byte(`{"nodeClusterName": "cluster-2",
+						"oCloudSiteId": "site-1", "clusterInstanceParameters": {"clusterName": "sno1"}}`)
+					Expect(c.Update(ctx, other)).To(Succeed())
+				}
+				return c.Patch(ctx, obj, patch, opts...)
+			},
+		})
+
+		response, err := server.PatchProvisioningRequestTemplateParameters(ctx, patchRequest(`{"oCloudSiteId": "site-2"}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response).To(BeAssignableToTypeOf(api.PatchProvisioningRequestTemplateParameters200JSONResponse{}))
+
+		Expect(getPatched()).To(SatisfyAll(
+			HaveKeyWithValue("nodeClusterName", "cluster-2"),
+			HaveKeyWithValue("oCloudSiteId", "site-2")))
+	})
+
+	It("reports the unknown ProvisioningRequests", func() {
+		request := patchRequest(`{"oCloudSiteId": "site-2"}`)
+		request.ProvisioningRequestId = uuid.New()
+		response, err := server.PatchProvisioningRequestTemplateParameters(ctx, request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response).To(BeAssignableToTypeOf(
+			api.PatchProvisioningRequestTemplateParameters404ApplicationProblemPlusJSONResponse{}))
+	})
+})
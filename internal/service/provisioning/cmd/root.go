@@ -6,6 +6,8 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+
+	toolcmd "github.com/openshift-kni/oran-o2ims/internal/cmd"
 )
 
 // provisioningRootCmd represents the root command for working provisioning server
@@ -24,6 +26,11 @@ func GetProvisioningRootCmd() *cobra.Command {
 	return provisioningRootCmd
 }
 
+func init() {
+	// Report the build information of the server
+	provisioningRootCmd.AddCommand(toolcmd.Version())
+}
+
 func configureProvisioningLogger() {
 	l := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
 		Level:     slog.LevelDebug,
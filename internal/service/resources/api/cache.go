@@ -0,0 +1,35 @@
+package api
+
+import (
+	"context"
+	"strings"
+
+	api2 "github.com/openshift-kni/oran-o2ims/internal/service/common/api"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/notifier"
+	api "github.com/openshift-kni/oran-o2ims/internal/service/resources/api/generated"
+	"github.com/openshift-kni/oran-o2ims/internal/service/resources/collector"
+	utils2 "github.com/openshift-kni/oran-o2ims/internal/service/resources/utils"
+)
+
+// CacheGroup maps an API path to the type of resource its response is built from (e.g., resourcePools).  Resources
+// are nested under their resource pool and therefore belong to the same group.
+func CacheGroup(path string) string {
+	path = strings.TrimPrefix(path, utils2.BaseInventoryURL)
+	group, _, _ := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	return group
+}
+
+// CacheInvalidator invalidates the cached responses affected by inventory changes before passing the change on to
+// the next notification handler.
+type CacheInvalidator struct {
+	Next  collector.NotificationHandler
+	Cache *api2.ResponseCache
+}
+
+// Notify invalidates the cached responses of the type of resource referenced by the notification
+func (c *CacheInvalidator) Notify(ctx context.Context, event *notifier.Notification) {
+	if payload, ok := event.Payload.(api.InventoryChangeNotification); ok && payload.ObjectRef != nil {
+		c.Cache.InvalidatePath(*payload.ObjectRef)
+	}
+	c.Next.Notify(ctx, event)
+}
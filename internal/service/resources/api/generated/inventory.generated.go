@@ -12,10 +12,12 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/oapi-codegen/runtime"
@@ -54,6 +56,34 @@ const (
 	N2 InventoryChangeNotificationNotificationEventType = 2
 )
 
+// Defines values for LogLevelLevel.
+const (
+	Debug LogLevelLevel = "debug"
+	Error LogLevelLevel = "error"
+	Info  LogLevelLevel = "info"
+	Warn  LogLevelLevel = "warn"
+)
+
+// Defines values for RefreshJobStatus.
+const (
+	Completed RefreshJobStatus = "completed"
+	Failed    RefreshJobStatus = "failed"
+	Pending   RefreshJobStatus = "pending"
+	Running   RefreshJobStatus = "running"
+)
+
+// Defines values for ResourceReferenceObjectType.
+const (
+	ResourceReferenceObjectTypeDeploymentManager ResourceReferenceObjectType = "DeploymentManager"
+	ResourceReferenceObjectTypeResourcePool      ResourceReferenceObjectType = "ResourcePool"
+)
+
+// Defines values for ResourceReferenceRelationship.
+const (
+	Contains ResourceReferenceRelationship = "contains"
+	Manages  ResourceReferenceRelationship = "manages"
+)
+
 // Defines values for ResourceTypeResourceClass.
 const (
 	ResourceTypeResourceClassCOMPUTE    ResourceTypeResourceClass = "COMPUTE"
@@ -69,6 +99,14 @@ const (
 	ResourceTypeResourceKindUNDEFINED ResourceTypeResourceKind = "UNDEFINED"
 )
 
+// Defines values for SubscriptionBatchOperationOperation.
+const (
+	Create SubscriptionBatchOperationOperation = "create"
+	Delete SubscriptionBatchOperationOperation = "delete"
+	Get    SubscriptionBatchOperationOperation = "get"
+	Update SubscriptionBatchOperationOperation = "update"
+)
+
 // AlarmDefinition Information about an alarm definition.
 type AlarmDefinition struct {
 	// AlarmAdditionalFields List of metadata key-value pairs used to associate meaningful metadata to the related resource type.
@@ -146,6 +184,21 @@ type AlarmDictionary struct {
 // AlarmDictionaryManagementInterfaceId defines model for AlarmDictionary.ManagementInterfaceId.
 type AlarmDictionaryManagementInterfaceId string
 
+// BuildInfo Information about the build of the server.
+type BuildInfo struct {
+	// ApiVersion Version of the inventory API implemented by the server.
+	ApiVersion string `json:"apiVersion"`
+
+	// BuildTime Time of the git commit the server was built from, or "unknown" if it is not embedded in the binary.
+	BuildTime string `json:"buildTime"`
+
+	// Commit Git commit the server was built from, or "unknown" if it is not embedded in the binary.
+	Commit string `json:"commit"`
+
+	// Version Version of the server binary, or "unknown" if it is not embedded in the binary.
+	Version string `json:"version"`
+}
+
 // DeploymentManager Information about a deployment manager.
 type DeploymentManager struct {
 	// Capabilities Information about the capabilities supported by the Deployment Manager and its set of deployment management
@@ -209,6 +262,15 @@ type InventoryChangeNotification struct {
 // InventoryChangeNotificationNotificationEventType One of the following values: 0 - create, 1 - modify, 2 - delete
 type InventoryChangeNotificationNotificationEventType int
 
+// LogLevel The logging level of the server.
+type LogLevel struct {
+	// Level The minimum level of the messages that are logged.
+	Level LogLevelLevel `json:"level"`
+}
+
+// LogLevelLevel The minimum level of the messages that are logged.
+type LogLevelLevel string
+
 // OCloudInfo defines model for OCloudInfo.
 type OCloudInfo struct {
 	// Description Human readable description of the O-Cloud as provided by the SMO at cloud genesis.
@@ -230,6 +292,30 @@ type OCloudInfo struct {
 	ServiceUri string `json:"serviceUri"`
 }
 
+// RefreshJob The status of an inventory refresh job.
+type RefreshJob struct {
+	// CompletedAt The time at which the refresh completed or failed.
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+
+	// CreatedAt The time at which the refresh was requested.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Error The reason for which the refresh failed.
+	Error *string `json:"error,omitempty"`
+
+	// RefreshJobId Identifier of the refresh job.
+	RefreshJobId openapi_types.UUID `json:"refreshJobId"`
+
+	// StartedAt The time at which the refresh started.
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+
+	// Status The state of the refresh job.
+	Status RefreshJobStatus `json:"status"`
+}
+
+// RefreshJobStatus The state of the refresh job.
+type RefreshJobStatus string
+
 // Resource Information about a resource.
 type Resource struct {
 	// Description Human readable description of the resource.
@@ -259,6 +345,63 @@ type Resource struct {
 	Tags []string `json:"tags"`
 }
 
+// ResourceImportItem Information about a resource to be imported.
+type ResourceImportItem struct {
+	// Description Human readable description of the resource.
+	Description string `json:"description"`
+
+	// Extensions List of metadata key-value pairs used to associate meaningful metadata to the related resource.
+	Extensions *map[string]string `json:"extensions,omitempty"`
+
+	// GlobalAssetId Identifier or serial number of the resource, if available.
+	GlobalAssetId *string `json:"globalAssetId,omitempty"`
+
+	// Groups Keywords denoting groups a resource belongs to.
+	Groups *[]string `json:"groups,omitempty"`
+
+	// ResourceId Identifier for the Resource. If not provided, a new identifier is allocated by the O-Cloud.
+	ResourceId *openapi_types.UUID `json:"resourceId,omitempty"`
+
+	// ResourceTypeId Identifier for the Resource Type of this resource.
+	ResourceTypeId openapi_types.UUID `json:"resourceTypeId"`
+
+	// Tags Keywords describing or classifying the resource instance
+	Tags *[]string `json:"tags,omitempty"`
+}
+
+// ResourceImportItemResult The outcome of the import of a single resource.
+type ResourceImportItemResult struct {
+	// Error The reason for which the resource could not be imported.
+	Error *string `json:"error,omitempty"`
+
+	// Index The position of the item in the request.
+	Index int `json:"index"`
+
+	// ResourceId Identifier of the imported resource.
+	ResourceId *openapi_types.UUID `json:"resourceId,omitempty"`
+
+	// Success Whether the resource was successfully imported.
+	Success bool `json:"success"`
+}
+
+// ResourceImportRequest A list of resources to be imported into a resource pool.
+type ResourceImportRequest struct {
+	// Resources The resources to be imported.
+	Resources []ResourceImportItem `json:"resources"`
+}
+
+// ResourceImportResult The outcome of a resource import request.
+type ResourceImportResult struct {
+	// Failed The number of resources that failed to be imported.
+	Failed int `json:"failed"`
+
+	// Results The outcome of each item, in the order of the request.
+	Results []ResourceImportItemResult `json:"results"`
+
+	// Succeeded The number of resources that were successfully imported.
+	Succeeded int `json:"succeeded"`
+}
+
 // ResourcePool Information about a resource pool.
 type ResourcePool struct {
 	// Description Human readable description of the resource pool.
@@ -283,6 +426,40 @@ type ResourcePool struct {
 	ResourcePoolId openapi_types.UUID `json:"resourcePoolId"`
 }
 
+// ResourceReference An inventory object referencing a resource.
+type ResourceReference struct {
+	// Name Human readable name of the referencing object.
+	Name string `json:"name"`
+
+	// ObjectId Identifier of the referencing object.
+	ObjectId openapi_types.UUID `json:"objectId"`
+
+	// ObjectType The type of the referencing object.
+	ObjectType ResourceReferenceObjectType `json:"objectType"`
+
+	// Relationship How the referencing object relates to the resource. A resource pool contains the resource and a
+	// deployment manager manages the cluster built from the resource pool containing the resource, as matched
+	// by name.
+	Relationship ResourceReferenceRelationship `json:"relationship"`
+}
+
+// ResourceReferenceObjectType The type of the referencing object.
+type ResourceReferenceObjectType string
+
+// ResourceReferenceRelationship How the referencing object relates to the resource. A resource pool contains the resource and a
+// deployment manager manages the cluster built from the resource pool containing the resource, as matched
+// by name.
+type ResourceReferenceRelationship string
+
+// ResourceReferences The inventory objects that reference a resource.
+type ResourceReferences struct {
+	// References The objects referencing the resource.
+	References []ResourceReference `json:"references"`
+
+	// ResourceId Identifier of the referenced resource.
+	ResourceId openapi_types.UUID `json:"resourceId"`
+}
+
 // ResourceType Information about a resource type.
 type ResourceType struct {
 	AlarmDictionary map[string]interface{} `json:"alarmDictionary"`
@@ -338,9 +515,67 @@ type Subscription struct {
 	SubscriptionId *openapi_types.UUID `json:"subscriptionId,omitempty"`
 }
 
+// SubscriptionBatchItemResult The outcome of a single subscription operation.
+type SubscriptionBatchItemResult struct {
+	// Error The reason for which the operation could not be applied.
+	Error *string `json:"error,omitempty"`
+
+	// Index The position of the item in the request.
+	Index int `json:"index"`
+
+	// Operation The operation that was requested.
+	Operation string `json:"operation"`
+
+	// Subscription Information about an inventory subscription.
+	Subscription *Subscription `json:"subscription,omitempty"`
+
+	// SubscriptionId Identifier of the subscription targeted by the operation.
+	SubscriptionId *openapi_types.UUID `json:"subscriptionId,omitempty"`
+
+	// Success Whether the operation was successfully applied.
+	Success bool `json:"success"`
+}
+
+// SubscriptionBatchOperation A single operation on an inventory subscription.  The subscriptionId is required by the get, update, and
+// delete operations, and the subscription is required by the create and update operations.
+type SubscriptionBatchOperation struct {
+	// Operation The operation to be applied.
+	Operation SubscriptionBatchOperationOperation `json:"operation"`
+
+	// Subscription Information about an inventory subscription.
+	Subscription *Subscription `json:"subscription,omitempty"`
+
+	// SubscriptionId Identifier of the subscription targeted by the operation.
+	SubscriptionId *openapi_types.UUID `json:"subscriptionId,omitempty"`
+}
+
+// SubscriptionBatchOperationOperation The operation to be applied.
+type SubscriptionBatchOperationOperation string
+
+// SubscriptionBatchRequest A list of operations to be applied to the inventory subscriptions.
+type SubscriptionBatchRequest struct {
+	// Operations The operations to be applied, in order.
+	Operations []SubscriptionBatchOperation `json:"operations"`
+}
+
+// SubscriptionBatchResult The outcome of a subscription batch request.
+type SubscriptionBatchResult struct {
+	// Failed The number of operations that failed to be applied.
+	Failed int `json:"failed"`
+
+	// Results The outcome of each item, in the order of the request.
+	Results []SubscriptionBatchItemResult `json:"results"`
+
+	// Succeeded The number of operations that were successfully applied.
+	Succeeded int `json:"succeeded"`
+}
+
 // DeploymentManagerId defines model for deploymentManagerId.
 type DeploymentManagerId = openapi_types.UUID
 
+// RefreshJobId defines model for refreshJobId.
+type RefreshJobId = openapi_types.UUID
+
 // ResourceId defines model for resourceId.
 type ResourceId = openapi_types.UUID
 
@@ -581,6 +816,24 @@ type GetResourcePoolsParams struct {
 	//
 	// When this parameter isn't used all the results will be returned.
 	Filter *externalRef0.Filter `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// Limit Maximum number of items to return in a page of results.
+	//
+	// When there are more items, the response includes a `Link` header with the `next` relation
+	// referencing the next page:
+	//
+	// ```
+	// Link: </o2ims-infrastructureInventory/v1/resourcePools?limit=100&nextpage_opaque_marker=...>; rel="next"
+	// ```
+	//
+	// When this parameter isn't used all the results will be returned in a single page.
+	Limit *externalRef0.Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// NextpageOpaqueMarker Opaque marker of the page of results to return, as provided in the `Link` header of the
+	// response to the request of the previous page.
+	//
+	// When this parameter isn't used the first page will be returned.
+	NextpageOpaqueMarker *externalRef0.NextpageOpaqueMarker `form:"nextpage_opaque_marker,omitempty" json:"nextpage_opaque_marker,omitempty"`
 }
 
 // GetResourcesParams defines parameters for GetResources.
@@ -666,6 +919,24 @@ type GetResourcesParams struct {
 	//
 	// When this parameter isn't used all the results will be returned.
 	Filter *externalRef0.Filter `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// Limit Maximum number of items to return in a page of results.
+	//
+	// When there are more items, the response includes a `Link` header with the `next` relation
+	// referencing the next page:
+	//
+	// ```
+	// Link: </o2ims-infrastructureInventory/v1/resourcePools?limit=100&nextpage_opaque_marker=...>; rel="next"
+	// ```
+	//
+	// When this parameter isn't used all the results will be returned in a single page.
+	Limit *externalRef0.Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// NextpageOpaqueMarker Opaque marker of the page of results to return, as provided in the `Link` header of the
+	// response to the request of the previous page.
+	//
+	// When this parameter isn't used the first page will be returned.
+	NextpageOpaqueMarker *externalRef0.NextpageOpaqueMarker `form:"nextpage_opaque_marker,omitempty" json:"nextpage_opaque_marker,omitempty"`
 }
 
 // GetResourceTypesParams defines parameters for GetResourceTypes.
@@ -868,180 +1139,3850 @@ type GetSubscriptionParams struct {
 	Fields *externalRef0.Fields `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
+// ImportResourcesJSONRequestBody defines body for ImportResources for application/json ContentType.
+type ImportResourcesJSONRequestBody = ResourceImportRequest
+
+// UpdateLogLevelJSONRequestBody defines body for UpdateLogLevel for application/json ContentType.
+type UpdateLogLevelJSONRequestBody = LogLevel
+
 // CreateSubscriptionJSONRequestBody defines body for CreateSubscription for application/json ContentType.
 type CreateSubscriptionJSONRequestBody = Subscription
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Get API versions
-	// (GET /o2ims-infrastructureInventory/api_versions)
-	GetAllVersions(w http.ResponseWriter, r *http.Request)
-	// Get O-Cloud info
-	// (GET /o2ims-infrastructureInventory/v1)
-	GetCloudInfo(w http.ResponseWriter, r *http.Request, params GetCloudInfoParams)
-	// Get minor API versions
-	// (GET /o2ims-infrastructureInventory/v1/api_versions)
-	GetMinorVersions(w http.ResponseWriter, r *http.Request)
-	// Get deployment managers
-	// (GET /o2ims-infrastructureInventory/v1/deploymentManagers)
-	GetDeploymentManagers(w http.ResponseWriter, r *http.Request, params GetDeploymentManagersParams)
-	// Get deployment manager
-	// (GET /o2ims-infrastructureInventory/v1/deploymentManagers/{deploymentManagerId})
-	GetDeploymentManager(w http.ResponseWriter, r *http.Request, deploymentManagerId DeploymentManagerId, params GetDeploymentManagerParams)
-	// Get resource pools
-	// (GET /o2ims-infrastructureInventory/v1/resourcePools)
-	GetResourcePools(w http.ResponseWriter, r *http.Request, params GetResourcePoolsParams)
-	// Get a resource pool
-	// (GET /o2ims-infrastructureInventory/v1/resourcePools/{resourcePoolId})
-	GetResourcePool(w http.ResponseWriter, r *http.Request, resourcePoolId ResourcePoolId)
-	// Get resources in a resource pool
-	// (GET /o2ims-infrastructureInventory/v1/resourcePools/{resourcePoolId}/resources)
-	GetResources(w http.ResponseWriter, r *http.Request, resourcePoolId ResourcePoolId, params GetResourcesParams)
-	// Get a resource in a resource pool
-	// (GET /o2ims-infrastructureInventory/v1/resourcePools/{resourcePoolId}/resources/{resourceId})
-	GetResource(w http.ResponseWriter, r *http.Request, resourcePoolId ResourcePoolId, resourceId ResourceId)
-	// Get resource types
-	// (GET /o2ims-infrastructureInventory/v1/resourceTypes)
-	GetResourceTypes(w http.ResponseWriter, r *http.Request, params GetResourceTypesParams)
-	// Get a resource type
-	// (GET /o2ims-infrastructureInventory/v1/resourceTypes/{resourceTypeId})
-	GetResourceType(w http.ResponseWriter, r *http.Request, resourceTypeId ResourceTypeId)
-	// Get subscriptions
-	// (GET /o2ims-infrastructureInventory/v1/subscriptions)
-	GetSubscriptions(w http.ResponseWriter, r *http.Request, params GetSubscriptionsParams)
-	// Create subscriptions
-	// (POST /o2ims-infrastructureInventory/v1/subscriptions)
-	CreateSubscription(w http.ResponseWriter, r *http.Request)
-	// Delete subscription
-	// (DELETE /o2ims-infrastructureInventory/v1/subscriptions/{subscriptionId})
-	DeleteSubscription(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId)
-	// Get subscription
-	// (GET /o2ims-infrastructureInventory/v1/subscriptions/{subscriptionId})
-	GetSubscription(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId, params GetSubscriptionParams)
-}
+// BatchSubscriptionsJSONRequestBody defines body for BatchSubscriptions for application/json ContentType.
+type BatchSubscriptionsJSONRequestBody = SubscriptionBatchRequest
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
 
-// GetAllVersions operation middleware
-func (siw *ServerInterfaceWrapper) GetAllVersions(w http.ResponseWriter, r *http.Request) {
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAllVersions(w, r)
-	}))
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
 
-	handler.ServeHTTP(w, r)
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
 }
 
-// GetCloudInfo operation middleware
-func (siw *ServerInterfaceWrapper) GetCloudInfo(w http.ResponseWriter, r *http.Request) {
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
 
-	var err error
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetCloudInfoParams
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetAllVersions request
+	GetAllVersions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// ------------- Optional query parameter "exclude_fields" -------------
+	// GetCloudInfo request
+	GetCloudInfo(ctx context.Context, params *GetCloudInfoParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	err = runtime.BindQueryParameter("form", true, false, "exclude_fields", r.URL.Query(), &params.ExcludeFields)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclude_fields", Err: err})
-		return
-	}
+	// ImportResourcesWithBody request with any body
+	ImportResourcesWithBody(ctx context.Context, resourcePoolId ResourcePoolId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// ------------- Optional query parameter "fields" -------------
+	ImportResources(ctx context.Context, resourcePoolId ResourcePoolId, body ImportResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	err = runtime.BindQueryParameter("form", true, false, "fields", r.URL.Query(), &params.Fields)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
-		return
-	}
+	// GetLogLevel request
+	GetLogLevel(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCloudInfo(w, r, params)
-	}))
+	// UpdateLogLevelWithBody request with any body
+	UpdateLogLevelWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	UpdateLogLevel(ctx context.Context, body UpdateLogLevelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	handler.ServeHTTP(w, r)
-}
+	// TriggerRefresh request
+	TriggerRefresh(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-// GetMinorVersions operation middleware
-func (siw *ServerInterfaceWrapper) GetMinorVersions(w http.ResponseWriter, r *http.Request) {
+	// GetRefreshJob request
+	GetRefreshJob(ctx context.Context, refreshJobId RefreshJobId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetMinorVersions(w, r)
-	}))
+	// GetMinorVersions request
+	GetMinorVersions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// GetDeploymentManagers request
+	GetDeploymentManagers(ctx context.Context, params *GetDeploymentManagersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	handler.ServeHTTP(w, r)
-}
+	// GetDeploymentManager request
+	GetDeploymentManager(ctx context.Context, deploymentManagerId DeploymentManagerId, params *GetDeploymentManagerParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-// GetDeploymentManagers operation middleware
-func (siw *ServerInterfaceWrapper) GetDeploymentManagers(w http.ResponseWriter, r *http.Request) {
+	// GetResourcePools request
+	GetResourcePools(ctx context.Context, params *GetResourcePoolsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	var err error
+	// GetResourcePool request
+	GetResourcePool(ctx context.Context, resourcePoolId ResourcePoolId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetDeploymentManagersParams
+	// GetResources request
+	GetResources(ctx context.Context, resourcePoolId ResourcePoolId, params *GetResourcesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// ------------- Optional query parameter "exclude_fields" -------------
+	// GetResource request
+	GetResource(ctx context.Context, resourcePoolId ResourcePoolId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	err = runtime.BindQueryParameter("form", true, false, "exclude_fields", r.URL.Query(), &params.ExcludeFields)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclude_fields", Err: err})
-		return
-	}
+	// GetResourceTypes request
+	GetResourceTypes(ctx context.Context, params *GetResourceTypesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// ------------- Optional query parameter "fields" -------------
+	// GetResourceType request
+	GetResourceType(ctx context.Context, resourceTypeId ResourceTypeId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	err = runtime.BindQueryParameter("form", true, false, "fields", r.URL.Query(), &params.Fields)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
-		return
-	}
+	// GetResourceReferences request
+	GetResourceReferences(ctx context.Context, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// ------------- Optional query parameter "filter" -------------
+	// GetSubscriptions request
+	GetSubscriptions(ctx context.Context, params *GetSubscriptionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
-		return
-	}
+	// CreateSubscriptionWithBody request with any body
+	CreateSubscriptionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetDeploymentManagers(w, r, params)
-	}))
+	CreateSubscription(ctx context.Context, body CreateSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// BatchSubscriptionsWithBody request with any body
+	BatchSubscriptionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	handler.ServeHTTP(w, r)
-}
+	BatchSubscriptions(ctx context.Context, body BatchSubscriptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-// GetDeploymentManager operation middleware
-func (siw *ServerInterfaceWrapper) GetDeploymentManager(w http.ResponseWriter, r *http.Request) {
+	// DeleteSubscription request
+	DeleteSubscription(ctx context.Context, subscriptionId SubscriptionId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	var err error
+	// GetSubscription request
+	GetSubscription(ctx context.Context, subscriptionId SubscriptionId, params *GetSubscriptionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// ------------- Path parameter "deploymentManagerId" -------------
-	var deploymentManagerId DeploymentManagerId
+	// GetBuildInfo request
+	GetBuildInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetAllVersions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAllVersionsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCloudInfo(ctx context.Context, params *GetCloudInfoParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCloudInfoRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportResourcesWithBody(ctx context.Context, resourcePoolId ResourcePoolId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportResourcesRequestWithBody(c.Server, resourcePoolId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportResources(ctx context.Context, resourcePoolId ResourcePoolId, body ImportResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportResourcesRequest(c.Server, resourcePoolId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetLogLevel(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLogLevelRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateLogLevelWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateLogLevelRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateLogLevel(ctx context.Context, body UpdateLogLevelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateLogLevelRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TriggerRefresh(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTriggerRefreshRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRefreshJob(ctx context.Context, refreshJobId RefreshJobId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRefreshJobRequest(c.Server, refreshJobId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetMinorVersions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMinorVersionsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDeploymentManagers(ctx context.Context, params *GetDeploymentManagersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDeploymentManagersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDeploymentManager(ctx context.Context, deploymentManagerId DeploymentManagerId, params *GetDeploymentManagerParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDeploymentManagerRequest(c.Server, deploymentManagerId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetResourcePools(ctx context.Context, params *GetResourcePoolsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetResourcePoolsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetResourcePool(ctx context.Context, resourcePoolId ResourcePoolId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetResourcePoolRequest(c.Server, resourcePoolId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetResources(ctx context.Context, resourcePoolId ResourcePoolId, params *GetResourcesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetResourcesRequest(c.Server, resourcePoolId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetResource(ctx context.Context, resourcePoolId ResourcePoolId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetResourceRequest(c.Server, resourcePoolId, resourceId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetResourceTypes(ctx context.Context, params *GetResourceTypesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetResourceTypesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetResourceType(ctx context.Context, resourceTypeId ResourceTypeId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetResourceTypeRequest(c.Server, resourceTypeId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetResourceReferences(ctx context.Context, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetResourceReferencesRequest(c.Server, resourceId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSubscriptions(ctx context.Context, params *GetSubscriptionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSubscriptionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSubscriptionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSubscriptionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSubscription(ctx context.Context, body CreateSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSubscriptionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BatchSubscriptionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBatchSubscriptionsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BatchSubscriptions(ctx context.Context, body BatchSubscriptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBatchSubscriptionsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteSubscription(ctx context.Context, subscriptionId SubscriptionId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSubscriptionRequest(c.Server, subscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSubscription(ctx context.Context, subscriptionId SubscriptionId, params *GetSubscriptionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSubscriptionRequest(c.Server, subscriptionId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBuildInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBuildInfoRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetAllVersionsRequest generates requests for GetAllVersions
+func NewGetAllVersionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/o2ims-infrastructureInventory/api_versions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCloudInfoRequest generates requests for GetCloudInfo
+func NewGetCloudInfoRequest(server string, params *GetCloudInfoParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/o2ims-infrastructureInventory/v1")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ExcludeFields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "exclude_fields", runtime.ParamLocationQuery, *params.ExcludeFields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewImportResourcesRequest calls the generic ImportResources builder with application/json body
+func NewImportResourcesRequest(server string, resourcePoolId ResourcePoolId, body ImportResourcesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewImportResourcesRequestWithBody(server, resourcePoolId, "application/json", bodyReader)
+}
+
+// NewImportResourcesRequestWithBody generates requests for ImportResources with any type of body
+func NewImportResourcesRequestWithBody(server string, resourcePoolId ResourcePoolId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourcePoolId", runtime.ParamLocationPath, resourcePoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/o2ims-infrastructureInventory/v1/admin/import/resourcePools/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetLogLevelRequest generates requests for GetLogLevel
+func NewGetLogLevelRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/o2ims-infrastructureInventory/v1/admin/logLevel")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateLogLevelRequest calls the generic UpdateLogLevel builder with application/json body
+func NewUpdateLogLevelRequest(server string, body UpdateLogLevelJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateLogLevelRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateLogLevelRequestWithBody generates requests for UpdateLogLevel with any type of body
+func NewUpdateLogLevelRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/o2ims-infrastructureInventory/v1/admin/logLevel")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTriggerRefreshRequest generates requests for TriggerRefresh
+func NewTriggerRefreshRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/o2ims-infrastructureInventory/v1/admin/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRefreshJobRequest generates requests for GetRefreshJob
+func NewGetRefreshJobRequest(server string, refreshJobId RefreshJobId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "refreshJobId", runtime.ParamLocationPath, refreshJobId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/o2ims-infrastructureInventory/v1/admin/refresh/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetMinorVersionsRequest generates requests for GetMinorVersions
+func NewGetMinorVersionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/o2ims-infrastructureInventory/v1/api_versions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDeploymentManagersRequest generates requests for GetDeploymentManagers
+func NewGetDeploymentManagersRequest(server string, params *GetDeploymentManagersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/o2ims-infrastructureInventory/v1/deploymentManagers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ExcludeFields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "exclude_fields", runtime.ParamLocationQuery, *params.ExcludeFields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDeploymentManagerRequest generates requests for GetDeploymentManager
+func NewGetDeploymentManagerRequest(server string, deploymentManagerId DeploymentManagerId, params *GetDeploymentManagerParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deploymentManagerId", runtime.ParamLocationPath, deploymentManagerId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/o2ims-infrastructureInventory/v1/deploymentManagers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ExcludeFields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "exclude_fields", runtime.ParamLocationQuery, *params.ExcludeFields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetResourcePoolsRequest generates requests for GetResourcePools
+func NewGetResourcePoolsRequest(server string, params *GetResourcePoolsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/o2ims-infrastructureInventory/v1/resourcePools")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ExcludeFields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "exclude_fields", runtime.ParamLocationQuery, *params.ExcludeFields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.NextpageOpaqueMarker != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nextpage_opaque_marker", runtime.ParamLocationQuery, *params.NextpageOpaqueMarker); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetResourcePoolRequest generates requests for GetResourcePool
+func NewGetResourcePoolRequest(server string, resourcePoolId ResourcePoolId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourcePoolId", runtime.ParamLocationPath, resourcePoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/o2ims-infrastructureInventory/v1/resourcePools/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetResourcesRequest generates requests for GetResources
+func NewGetResourcesRequest(server string, resourcePoolId ResourcePoolId, params *GetResourcesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourcePoolId", runtime.ParamLocationPath, resourcePoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/o2ims-infrastructureInventory/v1/resourcePools/%s/resources", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ExcludeFields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "exclude_fields", runtime.ParamLocationQuery, *params.ExcludeFields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.NextpageOpaqueMarker != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nextpage_opaque_marker", runtime.ParamLocationQuery, *params.NextpageOpaqueMarker); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetResourceRequest generates requests for GetResource
+func NewGetResourceRequest(server string, resourcePoolId ResourcePoolId, resourceId ResourceId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourcePoolId", runtime.ParamLocationPath, resourcePoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/o2ims-infrastructureInventory/v1/resourcePools/%s/resources/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetResourceTypesRequest generates requests for GetResourceTypes
+func NewGetResourceTypesRequest(server string, params *GetResourceTypesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/o2ims-infrastructureInventory/v1/resourceTypes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ExcludeFields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "exclude_fields", runtime.ParamLocationQuery, *params.ExcludeFields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetResourceTypeRequest generates requests for GetResourceType
+func NewGetResourceTypeRequest(server string, resourceTypeId ResourceTypeId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceTypeId", runtime.ParamLocationPath, resourceTypeId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/o2ims-infrastructureInventory/v1/resourceTypes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetResourceReferencesRequest generates requests for GetResourceReferences
+func NewGetResourceReferencesRequest(server string, resourceId ResourceId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/o2ims-infrastructureInventory/v1/resources/%s/references", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSubscriptionsRequest generates requests for GetSubscriptions
+func NewGetSubscriptionsRequest(server string, params *GetSubscriptionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/o2ims-infrastructureInventory/v1/subscriptions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ExcludeFields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "exclude_fields", runtime.ParamLocationQuery, *params.ExcludeFields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateSubscriptionRequest calls the generic CreateSubscription builder with application/json body
+func NewCreateSubscriptionRequest(server string, body CreateSubscriptionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSubscriptionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateSubscriptionRequestWithBody generates requests for CreateSubscription with any type of body
+func NewCreateSubscriptionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/o2ims-infrastructureInventory/v1/subscriptions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewBatchSubscriptionsRequest calls the generic BatchSubscriptions builder with application/json body
+func NewBatchSubscriptionsRequest(server string, body BatchSubscriptionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewBatchSubscriptionsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewBatchSubscriptionsRequestWithBody generates requests for BatchSubscriptions with any type of body
+func NewBatchSubscriptionsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/o2ims-infrastructureInventory/v1/subscriptions/batch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteSubscriptionRequest generates requests for DeleteSubscription
+func NewDeleteSubscriptionRequest(server string, subscriptionId SubscriptionId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "subscriptionId", runtime.ParamLocationPath, subscriptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/o2ims-infrastructureInventory/v1/subscriptions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSubscriptionRequest generates requests for GetSubscription
+func NewGetSubscriptionRequest(server string, subscriptionId SubscriptionId, params *GetSubscriptionParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "subscriptionId", runtime.ParamLocationPath, subscriptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/o2ims-infrastructureInventory/v1/subscriptions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ExcludeFields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "exclude_fields", runtime.ParamLocationQuery, *params.ExcludeFields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetBuildInfoRequest generates requests for GetBuildInfo
+func NewGetBuildInfoRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/o2ims-infrastructureInventory/version")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAllVersionsWithResponse request
+	GetAllVersionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllVersionsResponse, error)
+
+	// GetCloudInfoWithResponse request
+	GetCloudInfoWithResponse(ctx context.Context, params *GetCloudInfoParams, reqEditors ...RequestEditorFn) (*GetCloudInfoResponse, error)
+
+	// ImportResourcesWithBodyWithResponse request with any body
+	ImportResourcesWithBodyWithResponse(ctx context.Context, resourcePoolId ResourcePoolId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportResourcesResponse, error)
+
+	ImportResourcesWithResponse(ctx context.Context, resourcePoolId ResourcePoolId, body ImportResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportResourcesResponse, error)
+
+	// GetLogLevelWithResponse request
+	GetLogLevelWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLogLevelResponse, error)
+
+	// UpdateLogLevelWithBodyWithResponse request with any body
+	UpdateLogLevelWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateLogLevelResponse, error)
+
+	UpdateLogLevelWithResponse(ctx context.Context, body UpdateLogLevelJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateLogLevelResponse, error)
+
+	// TriggerRefreshWithResponse request
+	TriggerRefreshWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TriggerRefreshResponse, error)
+
+	// GetRefreshJobWithResponse request
+	GetRefreshJobWithResponse(ctx context.Context, refreshJobId RefreshJobId, reqEditors ...RequestEditorFn) (*GetRefreshJobResponse, error)
+
+	// GetMinorVersionsWithResponse request
+	GetMinorVersionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMinorVersionsResponse, error)
+
+	// GetDeploymentManagersWithResponse request
+	GetDeploymentManagersWithResponse(ctx context.Context, params *GetDeploymentManagersParams, reqEditors ...RequestEditorFn) (*GetDeploymentManagersResponse, error)
+
+	// GetDeploymentManagerWithResponse request
+	GetDeploymentManagerWithResponse(ctx context.Context, deploymentManagerId DeploymentManagerId, params *GetDeploymentManagerParams, reqEditors ...RequestEditorFn) (*GetDeploymentManagerResponse, error)
+
+	// GetResourcePoolsWithResponse request
+	GetResourcePoolsWithResponse(ctx context.Context, params *GetResourcePoolsParams, reqEditors ...RequestEditorFn) (*GetResourcePoolsResponse, error)
+
+	// GetResourcePoolWithResponse request
+	GetResourcePoolWithResponse(ctx context.Context, resourcePoolId ResourcePoolId, reqEditors ...RequestEditorFn) (*GetResourcePoolResponse, error)
+
+	// GetResourcesWithResponse request
+	GetResourcesWithResponse(ctx context.Context, resourcePoolId ResourcePoolId, params *GetResourcesParams, reqEditors ...RequestEditorFn) (*GetResourcesResponse, error)
+
+	// GetResourceWithResponse request
+	GetResourceWithResponse(ctx context.Context, resourcePoolId ResourcePoolId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetResourceResponse, error)
+
+	// GetResourceTypesWithResponse request
+	GetResourceTypesWithResponse(ctx context.Context, params *GetResourceTypesParams, reqEditors ...RequestEditorFn) (*GetResourceTypesResponse, error)
+
+	// GetResourceTypeWithResponse request
+	GetResourceTypeWithResponse(ctx context.Context, resourceTypeId ResourceTypeId, reqEditors ...RequestEditorFn) (*GetResourceTypeResponse, error)
+
+	// GetResourceReferencesWithResponse request
+	GetResourceReferencesWithResponse(ctx context.Context, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetResourceReferencesResponse, error)
+
+	// GetSubscriptionsWithResponse request
+	GetSubscriptionsWithResponse(ctx context.Context, params *GetSubscriptionsParams, reqEditors ...RequestEditorFn) (*GetSubscriptionsResponse, error)
+
+	// CreateSubscriptionWithBodyWithResponse request with any body
+	CreateSubscriptionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSubscriptionResponse, error)
+
+	CreateSubscriptionWithResponse(ctx context.Context, body CreateSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSubscriptionResponse, error)
+
+	// BatchSubscriptionsWithBodyWithResponse request with any body
+	BatchSubscriptionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BatchSubscriptionsResponse, error)
+
+	BatchSubscriptionsWithResponse(ctx context.Context, body BatchSubscriptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*BatchSubscriptionsResponse, error)
+
+	// DeleteSubscriptionWithResponse request
+	DeleteSubscriptionWithResponse(ctx context.Context, subscriptionId SubscriptionId, reqEditors ...RequestEditorFn) (*DeleteSubscriptionResponse, error)
+
+	// GetSubscriptionWithResponse request
+	GetSubscriptionWithResponse(ctx context.Context, subscriptionId SubscriptionId, params *GetSubscriptionParams, reqEditors ...RequestEditorFn) (*GetSubscriptionResponse, error)
+
+	// GetBuildInfoWithResponse request
+	GetBuildInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBuildInfoResponse, error)
+}
+
+type GetAllVersionsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *externalRef0.APIVersions
+	ApplicationProblemJSON400 *externalRef0.ProblemDetails
+	ApplicationProblemJSON500 *externalRef0.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAllVersionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAllVersionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCloudInfoResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *OCloudInfo
+	ApplicationProblemJSON400 *externalRef0.ProblemDetails
+	ApplicationProblemJSON500 *externalRef0.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCloudInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCloudInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportResourcesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ResourceImportResult
+	ApplicationProblemJSON400 *externalRef0.ProblemDetails
+	ApplicationProblemJSON404 *externalRef0.ProblemDetails
+	ApplicationProblemJSON500 *externalRef0.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportResourcesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportResourcesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLogLevelResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *LogLevel
+	ApplicationProblemJSON500 *externalRef0.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLogLevelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLogLevelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateLogLevelResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *LogLevel
+	ApplicationProblemJSON400 *externalRef0.ProblemDetails
+	ApplicationProblemJSON500 *externalRef0.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateLogLevelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateLogLevelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TriggerRefreshResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON202                   *RefreshJob
+	ApplicationProblemJSON500 *externalRef0.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r TriggerRefreshResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TriggerRefreshResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRefreshJobResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *RefreshJob
+	ApplicationProblemJSON400 *externalRef0.ProblemDetails
+	ApplicationProblemJSON404 *externalRef0.ProblemDetails
+	ApplicationProblemJSON500 *externalRef0.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRefreshJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRefreshJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMinorVersionsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *externalRef0.APIVersions
+	ApplicationProblemJSON400 *externalRef0.ProblemDetails
+	ApplicationProblemJSON500 *externalRef0.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMinorVersionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMinorVersionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDeploymentManagersResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]DeploymentManager
+	ApplicationProblemJSON400 *externalRef0.ProblemDetails
+	ApplicationProblemJSON500 *externalRef0.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDeploymentManagersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDeploymentManagersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDeploymentManagerResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *DeploymentManager
+	ApplicationProblemJSON400 *externalRef0.ProblemDetails
+	ApplicationProblemJSON404 *externalRef0.ProblemDetails
+	ApplicationProblemJSON500 *externalRef0.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDeploymentManagerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDeploymentManagerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetResourcePoolsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]ResourcePool
+	ApplicationProblemJSON400 *externalRef0.ProblemDetails
+	ApplicationProblemJSON500 *externalRef0.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetResourcePoolsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetResourcePoolsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetResourcePoolResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ResourcePool
+	ApplicationProblemJSON400 *externalRef0.ProblemDetails
+	ApplicationProblemJSON404 *externalRef0.ProblemDetails
+	ApplicationProblemJSON500 *externalRef0.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetResourcePoolResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetResourcePoolResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetResourcesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]Resource
+	ApplicationProblemJSON400 *externalRef0.ProblemDetails
+	ApplicationProblemJSON404 *externalRef0.ProblemDetails
+	ApplicationProblemJSON500 *externalRef0.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetResourcesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetResourcesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetResourceResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Resource
+	ApplicationProblemJSON400 *externalRef0.ProblemDetails
+	ApplicationProblemJSON404 *externalRef0.ProblemDetails
+	ApplicationProblemJSON500 *externalRef0.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetResourceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetResourceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetResourceTypesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]ResourceType
+	ApplicationProblemJSON400 *externalRef0.ProblemDetails
+	ApplicationProblemJSON500 *externalRef0.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetResourceTypesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetResourceTypesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetResourceTypeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ResourceType
+	ApplicationProblemJSON400 *externalRef0.ProblemDetails
+	ApplicationProblemJSON404 *externalRef0.ProblemDetails
+	ApplicationProblemJSON500 *externalRef0.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetResourceTypeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetResourceTypeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetResourceReferencesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ResourceReferences
+	ApplicationProblemJSON400 *externalRef0.ProblemDetails
+	ApplicationProblemJSON404 *externalRef0.ProblemDetails
+	ApplicationProblemJSON500 *externalRef0.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetResourceReferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetResourceReferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSubscriptionsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]Subscription
+	ApplicationProblemJSON400 *externalRef0.ProblemDetails
+	ApplicationProblemJSON500 *externalRef0.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSubscriptionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSubscriptionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSubscriptionResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *Subscription
+	ApplicationProblemJSON400 *externalRef0.ProblemDetails
+	ApplicationProblemJSON500 *externalRef0.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BatchSubscriptionsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SubscriptionBatchResult
+	ApplicationProblemJSON400 *externalRef0.ProblemDetails
+	ApplicationProblemJSON500 *externalRef0.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r BatchSubscriptionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BatchSubscriptionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSubscriptionResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationProblemJSON404 *externalRef0.ProblemDetails
+	ApplicationProblemJSON500 *externalRef0.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSubscriptionResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Subscription
+	ApplicationProblemJSON400 *externalRef0.ProblemDetails
+	ApplicationProblemJSON404 *externalRef0.ProblemDetails
+	ApplicationProblemJSON500 *externalRef0.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBuildInfoResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *BuildInfo
+	ApplicationProblemJSON500 *externalRef0.ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBuildInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBuildInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetAllVersionsWithResponse request returning *GetAllVersionsResponse
+func (c *ClientWithResponses) GetAllVersionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllVersionsResponse, error) {
+	rsp, err := c.GetAllVersions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAllVersionsResponse(rsp)
+}
+
+// GetCloudInfoWithResponse request returning *GetCloudInfoResponse
+func (c *ClientWithResponses) GetCloudInfoWithResponse(ctx context.Context, params *GetCloudInfoParams, reqEditors ...RequestEditorFn) (*GetCloudInfoResponse, error) {
+	rsp, err := c.GetCloudInfo(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCloudInfoResponse(rsp)
+}
+
+// ImportResourcesWithBodyWithResponse request with arbitrary body returning *ImportResourcesResponse
+func (c *ClientWithResponses) ImportResourcesWithBodyWithResponse(ctx context.Context, resourcePoolId ResourcePoolId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportResourcesResponse, error) {
+	rsp, err := c.ImportResourcesWithBody(ctx, resourcePoolId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportResourcesResponse(rsp)
+}
+
+func (c *ClientWithResponses) ImportResourcesWithResponse(ctx context.Context, resourcePoolId ResourcePoolId, body ImportResourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportResourcesResponse, error) {
+	rsp, err := c.ImportResources(ctx, resourcePoolId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportResourcesResponse(rsp)
+}
+
+// GetLogLevelWithResponse request returning *GetLogLevelResponse
+func (c *ClientWithResponses) GetLogLevelWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLogLevelResponse, error) {
+	rsp, err := c.GetLogLevel(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLogLevelResponse(rsp)
+}
+
+// UpdateLogLevelWithBodyWithResponse request with arbitrary body returning *UpdateLogLevelResponse
+func (c *ClientWithResponses) UpdateLogLevelWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateLogLevelResponse, error) {
+	rsp, err := c.UpdateLogLevelWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateLogLevelResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateLogLevelWithResponse(ctx context.Context, body UpdateLogLevelJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateLogLevelResponse, error) {
+	rsp, err := c.UpdateLogLevel(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateLogLevelResponse(rsp)
+}
+
+// TriggerRefreshWithResponse request returning *TriggerRefreshResponse
+func (c *ClientWithResponses) TriggerRefreshWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TriggerRefreshResponse, error) {
+	rsp, err := c.TriggerRefresh(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTriggerRefreshResponse(rsp)
+}
+
+// GetRefreshJobWithResponse request returning *GetRefreshJobResponse
+func (c *ClientWithResponses) GetRefreshJobWithResponse(ctx context.Context, refreshJobId RefreshJobId, reqEditors ...RequestEditorFn) (*GetRefreshJobResponse, error) {
+	rsp, err := c.GetRefreshJob(ctx, refreshJobId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRefreshJobResponse(rsp)
+}
+
+// GetMinorVersionsWithResponse request returning *GetMinorVersionsResponse
+func (c *ClientWithResponses) GetMinorVersionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMinorVersionsResponse, error) {
+	rsp, err := c.GetMinorVersions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMinorVersionsResponse(rsp)
+}
+
+// GetDeploymentManagersWithResponse request returning *GetDeploymentManagersResponse
+func (c *ClientWithResponses) GetDeploymentManagersWithResponse(ctx context.Context, params *GetDeploymentManagersParams, reqEditors ...RequestEditorFn) (*GetDeploymentManagersResponse, error) {
+	rsp, err := c.GetDeploymentManagers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDeploymentManagersResponse(rsp)
+}
+
+// GetDeploymentManagerWithResponse request returning *GetDeploymentManagerResponse
+func (c *ClientWithResponses) GetDeploymentManagerWithResponse(ctx context.Context, deploymentManagerId DeploymentManagerId, params *GetDeploymentManagerParams, reqEditors ...RequestEditorFn) (*GetDeploymentManagerResponse, error) {
+	rsp, err := c.GetDeploymentManager(ctx, deploymentManagerId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDeploymentManagerResponse(rsp)
+}
+
+// GetResourcePoolsWithResponse request returning *GetResourcePoolsResponse
+func (c *ClientWithResponses) GetResourcePoolsWithResponse(ctx context.Context, params *GetResourcePoolsParams, reqEditors ...RequestEditorFn) (*GetResourcePoolsResponse, error) {
+	rsp, err := c.GetResourcePools(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetResourcePoolsResponse(rsp)
+}
+
+// GetResourcePoolWithResponse request returning *GetResourcePoolResponse
+func (c *ClientWithResponses) GetResourcePoolWithResponse(ctx context.Context, resourcePoolId ResourcePoolId, reqEditors ...RequestEditorFn) (*GetResourcePoolResponse, error) {
+	rsp, err := c.GetResourcePool(ctx, resourcePoolId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetResourcePoolResponse(rsp)
+}
+
+// GetResourcesWithResponse request returning *GetResourcesResponse
+func (c *ClientWithResponses) GetResourcesWithResponse(ctx context.Context, resourcePoolId ResourcePoolId, params *GetResourcesParams, reqEditors ...RequestEditorFn) (*GetResourcesResponse, error) {
+	rsp, err := c.GetResources(ctx, resourcePoolId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetResourcesResponse(rsp)
+}
+
+// GetResourceWithResponse request returning *GetResourceResponse
+func (c *ClientWithResponses) GetResourceWithResponse(ctx context.Context, resourcePoolId ResourcePoolId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetResourceResponse, error) {
+	rsp, err := c.GetResource(ctx, resourcePoolId, resourceId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetResourceResponse(rsp)
+}
+
+// GetResourceTypesWithResponse request returning *GetResourceTypesResponse
+func (c *ClientWithResponses) GetResourceTypesWithResponse(ctx context.Context, params *GetResourceTypesParams, reqEditors ...RequestEditorFn) (*GetResourceTypesResponse, error) {
+	rsp, err := c.GetResourceTypes(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetResourceTypesResponse(rsp)
+}
+
+// GetResourceTypeWithResponse request returning *GetResourceTypeResponse
+func (c *ClientWithResponses) GetResourceTypeWithResponse(ctx context.Context, resourceTypeId ResourceTypeId, reqEditors ...RequestEditorFn) (*GetResourceTypeResponse, error) {
+	rsp, err := c.GetResourceType(ctx, resourceTypeId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetResourceTypeResponse(rsp)
+}
+
+// GetResourceReferencesWithResponse request returning *GetResourceReferencesResponse
+func (c *ClientWithResponses) GetResourceReferencesWithResponse(ctx context.Context, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetResourceReferencesResponse, error) {
+	rsp, err := c.GetResourceReferences(ctx, resourceId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetResourceReferencesResponse(rsp)
+}
+
+// GetSubscriptionsWithResponse request returning *GetSubscriptionsResponse
+func (c *ClientWithResponses) GetSubscriptionsWithResponse(ctx context.Context, params *GetSubscriptionsParams, reqEditors ...RequestEditorFn) (*GetSubscriptionsResponse, error) {
+	rsp, err := c.GetSubscriptions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSubscriptionsResponse(rsp)
+}
+
+// CreateSubscriptionWithBodyWithResponse request with arbitrary body returning *CreateSubscriptionResponse
+func (c *ClientWithResponses) CreateSubscriptionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSubscriptionResponse, error) {
+	rsp, err := c.CreateSubscriptionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSubscriptionResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSubscriptionWithResponse(ctx context.Context, body CreateSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSubscriptionResponse, error) {
+	rsp, err := c.CreateSubscription(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSubscriptionResponse(rsp)
+}
+
+// BatchSubscriptionsWithBodyWithResponse request with arbitrary body returning *BatchSubscriptionsResponse
+func (c *ClientWithResponses) BatchSubscriptionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BatchSubscriptionsResponse, error) {
+	rsp, err := c.BatchSubscriptionsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBatchSubscriptionsResponse(rsp)
+}
+
+func (c *ClientWithResponses) BatchSubscriptionsWithResponse(ctx context.Context, body BatchSubscriptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*BatchSubscriptionsResponse, error) {
+	rsp, err := c.BatchSubscriptions(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBatchSubscriptionsResponse(rsp)
+}
+
+// DeleteSubscriptionWithResponse request returning *DeleteSubscriptionResponse
+func (c *ClientWithResponses) DeleteSubscriptionWithResponse(ctx context.Context, subscriptionId SubscriptionId, reqEditors ...RequestEditorFn) (*DeleteSubscriptionResponse, error) {
+	rsp, err := c.DeleteSubscription(ctx, subscriptionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSubscriptionResponse(rsp)
+}
+
+// GetSubscriptionWithResponse request returning *GetSubscriptionResponse
+func (c *ClientWithResponses) GetSubscriptionWithResponse(ctx context.Context, subscriptionId SubscriptionId, params *GetSubscriptionParams, reqEditors ...RequestEditorFn) (*GetSubscriptionResponse, error) {
+	rsp, err := c.GetSubscription(ctx, subscriptionId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSubscriptionResponse(rsp)
+}
+
+// GetBuildInfoWithResponse request returning *GetBuildInfoResponse
+func (c *ClientWithResponses) GetBuildInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBuildInfoResponse, error) {
+	rsp, err := c.GetBuildInfo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBuildInfoResponse(rsp)
+}
+
+// ParseGetAllVersionsResponse parses an HTTP response from a GetAllVersionsWithResponse call
+func ParseGetAllVersionsResponse(rsp *http.Response) (*GetAllVersionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAllVersionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest externalRef0.APIVersions
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCloudInfoResponse parses an HTTP response from a GetCloudInfoWithResponse call
+func ParseGetCloudInfoResponse(rsp *http.Response) (*GetCloudInfoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCloudInfoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OCloudInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportResourcesResponse parses an HTTP response from a ImportResourcesWithResponse call
+func ParseImportResourcesResponse(rsp *http.Response) (*ImportResourcesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportResourcesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResourceImportResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLogLevelResponse parses an HTTP response from a GetLogLevelWithResponse call
+func ParseGetLogLevelResponse(rsp *http.Response) (*GetLogLevelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLogLevelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LogLevel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateLogLevelResponse parses an HTTP response from a UpdateLogLevelWithResponse call
+func ParseUpdateLogLevelResponse(rsp *http.Response) (*UpdateLogLevelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateLogLevelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LogLevel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTriggerRefreshResponse parses an HTTP response from a TriggerRefreshWithResponse call
+func ParseTriggerRefreshResponse(rsp *http.Response) (*TriggerRefreshResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TriggerRefreshResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest RefreshJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRefreshJobResponse parses an HTTP response from a GetRefreshJobWithResponse call
+func ParseGetRefreshJobResponse(rsp *http.Response) (*GetRefreshJobResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRefreshJobResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RefreshJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMinorVersionsResponse parses an HTTP response from a GetMinorVersionsWithResponse call
+func ParseGetMinorVersionsResponse(rsp *http.Response) (*GetMinorVersionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMinorVersionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest externalRef0.APIVersions
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDeploymentManagersResponse parses an HTTP response from a GetDeploymentManagersWithResponse call
+func ParseGetDeploymentManagersResponse(rsp *http.Response) (*GetDeploymentManagersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDeploymentManagersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DeploymentManager
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDeploymentManagerResponse parses an HTTP response from a GetDeploymentManagerWithResponse call
+func ParseGetDeploymentManagerResponse(rsp *http.Response) (*GetDeploymentManagerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDeploymentManagerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentManager
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetResourcePoolsResponse parses an HTTP response from a GetResourcePoolsWithResponse call
+func ParseGetResourcePoolsResponse(rsp *http.Response) (*GetResourcePoolsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetResourcePoolsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ResourcePool
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetResourcePoolResponse parses an HTTP response from a GetResourcePoolWithResponse call
+func ParseGetResourcePoolResponse(rsp *http.Response) (*GetResourcePoolResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetResourcePoolResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResourcePool
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetResourcesResponse parses an HTTP response from a GetResourcesWithResponse call
+func ParseGetResourcesResponse(rsp *http.Response) (*GetResourcesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetResourcesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Resource
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetResourceResponse parses an HTTP response from a GetResourceWithResponse call
+func ParseGetResourceResponse(rsp *http.Response) (*GetResourceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetResourceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Resource
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetResourceTypesResponse parses an HTTP response from a GetResourceTypesWithResponse call
+func ParseGetResourceTypesResponse(rsp *http.Response) (*GetResourceTypesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetResourceTypesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ResourceType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetResourceTypeResponse parses an HTTP response from a GetResourceTypeWithResponse call
+func ParseGetResourceTypeResponse(rsp *http.Response) (*GetResourceTypeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetResourceTypeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResourceType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetResourceReferencesResponse parses an HTTP response from a GetResourceReferencesWithResponse call
+func ParseGetResourceReferencesResponse(rsp *http.Response) (*GetResourceReferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetResourceReferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResourceReferences
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSubscriptionsResponse parses an HTTP response from a GetSubscriptionsWithResponse call
+func ParseGetSubscriptionsResponse(rsp *http.Response) (*GetSubscriptionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSubscriptionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Subscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSubscriptionResponse parses an HTTP response from a CreateSubscriptionWithResponse call
+func ParseCreateSubscriptionResponse(rsp *http.Response) (*CreateSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Subscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBatchSubscriptionsResponse parses an HTTP response from a BatchSubscriptionsWithResponse call
+func ParseBatchSubscriptionsResponse(rsp *http.Response) (*BatchSubscriptionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BatchSubscriptionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SubscriptionBatchResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSubscriptionResponse parses an HTTP response from a DeleteSubscriptionWithResponse call
+func ParseDeleteSubscriptionResponse(rsp *http.Response) (*DeleteSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSubscriptionResponse parses an HTTP response from a GetSubscriptionWithResponse call
+func ParseGetSubscriptionResponse(rsp *http.Response) (*GetSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Subscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBuildInfoResponse parses an HTTP response from a GetBuildInfoWithResponse call
+func ParseGetBuildInfoResponse(rsp *http.Response) (*GetBuildInfoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBuildInfoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BuildInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest externalRef0.ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Get API versions
+	// (GET /o2ims-infrastructureInventory/api_versions)
+	GetAllVersions(w http.ResponseWriter, r *http.Request)
+	// Get O-Cloud info
+	// (GET /o2ims-infrastructureInventory/v1)
+	GetCloudInfo(w http.ResponseWriter, r *http.Request, params GetCloudInfoParams)
+	// Import resources into a resource pool
+	// (POST /o2ims-infrastructureInventory/v1/admin/import/resourcePools/{resourcePoolId})
+	ImportResources(w http.ResponseWriter, r *http.Request, resourcePoolId ResourcePoolId)
+	// Get the logging level
+	// (GET /o2ims-infrastructureInventory/v1/admin/logLevel)
+	GetLogLevel(w http.ResponseWriter, r *http.Request)
+	// Set the logging level
+	// (PUT /o2ims-infrastructureInventory/v1/admin/logLevel)
+	UpdateLogLevel(w http.ResponseWriter, r *http.Request)
+	// Trigger a refresh of the inventory
+	// (POST /o2ims-infrastructureInventory/v1/admin/refresh)
+	TriggerRefresh(w http.ResponseWriter, r *http.Request)
+	// Get an inventory refresh job
+	// (GET /o2ims-infrastructureInventory/v1/admin/refresh/{refreshJobId})
+	GetRefreshJob(w http.ResponseWriter, r *http.Request, refreshJobId RefreshJobId)
+	// Get minor API versions
+	// (GET /o2ims-infrastructureInventory/v1/api_versions)
+	GetMinorVersions(w http.ResponseWriter, r *http.Request)
+	// Get deployment managers
+	// (GET /o2ims-infrastructureInventory/v1/deploymentManagers)
+	GetDeploymentManagers(w http.ResponseWriter, r *http.Request, params GetDeploymentManagersParams)
+	// Get deployment manager
+	// (GET /o2ims-infrastructureInventory/v1/deploymentManagers/{deploymentManagerId})
+	GetDeploymentManager(w http.ResponseWriter, r *http.Request, deploymentManagerId DeploymentManagerId, params GetDeploymentManagerParams)
+	// Get resource pools
+	// (GET /o2ims-infrastructureInventory/v1/resourcePools)
+	GetResourcePools(w http.ResponseWriter, r *http.Request, params GetResourcePoolsParams)
+	// Get a resource pool
+	// (GET /o2ims-infrastructureInventory/v1/resourcePools/{resourcePoolId})
+	GetResourcePool(w http.ResponseWriter, r *http.Request, resourcePoolId ResourcePoolId)
+	// Get resources in a resource pool
+	// (GET /o2ims-infrastructureInventory/v1/resourcePools/{resourcePoolId}/resources)
+	GetResources(w http.ResponseWriter, r *http.Request, resourcePoolId ResourcePoolId, params GetResourcesParams)
+	// Get a resource in a resource pool
+	// (GET /o2ims-infrastructureInventory/v1/resourcePools/{resourcePoolId}/resources/{resourceId})
+	GetResource(w http.ResponseWriter, r *http.Request, resourcePoolId ResourcePoolId, resourceId ResourceId)
+	// Get resource types
+	// (GET /o2ims-infrastructureInventory/v1/resourceTypes)
+	GetResourceTypes(w http.ResponseWriter, r *http.Request, params GetResourceTypesParams)
+	// Get a resource type
+	// (GET /o2ims-infrastructureInventory/v1/resourceTypes/{resourceTypeId})
+	GetResourceType(w http.ResponseWriter, r *http.Request, resourceTypeId ResourceTypeId)
+	// Get the references to a resource
+	// (GET /o2ims-infrastructureInventory/v1/resources/{resourceId}/references)
+	GetResourceReferences(w http.ResponseWriter, r *http.Request, resourceId ResourceId)
+	// Get subscriptions
+	// (GET /o2ims-infrastructureInventory/v1/subscriptions)
+	GetSubscriptions(w http.ResponseWriter, r *http.Request, params GetSubscriptionsParams)
+	// Create subscriptions
+	// (POST /o2ims-infrastructureInventory/v1/subscriptions)
+	CreateSubscription(w http.ResponseWriter, r *http.Request)
+	// Manage subscriptions in bulk
+	// (POST /o2ims-infrastructureInventory/v1/subscriptions/batch)
+	BatchSubscriptions(w http.ResponseWriter, r *http.Request)
+	// Delete subscription
+	// (DELETE /o2ims-infrastructureInventory/v1/subscriptions/{subscriptionId})
+	DeleteSubscription(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId)
+	// Get subscription
+	// (GET /o2ims-infrastructureInventory/v1/subscriptions/{subscriptionId})
+	GetSubscription(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId, params GetSubscriptionParams)
+	// Get build information
+	// (GET /o2ims-infrastructureInventory/version)
+	GetBuildInfo(w http.ResponseWriter, r *http.Request)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetAllVersions operation middleware
+func (siw *ServerInterfaceWrapper) GetAllVersions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAllVersions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCloudInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetCloudInfo(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCloudInfoParams
+
+	// ------------- Optional query parameter "exclude_fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "exclude_fields", r.URL.Query(), &params.ExcludeFields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclude_fields", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", r.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCloudInfo(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportResources operation middleware
+func (siw *ServerInterfaceWrapper) ImportResources(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "resourcePoolId" -------------
+	var resourcePoolId ResourcePoolId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourcePoolId", r.PathValue("resourcePoolId"), &resourcePoolId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resourcePoolId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportResources(w, r, resourcePoolId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLogLevel operation middleware
+func (siw *ServerInterfaceWrapper) GetLogLevel(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLogLevel(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateLogLevel operation middleware
+func (siw *ServerInterfaceWrapper) UpdateLogLevel(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateLogLevel(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TriggerRefresh operation middleware
+func (siw *ServerInterfaceWrapper) TriggerRefresh(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TriggerRefresh(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRefreshJob operation middleware
+func (siw *ServerInterfaceWrapper) GetRefreshJob(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "refreshJobId" -------------
+	var refreshJobId RefreshJobId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "refreshJobId", r.PathValue("refreshJobId"), &refreshJobId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refreshJobId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRefreshJob(w, r, refreshJobId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMinorVersions operation middleware
+func (siw *ServerInterfaceWrapper) GetMinorVersions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMinorVersions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDeploymentManagers operation middleware
+func (siw *ServerInterfaceWrapper) GetDeploymentManagers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDeploymentManagersParams
+
+	// ------------- Optional query parameter "exclude_fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "exclude_fields", r.URL.Query(), &params.ExcludeFields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclude_fields", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", r.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDeploymentManagers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDeploymentManager operation middleware
+func (siw *ServerInterfaceWrapper) GetDeploymentManager(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "deploymentManagerId" -------------
+	var deploymentManagerId DeploymentManagerId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "deploymentManagerId", r.PathValue("deploymentManagerId"), &deploymentManagerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -1111,6 +5052,22 @@ func (siw *ServerInterfaceWrapper) GetResourcePools(w http.ResponseWriter, r *ht
 		return
 	}
 
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "nextpage_opaque_marker" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "nextpage_opaque_marker", r.URL.Query(), &params.NextpageOpaqueMarker)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nextpage_opaque_marker", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetResourcePools(w, r, params)
 	}))
@@ -1188,6 +5145,22 @@ func (siw *ServerInterfaceWrapper) GetResources(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "nextpage_opaque_marker" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "nextpage_opaque_marker", r.URL.Query(), &params.NextpageOpaqueMarker)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nextpage_opaque_marker", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetResources(w, r, resourcePoolId, params)
 	}))
@@ -1301,6 +5274,31 @@ func (siw *ServerInterfaceWrapper) GetResourceType(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
+// GetResourceReferences operation middleware
+func (siw *ServerInterfaceWrapper) GetResourceReferences(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId ResourceId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", r.PathValue("resourceId"), &resourceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resourceId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetResourceReferences(w, r, resourceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetSubscriptions operation middleware
 func (siw *ServerInterfaceWrapper) GetSubscriptions(w http.ResponseWriter, r *http.Request) {
 
@@ -1358,6 +5356,20 @@ func (siw *ServerInterfaceWrapper) CreateSubscription(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
+// BatchSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) BatchSubscriptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BatchSubscriptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // DeleteSubscription operation middleware
 func (siw *ServerInterfaceWrapper) DeleteSubscription(w http.ResponseWriter, r *http.Request) {
 
@@ -1427,6 +5439,20 @@ func (siw *ServerInterfaceWrapper) GetSubscription(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
+// GetBuildInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetBuildInfo(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBuildInfo(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -1549,6 +5575,11 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 
 	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureInventory/api_versions", wrapper.GetAllVersions)
 	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureInventory/v1", wrapper.GetCloudInfo)
+	m.HandleFunc("POST "+options.BaseURL+"/o2ims-infrastructureInventory/v1/admin/import/resourcePools/{resourcePoolId}", wrapper.ImportResources)
+	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureInventory/v1/admin/logLevel", wrapper.GetLogLevel)
+	m.HandleFunc("PUT "+options.BaseURL+"/o2ims-infrastructureInventory/v1/admin/logLevel", wrapper.UpdateLogLevel)
+	m.HandleFunc("POST "+options.BaseURL+"/o2ims-infrastructureInventory/v1/admin/refresh", wrapper.TriggerRefresh)
+	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureInventory/v1/admin/refresh/{refreshJobId}", wrapper.GetRefreshJob)
 	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureInventory/v1/api_versions", wrapper.GetMinorVersions)
 	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureInventory/v1/deploymentManagers", wrapper.GetDeploymentManagers)
 	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureInventory/v1/deploymentManagers/{deploymentManagerId}", wrapper.GetDeploymentManager)
@@ -1558,10 +5589,13 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureInventory/v1/resourcePools/{resourcePoolId}/resources/{resourceId}", wrapper.GetResource)
 	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureInventory/v1/resourceTypes", wrapper.GetResourceTypes)
 	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureInventory/v1/resourceTypes/{resourceTypeId}", wrapper.GetResourceType)
+	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureInventory/v1/resources/{resourceId}/references", wrapper.GetResourceReferences)
 	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureInventory/v1/subscriptions", wrapper.GetSubscriptions)
 	m.HandleFunc("POST "+options.BaseURL+"/o2ims-infrastructureInventory/v1/subscriptions", wrapper.CreateSubscription)
+	m.HandleFunc("POST "+options.BaseURL+"/o2ims-infrastructureInventory/v1/subscriptions/batch", wrapper.BatchSubscriptions)
 	m.HandleFunc("DELETE "+options.BaseURL+"/o2ims-infrastructureInventory/v1/subscriptions/{subscriptionId}", wrapper.DeleteSubscription)
 	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureInventory/v1/subscriptions/{subscriptionId}", wrapper.GetSubscription)
+	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureInventory/version", wrapper.GetBuildInfo)
 
 	return m
 }
@@ -1608,27 +5642,201 @@ type GetCloudInfoResponseObject interface {
 	VisitGetCloudInfoResponse(w http.ResponseWriter) error
 }
 
-type GetCloudInfo200JSONResponse OCloudInfo
+type GetCloudInfo200JSONResponse OCloudInfo
+
+func (response GetCloudInfo200JSONResponse) VisitGetCloudInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCloudInfo400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetCloudInfo400ApplicationProblemPlusJSONResponse) VisitGetCloudInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCloudInfo500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetCloudInfo500ApplicationProblemPlusJSONResponse) VisitGetCloudInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportResourcesRequestObject struct {
+	ResourcePoolId ResourcePoolId `json:"resourcePoolId"`
+	Body           *ImportResourcesJSONRequestBody
+}
+
+type ImportResourcesResponseObject interface {
+	VisitImportResourcesResponse(w http.ResponseWriter) error
+}
+
+type ImportResources200JSONResponse ResourceImportResult
+
+func (response ImportResources200JSONResponse) VisitImportResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportResources400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response ImportResources400ApplicationProblemPlusJSONResponse) VisitImportResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportResources404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response ImportResources404ApplicationProblemPlusJSONResponse) VisitImportResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportResources500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response ImportResources500ApplicationProblemPlusJSONResponse) VisitImportResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLogLevelRequestObject struct {
+}
+
+type GetLogLevelResponseObject interface {
+	VisitGetLogLevelResponse(w http.ResponseWriter) error
+}
+
+type GetLogLevel200JSONResponse LogLevel
+
+func (response GetLogLevel200JSONResponse) VisitGetLogLevelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLogLevel500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetLogLevel500ApplicationProblemPlusJSONResponse) VisitGetLogLevelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateLogLevelRequestObject struct {
+	Body *UpdateLogLevelJSONRequestBody
+}
+
+type UpdateLogLevelResponseObject interface {
+	VisitUpdateLogLevelResponse(w http.ResponseWriter) error
+}
+
+type UpdateLogLevel200JSONResponse LogLevel
+
+func (response UpdateLogLevel200JSONResponse) VisitUpdateLogLevelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateLogLevel400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response UpdateLogLevel400ApplicationProblemPlusJSONResponse) VisitUpdateLogLevelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateLogLevel500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response UpdateLogLevel500ApplicationProblemPlusJSONResponse) VisitUpdateLogLevelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TriggerRefreshRequestObject struct {
+}
+
+type TriggerRefreshResponseObject interface {
+	VisitTriggerRefreshResponse(w http.ResponseWriter) error
+}
+
+type TriggerRefresh202JSONResponse RefreshJob
+
+func (response TriggerRefresh202JSONResponse) VisitTriggerRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TriggerRefresh500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response TriggerRefresh500ApplicationProblemPlusJSONResponse) VisitTriggerRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRefreshJobRequestObject struct {
+	RefreshJobId RefreshJobId `json:"refreshJobId"`
+}
+
+type GetRefreshJobResponseObject interface {
+	VisitGetRefreshJobResponse(w http.ResponseWriter) error
+}
+
+type GetRefreshJob200JSONResponse RefreshJob
 
-func (response GetCloudInfo200JSONResponse) VisitGetCloudInfoResponse(w http.ResponseWriter) error {
+func (response GetRefreshJob200JSONResponse) VisitGetRefreshJobResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCloudInfo400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type GetRefreshJob400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response GetCloudInfo400ApplicationProblemPlusJSONResponse) VisitGetCloudInfoResponse(w http.ResponseWriter) error {
+func (response GetRefreshJob400ApplicationProblemPlusJSONResponse) VisitGetRefreshJobResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCloudInfo500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type GetRefreshJob404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response GetCloudInfo500ApplicationProblemPlusJSONResponse) VisitGetCloudInfoResponse(w http.ResponseWriter) error {
+func (response GetRefreshJob404ApplicationProblemPlusJSONResponse) VisitGetRefreshJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRefreshJob500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetRefreshJob500ApplicationProblemPlusJSONResponse) VisitGetRefreshJobResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
@@ -1757,13 +5965,23 @@ type GetResourcePoolsResponseObject interface {
 	VisitGetResourcePoolsResponse(w http.ResponseWriter) error
 }
 
-type GetResourcePools200JSONResponse []ResourcePool
+type GetResourcePools200ResponseHeaders struct {
+	Link        string
+	XTotalCount int
+}
+
+type GetResourcePools200JSONResponse struct {
+	Body    []ResourcePool
+	Headers GetResourcePools200ResponseHeaders
+}
 
 func (response GetResourcePools200JSONResponse) VisitGetResourcePoolsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Link", fmt.Sprint(response.Headers.Link))
+	w.Header().Set("X-Total-Count", fmt.Sprint(response.Headers.XTotalCount))
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
 type GetResourcePools400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
@@ -1837,13 +6055,23 @@ type GetResourcesResponseObject interface {
 	VisitGetResourcesResponse(w http.ResponseWriter) error
 }
 
-type GetResources200JSONResponse []Resource
+type GetResources200ResponseHeaders struct {
+	Link        string
+	XTotalCount int
+}
+
+type GetResources200JSONResponse struct {
+	Body    []Resource
+	Headers GetResources200ResponseHeaders
+}
 
 func (response GetResources200JSONResponse) VisitGetResourcesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Link", fmt.Sprint(response.Headers.Link))
+	w.Header().Set("X-Total-Count", fmt.Sprint(response.Headers.XTotalCount))
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
 type GetResources400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
@@ -1997,6 +6225,50 @@ func (response GetResourceType500ApplicationProblemPlusJSONResponse) VisitGetRes
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetResourceReferencesRequestObject struct {
+	ResourceId ResourceId `json:"resourceId"`
+}
+
+type GetResourceReferencesResponseObject interface {
+	VisitGetResourceReferencesResponse(w http.ResponseWriter) error
+}
+
+type GetResourceReferences200JSONResponse ResourceReferences
+
+func (response GetResourceReferences200JSONResponse) VisitGetResourceReferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetResourceReferences400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetResourceReferences400ApplicationProblemPlusJSONResponse) VisitGetResourceReferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetResourceReferences404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetResourceReferences404ApplicationProblemPlusJSONResponse) VisitGetResourceReferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetResourceReferences500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetResourceReferences500ApplicationProblemPlusJSONResponse) VisitGetResourceReferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetSubscriptionsRequestObject struct {
 	Params GetSubscriptionsParams
 }
@@ -2067,6 +6339,41 @@ func (response CreateSubscription500ApplicationProblemPlusJSONResponse) VisitCre
 	return json.NewEncoder(w).Encode(response)
 }
 
+type BatchSubscriptionsRequestObject struct {
+	Body *BatchSubscriptionsJSONRequestBody
+}
+
+type BatchSubscriptionsResponseObject interface {
+	VisitBatchSubscriptionsResponse(w http.ResponseWriter) error
+}
+
+type BatchSubscriptions200JSONResponse SubscriptionBatchResult
+
+func (response BatchSubscriptions200JSONResponse) VisitBatchSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchSubscriptions400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response BatchSubscriptions400ApplicationProblemPlusJSONResponse) VisitBatchSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchSubscriptions500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response BatchSubscriptions500ApplicationProblemPlusJSONResponse) VisitBatchSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type DeleteSubscriptionRequestObject struct {
 	SubscriptionId SubscriptionId `json:"subscriptionId"`
 }
@@ -2146,6 +6453,31 @@ func (response GetSubscription500ApplicationProblemPlusJSONResponse) VisitGetSub
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetBuildInfoRequestObject struct {
+}
+
+type GetBuildInfoResponseObject interface {
+	VisitGetBuildInfoResponse(w http.ResponseWriter) error
+}
+
+type GetBuildInfo200JSONResponse BuildInfo
+
+func (response GetBuildInfo200JSONResponse) VisitGetBuildInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBuildInfo500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetBuildInfo500ApplicationProblemPlusJSONResponse) VisitGetBuildInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 	// Get API versions
@@ -2154,6 +6486,21 @@ type StrictServerInterface interface {
 	// Get O-Cloud info
 	// (GET /o2ims-infrastructureInventory/v1)
 	GetCloudInfo(ctx context.Context, request GetCloudInfoRequestObject) (GetCloudInfoResponseObject, error)
+	// Import resources into a resource pool
+	// (POST /o2ims-infrastructureInventory/v1/admin/import/resourcePools/{resourcePoolId})
+	ImportResources(ctx context.Context, request ImportResourcesRequestObject) (ImportResourcesResponseObject, error)
+	// Get the logging level
+	// (GET /o2ims-infrastructureInventory/v1/admin/logLevel)
+	GetLogLevel(ctx context.Context, request GetLogLevelRequestObject) (GetLogLevelResponseObject, error)
+	// Set the logging level
+	// (PUT /o2ims-infrastructureInventory/v1/admin/logLevel)
+	UpdateLogLevel(ctx context.Context, request UpdateLogLevelRequestObject) (UpdateLogLevelResponseObject, error)
+	// Trigger a refresh of the inventory
+	// (POST /o2ims-infrastructureInventory/v1/admin/refresh)
+	TriggerRefresh(ctx context.Context, request TriggerRefreshRequestObject) (TriggerRefreshResponseObject, error)
+	// Get an inventory refresh job
+	// (GET /o2ims-infrastructureInventory/v1/admin/refresh/{refreshJobId})
+	GetRefreshJob(ctx context.Context, request GetRefreshJobRequestObject) (GetRefreshJobResponseObject, error)
 	// Get minor API versions
 	// (GET /o2ims-infrastructureInventory/v1/api_versions)
 	GetMinorVersions(ctx context.Context, request GetMinorVersionsRequestObject) (GetMinorVersionsResponseObject, error)
@@ -2181,18 +6528,27 @@ type StrictServerInterface interface {
 	// Get a resource type
 	// (GET /o2ims-infrastructureInventory/v1/resourceTypes/{resourceTypeId})
 	GetResourceType(ctx context.Context, request GetResourceTypeRequestObject) (GetResourceTypeResponseObject, error)
+	// Get the references to a resource
+	// (GET /o2ims-infrastructureInventory/v1/resources/{resourceId}/references)
+	GetResourceReferences(ctx context.Context, request GetResourceReferencesRequestObject) (GetResourceReferencesResponseObject, error)
 	// Get subscriptions
 	// (GET /o2ims-infrastructureInventory/v1/subscriptions)
 	GetSubscriptions(ctx context.Context, request GetSubscriptionsRequestObject) (GetSubscriptionsResponseObject, error)
 	// Create subscriptions
 	// (POST /o2ims-infrastructureInventory/v1/subscriptions)
 	CreateSubscription(ctx context.Context, request CreateSubscriptionRequestObject) (CreateSubscriptionResponseObject, error)
+	// Manage subscriptions in bulk
+	// (POST /o2ims-infrastructureInventory/v1/subscriptions/batch)
+	BatchSubscriptions(ctx context.Context, request BatchSubscriptionsRequestObject) (BatchSubscriptionsResponseObject, error)
 	// Delete subscription
 	// (DELETE /o2ims-infrastructureInventory/v1/subscriptions/{subscriptionId})
 	DeleteSubscription(ctx context.Context, request DeleteSubscriptionRequestObject) (DeleteSubscriptionResponseObject, error)
 	// Get subscription
 	// (GET /o2ims-infrastructureInventory/v1/subscriptions/{subscriptionId})
 	GetSubscription(ctx context.Context, request GetSubscriptionRequestObject) (GetSubscriptionResponseObject, error)
+	// Get build information
+	// (GET /o2ims-infrastructureInventory/version)
+	GetBuildInfo(ctx context.Context, request GetBuildInfoRequestObject) (GetBuildInfoResponseObject, error)
 }
 
 type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
@@ -2274,6 +6630,144 @@ func (sh *strictHandler) GetCloudInfo(w http.ResponseWriter, r *http.Request, pa
 	}
 }
 
+// ImportResources operation middleware
+func (sh *strictHandler) ImportResources(w http.ResponseWriter, r *http.Request, resourcePoolId ResourcePoolId) {
+	var request ImportResourcesRequestObject
+
+	request.ResourcePoolId = resourcePoolId
+
+	var body ImportResourcesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportResources(ctx, request.(ImportResourcesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportResources")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ImportResourcesResponseObject); ok {
+		if err := validResponse.VisitImportResourcesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetLogLevel operation middleware
+func (sh *strictHandler) GetLogLevel(w http.ResponseWriter, r *http.Request) {
+	var request GetLogLevelRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLogLevel(ctx, request.(GetLogLevelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLogLevel")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLogLevelResponseObject); ok {
+		if err := validResponse.VisitGetLogLevelResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateLogLevel operation middleware
+func (sh *strictHandler) UpdateLogLevel(w http.ResponseWriter, r *http.Request) {
+	var request UpdateLogLevelRequestObject
+
+	var body UpdateLogLevelJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateLogLevel(ctx, request.(UpdateLogLevelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateLogLevel")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateLogLevelResponseObject); ok {
+		if err := validResponse.VisitUpdateLogLevelResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// TriggerRefresh operation middleware
+func (sh *strictHandler) TriggerRefresh(w http.ResponseWriter, r *http.Request) {
+	var request TriggerRefreshRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.TriggerRefresh(ctx, request.(TriggerRefreshRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TriggerRefresh")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(TriggerRefreshResponseObject); ok {
+		if err := validResponse.VisitTriggerRefreshResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRefreshJob operation middleware
+func (sh *strictHandler) GetRefreshJob(w http.ResponseWriter, r *http.Request, refreshJobId RefreshJobId) {
+	var request GetRefreshJobRequestObject
+
+	request.RefreshJobId = refreshJobId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRefreshJob(ctx, request.(GetRefreshJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRefreshJob")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRefreshJobResponseObject); ok {
+		if err := validResponse.VisitGetRefreshJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetMinorVersions operation middleware
 func (sh *strictHandler) GetMinorVersions(w http.ResponseWriter, r *http.Request) {
 	var request GetMinorVersionsRequestObject
@@ -2509,6 +7003,32 @@ func (sh *strictHandler) GetResourceType(w http.ResponseWriter, r *http.Request,
 	}
 }
 
+// GetResourceReferences operation middleware
+func (sh *strictHandler) GetResourceReferences(w http.ResponseWriter, r *http.Request, resourceId ResourceId) {
+	var request GetResourceReferencesRequestObject
+
+	request.ResourceId = resourceId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetResourceReferences(ctx, request.(GetResourceReferencesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetResourceReferences")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetResourceReferencesResponseObject); ok {
+		if err := validResponse.VisitGetResourceReferencesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetSubscriptions operation middleware
 func (sh *strictHandler) GetSubscriptions(w http.ResponseWriter, r *http.Request, params GetSubscriptionsParams) {
 	var request GetSubscriptionsRequestObject
@@ -2566,6 +7086,37 @@ func (sh *strictHandler) CreateSubscription(w http.ResponseWriter, r *http.Reque
 	}
 }
 
+// BatchSubscriptions operation middleware
+func (sh *strictHandler) BatchSubscriptions(w http.ResponseWriter, r *http.Request) {
+	var request BatchSubscriptionsRequestObject
+
+	var body BatchSubscriptionsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.BatchSubscriptions(ctx, request.(BatchSubscriptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BatchSubscriptions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(BatchSubscriptionsResponseObject); ok {
+		if err := validResponse.VisitBatchSubscriptionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // DeleteSubscription operation middleware
 func (sh *strictHandler) DeleteSubscription(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId) {
 	var request DeleteSubscriptionRequestObject
@@ -2619,113 +7170,184 @@ func (sh *strictHandler) GetSubscription(w http.ResponseWriter, r *http.Request,
 	}
 }
 
+// GetBuildInfo operation middleware
+func (sh *strictHandler) GetBuildInfo(w http.ResponseWriter, r *http.Request) {
+	var request GetBuildInfoRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBuildInfo(ctx, request.(GetBuildInfoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBuildInfo")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBuildInfoResponseObject); ok {
+		if err := validResponse.VisitGetBuildInfoResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xde3PjuJH/KijeVWUmJ8qSLMuPVOrKsb27qh2PfbYnudRoaw2SoIQMCXAA0F7drqvy",
-	"Qe6+XD7JFV58gnrY2skk8fwztgQ2uhv9+KHRoH/2QppmlCAiuHfys5dBBlMkEFO/hTRNKfkRZvhHmiEi",
-	"/0c/hUkeoW8wSiI1JkI8ZDgTmBLvxDujaQoBR5KOQBFIMBeAxiCW4wFDMWKIhIgDQYEhBWJGUyAWCDDE",
-	"80T0Z2RGLmC4aD4EMAfQfEhginqAMiAn+5yrr4tp5Je8wkSwBDyBfIF4H3xD2Yygn2CaJahX5UIycB/S",
-	"nAi2vAc8DzQtGutv0E8CEY4p4fd6lhPJ5v39vaSmKPyoPua/L0fuGXJm3Iz8aYEIEAvMQaFngDn5jQA5",
-	"RxEg1AjwiJMEBMjyFimVaJUDbCgozTYHAvSACMCK5yWATH6TJTjEIlkCTMygnGMyl0Nm5F4zfV8y1J8R",
-	"r+cZDXknntJ0Wyav52G54J9zpH6Rw7wTr64Lr+fxcIFSKA1FLDM5gguGydx7euq5zCvegV0ZObWm/k5W",
-	"NUdC2418ylgMgCR6gZkZ8+pYj01tDCaJmklTKwyIIZEzoiztBav//FVPBGLtVb9FkIULEDIsEMNQreEZ",
-	"JQJiwgElSC5VShkCvD6w11gmlOKQJpTwPlAm0BiuTGBGRJ4lCISavvQQSADNEIOCsl5hI6XhyOWsMvEA",
-	"k1waw90CFc+BEJIZCeTgpV3kmCYJfZQTaK1wtca/gCv7zC/gEkHFwXP+/TIjv/jFv8qPz/gnaUlzJeJe",
-	"UgaXUIQLxE2EMRoJ7YrIj5QSOvkC9+jzvf7NTQtzgD7nMJE+tIKcpjUX62jNGYLSAcQCki56lha634IW",
-	"ZU4+NS1M1vGlzCYun+Sd+krWypggzlcKWKG1TsaSVlPAkramRYxRdNCKKOKAUGGNo4M3Q8sYRTdfktI6",
-	"uzC0jPJX01qn/1+kR94VT9WShXxIxjtJoELHBFTzGw3+gkLRziUzYh814zvzCaimk5w7AIpvRCIcR2hG",
-	"1ucPGWR//wZ9dgT03sV/vS1SyF2pFgkhJGHI5nkqQWIhoAlWTV4VE5/vKwGQphlkiM9IuEDhp2I99ArS",
-	"tc7ftxwpt5IxV6+xnYADnmcZZQKkeSKwDOE2EDe1qBiw8xeqnJGmLjtSseIPiwVi4P7i9l6u7f2H27aC",
-	"MXEq+Lb34fZtPU0bJVsfkZkR8p41AzkBz6BCNRLOEYQiKUaAAM8ZozmJjNlgMk8Q+JxTgXh/RlbLXUUk",
-	"xpx1HgL36RKESc4FYvdOu1Fo4DflqN805ClWoMisHXlY2ZXEIz0FSLQVpCDNuQCp9FsQU6YRqrSfBAmV",
-	"mCMsgYEUSQ1y2F6ZWxWycUmO+YxUJQW/hST6bcO9igWUKpKrvaE+ftflXs2lX4/QNG5dD9EKRko+3nbi",
-	"M4WzVuOzCGUJXUpnv4QEzhGbRm1k9oHgzzkCOEJE4BgjJtcQgvJZkOqHm9xODkaj4cFk7B8FgwN/PJxA",
-	"P4jDfT8cHQyCcDJGQwgt9xkUi5J5F189j6HPOWYo8k4Ey1FVspiyFArvxMtzLEe2JWWI05yFaAsB7SNN",
-	"sfaPojCY7Ad+MIwH/jgahf7RURD7B5PxeDI5HKB4MHSLVWFiN9JcU5o8QyKQUZrsXizDzW5Eu1tmz1ks",
-	"ICm2RBvCyfHB4YG/Hx8P/DEKDvzgKIb+UXyERvvx8XEYD1aLZrh5mWg8DwpJthCt+lhTMgiP9qNBAH14",
-	"gJA/joexH6CjsR/v74+D0XA4mYSxW7IGMy+R7MkOVpv50wSy9BzFmGAtV1PMKdEEZcSFAc2F3HxB+RSI",
-	"isf6Xs/LmEz8AiNFV404jXRegElXVeqdqRakSMAICgg+oaWvAUgGMeM68AoKIOc0xFAgkOodWJwn5VMG",
-	"ljCUqIzmMC+jBY3+vKeeZvBsAclcmYtL8AiHUOgcryhJRkP1hETiAtAwzBlDEYhyZuo2RjMJ5MIM/R2A",
-	"USTTaYRksox6IKWRtBebLkieeicfvdPz84tzr+edX7y7uFM/XV6dT7+ZXpx7P7QW0bBfrpvLQK8ZfcCR",
-	"hIogd9lqyW6AJPsMYo4iuUvG3OKPa4ZTyJbge7QEmBg1K5sB5zhUa8uWSo41ZldwXOFwBcMJJXPEQPH9",
-	"Q7Hudc5L6CHxJwQVgnZgSImF+RYjz0jj6VJoTARS6E3DOYagAkiVgoMqIkmGIJEKhZLmozSHBcwyRFBk",
-	"WOGIcL2xkFygOEah4L0aOz1dqVDAFacZDKXxQoZgAeb5kguU1ky4odF3kAttxutMuLls4I+IaSBFwOMC",
-	"hwsNfVoWHK2a/r2KT50ryRcS/6udlcGNmr6bYpggKH/ucEhrvRw8LpBSmuYVc6CelMrLBZXBKoRJslT1",
-	"H0hy+XPD2T7cXV2e3k3PpJudvv9w+s7pZBopSWAzJQKxGLoRSRHEiuHKjNR4QB8QM+pV3JqdG4OEp1jI",
-	"BdeKwRxcEIHFEtzpoHVzcXt3Mz27m169P1FwWirvyj9LaB6B6eUtuEXsAes9COZm16bKzykW2oCvRtPL",
-	"Wy05FihV8deqQH3nlNp8ABmDS/l79uk9lXoPVRJQkXzN4uCimEtZvSDMbe1XrxypEK5XqzITeD6hJXhz",
-	"/f3bIvrMSMuOCwUWWv8dwH3Ur3FSo25IFOETTM8balqvFUYzylF0g2SiOlXM8BWeMM9xBEmo/cA+DJh6",
-	"GkD9uNPRnqrJ/qMj8Fc9sR0U2pnOEYq7xGm4ZJdHuG2k1wECfnBkYw1DiiXdDoYUj3XAkDq8KZb43xmK",
-	"vRPv3/bKg7Y9g4z2mrDIYQCwzvJtAcDqjNsgawJ6y3j1c9JbWzG4FExar5SfrwrFJVEz59bM9MuUwMED",
-	"IhFlGuShCPBcMQf16clDnVCVU0hAIDOsBWwReMRiIRNihkJpIs2HOY3FowyKEUrwA2KmHC934YxGeSg6",
-	"hEYqXLpzxZV/c/oe6BEauyEZbWso7UQnfb6AehtvQFKGmJX9prKf6Kc0QolM2DNS+9xI4+bx75hBAHjN",
-	"IV95DtFm5nJTZX7GPyqLqsOEOpTHvB1LYJYlWB90KcOmeRJJy1ZeJjGYXmBYaLBlympeKATDQS7Qxumo",
-	"FXm6wmPNawsFPCuvVKKzK6OcN4tSG+WUzjpZPa2EMIMBTnCRZoocd10b17KAdQyoDUuFuC2h6zKt/LaU",
-	"CxjB1CYCCw44Uk7SEkH+OCPcunoAJfKgxRm8Wn+ZcxIaQuP17pk6ttKS4RCL5c41AR8gTmCQoF6FOykt",
-	"Q1IaFAE7tZTbxrWbTpFmZGOZNqq1Tsv9tN3dOCYwe8tyLK5yZpbVMN9/Tkl27dY7WrXr/i5PIVEbXalp",
-	"1w667RM1Li+L6rYzSxcl9y9V/dl4jYlz7/pMfbQEp2pBNzSbSm+D0xJGk1F4FA8P/YNRcOCPJ8Oxf4wO",
-	"Jv7RcATRaBjDI3i4iSWYIPCB4TZb6lQ1l/vmzzlMVIkKfLiZKv23lap+VF6opbgaRSkHNsj0wS2Wmx1l",
-	"2uqbzG6EZqQ4jLKjezqfISLYUnqH1crPMMM3lIonQEmxhS90shAi4yd7e+myb+zvZDIe7zvFtlH0HdWZ",
-	"ZIUxzhMawMQOnJ5zjXofEZOZk+M5KUPklY44t1igN/xtFcE7wrRhgm+HEhr51n3WQvTeL6pt6QoDrC27",
-	"Uxm9ekarhHRXap2SB0QEZUu9qaxm6A03bthSsPXUKohrZ1tKeJ4idrumIl+cjltjK6KrpWBxT7Wcvln1",
-	"ssrghWS+Y99RaTsoDjz1YfIJGAAfhKpXpQeGwNeF4GUPjIBvqsPVItWgN+yNSvVLXCojTYMXlx5OHfVe",
-	"QQFDmcybRGgLreFm9KAwwiaa0HZwI/fOrgX4cPPOeoceWdZWCRXA2rI9+nXqVQ4egTe6FP62D6amnzKj",
-	"WHJPZ4S69KyTwTJDvNi1YgLCBOYcgf3+qD8pGv3KvhFFWJ9sV+v8mnc+I9JbEqxJZQxTdqW+uRUyG0ES",
-	"7VEGMspF5eOO/WpjlEt9Wk8b62gA3pzdXJzeXbyVm6QheKOODP78ViPCWjNTXUszsl5NKxWzSht29Iwo",
-	"LTMdLjEBheV05OMmwR1oqKITyio2tUpDM7KhIa3XUH3FX6igRiZoRIGuEOUK4DptycisOstrwfaFWNGC",
-	"cMhbQfj28gpAAUL1/RwRxDHvt5EkzaP1OHLjbUZBXKYR3SF74l3cej1vkQcSROTBoJJ0Sx1pGLAJhmtI",
-	"jgkXqtBbgIVSfudeQM+ULG3UhiGjnBf0ZsRS5OAToY/EhteSnk56jxvq3No+F5Rpy66wPyPTy1tQZPgm",
-	"5joKh+HwYBj5o6PjY38cHk/84HAS++MYHY8Gk3FwcBhslE43gd62Ia9hV4X2trCsdOl3WhZ9wSL3gapX",
-	"ELV+cmZWVDwxkUin9cDfBdZLGM8oFQrLJ0kBvFv2cjXCaaUG1i8toR4teQne+y1QfrK3J3e3yYJycXI0",
-	"GAzWVpEqULXudx3QtiKvK77ZCsBmJZ9659BOo2FBummMhEbIGeUStbHi7mUs+hpSPF8IECDVw6kOkmgM",
-	"eAqTBLFKSYcyc7hcPFiGEt0VhmOFg4TKR9VdyarDkUK9jpLmM8P0l+wK6Xud4f6UcyTWRQImTR/DBJA8",
-	"DcrQYMn3JCgpKlcKvFYRi9zJWthSLMsCchAgRGSwL6ObaSvBggOrSCtTaIOhNF+5m1MWCCX7NqDLoIi7",
-	"4ngwiSeH4eHIPzoeHvjjw3HgB/uTY38yPD5CcBgfBpPYZZ9zRvPMYZ3fo+UjZZHESxKGkDnQI6u9XgFK",
-	"KJlzIGh/qyr5qmZARynFWufWdbe1Ubbdx1dpWjsOjw7Q4dgfoeMjf4z2I/8oRqGPDuDR+Dg6nhyGk23m",
-	"6GqoWyGwPiGwZ2fu0HMw2d8/jAbIPwoQ8seH0b4P4zDw98PJaBjGMRwFGyUcAeerrUB+HKh2HSaBM+c4",
-	"XtrTt1Y0en45pNGj2epsbPQD1r28mVWK4GvkK8y9FtZWJRw583ZJp9Lc+atkHk1/h4D6i/bvNZhvhuui",
-	"SBd1bRVrzh/STIbV4lZrcRAaubB6tavPjiyrb5s4SUI3L4rJWeaIzhnMFjiECbAPOxdURv4ICRSuO0G4",
-	"+LAT9N1akAay7qz+f5VF8HX92KtirHymsWWqbAaefdIT7QejQzQc++ODo2N/HB3v+xAdTvwoCuHBwfHw",
-	"eB9tcNLTERyLeNiC2RUHciLtVbGuq2t2Rawr23FdXTq17p/Kvr3Vw/NxRXuxnetM5hzvxDu7urz+cHfR",
-	"0TLrvacRukQpZcvv8HzxQeAE/48tQrcbVj09VB1R4CSRhppnvRK9wYCqRlU1aIHnC5CXFIFYMMQXNIkK",
-	"q1JNlsMDkGKSC1V3r/RUet9RrqFjTnTja25CrSTf7zw0PzFtGR1NFy01nHd2f5143tMP3c1F3sNwLRNP",
-	"zqPV3WQ2ZU3/qJmtwXypHdVmtGnG0D1JTcXAsmwZLNX2wWzzWT3o/Lc/dG7OX5IgrFyVBIEinKer4rDx",
-	"1eZ03+RE21wCGE3cU9lKS7En6le6fD+8P7/4ZvpeNdPbONDz3l/c/enq5vvp+2+9nnd7d3Vz+u2FDHQl",
-	"x+XYTpa/x8SROP6ozKMCfv/21//NFksuEzoWy7/99f+69eXg+fq7P99Oz07feT3v3dW36qcan5Xvd76P",
-	"eEkug+FBeDge+Pvjw4E/hpPYh+HRsQ9Hh4eD4fFxPDkabZKmu7qjTEttsfG+ce50bmmKwBllGWXKa3pg",
-	"SsK+e5513ZLMlvZcYWhTb3sYDUbj/nC4cdoudi3OEljZOqUCRilGK2B7DbNtOt7a3U313HXrA972fahm",
-	"K1WSBDD8tGU/QnGimzEaoihnyJy+h5DozzgHEFxTHcV1s1tRzFTHI9Xk2NVbwFPaN5/2Q5rK3/cehntU",
-	"xZofCyl/pIFuhXDeatjw+NqNiLWUNNZHtByoA9woR8UpQFW/m7hV1ys9zuzNYDm5mUyrNKLq6LZyy1nX",
-	"nJDqzbS3YDXdMjZUFx7MSO242dRv1Rs5GIopM1UzQ8QeFhfVabGQ6CpJLF+QlTx0nLby7bVdU+UX79qS",
-	"efWKJEt7o291kCjcxuWwjhe5nF5PO7vCXZi90aN9ej11OW8ldJbyD/uDvrvkvx2jfDNObW+w4YWvYRlm",
-	"uEq/YPtjRRojggS/GxXEV+vbUdLMGb5mKMY/1TW3R0c45T4mMYNcsDwUOUNF0Np7GD5bq9eMBglKz5GA",
-	"OOHtU98SJJ/a5t+XgOdTsqxUx0siZWsx71UTJyaV+wEmzTJz7w5L5cj9BbQhzrGjkGK5GmEWErL6BWRF",
-	"P2UJJCYG2esIKpBibq9zmldL6QZypbW6e59RQlBoi/ES5AeQIyBwKuNhLlzBqCh0OlhUZ3NFO4rqNcNl",
-	"I7yKpJbTbg5lchMghUuwVJ3qcc70rcKKw+AYRKiYycTNMgqp3rB2GBVQ5B3HUd/d3V0DPQCENEJlE/1K",
-	"VRZTYlJRVqW/SWCROFWlbhP2movK81S1+ddn0sgaTIXtg1fvvNHdZqoCWOFR0G6Oe+o1cigT+uJWzuRG",
-	"WQUZdcZpNvd9MI31hgRzdTuUVK52qtf2zDwVsE6CBJJPM8+0PBb+YK6hwISrBGszX0dyE87aS9OWYBhS",
-	"FqkaJgXTi7tvwM03Z2D/+GgCPu7/4DS1lvJUW2ZIcwbnKg+b+z9yItvROSONBYlomBcOW2RXS/oN6s/7",
-	"+k13391dvnsLHmVyr1kmKF/EkSIVRQpAoBrYejMicXZxXQVyiZIsOGlougvaWYus6FBCvLU+0UjExkGK",
-	"INTOx0+mJMEITM5pyLtuK+mz96KKAm5r8fCwPwBvrkJBpTrkVuKtJ/NIUgWr1Qd4n/oMkj5l872IPpKE",
-	"wug/cfT7w/Gxjki6+adhQddT08yru0CqeahsBVAKTXCICFdWaN5RcJrBcIHASCX/OmePj499qL5W/Jhn",
-	"+d676dnF+9sLf9Qf9BciTSre763mQab3yqanSNk9z6Q878TbN0Akg2KhtL4mv8pE+VDBBnMk2iq6US97",
-	"4QaemzfgWAwi9VfgkCJxVTCxwb1Kg/pCua1EfovEaZIU0ERt0jIqtSR5GA0GphNWICI0jskSs9R7f+Ea",
-	"g5UvgXg2WuHaXhtv+8tDuZ/SmzEaCKiStlMDVnop4lPPG6/k27jgf7yY/wa8cYjwBxipM3jEFWI4+Fr4",
-	"su1DtpUeMUZZ37yAROU1bRs107IHlCcfPVto9H6Qj6wHkJuYtY5kvLPnyW29ZU9hr/ay2o9urZVD9ta+",
-	"zPap9xwa5p2XEsX/ar5U6aTcym82UPGr9+zQe0oFK/t8pvdsnyFsWEwxoaw7PRTAOYV/oaxz893yuUtJ",
-	"9qvOGa+GvFtDbhvSC8y5dZloO6Nu34PjHXZ63p7oK0sSz3xYlVJfnGE2KjK1LzS3m6O2yEBrFvHVaXfo",
-	"tA4dV7zW4YXP9t+9nx0XBJ+2RX3d1+DXe/bWju260fg8d/xHAY0OT34BdnQv1Vfsv+PB+Ovg666sraLI",
-	"vizmEepSU0xzEvX/WeLNTsJNtZ9sO6RQ6x/sAgk3NfKv+GBLfFDrQd4JNGiv2isq2KGX1tVb8dC6nz3H",
-	"Ofd+rvd+PgMBOBrUV3rs1g7b6E79VTNu3TdekGxbWnnNs//CebbhJb+uCxdf822dubwQqM7Zt/Fs/mK3",
-	"7r1m7uffp3xJ1uav4elfPDyt8ntHrNp5nCq/ehkC2TpqfYGgVbl4+EWQy05Qy2tEeAUs3U61i5Bwt8zQ",
-	"M0sD6n07axxbk38tDTwTYKgre7stDRSr9loaeKafrqoMCGPvTb/UfvAc3yxzsr5e8pK8XF7nXOmwz87G",
-	"5gLMF8mv2jV2URmwWnlNtK+J1trDS124epllu/TqvojVlWdva/O85tkt82ztotxO8mxr0V7T7A69lDfM",
-	"3fpo/fMfzLs7XVfmkPrrSp0XHp1+pp+q2YpuKUdc/IFGy53ltro51hvXBcvRU8snhr/i3CtMX78UN2pd",
-	"G3y1+F1avLa7jY1++8S093P90uWT9pgEuV7leq4+586/mFj3Fz2y4S/bZabGZdCubLDCRM1f72uZaGGh",
-	"r4jq17NcbQE1va+M1tvtZ9bZXwMXvdj4/ql7m7aK+6v2U04ne91O/Wtupzb0/CfzKlzrmOUltPYraaUX",
-	"GDrrb5l3djp3/QVs7aLryK4EjY6//Ouiav6CV/HGoR7ARIYK9YcH7F0DSKLaFQjDRm2ionl8E85dVVvH",
-	"H2Hm2xGrdIc5/lj1dsTcdGT0e/r/AAAA///itOAhW4sAAA==",
+	"H4sIAAAAAAAC/+x9jXLbNrfgq2C4O3Obu5IsybL80+nccRO39TaJs7bzffdulYlBEpLQkAADgHa1qWfu",
+	"g+y+3H2SHfyRIAlKlK3kS74605nKEnlwcHD+cM7BwacgomlGCSKCByefggwymCKBmPoromlKyXuY4fc0",
+	"Q0T+H/0RJXmMfsIoidUzMeIRw5nAlAQnwXOaphBwJOEIFIMEcwHoHMzl84ChOWKIRIgDQYEBBeaMpkAs",
+	"EWCI54kYzMiMnMFoWX8JYA6g+ZLAFPUAZUAO9jFXP9O58yN3kAhXgCeQLxEfgJ8omxH0B0yzBPVcLCQC",
+	"NxHNiWCrG8DzUMOic/0L+kMgwjEl/EaPciLRvLm5mRED4b36mv9QPrlnwJnnZuTvS0SAWGIOCjoDzMm/",
+	"CJBzFANCzQTucJKAEFncYkUSTXKADQRF2fqDAN0iArDCeQUgk79kCY6wSFYAE/NQzjFZyEdm5EYjfVMi",
+	"NJiRoBcYCgUngaJ0c05BL8BywT/mSP0hHwtOgiotgl7AoyVKoWQUscrkE1wwTBbB/X3Px17zHfCVmaem",
+	"1D+IqxZIaL6RbxmOAZDEj2Azw14t69GVx2CSqJE0tIKBGBI5Iyh+3Oo/fNUTgVhz1a8QZNESRAwLxDBU",
+	"a/icEgEx4YASJJcqpQwBXn2wV1smlOKIJpTwAVAsUHtcscCMiDxLEIg0fCkhkACaIQYFZT0AG4wjl9NF",
+	"4hYmuWSG6yUq3gMRJDMSyodXdpHnNEnonRxAU4WrNf4TXNh3/gSvEFQYPOTfnzPyZ7/453x8wD8JS7Ir",
+	"ETcSMngFRbRE3GgYQ5HIrohYGiK04gVu0McbANphYQ7QxxwmUobWgNOwFmITrAVDUCAGxBKSNngWFrrZ",
+	"AhZlXjw1LEw24aXYZl6+yVvplWycY4I4XztBBxa66QqrPsEStoZFDFO0wIop4oBQYZmjBTcDyzBFO14S",
+	"0ia+MLAw6QBrE/3/lBJ5vUQNmceay6W+kwAcOEahmr9o+DuKRNOWzIh91Tzfak+Aa05y7nFQ+mZKhOMY",
+	"zchm+yGV7A/foY8ehd47+1/PChNyXZIFMj0wZIs8RUSUEzTKqo6rQuLjjaMAaZpBhviMREsUfSjWQ68g",
+	"3Sj8A4uREiupc/Ua2wE44HmWUSZAmicCZ4l5z0NFhYAdvyDljNRp2WKKFX5YLBEDN2dXN3Jtb95eNQmM",
+	"iZfAV723V8+qZtoQ2cqItIyQ9ywbyAF4BpVXI905glAspxEiwHPGaE5iwzaYLBIEPuZUID6YkfXzdj0S",
+	"w87aDoGbdAWiJOcCsRsv38hXe/9SPvUvtfkUK1BY1hY7rPhK+iM95ZBoLkhBmnMBUim3YE6Z9lAl/yRI",
+	"KMMcY4EpkVNSD3l4r7StyrPxzRzzGXFnCv4Vkvhfa+JVLKAkkVztjvT4vk28rp5t66Fpv3Wzi1YgUuLx",
+	"rNU/k6hv758lOMWi6Z69gn/gNE8BydMQMbmKWKBU+eAaWYAJgCCDCyXoZkIDhwaIIcUKyn9SL/fs3DNK",
+	"OLK+vHTRb15i8uEGLBGMEQN3WCzNqqI/xA1gKIESrRmxytrscYD8XeFQLqGEdAJm+XC4H+3RMU55H5M5",
+	"g1ywPBI5Q+fkFhFB2WrvdrTHEKc5i9AbShP+b4oWP4yGQ/n6eCqhS+DvaQY/5uh9CtkHxH4YDAYKPPpe",
+	"YvbDLJDPzYJd8YAmrJF7OXyNKUbDoZ8D9Eq6DJDqRVTvDHtBion5s2d5AxOBFoi1MYelwIUiwCs1/yav",
+	"6F+BJo9V+zXOKBmnByAHGaO3ONaTVStdYQANYkYKVjFGg0ljqzeHagiGbjHNuaXSRtJrc8S45pkW6fOR",
+	"1s8JG4QtRllCV9KyvoIELhA7j5u0e0uwpB2OERF4jvXkISjfBal+ua4apgfj8ehgOukfhcOD/mQ0hf1w",
+	"Hu33o/HBMIymEzSC0M4mg2JZTsaHVy+QtMUMxcGJYDlyZzanLIUiOAnyHMsnmzNlaM4QX/5PGnafIgHY",
+	"CiIw74PfaVif5kE8iYZwctjfnw9hf4L2w/5xfHDQH8JJPA3Dw/kwGvunWUHqsfPTWmKLBbSv1OezfxRH",
+	"4XQ/7Iej+bA/icdR/+gonPcPppPJdHo4RPPhqG0+BRK7mY3UeQ+YEcgoTXY/LYPNbqZ2vcoeslhAQmxM",
+	"bQSnxweHB/39+fGwP0HhQT88msP+0fwIjffnx8fRfLh+agabx02N52Exky2m5r5WnxmER/vxMIR9eIBQ",
+	"fzIfzfshOpr05/v7k3A8Gk2n0dw/sxoyj5nZvX1YRQZPE8jSF2iOCdbzqk/znGiAmBIAQ5oLqUmgfAvE",
+	"xWuDoBdkjGaICYwUXPXEaaydTJi0hbhfmtBjigSMoYDgA1r19W4mg5hxY0YogJzTCEOBQKrDOfM8Kd8q",
+	"zFWi3GMPexkq6K1kcN/TCD5fQrJQ7OKbeIwjKPSGQUGSiEbqDbmtF4BGUc4YikGcM+sgacokkAvz6PcA",
+	"xrH0zWOUICE/pDSW/GJ9TyIdhN+C0xcvzl4EveDF2cuza/Xp1cWL85/Oz14E7xqLaNAv183HoG+0yZfu",
+	"Xu7j1RLdEEn0GcQcxTLkhrndzLxhOIVsBX5FK4CJIbPiGfACR2ptZbg06G1iuwJjB8M1CCeULBADxe+3",
+	"xbpXMS/3MXIzC4ED0D4YUWJjBrxwdGpvl5PGRCASF3tDhqDabTnRS/nLQiIkLWqMoIR5J9lhCbMMERQb",
+	"VDgiXEcpJBZoPkeR4L0KOj31KFW7YJxmMJLMCxmCFlHAV1ygtMLCNYq+hFxoNt7EwvVlA39DTO/KCLhb",
+	"4mipHbkGB8frhn8NU8/AxUryJWVCR3jMJlTD90OMEgTl5xaBtNzLwd0SKaJpXDEH6k1JvFxQqawimCQr",
+	"FUyGJJefa8L29vri1en1+XMpZqev356+9AqZ9gRTRMQ5EYjNod8jKZRY8TjA9nlAbxEz5FXYmjAQg4Sn",
+	"WMgF14TBHJwRgcUKXGuldXl2dX15/vz6/OL1CfjJEO+i/zyheQzOX12BK8RusQ5oYG5CQCqXlWKhGfhi",
+	"fP7qSs9c7Qcl6pYE6jfvrM0XkDG4kn9nH15TSfdIGQGlyTcsDi4yQ5RVs0vc7j/0yhEHcDX0nRnF8wGt",
+	"wHdvfn1WaJ8ZafBxQcCC6t8DPECDCiYV6AZEoT7B+YsamTZThdGMchRfImmoThUyfI0kLHIcQxJpObAv",
+	"A6beBlC/7hW0e9fY/+ZR/K4kNpVC09J5VHHbdGoi2SYRfh7ptTgB7zzWWLshxZJu54YUr7W4IVX3plji",
+	"/87QPDgJ/ttembXfM57RXt0t8jAArKJ8VThgVcStkjUKvcG8+j0prQ0dXE5Mcq+cP1+nikugZsytkRmU",
+	"JoGDW0RiyrSTh2LAc4Uc1KnY2yogF1NIQCgtrHXYYh1egoBnKJIsUn+Z07m4gwxJDwnfImZye1gFLeI8",
+	"Ei2TRkpd+m3FRf/y9DXQT2jfDUltW/HSTrTR50uooxLGScoQs3O/dPYTg5TGKJEGe0Yq35vZ+HH8B1oQ",
+	"AJ5syFduQzSb+cRUfm/lw1lUrSZUhQ/mTV0CsyzBOmuuGJvmSSw5W0mZ9MH0AsOCgg1WVuNCIRgOc4E6",
+	"m6OG5mlTjxWpLQjwILviaGefRfkxx0ksrUYXWyJpEcoXCp2E2K0NAdYsSoa7qtcy3nb65lw6+Imaoc7f",
+	"OKNUYgSjwXAw9KkShd819rnb8ls76AILlfvCwhkC3EGuJihUgZhKpc6CnHwg9I7MAoDnAIsiN5yGKHZC",
+	"xSG2trXEcjwcT/qjYX84uh4NT4byv//t9egVJk2Mf/4iWMJJfDQ/jCZhGB+g/Wg+RNP4EEaH8GAeHsVj",
+	"OAwP0TQajXyY33ZcZIO6Hv7xKN9OBqOjwXCj3N0WgmZI7DJIz+VSn3C8qEekOzlcrUHyqoREMIMhTrD9",
+	"GxYO4JvKcw2Sd5FSF7hNVpcCVc4LmImpHTYWHHCkLEhjCvLjjHBrB0PIUQxoUe2mlKN0yBIaQWMS/SO1",
+	"xJkkwhEWq51TAt5CnMBQJmtL7ORsGVI8GQM7tJy3NfqXrVOakc5z6pRoOS+DTXbr7xnABF7KZ7GLmVlW",
+	"g/zgIfmYjXGpeF1I6pc8hURFgSSlfeGlpkxUsHxV5JF9Y5fJ7S8VGu28xsQb2HkgPRoTp2pBO7KNU0Xo",
+	"5YTxdBwdzUeH/YNxeNCfTEeT/jE6mPaPRmOIxqM5PIKHXTjBKIG3DHtMrMxk5jKoJItqJH4xeHt5rujf",
+	"JKr6aCyDnMXFOE45sEpmAK4wibRbpn/JbJRgRoqyD/t0Tzt7iAi2ktJhqfIJZviSUnEPKCniWwVNlkJk",
+	"/GRvL10NDP+dTCeTfe+0rRZ9SbWbtYYZFwkNYWIfPH/B9ZbwDjEk+RAvSKkiL7TGucICfcefudtbj5o2",
+	"SPDtXOiaUfQnWokOjMSVeEfBgJVl9xKjV7Vojkr3mdai2kFHXFz3tWNUo3QbTbLB3eE0rS0lPE8Ru9qQ",
+	"rirq0MpSAKNdLQTrlri5pm6hfRfBM4l8y6bcKfArSosUTvwEDEEfRAxBgXpgBPo6S7LqgTHom9SJG8Ed",
+	"9ka98btmVUUVFx8dTj3JEEEBQxlDHBGhOdSFos4BiG6U0Hxwieb+BXh7+dJKh36yTDwQKoDlZVtk5aWr",
+	"fHgMvtN5omcDcG5OLmQUS+zpjFAfnbUxWGWIFyEdTECUwJwjsD8YD6ZFSX1ZoakA6xoyNwmmceey2E/t",
+	"OBWojGHKLtQvVwIKVdK9RxnIKBfO1y3BnNpTPvJpOnWm0RB89/zy7PT67BmgDIzAdyqf9h/P1DSrZcNV",
+	"Ks3IZjKtJcw6atinTWkV0+oSE1BwTos9rgPcAYUcmlDm8NQ6Cs1IR0baTKHqij+SQDVLUNMCbSrKp8Bf",
+	"0sVLdIsSvwQndLGQjJLIRzZHC5J2SKY4rAopRZzDBTJmFTI9IIoHpeoLYhTmC1UmMKdBL7iDTI6LGKPM",
+	"E4urEUYj5Ju3Ntc2YlKdxiN9ZLv5gLxhfK5eXQAoQKR+XyCCOOaDpgdN83iz/9x5e1UA/xSYmtLgJDi7",
+	"CnrBMg+l85SHw+DeQyPt/nTxXWszx4QLlf0pnKRy/t49kB4pWVlrBSNGOS/gzYiFyIGKNVizUsLTxv6u",
+	"I82tzHNBWRmhMMPNiIwVF55N3dc8ikbR6GAU98dHx8f9SXQ87YeH03l/MkfH4+F0Eh4chp3ciC5bDlvy",
+	"X+OrgnpbcFa66rdyFn3EIg+ACmIStX5yZFakQTABkDRf+IdsZ+T2hVEq1B4mSYoNR4NfLmRRbxkYH5Sc",
+	"ULUSvNy0DBqbkZO9PbmrT5aUi5Oj4XBziMtx0aty1+LSO/P16bfLojzRTw4uoMj55lLJuvutK+rjU+EH",
+	"K3CKJB/a7Q8qIBavAsrAHOJEq/liXWMoUF/oeF4zsKpc5QeMKhWCKevdZjxtXrxjMQQ5JWpf2hyunFcD",
+	"5Poi1qaYuSvRif8FZA8ikXmxO3E067SzFWqZgrXoGSKxBNULWE6I/lRwh8RCEXGzca9V4Bq0XGbxC4YO",
+	"CXaLAVfrbHfqJhSg61qa0NjPlDqhwtv4UoMDKV4sBQiRkjhVdkHngKcwSRBzYryUmVKs4sXSxuoacTxX",
+	"GyOhHNSg162UoCCvJwH4QP/lS9ZQDoJWP+iUcyQ2yS4DHDEME+dgi7vWPblLKULZajfrbmFkaAvg6itg",
+	"KdNECBHpBZVm3xRhYsGBJaSdU2S9BMm+GWVCcSCU6FtPR3oLuM3BCafz6WF0OO4fHY8O+pPDSdgP96fH",
+	"/eno+AjB0fwwnM59/LlgNM883PkrWt1RFnMQI0JVUYJ+0hEvECJZA8mBoIOtcsrrSuc9sVXLnVsH4jeq",
+	"32bVu1PifRwdHaDDSX+Mjo/kIYO4fzRHUR8dwKPJcXw8PYym24zRVn6+ZsI6n24rTfyq52C6v38YD1H/",
+	"KJT12ofxfh/Oo7C/H03Ho2g+h+Owkycm4GI9F8ivQ8kHlMmdNOd4vrK1Kg1t9PD4aO1EQ+McQK16virl",
+	"dXerUL5mfgW7V9TaOoNznkpZPBco3c70mNpcnOpI7VdhiZ4UeVWR//X05/lcxXDt5qkHICDorqtG7RZe",
+	"ftJ3W+aDXIVVI143zXSpTlP6HUyai4iW0Qitj8wpIH2adJ2zvP2OytAlUmVdktdcJehbB0xi9Id/iIxy",
+	"7Go8SeGyyY/aHjognfxKR/mo0KSmhTbv3PIoQtzDQn83df8VesgdrXlDZ2g9NAkpTRAkDQ7RFCpH3MwU",
+	"l5o4vsSSrVIstxRVQ6XrD70H+6qsUQBYv7Gpwx9suyVxDHBX16EbiTrJjEMIIzqW8TwkMVtgL8zSIDmU",
+	"kfFz/ZKHSl62lqemNyKNYLRUwtKz0kJZ7FrDQnQeuBKGeB7LpHgUxVtTQSXnNwmIey7dXfdy0CIMURJr",
+	"HStIt3JLt65NHHbkzGn436j3Vke+7sIVJRlxW2Kw4odENMMoLrsFFjXhsS9D4R5wtE+WtRZdNHpCu5dA",
+	"yFEWiC4YzJbyIBewL3sXVG7rYyRQtKle7OztTnIOjQWp5RNaa72+ypKnTUfT1zmU8p1aoshJgTy4ri/e",
+	"D8eHaDTpTw6OjvuT+Hi/D9HhtB/HETw4OB4d76MOdX0t5qvY7DaSC44AefML63TdpS2Y8HgFbi5Bvwnc",
+	"pirrA6oP4M4StB5tKxZVb3SOxq8daVchHg3bX1B07RQWrMHJRNkrlslXA/3Oxb/2tEdydI8cvsSZZ4no",
+	"XQtGRrPzUtHbDeRpTbFVTpsUP6nDxTPSLLM0/+cm4qkW2amm92hOR8lUd/KQ68oNFM9IuFIMVj0/a1Er",
+	"Tm7wKvGc3zfk+MrVddivEMAKkTtJYIsHV5dB4xo5/SfXyiHbAN4CrbdLcnc9W/mDpUZ5eIiiJhTbbcLW",
+	"Rw4Laqxbk7a2CusCe6vMS3/YPB7q1HA0Dnn+tqb/hB3ruQw7yGa4F6/evL0+a+mpELymMXqFUspWv+DF",
+	"8q3ACf4/thCz2dEg0I+qMl2cJJIN8qxXJixgSFUnA/XQEi+WIC8hArFkiC9pEhe2Vp3CHx2AFJNcIG7H",
+	"1Ifug18o19kSnTSU2xTtgErwg9ZTVSfm3F7LqbwGGV60Hg8+CYL7d+2nT4Pb0UYk7r3HC3bj7ytu+lb9",
+	"/RryJXXUOdSufrR6uEEYWJbuhSuVMTNRy9pZtH/vj7yFGo9xm+28ylFSFOM8XeedGlmtD/dTTjTPJYDR",
+	"xD+Urbop0oCuR/D29Yuzn85fq24rVg/0gtdn13+/uPz1/PXPQS+4ur64PP35rGreymdbUf4VE492/pti",
+	"Dyf++V//+X+z5YrLbQ4Wq//6z//XTi8Pzm9++Y+r8+enL4Ne8PLiZ/Wpgqfz+85DyY/x8GF0EB1Ohv39",
+	"yeGwP4HTeR9GR8d9OD48HI6Oj+fTo3EX17Dt+KzpuVBYwEtvsPuKpgg8pyyjTElND5yTaPCwo4DMlnn5",
+	"1FBXabuVhyoHvtOILca4SNR5y6HKs7VKYfScI4N1c1pj27rgbUzouWcPtj7k0GyYVT9OmCQhjD5seSan",
+	"ONWQMRqhOGfIxNIjSPR3nAMI3tCyveGMFIVtqkTYNY5t52t4Sgfm20FEU/m3bHNJla55X8zyPQ11kbD/",
+	"kGy3Ixz+OIGeJZ3rYwocqEMMcV70cHShdoq+tzWQf2770MrBzWCapDFV6Qinp64us9Clbbbro4brnH52",
+	"EAMzUjlyYWr5pJJBDM0pM/lFA8QemCgqFcUSEVXEaPCCrMSh5cQB357aFVJ+8ZOL0q5ekGRlW76tVxKF",
+	"2GwS2B/lTm+LVFeR3Kqsn+6O1SLC26a6CmDVXJdub/DlUl0FGi00KbDUYfZ6YeVajtu0Eayo1O241Uyz",
+	"sjwCsgVy+LJcrp3l40pyNBJyzYXblI8rSb8+N9dg5Iv2NTu1jFtiSskaWwSk8gFVwleK0wwtF0j0QJ7F",
+	"6uia6g+jz6uV45hGb41V8QDTpZrqcQ3SgeITre48SmsiVMRy1IgyDopE0Av0qMqbkJPwdnf5Whl514fD",
+	"68GqgtadWLFDprhc2+r6WNvt58z1jMA3cEJtKJXHVDnMzkGqNUK3KY3sYNmRhl1tkvMmCOWrj88muyRr",
+	"pJObOu0fn01eZ9Yfk1CuE6KZUV5DjccmlD1d0k/fnLf24PHFF2sNx07fnPuYwtnmbWzHc78torwbplYx",
+	"GFz4BpTLNi+8gvZvzmzMFO7fdWSi9fT2sFHO8BuG5viPKuU23gXwYKq+YTRMUPoCCYgT3jytWAb0Tm0n",
+	"q8cE+k7JypGFEkjZJ4v33E0+Jk6zO+NnMNNEtmgCVdgsT/RTTstnNZYyvNYvwmvyNjpI9AB2OG04MLe9",
+	"ic2la7obmqJa1U4+p4SgyDoIMiAZQq7PxsRSUfldbVOX50FRnSkrkipKWeCyq5uy5hbTdgzlRlymtVZg",
+	"pdquzXOmW+Q6AoPnIEbFSI36SYZ9mK87p/PL9fUbewYsojEqO8KtJWUxJCbCawoEFomXVKo1bq++qDxP",
+	"Vc+66kg6CgjOhW3qpm6D0t0hVHLPwVHQdox76oJFlAk1uyxnGeX6dIs6m2cSEaqWVZhD4rLVMXH6FKsL",
+	"rWaBUlgnYQLJh1lgWpQU8mB6KsKEK1Npd+ktG3GxyjrwEowiymKVWKPg/Oz6J3D503Owf3w0Bb/tv/Oy",
+	"WoN4mANEIpozuEBx2cxSDmRw5DNSW5CYRnkhsEUkwIL+Dg0WA30H5C/Xr17KLieIVDkTlHdkpCgN3eAF",
+	"4oiI3oxg4fRehFxGdKybUaN0WxjKcqRDQxmO2igTdeNsT4wZJdS0x/cmfcIITF7QiLe13tRnRouMD7iq",
+	"6MPDwRB8dxEJKskhw57ykp2cJc6MKgqUD2ifQTKgbLEX0zuSUBj/G45/OJwca43ka/Mnm+7p5jv69LJr",
+	"h8ojrIqgCY4Q4agsuQhOMxgtERgPhg3M7u7uBlD9rPAx7/K9l+fPz15fnfXHg+FgKdLEkf5gPQ7SvAe9",
+	"psnuBcbkyXoK44hkUCwV1TfYV2kobx3fQO7uGiS6VBexmJIBezeU9UEk/SyEtu6F2ObLCxdR5fZ+RuI0",
+	"SQrXpBfYi2UUKuPh0HSuEYgI7cdkiVnqvd+59sHKGw0e7K1wza+1ezBdv5WGAiqj7aWAnb2c4n0vmKzF",
+	"24jg/3g0/jX3xjOFH2Fs9wYSr4OvBS977N22vlJxv4G5TUPZNc0bFday54dOfgtsUjR4J1/Z7EB2YWut",
+	"yXjrWX0/95a9MHqVa5x/81OtfGRv4zXP972HwDC3wd6/+4yy5HQA2UpuOpD4SXp2KD0lgef04dKzB+MU",
+	"kz1dC169l23vU7Ve8l7tsagvlqUL17mzdS2rz73HHYC+Nbj4UncqwTHUByRifItjdWNDETI18ZIZcQMm",
+	"QMVOi3MVlYvuBgD8TYJ0UIEMgQwxjrl9nkHCTdt9ENFbpAqc4YxElMzxIme6gqF6Gd/3OgflvKtiQrxM",
+	"P9n8gumwDIWJRbn5KLlXNG0R9GWciMS6eRfmICexiaer5QHS3PcApxoYVCIIBAVYmE7rYMEgse1FaC70",
+	"32oyxcOQrGZEu+/FWL5yZu7ThsWZDk3KrRVilZWMBlPS9yONVztTXv5TOvdVD1ewHN1/Rg3qPQezSZea",
+	"ZLRRprWjMDoJoeNjoC4AxSX0dQH4ujXuZDj5OvC6LuMRKLa3BdxBvT2b05zEg2/PQpxbBlqnhh2z4Zzs",
+	"2sZuJE6btI1OmL79CN+29E9z0hxOJ7WGU1Z0ZvuMElyMsZUHVJmVkb5vz69ozMThErXowTvZiDD3LLZu",
+	"dsqbINqWGEABWE4ETlGvMF2KSQVkVu2ZCJeNl1j7PSNFNlXDYpbVqCqvitEcSnWpEVARGWzaitjWPh4G",
+	"e6sSnxUe272VqrLXlzNM69i62dawqFe2F4Dp5XCkWD2GuaG8JuiTj78jWbzqKItbaGvTGardj79meLFA",
+	"TDryUr8Bhvp8RaKm+Kpws5IyKCAwpqNwU9QoUrBlHHNFoiWjhOY8WX1vHBTNLjPyOw2tA5vRxKRVBYPy",
+	"hnHBbShGl2Gc67PCGraqsmIIxitg2mZp71v5xxKqw5VqC4pgrLYOSvT1kSuC7gAl3r2/oYNpFte0NOMd",
+	"+opFP7oWobRTLo8PRBHKRCGPcrZOLTEW7ty/SStkqO8sd53/diIGe5/MB9Up7b6TC9O1PWDDa3HWefut",
+	"U4nl5w39rGfGdsenpEq9vd3TFuSvuwWRzmSblDxYgLfNZ9h4VIoJZe3JjCLNm8LfKWstFWmI9SsJ9qvO",
+	"cDy5ZLvl6CYjPSL42riqYjumbh7/5S18+qI50FeW0njgy+qQwqONYqeSqAYNPXWO20QL1i/ik9DuUGg9",
+	"NHak1iOFD5bfvU+e62fut81Rtl+ytlmytxZsD8IPFMdvJcXpkeRHZDr9S/Xk9v6F3V7/9WePVjeV9OxW",
+	"nkI1wdfTBebmKGpx1GIwIzoVeYM+3qjE6w0mN4AjyOSJSXvwz1yLeFNN6d2Y23EhK88vqKDrxxyxlboz",
+	"prKFV4f6TDVhfQQXRtkgRdaGmyQoUjdM6wNhMlmbhko41RXTCjd1dbGZA0F/iAwu0HuawY85ep9C9gGx",
+	"G1DqodY9u0vufyZ/6UEvK5o+8F27BhdqCV6pFfhCbpu7irvx2HzZ8iWCsXHfX2LywXd3HvlgmVlSA0hy",
+	"GGias0vulb/fANv2ZgDO0kystDRJeUFKQAidkZSyQjQ0HiVxmjWe/96/pgIm/ec0J77gr/zR00BQT1P3",
+	"ArItbWoS27M309jqBzm5NozKMyH3T07uDo1Odb08uV2txx5ia7ylQNs5tJ7+jmsV7m4qTD5zhYfWKY/w",
+	"HRtUeXIb/8rR0o3lGTsU4b1Kf+FthNktKqnj/HlcS/VCteGJ8TdVUd+MeD1OsAuHc0YaHif43A7n48vr",
+	"ek/u6Tfmnu7WNf3n90r/cg7pk/n9Ug60z649plSyqx0uf3qch93EfoO9+QLmppzZl/HMd+KVPznkTw55",
+	"u1DtQiVIX/aBkVx1+fYGwdbgnzK9D/TJJPl2HDIsVu0p9PVAOV0X+RKG3+tyqeXgIbK598n983F2uexr",
+	"vVZgH2yNNYZfxr5q0dhF5MtS5cnQPhlayw+7EuGqS71XbeC/UYq3uC5Atf6RVMuFe6GOe3NB7Vb9GSnA",
+	"n3S/laE4Duu5+eE7PECDnr304Zlz68OMGMSrW4IZOfXBUbe5J6vypKptd1JLCEU0SfSFO2q+p89f9cDd",
+	"kvJqO3bMzWzrHf0ws6iemKL1lnmZCITb2YUNwGvqx31GNuGthzeRwMr5hfW62bld4qEa+ktpZwfVrXR0",
+	"KSCNi0meNPRfW0M32aPUfo/ZCVVaWm61E1rbFrMhxVeVcZ62RFtuieoNXB+/JWos2tOOaIfiymvsbuWz",
+	"+r06yes9CficIXU/VWtjZK+c6bcqvPJ5TtBW2bHLKdrRZxx7DevrvsrNrs9PHL9Ljtd815nptzdMe6qB",
+	"S/vB2VNJHOQ2wNEL32v0BQeNtuCb2jybXjnFCzNS6ZYjYdrctds5p1dvnQO26JxzqnrT5Ez326HE9MuP",
+	"KSq6FkpkzWup2anIZ7jeDYRIflE0NfEpC9WbuG6WP7eyqPTk/sLH79v6Wm/XGqba1vqfsTPMt6V7dBF3",
+	"VWYlwcM8+bBbHfSp2jX/XquhBAlP49QX6nte64nuE0P9ZM1mb+cdV/Fq9UjXsLieRtNMFpz6tL37fBys",
+	"OaBC97Ue43bh7038V9ubPZr5/qlPLm3le64Lv3uF7Cm289eM7XSU/A4mq7w8YaOSMM8qL3WBhYyep1i4",
+	"DZ0kaZ2ri2FCyaKsR2ue0VdhZHuovy0O9GOOE9vT9bOJaTnIVjIqJxu7De6/2SZijZm0HJKXLypQWtWX",
+	"LbZP9vZUM/ol5eLkSN4/ev+uALH5Do3Wk/GmsbfnDN59bzPYtaEQA7ryiwfqlW6YXdz92jNuutwvFb0p",
+	"pFC4DV4dfigGKujYBXNf2YiBU82wbQXMOQBVA6YL1bcB5ofjg3Eap5hgLqRg31Z20qZ+vKz5dkHqRiP3",
+	"7+7//wBv8Pp+otMAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -8,15 +8,19 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/google/uuid"
+
 	api2 "github.com/openshift-kni/oran-o2ims/internal/service/common/api"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/api/generated"
 	models2 "github.com/openshift-kni/oran-o2ims/internal/service/common/db/models"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/notifier"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/utils"
 	api "github.com/openshift-kni/oran-o2ims/internal/service/resources/api/generated"
+	"github.com/openshift-kni/oran-o2ims/internal/service/resources/collector"
 	"github.com/openshift-kni/oran-o2ims/internal/service/resources/db/models"
 	"github.com/openshift-kni/oran-o2ims/internal/service/resources/db/repo"
 	utils2 "github.com/openshift-kni/oran-o2ims/internal/service/resources/utils"
+	"github.com/openshift-kni/oran-o2ims/internal/version"
 )
 
 // ResourceServer implements StrictServerInterface. This ensures that we've conformed to the `StrictServerInterface` with a compile-time check
@@ -30,14 +34,86 @@ type ResourceServerConfig struct {
 	BackendURL      string
 	Extensions      []string
 	ExternalAddress string
+	ImportBatchSize int
+	CORS            api2.CORSConfig
+	ResponseCache   api2.ResponseCacheConfig
 }
 
 // ResourceServer defines the instance attributes for an instance of a resource server
 type ResourceServer struct {
 	Config                   *ResourceServerConfig
 	Info                     api.OCloudInfo
-	Repo                     *repo.ResourcesRepository
+	Repo                     ResourcesRepository
 	SubscriptionEventHandler notifier.SubscriptionEventHandler
+	NotificationHandler      collector.NotificationHandler
+	RefreshHandler           collector.RefreshHandler
+	// LogLevel controls the verbosity of the server loggers and can be changed through the API
+	LogLevel *slog.LevelVar
+}
+
+// importDataSourceName is the name of the data source owning the resources created through the import endpoint
+const importDataSourceName = "Import"
+
+// ResourcesRepository defines the repository operations used by the resource server
+type ResourcesRepository interface {
+	GetDeploymentManagers(ctx context.Context) ([]models.DeploymentManager, error)
+	GetDeploymentManager(ctx context.Context, id uuid.UUID) (*models.DeploymentManager, error)
+	GetResourceTypes(ctx context.Context) ([]models.ResourceType, error)
+	GetResourceType(ctx context.Context, id uuid.UUID) (*models.ResourceType, error)
+	ResourceTypeExists(ctx context.Context, id uuid.UUID) (bool, error)
+	SearchResourcePools(ctx context.Context, filter repo.ResourcePoolFilter, page utils.Page) ([]models.ResourcePool, bool, error)
+	CountResourcePools(ctx context.Context, filter repo.ResourcePoolFilter) (int, error)
+	GetResourcePool(ctx context.Context, id uuid.UUID) (*models.ResourcePool, error)
+	ResourcePoolExists(ctx context.Context, id uuid.UUID) (bool, error)
+	SearchResourcePoolResources(ctx context.Context, id uuid.UUID, filter repo.ResourceFilter, page utils.Page) ([]models.Resource, bool, error)
+	CountResourcePoolResources(ctx context.Context, id uuid.UUID, filter repo.ResourceFilter) (int, error)
+	GetResource(ctx context.Context, id uuid.UUID) (*models.Resource, error)
+	ResourceExists(ctx context.Context, id uuid.UUID) (bool, error)
+	ImportResources(ctx context.Context, resources []models.Resource) ([]models2.DataChangeEvent, error)
+	GetSubscriptions(ctx context.Context) ([]models2.Subscription, error)
+	GetSubscription(ctx context.Context, id uuid.UUID) (*models2.Subscription, error)
+	CreateSubscription(ctx context.Context, subscription *models2.Subscription) (*models2.Subscription, error)
+	UpdateSubscription(ctx context.Context, subscription *models2.Subscription) (*models2.Subscription, error)
+	DeleteSubscription(ctx context.Context, id uuid.UUID) (int64, error)
+	GetDataSourceByName(ctx context.Context, name string) (*models2.DataSource, error)
+	CreateDataSource(ctx context.Context, dataSource *models2.DataSource) (*models2.DataSource, error)
+}
+
+// ResourcesRepository is implemented by the database repository
+var _ ResourcesRepository = (*repo.ResourcesRepository)(nil)
+
+// listPage returns the page of items requested by the limit and next page marker query parameters.  A page can only
+// be combined with the `eq` and `in` search criteria on the given fields, which are applied when querying the
+// database; the other criteria are applied to the response, which would leave the pages partially filled.
+func listPage(limit *int, marker, filter *string, fields ...string) (utils.Page, error) {
+	after, err := api2.DecodeNextPageMarker(marker)
+	if err != nil {
+		return utils.Page{}, fmt.Errorf("failed to decode the next page marker: %w", err)
+	}
+
+	page := utils.Page{After: after}
+	if limit != nil {
+		page.Limit = *limit
+	}
+	if page.Limit == 0 && page.After == nil {
+		return page, nil
+	}
+
+	pushedDown, err := api2.FilterPushedDown(filter, fields...)
+	if err != nil {
+		return utils.Page{}, fmt.Errorf("failed to check the filter: %w", err)
+	}
+	if !pushedDown {
+		return utils.Page{}, fmt.Errorf("the limit and nextpage_opaque_marker parameters can only be combined with "+
+			"the eq and in search criteria on the %s fields", strings.Join(fields, " and "))
+	}
+	return page, nil
+}
+
+// pendingImport tracks a validated import item along with its position in the request
+type pendingImport struct {
+	index  int
+	record models.Resource
 }
 
 // GetAllVersions receives the API request to this endpoint, executes the request, and responds appropriately
@@ -57,6 +133,17 @@ func (r *ResourceServer) GetAllVersions(ctx context.Context, request api.GetAllV
 	}), nil
 }
 
+// GetBuildInfo receives the API request to this endpoint, executes the request, and responds appropriately
+func (r *ResourceServer) GetBuildInfo(ctx context.Context, request api.GetBuildInfoRequestObject) (api.GetBuildInfoResponseObject, error) {
+	info := version.Get()
+	return api.GetBuildInfo200JSONResponse(api.BuildInfo{
+		ApiVersion: utils2.CurrentInventoryVersion,
+		BuildTime:  info.Time,
+		Commit:     info.Commit,
+		Version:    info.Version,
+	}), nil
+}
+
 // GetCloudInfo receives the API request to this endpoint, executes the request, and responds appropriately
 func (r *ResourceServer) GetCloudInfo(ctx context.Context, request api.GetCloudInfoRequestObject) (api.GetCloudInfoResponseObject, error) {
 	return api.GetCloudInfo200JSONResponse(r.Info), nil
@@ -267,19 +354,238 @@ func (r *ResourceServer) DeleteSubscription(ctx context.Context, request api.Del
 	return api.DeleteSubscription200Response{}, nil
 }
 
+// BatchSubscriptions receives the API request to this endpoint, executes the request, and responds appropriately
+func (r *ResourceServer) BatchSubscriptions(ctx context.Context, request api.BatchSubscriptionsRequestObject) (api.BatchSubscriptionsResponseObject, error) {
+	if len(request.Body.Operations) > utils2.MaxSubscriptionBatchSize {
+		return api.BatchSubscriptions400ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"operations": fmt.Sprintf("%d", len(request.Body.Operations)),
+			},
+			Detail: fmt.Sprintf("batch must not contain more than %d operations", utils2.MaxSubscriptionBatchSize),
+			Status: http.StatusBadRequest,
+		}, nil
+	}
+
+	// Apply each item individually so that failed items do not prevent the others from being applied
+	results := make([]api.SubscriptionBatchItemResult, len(request.Body.Operations))
+	targets := make(map[uuid.UUID]int)
+	callbacks := make(map[string]int)
+	for i, item := range request.Body.Operations {
+		results[i].Index = i
+		results[i].Operation = string(item.Operation)
+		results[i].SubscriptionId = item.SubscriptionId
+
+		err := validateSubscriptionBatchItem(&item, targets, callbacks)
+		if err == nil {
+			results[i].Subscription, err = r.applySubscriptionBatchItem(ctx, &item)
+		}
+		if err != nil {
+			message := err.Error()
+			results[i].Error = &message
+			continue
+		}
+
+		results[i].Success = true
+		if results[i].Subscription != nil {
+			results[i].SubscriptionId = results[i].Subscription.SubscriptionId
+		}
+		if item.Operation != api.Get && item.SubscriptionId != nil {
+			targets[*item.SubscriptionId] = i
+		}
+		if item.Subscription != nil {
+			callbacks[item.Subscription.Callback] = i
+		}
+	}
+
+	response := api.SubscriptionBatchResult{
+		Results: results,
+	}
+	for i := range results {
+		if results[i].Success {
+			response.Succeeded++
+		} else {
+			response.Failed++
+		}
+	}
+
+	return api.BatchSubscriptions200JSONResponse(response), nil
+}
+
+// validateSubscriptionBatchItem validates a subscription operation before applying it.  The targets and callbacks
+// maps record the position of the items which already successfully modified a subscription or used a callback so
+// that conflicting items within the same batch can be rejected.
+func validateSubscriptionBatchItem(item *api.SubscriptionBatchOperation, targets map[uuid.UUID]int, callbacks map[string]int) error {
+	switch item.Operation {
+	case api.Create:
+		if item.SubscriptionId != nil {
+			return fmt.Errorf("subscriptionId must not be set for the '%s' operation", item.Operation)
+		}
+	case api.Get, api.Update, api.Delete:
+		if item.SubscriptionId == nil {
+			return fmt.Errorf("subscriptionId is required for the '%s' operation", item.Operation)
+		}
+	default:
+		return fmt.Errorf("unsupported operation '%s'", item.Operation)
+	}
+
+	switch item.Operation {
+	case api.Create, api.Update:
+		if item.Subscription == nil {
+			return fmt.Errorf("subscription is required for the '%s' operation", item.Operation)
+		}
+		if err := api2.ValidateCallbackURL(item.Subscription.Callback); err != nil {
+			return fmt.Errorf("invalid callback url: %w", err)
+		}
+		if index, found := callbacks[item.Subscription.Callback]; found {
+			return fmt.Errorf("callback '%s' is duplicated by item %d", item.Subscription.Callback, index)
+		}
+	}
+
+	if item.Operation != api.Get && item.SubscriptionId != nil {
+		if index, found := targets[*item.SubscriptionId]; found {
+			return fmt.Errorf("subscriptionId '%s' is duplicated by item %d", *item.SubscriptionId, index)
+		}
+	}
+
+	return nil
+}
+
+// applySubscriptionBatchItem applies a validated subscription operation and returns the resulting subscription, if
+// any.  The notifier is signaled of any change to the subscriptions.
+func (r *ResourceServer) applySubscriptionBatchItem(ctx context.Context, item *api.SubscriptionBatchOperation) (*api.Subscription, error) {
+	switch item.Operation {
+	case api.Create:
+		record := models.SubscriptionFromModel(item.Subscription)
+		result, err := r.Repo.CreateSubscription(ctx, record)
+		if err != nil {
+			return nil, subscriptionBatchError(err)
+		}
+
+		// Signal the notifier to handle this new subscription
+		r.SubscriptionEventHandler.SubscriptionEvent(ctx, &notifier.SubscriptionEvent{
+			Removed:      false,
+			Subscription: models.SubscriptionToInfo(result),
+		})
+
+		object := models.SubscriptionToModel(result)
+		return &object, nil
+	case api.Get:
+		record, err := r.Repo.GetSubscription(ctx, *item.SubscriptionId)
+		if err != nil {
+			return nil, subscriptionBatchError(err)
+		}
+
+		object := models.SubscriptionToModel(record)
+		return &object, nil
+	case api.Update:
+		current, err := r.Repo.GetSubscription(ctx, *item.SubscriptionId)
+		if err != nil {
+			return nil, subscriptionBatchError(err)
+		}
+
+		// Keep the internal fields so that no event is replayed or lost by the update
+		record := models.SubscriptionFromModel(item.Subscription)
+		record.SubscriptionID = current.SubscriptionID
+		record.EventCursor = current.EventCursor
+		result, err := r.Repo.UpdateSubscription(ctx, record)
+		if err != nil {
+			return nil, subscriptionBatchError(err)
+		}
+
+		// Signal the notifier to restart the worker of this subscription with the new attributes
+		r.SubscriptionEventHandler.SubscriptionEvent(ctx, &notifier.SubscriptionEvent{
+			Removed:      true,
+			Subscription: models.SubscriptionToInfo(current),
+		})
+		r.SubscriptionEventHandler.SubscriptionEvent(ctx, &notifier.SubscriptionEvent{
+			Removed:      false,
+			Subscription: models.SubscriptionToInfo(result),
+		})
+
+		object := models.SubscriptionToModel(result)
+		return &object, nil
+	default:
+		count, err := r.Repo.DeleteSubscription(ctx, *item.SubscriptionId)
+		if err != nil {
+			return nil, subscriptionBatchError(err)
+		}
+		if count == 0 {
+			return nil, subscriptionBatchError(utils.ErrNotFound)
+		}
+
+		// Signal the notifier to handle this subscription change
+		r.SubscriptionEventHandler.SubscriptionEvent(ctx, &notifier.SubscriptionEvent{
+			Removed: true,
+			Subscription: models.SubscriptionToInfo(&models2.Subscription{
+				SubscriptionID: item.SubscriptionId,
+			}),
+		})
+		return nil, nil
+	}
+}
+
+// subscriptionBatchError converts a repository error to the reason reported for a failed subscription operation
+func subscriptionBatchError(err error) error {
+	switch {
+	case errors.Is(err, utils.ErrNotFound):
+		return fmt.Errorf("requested subscription not found")
+	case strings.Contains(err.Error(), "unique_callback"):
+		return fmt.Errorf("callback value must be unique")
+	default:
+		slog.Error("error applying subscription operation", "error", err.Error())
+		return err
+	}
+}
+
 // GetResourcePools receives the API request to this endpoint, executes the request, and responds appropriately
 func (r *ResourceServer) GetResourcePools(ctx context.Context, request api.GetResourcePoolsRequestObject) (api.GetResourcePoolsResponseObject, error) {
-	records, err := r.Repo.GetResourcePools(ctx)
+	params := request.Params
+	page, err := listPage(params.Limit, params.NextpageOpaqueMarker, params.Filter, "resourcePoolId")
+	if err != nil {
+		return api.GetResourcePools400ApplicationProblemPlusJSONResponse{
+			Detail: err.Error(),
+			Status: http.StatusBadRequest,
+		}, nil
+	}
+	ids, err := api2.FilterIDs(params.Filter, "resourcePoolId")
+	if err != nil {
+		return api.GetResourcePools400ApplicationProblemPlusJSONResponse{
+			Detail: err.Error(),
+			Status: http.StatusBadRequest,
+		}, nil
+	}
+	filter := repo.ResourcePoolFilter{ResourcePoolIDs: ids}
+
+	records, more, err := r.Repo.SearchResourcePools(ctx, filter, page)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve resource pools: %w", err)
 	}
+	count, err := r.Repo.CountResourcePools(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count resource pools: %w", err)
+	}
 
 	objects := make([]api.ResourcePool, len(records))
 	for i, record := range records {
 		objects[i] = models.ResourcePoolToModel(&record)
 	}
 
-	return api.GetResourcePools200JSONResponse(objects), nil
+	marker := ""
+	if more {
+		marker = api2.EncodeNextPageMarker(records[len(records)-1].ResourcePoolID)
+	}
+	return api.GetResourcePools200JSONResponse{
+		Body: objects,
+		Headers: api.GetResourcePools200ResponseHeaders{
+			Link: api2.NextPageLink(api2.RequestPathFromContext(ctx), api2.ListParams{
+				ExcludeFields: params.ExcludeFields,
+				Fields:        params.Fields,
+				Filter:        params.Filter,
+				Limit:         params.Limit,
+			}, marker),
+			XTotalCount: count,
+		},
+	}, nil
 }
 
 // GetResourcePool receives the API request to this endpoint, executes the request, and responds appropriately
@@ -328,8 +634,46 @@ func (r *ResourceServer) GetResources(ctx context.Context, request api.GetResour
 		}, nil
 	}
 
+	// Next, build the page and the filter applied by the database
+	params := request.Params
+	page, err := listPage(params.Limit, params.NextpageOpaqueMarker, params.Filter, "resourcePoolId", "resourceTypeId")
+	if err != nil {
+		return api.GetResources400ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"resourcePoolId": request.ResourcePoolId.String(),
+			},
+			Detail: err.Error(),
+			Status: http.StatusBadRequest,
+		}, nil
+	}
+	var filter repo.ResourceFilter
+	for field, ids := range map[string]*[]uuid.UUID{
+		"resourcePoolId": &filter.ResourcePoolIDs,
+		"resourceTypeId": &filter.ResourceTypeIDs,
+	} {
+		if *ids, err = api2.FilterIDs(params.Filter, field); err != nil {
+			return api.GetResources400ApplicationProblemPlusJSONResponse{
+				AdditionalAttributes: &map[string]string{
+					"resourcePoolId": request.ResourcePoolId.String(),
+				},
+				Detail: err.Error(),
+				Status: http.StatusBadRequest,
+			}, nil
+		}
+	}
+
 	// Next, get the resources
-	records, err := r.Repo.GetResourcePoolResources(ctx, request.ResourcePoolId)
+	records, more, err := r.Repo.SearchResourcePoolResources(ctx, request.ResourcePoolId, filter, page)
+	if err != nil {
+		return api.GetResources500ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"resourcePoolId": request.ResourcePoolId.String(),
+			},
+			Detail: err.Error(),
+			Status: http.StatusInternalServerError,
+		}, nil
+	}
+	count, err := r.Repo.CountResourcePoolResources(ctx, request.ResourcePoolId, filter)
 	if err != nil {
 		return api.GetResources500ApplicationProblemPlusJSONResponse{
 			AdditionalAttributes: &map[string]string{
@@ -347,7 +691,22 @@ func (r *ResourceServer) GetResources(ctx context.Context, request api.GetResour
 		objects[i] = models.ResourceToModel(&record, nil)
 	}
 
-	return api.GetResources200JSONResponse(objects), nil
+	marker := ""
+	if more {
+		marker = api2.EncodeNextPageMarker(records[len(records)-1].ResourceID)
+	}
+	return api.GetResources200JSONResponse{
+		Body: objects,
+		Headers: api.GetResources200ResponseHeaders{
+			Link: api2.NextPageLink(api2.RequestPathFromContext(ctx), api2.ListParams{
+				ExcludeFields: params.ExcludeFields,
+				Fields:        params.Fields,
+				Filter:        params.Filter,
+				Limit:         params.Limit,
+			}, marker),
+			XTotalCount: count,
+		},
+	}, nil
 }
 
 // GetResource receives the API request to this endpoint, executes the request, and responds appropriately
@@ -398,6 +757,109 @@ func (r *ResourceServer) GetResource(ctx context.Context, request api.GetResourc
 	return api.GetResource200JSONResponse(object), nil
 }
 
+// GetResourceReferences computes the objects referencing a resource from the stored relationships.  The ACM
+// collector stores the identifier of the cluster of each resource pool it collects, which is also the identifier of
+// the deployment manager of the cluster, so the deployment manager of the pool containing the resource is found by
+// identifier.
+func (r *ResourceServer) GetResourceReferences(ctx context.Context, request api.GetResourceReferencesRequestObject) (api.GetResourceReferencesResponseObject, error) {
+	record, err := r.Repo.GetResource(ctx, request.ResourceId)
+	if errors.Is(err, utils.ErrNotFound) {
+		return api.GetResourceReferences404ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"resourceId": request.ResourceId.String(),
+			},
+			Detail: "requested resource not found",
+			Status: http.StatusNotFound,
+		}, nil
+	} else if err != nil {
+		return api.GetResourceReferences500ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"resourceId": request.ResourceId.String(),
+			},
+			Detail: err.Error(),
+			Status: http.StatusInternalServerError,
+		}, nil
+	}
+
+	references := []api.ResourceReference{}
+	pool, err := r.Repo.GetResourcePool(ctx, record.ResourcePoolID)
+	if errors.Is(err, utils.ErrNotFound) {
+		// The pool may have been removed by the collector before the resources it contained
+		return api.GetResourceReferences200JSONResponse{
+			ResourceId: request.ResourceId,
+			References: references,
+		}, nil
+	} else if err != nil {
+		return api.GetResourceReferences500ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"resourceId":     request.ResourceId.String(),
+				"resourcePoolId": record.ResourcePoolID.String(),
+			},
+			Detail: err.Error(),
+			Status: http.StatusInternalServerError,
+		}, nil
+	}
+	references = append(references, api.ResourceReference{
+		ObjectType:   api.ResourceReferenceObjectTypeResourcePool,
+		ObjectId:     pool.ResourcePoolID,
+		Name:         pool.Name,
+		Relationship: api.Contains,
+	})
+
+	manager, err := r.getResourcePoolDeploymentManager(ctx, pool)
+	if err != nil {
+		return api.GetResourceReferences500ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"resourceId":     request.ResourceId.String(),
+				"resourcePoolId": record.ResourcePoolID.String(),
+			},
+			Detail: err.Error(),
+			Status: http.StatusInternalServerError,
+		}, nil
+	}
+	if manager != nil {
+		references = append(references, api.ResourceReference{
+			ObjectType:   api.ResourceReferenceObjectTypeDeploymentManager,
+			ObjectId:     manager.DeploymentManagerID,
+			Name:         manager.Name,
+			Relationship: api.Manages,
+		})
+	}
+
+	return api.GetResourceReferences200JSONResponse{
+		ResourceId: request.ResourceId,
+		References: references,
+	}, nil
+}
+
+// getResourcePoolDeploymentManager returns the deployment manager of the cluster a resource pool is collected from,
+// or nil if the resource pool is not collected from ACM or its cluster has no deployment manager.
+func (r *ResourceServer) getResourcePoolDeploymentManager(ctx context.Context,
+	pool *models.ResourcePool) (*models.DeploymentManager, error) {
+	dataSource, err := r.Repo.GetDataSourceByName(ctx, collector.ACMDataSourceName)
+	if errors.Is(err, utils.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get the %s data source: %w", collector.ACMDataSourceName, err)
+	}
+	if dataSource.DataSourceID == nil || *dataSource.DataSourceID != pool.DataSourceID {
+		return nil, nil
+	}
+
+	clusterID, err := uuid.Parse(pool.Description)
+	if err != nil {
+		// The cluster has no clusterID label
+		return nil, nil
+	}
+	manager, err := r.Repo.GetDeploymentManager(ctx, clusterID)
+	if errors.Is(err, utils.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get deployment manager %s: %w", clusterID, err)
+	}
+	return manager, nil
+}
+
 // GetResourceTypes receives the API request to this endpoint, executes the request, and responds appropriately
 func (r *ResourceServer) GetResourceTypes(ctx context.Context, request api.GetResourceTypesRequestObject) (api.GetResourceTypesResponseObject, error) {
 	records, err := r.Repo.GetResourceTypes(ctx)
@@ -437,3 +899,236 @@ func (r *ResourceServer) GetResourceType(ctx context.Context, request api.GetRes
 	object := models.ResourceTypeToModel(record)
 	return api.GetResourceType200JSONResponse(object), nil
 }
+
+// getImportDataSourceID returns the identifier of the data source used for imported resources, creating it if needed
+func (r *ResourceServer) getImportDataSourceID(ctx context.Context) (uuid.UUID, error) {
+	record, err := r.Repo.GetDataSourceByName(ctx, importDataSourceName)
+	if errors.Is(err, utils.ErrNotFound) {
+		record, err = r.Repo.CreateDataSource(ctx, &models2.DataSource{
+			Name: importDataSourceName,
+		})
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("failed to create data source %q: %w", importDataSourceName, err)
+		}
+	} else if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get data source %q: %w", importDataSourceName, err)
+	}
+	return *record.DataSourceID, nil
+}
+
+// validateImportItem validates a resource before accepting it for import
+func (r *ResourceServer) validateImportItem(ctx context.Context, record *models.Resource, seen map[uuid.UUID]int) error {
+	if strings.TrimSpace(record.Description) == "" {
+		return fmt.Errorf("description must not be empty")
+	}
+
+	if index, found := seen[record.ResourceID]; found {
+		return fmt.Errorf("resourceId '%s' is duplicated by item %d", record.ResourceID, index)
+	}
+
+	exists, err := r.Repo.ResourceTypeExists(ctx, record.ResourceTypeID)
+	if err != nil {
+		return fmt.Errorf("failed to check resourceTypeId '%s': %w", record.ResourceTypeID, err)
+	}
+	if !exists {
+		return fmt.Errorf("resourceTypeId '%s' not found", record.ResourceTypeID)
+	}
+
+	exists, err = r.Repo.ResourceExists(ctx, record.ResourceID)
+	if err != nil {
+		return fmt.Errorf("failed to check resourceId '%s': %w", record.ResourceID, err)
+	}
+	if exists {
+		return fmt.Errorf("resourceId '%s' already exists", record.ResourceID)
+	}
+
+	return nil
+}
+
+// ImportResources receives the API request to this endpoint, executes the request, and responds appropriately
+func (r *ResourceServer) ImportResources(ctx context.Context, request api.ImportResourcesRequestObject) (api.ImportResourcesResponseObject, error) {
+	// First, find the pool
+	if exists, err := r.Repo.ResourcePoolExists(ctx, request.ResourcePoolId); err == nil && !exists {
+		return api.ImportResources404ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"resourcePoolId": request.ResourcePoolId.String(),
+			},
+			Detail: "requested resource pool not found",
+			Status: http.StatusNotFound,
+		}, nil
+	} else if err != nil {
+		return api.ImportResources500ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"resourcePoolId": request.ResourcePoolId.String(),
+			},
+			Detail: err.Error(),
+			Status: http.StatusInternalServerError,
+		}, nil
+	}
+
+	dataSourceID, err := r.getImportDataSourceID(ctx)
+	if err != nil {
+		return api.ImportResources500ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"resourcePoolId": request.ResourcePoolId.String(),
+			},
+			Detail: err.Error(),
+			Status: http.StatusInternalServerError,
+		}, nil
+	}
+
+	// Validate each item individually so that invalid items do not prevent the others from being imported
+	results := make([]api.ResourceImportItemResult, len(request.Body.Resources))
+	seen := make(map[uuid.UUID]int)
+	var pending []pendingImport
+	for i, item := range request.Body.Resources {
+		results[i].Index = i
+		record := models.ResourceFromImportItem(&item, request.ResourcePoolId, dataSourceID)
+		if err := r.validateImportItem(ctx, record, seen); err != nil {
+			message := err.Error()
+			results[i].Error = &message
+			continue
+		}
+		seen[record.ResourceID] = i
+		results[i].ResourceId = &record.ResourceID
+		pending = append(pending, pendingImport{index: i, record: *record})
+	}
+
+	// Persist the valid items with one transaction per batch
+	batchSize := r.Config.ImportBatchSize
+	if batchSize <= 0 {
+		batchSize = utils2.DefaultImportBatchSize
+	}
+	for start := 0; start < len(pending); start += batchSize {
+		batch := pending[start:min(start+batchSize, len(pending))]
+		records := make([]models.Resource, len(batch))
+		for i, item := range batch {
+			records[i] = item.record
+		}
+
+		dataChangeEvents, err := r.Repo.ImportResources(ctx, records)
+		if err != nil {
+			slog.Error("error importing resources", "resourcePoolId", request.ResourcePoolId, "error", err.Error())
+			message := fmt.Sprintf("failed to persist batch: %s", err.Error())
+			for _, item := range batch {
+				results[item.index].Error = &message
+			}
+			continue
+		}
+
+		for _, item := range batch {
+			results[item.index].Success = true
+		}
+		for _, dataChangeEvent := range dataChangeEvents {
+			r.NotificationHandler.Notify(ctx, models.DataChangeEventToNotification(&dataChangeEvent))
+		}
+	}
+
+	response := api.ResourceImportResult{
+		Results: results,
+	}
+	for i := range results {
+		if results[i].Success {
+			response.Succeeded++
+		} else {
+			results[i].ResourceId = nil
+			response.Failed++
+		}
+	}
+
+	return api.ImportResources200JSONResponse(response), nil
+}
+
+// refreshJobToModel converts a collector refresh job to its API representation
+func refreshJobToModel(job collector.RefreshJob) api.RefreshJob {
+	result := api.RefreshJob{
+		RefreshJobId: job.ID,
+		Status:       api.RefreshJobStatus(job.Status),
+		CreatedAt:    job.CreatedAt,
+		StartedAt:    job.StartedAt,
+		CompletedAt:  job.CompletedAt,
+	}
+	if job.Error != "" {
+		result.Error = &job.Error
+	}
+	return result
+}
+
+// TriggerRefresh receives the API request to this endpoint, executes the request, and responds appropriately
+func (r *ResourceServer) TriggerRefresh(ctx context.Context, request api.TriggerRefreshRequestObject) (api.TriggerRefreshResponseObject, error) {
+	if r.RefreshHandler == nil {
+		return api.TriggerRefresh500ApplicationProblemPlusJSONResponse{
+			Detail: "inventory refresh is not supported",
+			Status: http.StatusInternalServerError,
+		}, nil
+	}
+
+	job := r.RefreshHandler.Refresh()
+	return api.TriggerRefresh202JSONResponse(refreshJobToModel(job)), nil
+}
+
+// GetRefreshJob receives the API request to this endpoint, executes the request, and responds appropriately
+func (r *ResourceServer) GetRefreshJob(ctx context.Context, request api.GetRefreshJobRequestObject) (api.GetRefreshJobResponseObject, error) {
+	if r.RefreshHandler == nil {
+		return api.GetRefreshJob500ApplicationProblemPlusJSONResponse{
+			Detail: "inventory refresh is not supported",
+			Status: http.StatusInternalServerError,
+		}, nil
+	}
+
+	job, found := r.RefreshHandler.GetRefreshJob(request.RefreshJobId)
+	if !found {
+		return api.GetRefreshJob404ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"refreshJobId": request.RefreshJobId.String(),
+			},
+			Detail: "requested refresh job not found",
+			Status: http.StatusNotFound,
+		}, nil
+	}
+
+	return api.GetRefreshJob200JSONResponse(refreshJobToModel(job)), nil
+}
+
+// GetLogLevel receives the API request to this endpoint, executes the request, and responds appropriately
+func (r *ResourceServer) GetLogLevel(ctx context.Context, request api.GetLogLevelRequestObject) (api.GetLogLevelResponseObject, error) {
+	if r.LogLevel == nil {
+		return api.GetLogLevel500ApplicationProblemPlusJSONResponse{
+			Detail: "changing the logging level is not supported",
+			Status: http.StatusInternalServerError,
+		}, nil
+	}
+
+	return api.GetLogLevel200JSONResponse(logLevelToModel(r.LogLevel.Level())), nil
+}
+
+// UpdateLogLevel receives the API request to this endpoint, executes the request, and responds appropriately
+func (r *ResourceServer) UpdateLogLevel(ctx context.Context, request api.UpdateLogLevelRequestObject) (api.UpdateLogLevelResponseObject, error) {
+	if r.LogLevel == nil {
+		return api.UpdateLogLevel500ApplicationProblemPlusJSONResponse{
+			Detail: "changing the logging level is not supported",
+			Status: http.StatusInternalServerError,
+		}, nil
+	}
+
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(request.Body.Level)); err != nil {
+		return api.UpdateLogLevel400ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"level": string(request.Body.Level),
+			},
+			Detail: "invalid logging level",
+			Status: http.StatusBadRequest,
+		}, nil
+	}
+
+	previous := r.LogLevel.Level()
+	r.LogLevel.Set(level)
+	slog.Info("Logging level changed", "previous", previous, "level", level)
+	return api.UpdateLogLevel200JSONResponse(logLevelToModel(r.LogLevel.Level())), nil
+}
+
+// logLevelToModel converts a logging level to its API representation
+func logLevelToModel(level slog.Level) api.LogLevel {
+	return api.LogLevel{Level: api.LogLevelLevel(strings.ToLower(level.String()))}
+}
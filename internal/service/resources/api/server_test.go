@@ -0,0 +1,945 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	commonapi "github.com/openshift-kni/oran-o2ims/internal/service/common/api"
+	models2 "github.com/openshift-kni/oran-o2ims/internal/service/common/db/models"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/notifier"
+	commonutils "github.com/openshift-kni/oran-o2ims/internal/service/common/utils"
+	api "github.com/openshift-kni/oran-o2ims/internal/service/resources/api/generated"
+	"github.com/openshift-kni/oran-o2ims/internal/service/resources/collector"
+	"github.com/openshift-kni/oran-o2ims/internal/service/resources/db/models"
+	"github.com/openshift-kni/oran-o2ims/internal/service/resources/db/repo"
+	"github.com/openshift-kni/oran-o2ims/internal/service/resources/utils"
+	"github.com/openshift-kni/oran-o2ims/internal/version"
+)
+
+// fakeRepository is an in-memory implementation of the repository used by the resource server.  It applies the
+// filters and pages the same way as the database, and records the filters it was given.
+type fakeRepository struct {
+	managers        []models.DeploymentManager
+	resourceTypes   []models.ResourceType
+	pools           []models.ResourcePool
+	resources       []models.Resource
+	subscriptions   map[uuid.UUID]models2.Subscription
+	dataSources     map[string]models2.DataSource
+	poolFilters     []repo.ResourcePoolFilter
+	resourceFilters []repo.ResourceFilter
+}
+
+func newFakeRepository() *fakeRepository {
+	return &fakeRepository{
+		subscriptions: map[uuid.UUID]models2.Subscription{},
+		dataSources:   map[string]models2.DataSource{},
+	}
+}
+
+// fakeFind returns the tuple with the given primary key, or ErrNotFound
+func fakeFind[T any](records []T, key func(T) uuid.UUID, id uuid.UUID) (*T, error) {
+	index := slices.IndexFunc(records, func(record T) bool { return key(record) == id })
+	if index < 0 {
+		return nil, commonutils.ErrNotFound
+	}
+	record := records[index]
+	return &record, nil
+}
+
+// matchesIDs mirrors the database filters: nil identifiers match any tuple whereas an empty list matches none
+func matchesIDs(ids []uuid.UUID, id uuid.UUID) bool {
+	return ids == nil || slices.Contains(ids, id)
+}
+
+// fakePage orders the tuples by primary key and returns the requested page, and whether more tuples follow it
+func fakePage[T any](records []T, key func(T) uuid.UUID, page commonutils.Page) ([]T, bool) {
+	records = slices.Clone(records)
+	slices.SortFunc(records, func(a, b T) int { return strings.Compare(key(a).String(), key(b).String()) })
+	if page.After != nil {
+		records = slices.DeleteFunc(records, func(record T) bool { return key(record).String() <= page.After.String() })
+	}
+	if page.Limit > 0 && len(records) > page.Limit {
+		return records[:page.Limit], true
+	}
+	return records, false
+}
+
+// The primary keys of the tuples
+func managerKey(manager models.DeploymentManager) uuid.UUID {
+	return manager.DeploymentManagerID
+}
+
+func resourceTypeKey(resourceType models.ResourceType) uuid.UUID {
+	return resourceType.ResourceTypeID
+}
+
+func poolKey(pool models.ResourcePool) uuid.UUID {
+	return pool.ResourcePoolID
+}
+
+func resourceKey(resource models.Resource) uuid.UUID {
+	return resource.ResourceID
+}
+
+func (f *fakeRepository) GetDeploymentManagers(_ context.Context) ([]models.DeploymentManager, error) {
+	return f.managers, nil
+}
+
+func (f *fakeRepository) GetDeploymentManager(_ context.Context, id uuid.UUID) (*models.DeploymentManager, error) {
+	return fakeFind(f.managers, managerKey, id)
+}
+
+func (f *fakeRepository) GetResourceTypes(_ context.Context) ([]models.ResourceType, error) {
+	return f.resourceTypes, nil
+}
+
+func (f *fakeRepository) GetResourceType(_ context.Context, id uuid.UUID) (*models.ResourceType, error) {
+	return fakeFind(f.resourceTypes, resourceTypeKey, id)
+}
+
+func (f *fakeRepository) ResourceTypeExists(_ context.Context, id uuid.UUID) (bool, error) {
+	_, err := fakeFind(f.resourceTypes, resourceTypeKey, id)
+	return err == nil, nil
+}
+
+func (f *fakeRepository) searchPools(filter repo.ResourcePoolFilter) []models.ResourcePool {
+	f.poolFilters = append(f.poolFilters, filter)
+	var result []models.ResourcePool
+	for _, pool := range f.pools {
+		if matchesIDs(filter.ResourcePoolIDs, pool.ResourcePoolID) {
+			result = append(result, pool)
+		}
+	}
+	return result
+}
+
+func (f *fakeRepository) SearchResourcePools(_ context.Context, filter repo.ResourcePoolFilter,
+	page commonutils.Page) ([]models.ResourcePool, bool, error) {
+	records, more := fakePage(f.searchPools(filter), poolKey, page)
+	return records, more, nil
+}
+
+func (f *fakeRepository) CountResourcePools(_ context.Context, filter repo.ResourcePoolFilter) (int, error) {
+	return len(f.searchPools(filter)), nil
+}
+
+func (f *fakeRepository) GetResourcePool(_ context.Context, id uuid.UUID) (*models.ResourcePool, error) {
+	return fakeFind(f.pools, poolKey, id)
+}
+
+func (f *fakeRepository) ResourcePoolExists(_ context.Context, id uuid.UUID) (bool, error) {
+	_, err := fakeFind(f.pools, poolKey, id)
+	return err == nil, nil
+}
+
+func (f *fakeRepository) searchResources(id uuid.UUID, filter repo.ResourceFilter) []models.Resource {
+	f.resourceFilters = append(f.resourceFilters, filter)
+	var result []models.Resource
+	for _, resource := range f.resources {
+		if resource.ResourcePoolID == id && matchesIDs(filter.ResourcePoolIDs, resource.ResourcePoolID) &&
+			matchesIDs(filter.ResourceTypeIDs, resource.ResourceTypeID) {
+			result = append(result, resource)
+		}
+	}
+	return result
+}
+
+func (f *fakeRepository) SearchResourcePoolResources(_ context.Context, id uuid.UUID, filter repo.ResourceFilter,
+	page commonutils.Page) ([]models.Resource, bool, error) {
+	records, more := fakePage(f.searchResources(id, filter), resourceKey, page)
+	return records, more, nil
+}
+
+func (f *fakeRepository) CountResourcePoolResources(_ context.Context, id uuid.UUID, filter repo.ResourceFilter) (int, error) {
+	return len(f.searchResources(id, filter)), nil
+}
+
+func (f *fakeRepository) GetResource(_ context.Context, id uuid.UUID) (*models.Resource, error) {
+	return fakeFind(f.resources, resourceKey, id)
+}
+
+func (f *fakeRepository) ResourceExists(_ context.Context, id uuid.UUID) (bool, error) {
+	_, err := fakeFind(f.resources, resourceKey, id)
+	return err == nil, nil
+}
+
+func (f *fakeRepository) ImportResources(_ context.Context, resources []models.Resource) ([]models2.DataChangeEvent, error) {
+	f.resources = append(f.resources, resources...)
+	return nil, nil
+}
+
+func (f *fakeRepository) GetSubscriptions(_ context.Context) ([]models2.Subscription, error) {
+	result := make([]models2.Subscription, 0, len(f.subscriptions))
+	for _, subscription := range f.subscriptions {
+		result = append(result, subscription)
+	}
+	return result, nil
+}
+
+func (f *fakeRepository) GetSubscription(_ context.Context, id uuid.UUID) (*models2.Subscription, error) {
+	subscription, found := f.subscriptions[id]
+	if !found {
+		return nil, commonutils.ErrNotFound
+	}
+	return &subscription, nil
+}
+
+func (f *fakeRepository) CreateSubscription(_ context.Context, subscription *models2.Subscription) (*models2.Subscription, error) {
+	for _, existing := range f.subscriptions {
+		if existing.Callback == subscription.Callback {
+			return nil, errors.New(`duplicate key value violates unique constraint "unique_callback"`)
+		}
+	}
+	f.subscriptions[*subscription.SubscriptionID] = *subscription
+	return subscription, nil
+}
+
+func (f *fakeRepository) UpdateSubscription(_ context.Context, subscription *models2.Subscription) (*models2.Subscription, error) {
+	f.subscriptions[*subscription.SubscriptionID] = *subscription
+	return subscription, nil
+}
+
+func (f *fakeRepository) DeleteSubscription(_ context.Context, id uuid.UUID) (int64, error) {
+	if _, found := f.subscriptions[id]; !found {
+		return 0, nil
+	}
+	delete(f.subscriptions, id)
+	return 1, nil
+}
+
+func (f *fakeRepository) GetDataSourceByName(_ context.Context, name string) (*models2.DataSource, error) {
+	dataSource, found := f.dataSources[name]
+	if !found {
+		return nil, commonutils.ErrNotFound
+	}
+	return &dataSource, nil
+}
+
+func (f *fakeRepository) CreateDataSource(_ context.Context, dataSource *models2.DataSource) (*models2.DataSource, error) {
+	id := uuid.New()
+	dataSource.DataSourceID = &id
+	f.dataSources[dataSource.Name] = *dataSource
+	return dataSource, nil
+}
+
+var _ = Describe("Resource import", func() {
+	var (
+		ctx          context.Context
+		repository   *fakeRepository
+		server       *ResourceServer
+		poolID       uuid.UUID
+		resourceType uuid.UUID
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		poolID = uuid.New()
+		resourceType = uuid.New()
+		repository = newFakeRepository()
+		repository.pools = []models.ResourcePool{{ResourcePoolID: poolID}}
+		repository.resourceTypes = []models.ResourceType{{ResourceTypeID: resourceType}}
+		server = &ResourceServer{Config: &ResourceServerConfig{}, Repo: repository}
+	})
+
+	It("imports the valid items of a mixed batch and reports the invalid ones", func() {
+		validID := uuid.New()
+		invalidID := uuid.New()
+		response, err := server.ImportResources(ctx, api.ImportResourcesRequestObject{
+			ResourcePoolId: poolID,
+			Body: &api.ImportResourcesJSONRequestBody{
+				Resources: []api.ResourceImportItem{
+					{
+						ResourceId:     &validID,
+						ResourceTypeId: resourceType,
+						Description:    "server-1",
+					},
+					{
+						ResourceId:     &invalidID,
+						ResourceTypeId: uuid.New(),
+						Description:    "server-2",
+					},
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		rec := httptest.NewRecorder()
+		Expect(response.VisitImportResourcesResponse(rec)).To(Succeed())
+		Expect(rec.Code).To(Equal(http.StatusOK))
+
+		result := api.ResourceImportResult{}
+		Expect(json.Unmarshal(rec.Body.Bytes(), &result)).To(Succeed())
+		Expect(result.Succeeded).To(Equal(1))
+		Expect(result.Failed).To(Equal(1))
+		Expect(result.Results).To(HaveLen(2))
+
+		Expect(result.Results[0].Index).To(Equal(0))
+		Expect(result.Results[0].Success).To(BeTrue())
+		Expect(result.Results[0].ResourceId).To(Equal(&validID))
+		Expect(result.Results[0].Error).To(BeNil())
+
+		Expect(result.Results[1].Index).To(Equal(1))
+		Expect(result.Results[1].Success).To(BeFalse())
+		Expect(result.Results[1].ResourceId).To(BeNil())
+		Expect(*result.Results[1].Error).To(ContainSubstring("not found"))
+
+		imported, err := repository.GetResource(ctx, validID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(imported.ResourcePoolID).To(Equal(poolID))
+		Expect(repository.ResourceExists(ctx, invalidID)).To(BeFalse())
+	})
+
+	It("rejects an import into an unknown resource pool", func() {
+		response, err := server.ImportResources(ctx, api.ImportResourcesRequestObject{
+			ResourcePoolId: uuid.New(),
+			Body:           &api.ImportResourcesJSONRequestBody{},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		rec := httptest.NewRecorder()
+		Expect(response.VisitImportResourcesResponse(rec)).To(Succeed())
+		Expect(rec.Code).To(Equal(http.StatusNotFound))
+	})
+})
+
+var _ = Describe("Resource lists", func() {
+	var (
+		ctx        context.Context
+		repository *fakeRepository
+		server     *ResourceServer
+		poolID     uuid.UUID
+		serverType uuid.UUID
+	)
+
+	// nextPageMarker extracts the next page marker from the Link header of a response
+	nextPageMarker := func(header http.Header) string {
+		link := header.Get("Link")
+		if link == "" {
+			return ""
+		}
+		Expect(link).To(HaveSuffix(`>; rel="next"`))
+		target, err := url.Parse(strings.TrimSuffix(strings.TrimPrefix(link, "<"), `>; rel="next"`))
+		Expect(err).ToNot(HaveOccurred())
+		return target.Query().Get("nextpage_opaque_marker")
+	}
+
+	// listPools submits the request and returns the recorded response
+	listPools := func(params api.GetResourcePoolsParams) *httptest.ResponseRecorder {
+		ctx := commonapi.RequestPathIntoContext(ctx, utils.BaseInventoryURL+"/resourcePools")
+		response, err := server.GetResourcePools(ctx, api.GetResourcePoolsRequestObject{Params: params})
+		Expect(err).ToNot(HaveOccurred())
+		rec := httptest.NewRecorder()
+		Expect(response.VisitGetResourcePoolsResponse(rec)).To(Succeed())
+		return rec
+	}
+
+	// listResources submits the request and returns the recorded response
+	listResources := func(params api.GetResourcesParams) *httptest.ResponseRecorder {
+		ctx := commonapi.RequestPathIntoContext(ctx, utils.BaseInventoryURL+"/resourcePools/"+poolID.String()+
+			"/resources")
+		response, err := server.GetResources(ctx, api.GetResourcesRequestObject{
+			ResourcePoolId: poolID,
+			Params:         params,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		rec := httptest.NewRecorder()
+		Expect(response.VisitGetResourcesResponse(rec)).To(Succeed())
+		return rec
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		poolID = uuid.New()
+		serverType = uuid.New()
+		repository = newFakeRepository()
+		for i := 0; i < 5; i++ {
+			repository.pools = append(repository.pools, models.ResourcePool{ResourcePoolID: uuid.New()})
+			resourceType := serverType
+			if i%2 == 1 {
+				resourceType = uuid.New()
+			}
+			repository.resources = append(repository.resources, models.Resource{
+				ResourceID:     uuid.New(),
+				ResourcePoolID: poolID,
+				ResourceTypeID: resourceType,
+			})
+		}
+		repository.pools = append(repository.pools, models.ResourcePool{ResourcePoolID: poolID})
+		server = &ResourceServer{Config: &ResourceServerConfig{}, Repo: repository}
+	})
+
+	It("pages through the resource pools", func() {
+		var ids []uuid.UUID
+		params := api.GetResourcePoolsParams{Fields: ptr.To("name"), Limit: ptr.To(4)}
+		for page := 0; ; page++ {
+			rec := listPools(params)
+			Expect(rec.Code).To(Equal(http.StatusOK))
+			Expect(rec.Header().Get("X-Total-Count")).To(Equal("6"))
+
+			var pools []api.ResourcePool
+			Expect(json.Unmarshal(rec.Body.Bytes(), &pools)).To(Succeed())
+			for _, pool := range pools {
+				ids = append(ids, pool.ResourcePoolId)
+			}
+
+			marker := nextPageMarker(rec.Header())
+			if page == 0 {
+				Expect(pools).To(HaveLen(4))
+				Expect(rec.Header().Get("Link")).To(HavePrefix("<" + utils.BaseInventoryURL + "/resourcePools?"))
+				Expect(rec.Header().Get("Link")).To(ContainSubstring("fields=name"))
+				Expect(marker).ToNot(BeEmpty())
+			} else {
+				Expect(pools).To(HaveLen(2))
+				Expect(marker).To(BeEmpty())
+				break
+			}
+			params.NextpageOpaqueMarker = &marker
+		}
+
+		Expect(ids).To(HaveLen(6))
+		Expect(ids).To(ConsistOf(poolID, repository.pools[0].ResourcePoolID, repository.pools[1].ResourcePoolID,
+			repository.pools[2].ResourcePoolID, repository.pools[3].ResourcePoolID, repository.pools[4].ResourcePoolID))
+	})
+
+	It("returns an empty page when there are no more resource pools", func() {
+		repository.pools = nil
+		rec := listPools(api.GetResourcePoolsParams{Limit: ptr.To(10)})
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Body.String()).To(MatchJSON("[]"))
+		Expect(rec.Header().Get("X-Total-Count")).To(Equal("0"))
+		Expect(nextPageMarker(rec.Header())).To(BeEmpty())
+	})
+
+	It("pushes the resource pool filter down to the repository", func() {
+		filter := "(in,resourcePoolId," + poolID.String() + "," + uuid.NewString() + ");(eq,name,pool)"
+		rec := listPools(api.GetResourcePoolsParams{Filter: &filter})
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Header().Get("X-Total-Count")).To(Equal("1"))
+		Expect(repository.poolFilters).ToNot(BeEmpty())
+		Expect(repository.poolFilters[0].ResourcePoolIDs).To(HaveLen(2))
+		Expect(repository.poolFilters[0].ResourcePoolIDs).To(ContainElement(poolID))
+	})
+
+	It("rejects an invalid next page marker", func() {
+		rec := listPools(api.GetResourcePoolsParams{NextpageOpaqueMarker: ptr.To("not a marker")})
+		Expect(rec.Code).To(Equal(http.StatusBadRequest))
+		Expect(rec.Body.String()).To(ContainSubstring("invalid next page marker"))
+
+		rec = listResources(api.GetResourcesParams{NextpageOpaqueMarker: ptr.To("not a marker")})
+		Expect(rec.Code).To(Equal(http.StatusBadRequest))
+		Expect(rec.Body.String()).To(ContainSubstring("invalid next page marker"))
+	})
+
+	It("rejects paging combined with search criteria that are not applied by the repository", func() {
+		filter := "(eq,resourcePoolId," + poolID.String() + ");(eq,name,pool)"
+		rec := listPools(api.GetResourcePoolsParams{Filter: &filter, Limit: ptr.To(2)})
+		Expect(rec.Code).To(Equal(http.StatusBadRequest))
+		Expect(rec.Body.String()).To(ContainSubstring("can only be combined with the eq and in search criteria"))
+
+		marker := commonapi.EncodeNextPageMarker(poolID)
+		filter = "(eq,resourceTypeId," + serverType.String() + ");(neq,description,node)"
+		rec = listResources(api.GetResourcesParams{Filter: &filter, NextpageOpaqueMarker: &marker})
+		Expect(rec.Code).To(Equal(http.StatusBadRequest))
+		Expect(repository.poolFilters).To(BeEmpty())
+		Expect(repository.resourceFilters).To(BeEmpty())
+	})
+
+	It("rejects a filter with an invalid identifier", func() {
+		rec := listResources(api.GetResourcesParams{Filter: ptr.To("(eq,resourceTypeId,server)")})
+		Expect(rec.Code).To(Equal(http.StatusBadRequest))
+		Expect(repository.resourceFilters).To(BeEmpty())
+	})
+
+	It("pages through the resources of a type", func() {
+		filter := "(eq,resourceTypeId," + serverType.String() + ")"
+		rec := listResources(api.GetResourcesParams{Filter: &filter, Limit: ptr.To(2)})
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Header().Get("X-Total-Count")).To(Equal("3"))
+		Expect(rec.Header().Get("Link")).To(HavePrefix(
+			"<" + utils.BaseInventoryURL + "/resourcePools/" + poolID.String() + "/resources?"))
+
+		var resources []api.Resource
+		Expect(json.Unmarshal(rec.Body.Bytes(), &resources)).To(Succeed())
+		Expect(resources).To(HaveLen(2))
+
+		marker := nextPageMarker(rec.Header())
+		rec = listResources(api.GetResourcesParams{Filter: &filter, Limit: ptr.To(2), NextpageOpaqueMarker: &marker})
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(json.Unmarshal(rec.Body.Bytes(), &resources)).To(Succeed())
+		Expect(resources).To(HaveLen(1))
+		Expect(resources[0].ResourceTypeId).To(Equal(serverType))
+		Expect(nextPageMarker(rec.Header())).To(BeEmpty())
+
+		Expect(repository.resourceFilters[0].ResourceTypeIDs).To(Equal([]uuid.UUID{serverType}))
+		Expect(repository.resourceFilters[0].ResourcePoolIDs).To(BeNil())
+	})
+
+	It("rejects listing the resources of an unknown resource pool", func() {
+		poolID = uuid.New()
+		rec := listResources(api.GetResourcesParams{})
+		Expect(rec.Code).To(Equal(http.StatusNotFound))
+	})
+})
+
+// fakeSubscriptionEventHandler records the subscription events signaled to the notifier
+type fakeSubscriptionEventHandler struct {
+	events []notifier.SubscriptionEvent
+}
+
+func (f *fakeSubscriptionEventHandler) SubscriptionEvent(_ context.Context, event *notifier.SubscriptionEvent) {
+	f.events = append(f.events, *event)
+}
+
+var _ = Describe("Subscription batch", func() {
+	var (
+		ctx        context.Context
+		repository *fakeRepository
+		handler    *fakeSubscriptionEventHandler
+		server     *ResourceServer
+	)
+
+	// runBatch submits the operations and returns the HTTP status and the decoded result
+	runBatch := func(operations []api.SubscriptionBatchOperation) (int, api.SubscriptionBatchResult) {
+		response, err := server.BatchSubscriptions(ctx, api.BatchSubscriptionsRequestObject{
+			Body: &api.BatchSubscriptionsJSONRequestBody{
+				Operations: operations,
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		rec := httptest.NewRecorder()
+		Expect(response.VisitBatchSubscriptionsResponse(rec)).To(Succeed())
+
+		result := api.SubscriptionBatchResult{}
+		if rec.Code == http.StatusOK {
+			Expect(json.Unmarshal(rec.Body.Bytes(), &result)).To(Succeed())
+		}
+		return rec.Code, result
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		repository = newFakeRepository()
+		handler = &fakeSubscriptionEventHandler{}
+		server = &ResourceServer{
+			Config:                   &ResourceServerConfig{},
+			Repo:                     repository,
+			SubscriptionEventHandler: handler,
+		}
+	})
+
+	It("creates the valid subscriptions of a mixed batch and reports the invalid ones", func() {
+		existingID := uuid.New()
+		repository.subscriptions[existingID] = models2.Subscription{
+			SubscriptionID: &existingID,
+			Callback:       "https://smo.example.com/existing",
+		}
+
+		status, result := runBatch([]api.SubscriptionBatchOperation{
+			{
+				Operation:    api.Create,
+				Subscription: &api.Subscription{Callback: "https://smo.example.com/one"},
+			},
+			{
+				Operation:    api.Create,
+				Subscription: &api.Subscription{Callback: "not a url"},
+			},
+			{
+				Operation: api.Create,
+			},
+			{
+				Operation:    api.Create,
+				Subscription: &api.Subscription{Callback: "https://smo.example.com/one"},
+			},
+			{
+				Operation:    api.Create,
+				Subscription: &api.Subscription{Callback: "https://smo.example.com/existing"},
+			},
+			{
+				Operation:    api.Create,
+				Subscription: &api.Subscription{Callback: "https://smo.example.com/two"},
+			},
+		})
+		Expect(status).To(Equal(http.StatusOK))
+		Expect(result.Succeeded).To(Equal(2))
+		Expect(result.Failed).To(Equal(4))
+		Expect(result.Results).To(HaveLen(6))
+
+		for i, item := range result.Results {
+			Expect(item.Index).To(Equal(i))
+			Expect(item.Operation).To(Equal(string(api.Create)))
+		}
+
+		for _, i := range []int{0, 5} {
+			Expect(result.Results[i].Success).To(BeTrue())
+			Expect(result.Results[i].Error).To(BeNil())
+			Expect(result.Results[i].SubscriptionId).ToNot(BeNil())
+			Expect(result.Results[i].Subscription).ToNot(BeNil())
+			Expect(repository.subscriptions).To(HaveKey(*result.Results[i].SubscriptionId))
+		}
+
+		Expect(result.Results[1].Success).To(BeFalse())
+		Expect(*result.Results[1].Error).To(ContainSubstring("invalid callback url"))
+		Expect(result.Results[2].Success).To(BeFalse())
+		Expect(*result.Results[2].Error).To(ContainSubstring("subscription is required"))
+		Expect(result.Results[3].Success).To(BeFalse())
+		Expect(*result.Results[3].Error).To(ContainSubstring("duplicated by item 0"))
+		Expect(result.Results[4].Success).To(BeFalse())
+		Expect(*result.Results[4].Error).To(Equal("callback value must be unique"))
+
+		Expect(repository.subscriptions).To(HaveLen(3))
+		Expect(handler.events).To(HaveLen(2))
+		for _, event := range handler.events {
+			Expect(event.Removed).To(BeFalse())
+		}
+	})
+
+	It("gets, updates, and deletes existing subscriptions", func() {
+		updatedID := uuid.New()
+		repository.subscriptions[updatedID] = models2.Subscription{
+			SubscriptionID: &updatedID,
+			Callback:       "https://smo.example.com/old",
+			EventCursor:    42,
+		}
+		deletedID := uuid.New()
+		repository.subscriptions[deletedID] = models2.Subscription{
+			SubscriptionID: &deletedID,
+			Callback:       "https://smo.example.com/deleted",
+		}
+		unknownID := uuid.New()
+
+		status, result := runBatch([]api.SubscriptionBatchOperation{
+			{
+				Operation:      api.Get,
+				SubscriptionId: &updatedID,
+			},
+			{
+				Operation:      api.Update,
+				SubscriptionId: &updatedID,
+				Subscription:   &api.Subscription{Callback: "https://smo.example.com/new"},
+			},
+			{
+				Operation:      api.Delete,
+				SubscriptionId: &deletedID,
+			},
+			{
+				Operation:      api.Delete,
+				SubscriptionId: &unknownID,
+			},
+			{
+				Operation:      api.Delete,
+				SubscriptionId: &updatedID,
+			},
+		})
+		Expect(status).To(Equal(http.StatusOK))
+		Expect(result.Succeeded).To(Equal(3))
+		Expect(result.Failed).To(Equal(2))
+
+		Expect(result.Results[0].Success).To(BeTrue())
+		Expect(result.Results[0].Subscription.Callback).To(Equal("https://smo.example.com/old"))
+		Expect(result.Results[1].Success).To(BeTrue())
+		Expect(result.Results[1].SubscriptionId).To(Equal(&updatedID))
+		Expect(result.Results[2].Success).To(BeTrue())
+		Expect(*result.Results[3].Error).To(Equal("requested subscription not found"))
+		Expect(*result.Results[4].Error).To(ContainSubstring("duplicated by item 1"))
+
+		Expect(repository.subscriptions).To(HaveLen(1))
+		Expect(repository.subscriptions[updatedID].Callback).To(Equal("https://smo.example.com/new"))
+		Expect(repository.subscriptions[updatedID].EventCursor).To(Equal(42))
+		Expect(handler.events).To(HaveLen(3))
+	})
+
+	It("rejects a batch exceeding the maximum size", func() {
+		operations := make([]api.SubscriptionBatchOperation, utils.MaxSubscriptionBatchSize+1)
+		for i := range operations {
+			operations[i] = api.SubscriptionBatchOperation{
+				Operation: api.Create,
+				Subscription: &api.Subscription{
+					Callback: "https://smo.example.com/" + strings.Repeat("x", i+1),
+				},
+			}
+		}
+
+		status, _ := runBatch(operations)
+		Expect(status).To(Equal(http.StatusBadRequest))
+		Expect(repository.subscriptions).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Resource references", func() {
+	var (
+		ctx        context.Context
+		repository *fakeRepository
+		server     *ResourceServer
+		pool1      models.ResourcePool
+		pool2      models.ResourcePool
+		acmID      uuid.UUID
+		manager1   models.DeploymentManager
+		manager2   models.DeploymentManager
+		node1      models.Resource
+		node2      models.Resource
+		orphan     models.Resource
+	)
+
+	// getReferences queries the references of a resource and returns the HTTP status and the decoded result
+	getReferences := func(id uuid.UUID) (int, api.ResourceReferences) {
+		response, err := server.GetResourceReferences(ctx, api.GetResourceReferencesRequestObject{
+			ResourceId: id,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		rec := httptest.NewRecorder()
+		Expect(response.VisitGetResourceReferencesResponse(rec)).To(Succeed())
+
+		result := api.ResourceReferences{}
+		if rec.Code == http.StatusOK {
+			Expect(json.Unmarshal(rec.Body.Bytes(), &result)).To(Succeed())
+		}
+		return rec.Code, result
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		// Two clusters, each with its own pool collected from ACM, and a node in each pool.  The last resource
+		// belongs to a pool that no longer exists.
+		acmID = uuid.New()
+		manager1 = models.DeploymentManager{DeploymentManagerID: uuid.New(), Name: "cluster-1"}
+		manager2 = models.DeploymentManager{DeploymentManagerID: uuid.New(), Name: "cluster-2"}
+		pool1 = models.ResourcePool{ResourcePoolID: uuid.New(), Name: "cluster-1",
+			Description: manager1.DeploymentManagerID.String(), DataSourceID: acmID}
+		pool2 = models.ResourcePool{ResourcePoolID: uuid.New(), Name: "cluster-2",
+			Description: manager2.DeploymentManagerID.String(), DataSourceID: acmID}
+		node1 = models.Resource{ResourceID: uuid.New(), ResourcePoolID: pool1.ResourcePoolID, Description: "node-1"}
+		node2 = models.Resource{ResourceID: uuid.New(), ResourcePoolID: pool2.ResourcePoolID, Description: "node-2"}
+		orphan = models.Resource{ResourceID: uuid.New(), ResourcePoolID: uuid.New(), Description: "node-3"}
+
+		repository = newFakeRepository()
+		repository.dataSources[collector.ACMDataSourceName] = models2.DataSource{
+			DataSourceID: &acmID,
+			Name:         collector.ACMDataSourceName,
+		}
+		repository.resources = []models.Resource{node1, node2, orphan}
+		repository.pools = []models.ResourcePool{pool1, pool2}
+		repository.managers = []models.DeploymentManager{manager1, manager2}
+		server = &ResourceServer{Config: &ResourceServerConfig{}, Repo: repository}
+	})
+
+	It("returns the pool and the cluster referencing each resource", func() {
+		code, result := getReferences(node1.ResourceID)
+		Expect(code).To(Equal(http.StatusOK))
+		Expect(result.ResourceId).To(Equal(node1.ResourceID))
+		Expect(result.References).To(Equal([]api.ResourceReference{
+			{
+				ObjectType:   api.ResourceReferenceObjectTypeResourcePool,
+				ObjectId:     pool1.ResourcePoolID,
+				Name:         "cluster-1",
+				Relationship: api.Contains,
+			},
+			{
+				ObjectType:   api.ResourceReferenceObjectTypeDeploymentManager,
+				ObjectId:     manager1.DeploymentManagerID,
+				Name:         "cluster-1",
+				Relationship: api.Manages,
+			},
+		}))
+
+		code, result = getReferences(node2.ResourceID)
+		Expect(code).To(Equal(http.StatusOK))
+		Expect(result.References).To(HaveLen(2))
+		Expect(result.References[0].ObjectId).To(Equal(pool2.ResourcePoolID))
+		Expect(result.References[1].ObjectId).To(Equal(manager2.DeploymentManagerID))
+	})
+
+	It("returns only the pool when no cluster is built from it", func() {
+		repository.managers = []models.DeploymentManager{manager2}
+
+		code, result := getReferences(node1.ResourceID)
+		Expect(code).To(Equal(http.StatusOK))
+		Expect(result.References).To(HaveLen(1))
+		Expect(result.References[0].ObjectType).To(Equal(api.ResourceReferenceObjectTypeResourcePool))
+	})
+
+	It("ignores the clusters sharing the name of a pool of another data source", func() {
+		pool := models.ResourcePool{ResourcePoolID: uuid.New(), Name: "cluster-1",
+			Description: manager1.DeploymentManagerID.String(), DataSourceID: uuid.New()}
+		node := models.Resource{ResourceID: uuid.New(), ResourcePoolID: pool.ResourcePoolID, Description: "node-4"}
+		repository.pools = append(repository.pools, pool)
+		repository.resources = append(repository.resources, node)
+
+		code, result := getReferences(node.ResourceID)
+		Expect(code).To(Equal(http.StatusOK))
+		Expect(result.References).To(HaveLen(1))
+		Expect(result.References[0].ObjectId).To(Equal(pool.ResourcePoolID))
+	})
+
+	It("identifies the cluster of a pool regardless of its name", func() {
+		repository.managers[0].Name = "renamed"
+
+		code, result := getReferences(node1.ResourceID)
+		Expect(code).To(Equal(http.StatusOK))
+		Expect(result.References).To(HaveLen(2))
+		Expect(result.References[1].ObjectId).To(Equal(manager1.DeploymentManagerID))
+		Expect(result.References[1].Name).To(Equal("renamed"))
+	})
+
+	It("returns no references when the pool no longer exists", func() {
+		code, result := getReferences(orphan.ResourceID)
+		Expect(code).To(Equal(http.StatusOK))
+		Expect(result.ResourceId).To(Equal(orphan.ResourceID))
+		Expect(result.References).To(BeEmpty())
+	})
+
+	It("reports unknown resources as not found", func() {
+		code, _ := getReferences(uuid.New())
+		Expect(code).To(Equal(http.StatusNotFound))
+	})
+})
+
+var _ = Describe("Inventory refresh", func() {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		c      *collector.Collector
+		server *ResourceServer
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		c = collector.NewCollector(nil, nil, nil)
+		server = &ResourceServer{RefreshHandler: c}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("kicks off a sync and reports its completion", func() {
+		response, err := server.TriggerRefresh(ctx, api.TriggerRefreshRequestObject{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response).To(BeAssignableToTypeOf(api.TriggerRefresh202JSONResponse{}))
+		job := response.(api.TriggerRefresh202JSONResponse)
+		Expect(job.Status).To(Equal(api.RefreshJobStatus(collector.RefreshJobPending)))
+		Expect(job.StartedAt).To(BeNil())
+
+		go func() {
+			defer GinkgoRecover()
+			Expect(c.Run(ctx)).To(Succeed())
+		}()
+
+		Eventually(func() api.RefreshJobStatus {
+			response, err := server.GetRefreshJob(ctx, api.GetRefreshJobRequestObject{RefreshJobId: job.RefreshJobId})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(api.GetRefreshJob200JSONResponse{}))
+			return response.(api.GetRefreshJob200JSONResponse).Status
+		}).Should(Equal(api.RefreshJobStatus(collector.RefreshJobCompleted)))
+
+		response2, err := server.GetRefreshJob(ctx, api.GetRefreshJobRequestObject{RefreshJobId: job.RefreshJobId})
+		Expect(err).ToNot(HaveOccurred())
+		completed := response2.(api.GetRefreshJob200JSONResponse)
+		Expect(completed.StartedAt).ToNot(BeNil())
+		Expect(completed.CompletedAt).ToNot(BeNil())
+		Expect(completed.Error).To(BeNil())
+	})
+
+	It("returns the pending job when a refresh is already pending", func() {
+		first, err := server.TriggerRefresh(ctx, api.TriggerRefreshRequestObject{})
+		Expect(err).ToNot(HaveOccurred())
+		second, err := server.TriggerRefresh(ctx, api.TriggerRefreshRequestObject{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(second.(api.TriggerRefresh202JSONResponse).RefreshJobId).To(
+			Equal(first.(api.TriggerRefresh202JSONResponse).RefreshJobId))
+	})
+
+	It("reports unknown jobs as not found", func() {
+		response, err := server.GetRefreshJob(ctx, api.GetRefreshJobRequestObject{RefreshJobId: uuid.New()})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response).To(BeAssignableToTypeOf(api.GetRefreshJob404ApplicationProblemPlusJSONResponse{}))
+	})
+})
+
+var _ = Describe("Build info", func() {
+	It("returns the build information embedded in the binary", func() {
+		server := &ResourceServer{}
+		router := http.NewServeMux()
+		api.HandlerFromMux(api.NewStrictHandler(server, nil), router)
+
+		req := httptest.NewRequest(http.MethodGet, "/o2ims-infrastructureInventory/version", nil)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		Expect(rec.Code).To(Equal(http.StatusOK))
+
+		var info api.BuildInfo
+		Expect(json.Unmarshal(rec.Body.Bytes(), &info)).To(Succeed())
+		expected := version.Get()
+		Expect(info).To(Equal(api.BuildInfo{
+			ApiVersion: utils.CurrentInventoryVersion,
+			BuildTime:  expected.Time,
+			Commit:     expected.Commit,
+			Version:    expected.Version,
+		}))
+	})
+})
+
+var _ = Describe("Logging level", func() {
+	var (
+		ctx    context.Context
+		level  *slog.LevelVar
+		logger *slog.Logger
+		server *ResourceServer
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		level = &slog.LevelVar{}
+		level.Set(slog.LevelInfo)
+		logger = slog.New(slog.NewJSONHandler(GinkgoWriter, &slog.HandlerOptions{Level: level}))
+		server = &ResourceServer{LogLevel: level}
+	})
+
+	It("returns the effective logging level", func() {
+		response, err := server.GetLogLevel(ctx, api.GetLogLevelRequestObject{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response).To(Equal(api.GetLogLevel200JSONResponse{Level: "info"}))
+	})
+
+	It("changes the verbosity of the loggers", func() {
+		Expect(logger.Enabled(ctx, slog.LevelDebug)).To(BeFalse())
+
+		response, err := server.UpdateLogLevel(ctx, api.UpdateLogLevelRequestObject{
+			Body: &api.LogLevel{Level: "debug"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response).To(Equal(api.UpdateLogLevel200JSONResponse{Level: "debug"}))
+		Expect(logger.Enabled(ctx, slog.LevelDebug)).To(BeTrue())
+
+		response, err = server.UpdateLogLevel(ctx, api.UpdateLogLevelRequestObject{
+			Body: &api.LogLevel{Level: "error"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response).To(Equal(api.UpdateLogLevel200JSONResponse{Level: "error"}))
+		Expect(logger.Enabled(ctx, slog.LevelWarn)).To(BeFalse())
+		Expect(logger.Enabled(ctx, slog.LevelError)).To(BeTrue())
+	})
+
+	It("rejects an unknown logging level", func() {
+		response, err := server.UpdateLogLevel(ctx, api.UpdateLogLevelRequestObject{
+			Body: &api.LogLevel{Level: "verbose"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response).To(BeAssignableToTypeOf(api.UpdateLogLevel400ApplicationProblemPlusJSONResponse{}))
+		Expect(level.Level()).To(Equal(slog.LevelInfo))
+	})
+})
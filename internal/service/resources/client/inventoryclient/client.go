@@ -0,0 +1,257 @@
+package inventoryclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	commonapi "github.com/openshift-kni/oran-o2ims/internal/service/common/api/generated"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/clients"
+	api "github.com/openshift-kni/oran-o2ims/internal/service/resources/api/generated"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a failed request is retried when the configuration doesn't set one
+	DefaultMaxRetries = 3
+	// DefaultRetryDelay is the delay before the first retry when the configuration doesn't set one. It is doubled
+	// on every subsequent retry.
+	DefaultRetryDelay = 500 * time.Millisecond
+)
+
+// Config holds the parameters used to create an inventory API client
+type Config struct {
+	// BaseURL is the URL of the inventory server (e.g., https://o2ims.example.com)
+	BaseURL string
+	// HTTPClient is the client used to send the requests. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+	// TokenSource provides the bearer token sent with each request. No token is sent when it is nil. It is called
+	// for every request, so it should cache its tokens (see TokenCache).
+	TokenSource oauth2.TokenSource
+	// MaxRetries is the number of times a failed request is retried. Zero selects DefaultMaxRetries and a negative
+	// value disables the retries.
+	MaxRetries int
+	// RetryDelay is the delay before the first retry. Zero selects DefaultRetryDelay.
+	RetryDelay time.Duration
+}
+
+// StatusError is returned when the inventory server answers with an unexpected status code
+type StatusError struct {
+	StatusCode int
+	// Problem is the problem details returned by the server, if any
+	Problem *commonapi.ProblemDetails
+}
+
+// Error implements the error interface
+func (e *StatusError) Error() string {
+	if e.Problem != nil && e.Problem.Detail != "" {
+		return fmt.Sprintf("inventory server returned status %d: %s", e.StatusCode, e.Problem.Detail)
+	}
+	return fmt.Sprintf("inventory server returned status %d", e.StatusCode)
+}
+
+// IsNotFound reports whether the error is a StatusError for a resource that does not exist
+func IsNotFound(err error) bool {
+	var statusErr *StatusError
+	return errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound
+}
+
+// Client is a typed client for the O2IMS inventory API
+type Client struct {
+	client *api.ClientWithResponses
+}
+
+// NewClient creates a new inventory API client
+func NewClient(config Config) (*Client, error) {
+	if config.BaseURL == "" {
+		return nil, fmt.Errorf("the inventory server base URL is required")
+	}
+
+	doer := &retryingDoer{
+		client:     config.HTTPClient,
+		maxRetries: config.MaxRetries,
+		retryDelay: config.RetryDelay,
+	}
+	if doer.client == nil {
+		doer.client = http.DefaultClient
+	}
+	if doer.maxRetries == 0 {
+		doer.maxRetries = DefaultMaxRetries
+	} else if doer.maxRetries < 0 {
+		doer.maxRetries = 0
+	}
+	if doer.retryDelay <= 0 {
+		doer.retryDelay = DefaultRetryDelay
+	}
+
+	options := []api.ClientOption{api.WithHTTPClient(doer)}
+	if config.TokenSource != nil {
+		tokenSource := config.TokenSource
+		options = append(options, api.WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+			token, err := tokenSource.Token()
+			if err != nil {
+				return fmt.Errorf("failed to get the bearer token: %w", err)
+			}
+			token.SetAuthHeader(req)
+			return nil
+		}))
+	}
+
+	c, err := api.NewClientWithResponses(config.BaseURL, options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the inventory client: %w", err)
+	}
+	return &Client{client: c}, nil
+}
+
+// ListResourcePools returns the resource pools matching the given parameters
+func (c *Client) ListResourcePools(ctx context.Context, params *api.GetResourcePoolsParams) ([]api.ResourcePool, error) {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, clients.ListRequestTimeout)
+	defer cancel()
+
+	resp, err := c.client.GetResourcePoolsWithResponse(ctxWithTimeout, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the resource pools: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return nil, newStatusError(resp.StatusCode(), resp.Body)
+	}
+	return *resp.JSON200, nil
+}
+
+// GetResourcePool returns the resource pool with the given identifier
+func (c *Client) GetResourcePool(ctx context.Context, resourcePoolID api.ResourcePoolId) (*api.ResourcePool, error) {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, clients.SingleRequestTimeout)
+	defer cancel()
+
+	resp, err := c.client.GetResourcePoolWithResponse(ctxWithTimeout, resourcePoolID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the resource pool %s: %w", resourcePoolID, err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return nil, newStatusError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// ListResources returns the resources of the given resource pool matching the given parameters
+func (c *Client) ListResources(ctx context.Context, resourcePoolID api.ResourcePoolId,
+	params *api.GetResourcesParams) ([]api.Resource, error) {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, clients.ListRequestTimeout)
+	defer cancel()
+
+	resp, err := c.client.GetResourcesWithResponse(ctxWithTimeout, resourcePoolID, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the resources of the resource pool %s: %w", resourcePoolID, err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return nil, newStatusError(resp.StatusCode(), resp.Body)
+	}
+	return *resp.JSON200, nil
+}
+
+// GetResource returns the resource with the given identifier from the given resource pool
+func (c *Client) GetResource(ctx context.Context, resourcePoolID api.ResourcePoolId,
+	resourceID api.ResourceId) (*api.Resource, error) {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, clients.SingleRequestTimeout)
+	defer cancel()
+
+	resp, err := c.client.GetResourceWithResponse(ctxWithTimeout, resourcePoolID, resourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the resource %s: %w", resourceID, err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return nil, newStatusError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// ListDeploymentManagers returns the deployment managers matching the given parameters
+func (c *Client) ListDeploymentManagers(ctx context.Context,
+	params *api.GetDeploymentManagersParams) ([]api.DeploymentManager, error) {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, clients.ListRequestTimeout)
+	defer cancel()
+
+	resp, err := c.client.GetDeploymentManagersWithResponse(ctxWithTimeout, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the deployment managers: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return nil, newStatusError(resp.StatusCode(), resp.Body)
+	}
+	return *resp.JSON200, nil
+}
+
+// GetDeploymentManager returns the deployment manager with the given identifier
+func (c *Client) GetDeploymentManager(ctx context.Context, deploymentManagerID api.DeploymentManagerId,
+	params *api.GetDeploymentManagerParams) (*api.DeploymentManager, error) {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, clients.SingleRequestTimeout)
+	defer cancel()
+
+	resp, err := c.client.GetDeploymentManagerWithResponse(ctxWithTimeout, deploymentManagerID, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the deployment manager %s: %w", deploymentManagerID, err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return nil, newStatusError(resp.StatusCode(), resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// newStatusError builds a StatusError, decoding the problem details from the response body when possible
+func newStatusError(statusCode int, body []byte) error {
+	statusErr := &StatusError{StatusCode: statusCode}
+	problem := &commonapi.ProblemDetails{}
+	if err := json.Unmarshal(body, problem); err == nil {
+		statusErr.Problem = problem
+	}
+	return statusErr
+}
+
+// retryingDoer sends the requests with the wrapped HTTP client and retries the idempotent ones on transport
+// errors and on the status codes indicating a transient failure, doubling the delay after each attempt.
+type retryingDoer struct {
+	client     *http.Client
+	maxRetries int
+	retryDelay time.Duration
+}
+
+// Do implements the api.HttpRequestDoer interface
+func (d *retryingDoer) Do(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return d.client.Do(req) // nolint: wrapcheck
+	}
+
+	delay := d.retryDelay
+	for attempt := 0; ; attempt++ {
+		resp, err := d.client.Do(req.Clone(req.Context()))
+		if attempt >= d.maxRetries || !isRetryable(resp, err) {
+			return resp, err // nolint: wrapcheck
+		}
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, fmt.Errorf("request cancelled while waiting to retry: %w", req.Context().Err())
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// isRetryable reports whether a request that ended with the given response or error may succeed when retried
+func isRetryable(resp *http.Response, err error) bool {
+	if err != nil {
+		// Don't retry once the caller gave up
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
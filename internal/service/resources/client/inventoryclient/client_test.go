@@ -0,0 +1,113 @@
+package inventoryclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/oauth2"
+)
+
+var _ = Describe("Inventory client", func() {
+	var (
+		ctx      context.Context
+		server   *httptest.Server
+		handler  http.HandlerFunc
+		requests atomic.Int32
+		poolID   uuid.UUID
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		poolID = uuid.New()
+		requests.Store(0)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			handler(w, r)
+		}))
+		DeferCleanup(server.Close)
+	})
+
+	newClient := func(config Config) *Client {
+		config.BaseURL = server.URL
+		config.RetryDelay = time.Millisecond
+		c, err := NewClient(config)
+		Expect(err).ToNot(HaveOccurred())
+		return c
+	}
+
+	writeJSON := func(w http.ResponseWriter, status int, body string) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}
+
+	It("returns the typed resource pools and sends the bearer token", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.URL.Path).To(Equal("/o2ims-infrastructureInventory/v1/resourcePools"))
+			Expect(r.Header.Get("Authorization")).To(Equal("Bearer secret"))
+			writeJSON(w, http.StatusOK, `[{"resourcePoolId": "`+poolID.String()+`", "name": "pool-1"}]`)
+		}
+
+		c := newClient(Config{TokenSource: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "secret"})})
+		pools, err := c.ListResourcePools(ctx, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pools).To(HaveLen(1))
+		Expect(pools[0].ResourcePoolId).To(Equal(poolID))
+		Expect(pools[0].Name).To(Equal("pool-1"))
+	})
+
+	It("retries the transient failures with backoff", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			if requests.Load() < 3 {
+				writeJSON(w, http.StatusServiceUnavailable, `{"status": 503, "detail": "not ready"}`)
+				return
+			}
+			writeJSON(w, http.StatusOK, `{"resourcePoolId": "`+poolID.String()+`", "name": "pool-1"}`)
+		}
+
+		pool, err := newClient(Config{}).GetResourcePool(ctx, poolID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pool.ResourcePoolId).To(Equal(poolID))
+		Expect(requests.Load()).To(Equal(int32(3)))
+	})
+
+	It("gives up once the retries are exhausted", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusInternalServerError, `{"status": 500, "detail": "boom"}`)
+		}
+
+		_, err := newClient(Config{MaxRetries: 2}).ListDeploymentManagers(ctx, nil)
+		Expect(err).To(MatchError(ContainSubstring("inventory server returned status 500: boom")))
+		Expect(requests.Load()).To(Equal(int32(3)))
+
+		requests.Store(0)
+		_, err = newClient(Config{MaxRetries: -1}).ListDeploymentManagers(ctx, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(requests.Load()).To(Equal(int32(1)))
+	})
+
+	It("reports the missing resources without retrying", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusNotFound, `{"status": 404, "detail": "resource not found"}`)
+		}
+
+		_, err := newClient(Config{}).GetResource(ctx, poolID, uuid.New())
+		Expect(IsNotFound(err)).To(BeTrue())
+		var statusErr *StatusError
+		Expect(err).To(BeAssignableToTypeOf(statusErr))
+		Expect(err.(*StatusError).Problem.Detail).To(Equal("resource not found"))
+		Expect(requests.Load()).To(Equal(int32(1)))
+	})
+
+	It("requires a base URL", func() {
+		_, err := NewClient(Config{})
+		Expect(err).To(MatchError(ContainSubstring("base URL is required")))
+	})
+})
@@ -0,0 +1,13 @@
+package inventoryclient
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestInventoryClient(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Inventory Client Suite")
+}
@@ -0,0 +1,92 @@
+package inventoryclient
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// DefaultTokenRefreshMargin is how long before its expiry a cached token is refreshed
+const DefaultTokenRefreshMargin = 30 * time.Second
+
+// TokenCache is a goroutine-safe oauth2.TokenSource that keeps the last token obtained from the wrapped source and
+// only asks for a new one shortly before it expires. If the refresh fails, the cached token keeps being used for as
+// long as it is still valid.
+type TokenCache struct {
+	source        oauth2.TokenSource
+	refreshMargin time.Duration
+	now           func() time.Time
+
+	mutex sync.Mutex
+	token *oauth2.Token
+}
+
+// NewTokenCache creates a token cache in front of the given token source. The source is expected to fetch a new
+// token on each call.
+func NewTokenCache(source oauth2.TokenSource) *TokenCache {
+	return &TokenCache{
+		source:        source,
+		refreshMargin: DefaultTokenRefreshMargin,
+		now:           time.Now,
+	}
+}
+
+// NewClientCredentialsTokenSource returns a token cache fetching its tokens from the given token URL with the
+// OAuth client credentials flow. The HTTP client used to reach the token URL can be set in the context with the
+// oauth2.HTTPClient key.
+func NewClientCredentialsTokenSource(ctx context.Context, clientID, clientSecret, tokenURL string,
+	scopes ...string) *TokenCache {
+	config := &clientcredentials.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     tokenURL,
+		Scopes:       scopes,
+	}
+	// The config's own token source caches the token until it expires; use the uncached fetch so that the refresh
+	// margin and the fallback of the TokenCache apply.
+	return NewTokenCache(tokenSourceFunc(func() (*oauth2.Token, error) {
+		return config.Token(ctx) // nolint: wrapcheck
+	}))
+}
+
+// Token implements the oauth2.TokenSource interface
+func (c *TokenCache) Token() (*oauth2.Token, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := c.now()
+	if c.token != nil && !c.expiresBefore(c.token, now.Add(c.refreshMargin)) {
+		return c.token, nil
+	}
+
+	token, err := c.source.Token()
+	if err != nil {
+		if c.token != nil && !c.expiresBefore(c.token, now) {
+			slog.Warn("Failed to refresh the OAuth token, using the cached one", "expiry", c.token.Expiry, "error", err)
+			return c.token, nil
+		}
+		return nil, fmt.Errorf("failed to get an OAuth token: %w", err)
+	}
+
+	c.token = token
+	return token, nil
+}
+
+// expiresBefore reports whether the token is no longer valid at the given time. Tokens without an expiry never
+// expire.
+func (c *TokenCache) expiresBefore(token *oauth2.Token, t time.Time) bool {
+	return !token.Expiry.IsZero() && !t.Before(token.Expiry)
+}
+
+// tokenSourceFunc adapts a function to the oauth2.TokenSource interface
+type tokenSourceFunc func() (*oauth2.Token, error)
+
+// Token implements the oauth2.TokenSource interface
+func (f tokenSourceFunc) Token() (*oauth2.Token, error) {
+	return f()
+}
@@ -0,0 +1,109 @@
+package inventoryclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/oauth2"
+)
+
+var _ = Describe("Token cache", func() {
+	var (
+		now     time.Time
+		fetches atomic.Int32
+		fail    atomic.Bool
+		cache   *TokenCache
+	)
+
+	BeforeEach(func() {
+		now = time.Date(2024, 10, 1, 10, 0, 0, 0, time.UTC)
+		fetches.Store(0)
+		fail.Store(false)
+		cache = NewTokenCache(tokenSourceFunc(func() (*oauth2.Token, error) {
+			if fail.Load() {
+				return nil, errors.New("token server unavailable")
+			}
+			n := fetches.Add(1)
+			return &oauth2.Token{
+				AccessToken: string(rune('a' + n - 1)),
+				Expiry:      now.Add(5 * time.Minute),
+			}, nil
+		}))
+		cache.now = func() time.Time { return now }
+	})
+
+	It("reuses the token until shortly before it expires", func() {
+		token, err := cache.Token()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(token.AccessToken).To(Equal("a"))
+
+		now = now.Add(4 * time.Minute)
+		token, err = cache.Token()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(token.AccessToken).To(Equal("a"))
+		Expect(fetches.Load()).To(Equal(int32(1)))
+
+		// Within the refresh margin
+		now = now.Add(31 * time.Second)
+		token, err = cache.Token()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(token.AccessToken).To(Equal("b"))
+		Expect(fetches.Load()).To(Equal(int32(2)))
+	})
+
+	It("falls back to the cached token while it is still valid", func() {
+		_, err := cache.Token()
+		Expect(err).ToNot(HaveOccurred())
+
+		fail.Store(true)
+		now = now.Add(4*time.Minute + 45*time.Second)
+		token, err := cache.Token()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(token.AccessToken).To(Equal("a"))
+
+		now = now.Add(15 * time.Second)
+		_, err = cache.Token()
+		Expect(err).To(MatchError(ContainSubstring("token server unavailable")))
+	})
+
+	It("fetches a single token for concurrent callers", func() {
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer GinkgoRecover()
+				_, err := cache.Token()
+				Expect(err).ToNot(HaveOccurred())
+			}()
+		}
+		wg.Wait()
+		Expect(fetches.Load()).To(Equal(int32(1)))
+	})
+
+	It("honors the expires_in of the client credentials token response", func() {
+		var requests atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"access_token": "secret", "token_type": "bearer", "expires_in": 3600}`))
+		}))
+		DeferCleanup(server.Close)
+
+		source := NewClientCredentialsTokenSource(context.Background(), "client", "secret", server.URL)
+		for range 3 {
+			token, err := source.Token()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(token.AccessToken).To(Equal("secret"))
+			Expect(token.Expiry).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+		}
+		Expect(requests.Load()).To(Equal(int32(1)))
+	})
+})
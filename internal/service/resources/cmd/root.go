@@ -6,6 +6,9 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+
+	toolcmd "github.com/openshift-kni/oran-o2ims/internal/cmd"
+	"github.com/openshift-kni/oran-o2ims/internal/service/resources"
 )
 
 // resourcesRootCmd represents the root command for working resource server
@@ -24,9 +27,14 @@ func GetResourcesRootCmd() *cobra.Command {
 	return resourcesRootCmd
 }
 
+func init() {
+	// Report the build information of the server
+	resourcesRootCmd.AddCommand(toolcmd.Version())
+}
+
 func configureResourcesLogger() {
 	l := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
-		Level:     slog.LevelDebug,
+		Level:     resources.LogLevel,
 		AddSource: true,
 	}))
 	slog.SetDefault(l)
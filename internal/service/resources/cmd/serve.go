@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"log/slog"
+	"net/http"
 	"os"
 
 	"github.com/spf13/cobra"
@@ -12,6 +13,7 @@ import (
 	utils2 "github.com/openshift-kni/oran-o2ims/internal/service/common/utils"
 	"github.com/openshift-kni/oran-o2ims/internal/service/resources"
 	"github.com/openshift-kni/oran-o2ims/internal/service/resources/api"
+	utils3 "github.com/openshift-kni/oran-o2ims/internal/service/resources/utils"
 )
 
 // config defines the configuration attributes for the resource server
@@ -73,6 +75,42 @@ func setServerFlags(cmd *cobra.Command) error {
 		utils.DefaultOCloudID,
 		"The global O-Cloud identifier.",
 	)
+	flags.IntVar(
+		&config.ImportBatchSize,
+		server.ImportBatchSizeFlagName,
+		utils3.DefaultImportBatchSize,
+		"Number of resources persisted per transaction by the bulk import endpoint.",
+	)
+	flags.StringSliceVar(
+		&config.CORS.AllowedOrigins,
+		server.CORSAllowedOriginsFlagName,
+		[]string{},
+		"Origins allowed to make cross-origin requests to the API. Cross-origin requests are rejected if empty.",
+	)
+	flags.StringSliceVar(
+		&config.CORS.AllowedMethods,
+		server.CORSAllowedMethodsFlagName,
+		[]string{http.MethodGet, http.MethodHead},
+		"Methods allowed for cross-origin requests.",
+	)
+	flags.StringSliceVar(
+		&config.CORS.AllowedHeaders,
+		server.CORSAllowedHeadersFlagName,
+		[]string{"Authorization", "Content-Type"},
+		"Request headers allowed for cross-origin requests.",
+	)
+	flags.DurationVar(
+		&config.ResponseCache.TTL,
+		server.ResponseCacheTTLFlagName,
+		0,
+		"How long GET responses are served from the response cache. The response cache is disabled if zero.",
+	)
+	flags.IntVar(
+		&config.ResponseCache.MaxEntries,
+		server.ResponseCacheSizeFlagName,
+		utils3.DefaultResponseCacheSize,
+		"Maximum number of responses kept by the response cache.",
+	)
 
 	// The O-Cloud ID and External address arguments are mandatory while all other arguments are optional.  The Global
 	// O-Cloud ID is special in that it is not strictly mandatory to start the server, but it is mandatory to enable
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -63,6 +64,10 @@ type Collector struct {
 	repository          *repo.ResourcesRepository
 	dataSources         []DataSource
 	AsyncChangeEvents   chan *async.AsyncChangeEvent
+	refreshRequests     chan uuid.UUID
+	refreshLock         sync.Mutex
+	refreshJobs         map[uuid.UUID]*RefreshJob
+	pendingRefresh      *RefreshJob
 }
 
 // NewCollector creates a new collector instance
@@ -72,6 +77,8 @@ func NewCollector(repo *repo.ResourcesRepository, notificationHandler Notificati
 		notificationHandler: notificationHandler,
 		dataSources:         dataSources,
 		AsyncChangeEvents:   make(chan *async.AsyncChangeEvent, asyncEventBufferSize),
+		refreshRequests:     make(chan uuid.UUID, 1),
+		refreshJobs:         make(map[uuid.UUID]*RefreshJob),
 	}
 }
 
@@ -96,6 +103,8 @@ func (c *Collector) Run(ctx context.Context) error {
 			if err := c.handleAsyncEvent(ctx, event); err != nil {
 				slog.Error("failed to handle async change", "event", event, "error", err)
 			}
+		case id := <-c.refreshRequests:
+			c.runRefreshJob(ctx, id)
 		case <-time.After(pollingDelay):
 			c.execute(ctx)
 		case <-ctx.Done():
@@ -166,6 +175,14 @@ func (c *Collector) watchForChanges(ctx context.Context) error {
 // execute runs a single iteration of the main loop.  It does not return an error because all errors should be handled
 // gracefully.  If a truly unrecoverable error happens then a panic should be used to restart the process.
 func (c *Collector) execute(ctx context.Context) {
+	// Failures are already logged for each data source and retried on the next iteration
+	_ = c.collectAll(ctx)
+}
+
+// collectAll collects the data from all data sources.  A failure to collect from a data source does not prevent the
+// others from being collected; the failures are returned together.
+func (c *Collector) collectAll(ctx context.Context) error {
+	var errs []error
 	slog.Debug("collector loop running", "sources", len(c.dataSources))
 	for _, d := range c.dataSources {
 		rd, ok := d.(ResourceDataSource)
@@ -177,11 +194,13 @@ func (c *Collector) execute(ctx context.Context) {
 		slog.Debug("collecting data from data source", "source", d.Name(), "generationID", d.GetGenerationID())
 		if err := c.executeOneDataSource(ctx, rd); err != nil {
 			slog.Warn("failed to collect data from data source", "source", d.Name(), "error", err)
+			errs = append(errs, fmt.Errorf("failed to collect data from data source %q: %w", d.Name(), err))
 		} else {
 			slog.Debug("collected data from data source", "source", d.Name())
 		}
 	}
 	slog.Debug("collector loop complete", "sources", len(c.dataSources))
+	return errors.Join(errs...)
 }
 
 // executeOneDataSource runs a single iteration of the main loop for a specific data source instance.
@@ -47,6 +47,11 @@ const (
 	ResourceTypeUUIDNamespace = "2e300cf4-3c4c-4c9a-a34c-1985bf4b7c41"
 )
 
+// ACMDataSourceName is the name of the data source collecting the resource pools and resources from ACM.  The
+// description of the resource pools it collects is the identifier of their cluster, which is also the identifier of
+// the deployment manager of the cluster.
+const ACMDataSourceName = "ACM"
+
 // graphqlQuery defines the query expression supported by the search api
 const graphqlQuery = `query ($input: [SearchInput]) {
 				searchResult: search(input: $input) {
@@ -144,7 +149,7 @@ func NewACMDataSource(cloudID, globalCloudID uuid.UUID, backendURL string, exten
 
 // Name returns the name of this data source
 func (d *ACMDataSource) Name() string {
-	return "ACM"
+	return ACMDataSourceName
 }
 
 // GetID returns the data source ID for this data source
@@ -0,0 +1,121 @@
+package collector
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// refreshJobRetention defines how long finished refresh jobs are kept so that they can be polled
+const refreshJobRetention = time.Hour
+
+// RefreshJobStatus defines the states of an on-demand inventory refresh
+type RefreshJobStatus string
+
+const (
+	RefreshJobPending   RefreshJobStatus = "pending"
+	RefreshJobRunning   RefreshJobStatus = "running"
+	RefreshJobCompleted RefreshJobStatus = "completed"
+	RefreshJobFailed    RefreshJobStatus = "failed"
+)
+
+// RefreshJob tracks the progress of an on-demand inventory refresh
+type RefreshJob struct {
+	ID          uuid.UUID
+	Status      RefreshJobStatus
+	CreatedAt   time.Time
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+	Error       string
+}
+
+// RefreshHandler defines an interface over which on-demand inventory refreshes are requested and tracked.
+type RefreshHandler interface {
+	Refresh() RefreshJob
+	GetRefreshJob(id uuid.UUID) (RefreshJob, bool)
+}
+
+// Refresh requests a full re-sync of the inventory from all data sources and returns the job tracking it.  The
+// refresh is run by the collector main loop.  If a refresh is already pending then its job is returned instead.
+func (c *Collector) Refresh() RefreshJob {
+	c.refreshLock.Lock()
+	defer c.refreshLock.Unlock()
+
+	c.pruneRefreshJobs()
+
+	if c.pendingRefresh != nil {
+		return *c.pendingRefresh
+	}
+
+	job := &RefreshJob{
+		ID:        uuid.New(),
+		Status:    RefreshJobPending,
+		CreatedAt: time.Now(),
+	}
+	c.refreshJobs[job.ID] = job
+	c.pendingRefresh = job
+
+	// At most one refresh is pending at a time so this never blocks
+	c.refreshRequests <- job.ID
+	slog.Info("inventory refresh requested", "job", job.ID)
+
+	return *job
+}
+
+// GetRefreshJob returns the current state of a refresh job
+func (c *Collector) GetRefreshJob(id uuid.UUID) (RefreshJob, bool) {
+	c.refreshLock.Lock()
+	defer c.refreshLock.Unlock()
+
+	job, found := c.refreshJobs[id]
+	if !found {
+		return RefreshJob{}, false
+	}
+	return *job, true
+}
+
+// runRefreshJob runs a full re-sync of the inventory on behalf of a refresh job and records its outcome
+func (c *Collector) runRefreshJob(ctx context.Context, id uuid.UUID) {
+	c.updateRefreshJob(id, func(job *RefreshJob) {
+		now := time.Now()
+		job.Status = RefreshJobRunning
+		job.StartedAt = &now
+		// Requests received from now on need another pass to be picked up
+		c.pendingRefresh = nil
+	})
+
+	err := c.collectAll(ctx)
+
+	c.updateRefreshJob(id, func(job *RefreshJob) {
+		now := time.Now()
+		job.CompletedAt = &now
+		if err != nil {
+			job.Status = RefreshJobFailed
+			job.Error = err.Error()
+		} else {
+			job.Status = RefreshJobCompleted
+		}
+	})
+	slog.Info("inventory refresh finished", "job", id, "error", err)
+}
+
+// updateRefreshJob applies a change to a refresh job while holding the lock
+func (c *Collector) updateRefreshJob(id uuid.UUID, update func(job *RefreshJob)) {
+	c.refreshLock.Lock()
+	defer c.refreshLock.Unlock()
+
+	if job, found := c.refreshJobs[id]; found {
+		update(job)
+	}
+}
+
+// pruneRefreshJobs removes the finished refresh jobs past their retention period.  The caller must hold the lock.
+func (c *Collector) pruneRefreshJobs() {
+	for id, job := range c.refreshJobs {
+		if job.CompletedAt != nil && time.Since(*job.CompletedAt) > refreshJobRetention {
+			delete(c.refreshJobs, id)
+		}
+	}
+}
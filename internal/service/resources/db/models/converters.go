@@ -128,6 +128,32 @@ func ResourceToModel(record *Resource, elements []Resource) generated.Resource {
 	return object
 }
 
+// ResourceFromImportItem converts an API import item to a DB tuple belonging to the specified resource pool
+func ResourceFromImportItem(object *generated.ResourceImportItem, resourcePoolID, dataSourceID uuid.UUID) *Resource {
+	id := uuid.Must(uuid.NewRandom())
+	if object.ResourceId != nil {
+		id = *object.ResourceId
+	}
+
+	record := Resource{
+		ResourceID:     id,
+		Description:    object.Description,
+		ResourceTypeID: object.ResourceTypeId,
+		GlobalAssetID:  object.GlobalAssetId,
+		ResourcePoolID: resourcePoolID,
+		Groups:         object.Groups,
+		Tags:           object.Tags,
+		DataSourceID:   dataSourceID,
+		ExternalID:     id.String(),
+	}
+
+	if object.Extensions != nil {
+		record.Extensions = *object.Extensions
+	}
+
+	return &record
+}
+
 // getEventType determines the event type based on the object transition
 func getEventType(before, after map[string]interface{}) int {
 	switch {
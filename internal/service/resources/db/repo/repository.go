@@ -2,11 +2,14 @@ package repo
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/stephenafamo/bob"
 	"github.com/stephenafamo/bob/dialect/psql"
 
+	models2 "github.com/openshift-kni/oran-o2ims/internal/service/common/db/models"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/repo"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/utils"
 	"github.com/openshift-kni/oran-o2ims/internal/service/resources/db/models"
@@ -17,6 +20,63 @@ type ResourcesRepository struct {
 	repo.CommonRepository
 }
 
+// ResourcePoolFilter defines the criteria applied by the database when listing ResourcePool tuples.  A nil list of
+// identifiers matches any tuple whereas an empty list matches none.
+type ResourcePoolFilter struct {
+	ResourcePoolIDs []uuid.UUID
+}
+
+// ResourceFilter defines the criteria applied by the database when listing Resource tuples.  A nil list of
+// identifiers matches any tuple whereas an empty list matches none.
+type ResourceFilter struct {
+	ResourcePoolIDs []uuid.UUID
+	ResourceTypeIDs []uuid.UUID
+}
+
+// inExpression returns an expression matching the tuples whose column is one of the identifiers, or nil if the
+// identifiers are nil
+func inExpression(column string, ids []uuid.UUID) bob.Expression {
+	if ids == nil {
+		return nil
+	}
+	if len(ids) == 0 {
+		return psql.RawQuery("1=0")
+	}
+	values := make([]any, len(ids))
+	for i, id := range ids {
+		values[i] = id
+	}
+	return psql.Quote(column).In(psql.Arg(values...))
+}
+
+// andExpression returns an expression matching the tuples matching all the non-nil expressions
+func andExpression(expressions ...bob.Expression) bob.Expression {
+	var result []bob.Expression
+	for _, e := range expressions {
+		if e != nil {
+			result = append(result, e)
+		}
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return psql.And(result...)
+}
+
+// expression returns the expression matching the ResourcePool tuples selected by the filter
+func (f ResourcePoolFilter) expression() bob.Expression {
+	return andExpression(inExpression("resource_pool_id", f.ResourcePoolIDs))
+}
+
+// expression returns the expression matching the Resource tuples of a ResourcePool selected by the filter
+func (f ResourceFilter) expression(id uuid.UUID) bob.Expression {
+	return andExpression(
+		psql.Quote("resource_pool_id").EQ(psql.Arg(id)),
+		inExpression("resource_pool_id", f.ResourcePoolIDs),
+		inExpression("resource_type_id", f.ResourceTypeIDs),
+	)
+}
+
 // GetDeploymentManagers retrieves all DeploymentManager tuples or returns an empty array if no tuples are found
 func (r *ResourcesRepository) GetDeploymentManagers(ctx context.Context) ([]models.DeploymentManager, error) {
 	return utils.FindAll[models.DeploymentManager](ctx, r.Db)
@@ -47,11 +107,27 @@ func (r *ResourcesRepository) GetResourceType(ctx context.Context, id uuid.UUID)
 	return utils.Find[models.ResourceType](ctx, r.Db, id)
 }
 
+// ResourceTypeExists determines whether a ResourceType exists or not
+func (r *ResourcesRepository) ResourceTypeExists(ctx context.Context, id uuid.UUID) (bool, error) {
+	return utils.Exists[models.ResourceType](ctx, r.Db, id)
+}
+
 // GetResourcePools retrieves all ResourcePool tuples or returns an empty array if no tuples are found
 func (r *ResourcesRepository) GetResourcePools(ctx context.Context) ([]models.ResourcePool, error) {
 	return utils.FindAll[models.ResourcePool](ctx, r.Db)
 }
 
+// SearchResourcePools retrieves a page of the ResourcePool tuples matching the filter, and whether more tuples follow
+// the page
+func (r *ResourcesRepository) SearchResourcePools(ctx context.Context, filter ResourcePoolFilter, page utils.Page) ([]models.ResourcePool, bool, error) {
+	return utils.SearchPage[models.ResourcePool](ctx, r.Db, filter.expression(), page)
+}
+
+// CountResourcePools returns the number of ResourcePool tuples matching the filter
+func (r *ResourcesRepository) CountResourcePools(ctx context.Context, filter ResourcePoolFilter) (int, error) {
+	return utils.Count[models.ResourcePool](ctx, r.Db, filter.expression())
+}
+
 // GetResourcePool retrieves a specific ResourcePool tuple or returns ErrNotFound if not found
 func (r *ResourcesRepository) GetResourcePool(ctx context.Context, id uuid.UUID) (*models.ResourcePool, error) {
 	return utils.Find[models.ResourcePool](ctx, r.Db, id)
@@ -78,11 +154,27 @@ func (r *ResourcesRepository) GetResourcePoolResources(ctx context.Context, id u
 	return utils.Search[models.Resource](ctx, r.Db, e)
 }
 
+// SearchResourcePoolResources retrieves a page of the Resource tuples of a specific ResourcePool matching the filter,
+// and whether more tuples follow the page
+func (r *ResourcesRepository) SearchResourcePoolResources(ctx context.Context, id uuid.UUID, filter ResourceFilter, page utils.Page) ([]models.Resource, bool, error) {
+	return utils.SearchPage[models.Resource](ctx, r.Db, filter.expression(id), page)
+}
+
+// CountResourcePoolResources returns the number of Resource tuples of a specific ResourcePool matching the filter
+func (r *ResourcesRepository) CountResourcePoolResources(ctx context.Context, id uuid.UUID, filter ResourceFilter) (int, error) {
+	return utils.Count[models.Resource](ctx, r.Db, filter.expression(id))
+}
+
 // GetResource retrieves a specific Resource tuple or returns ErrNotFound if not found
 func (r *ResourcesRepository) GetResource(ctx context.Context, id uuid.UUID) (*models.Resource, error) {
 	return utils.Find[models.Resource](ctx, r.Db, id)
 }
 
+// ResourceExists determines whether a Resource exists or not
+func (r *ResourcesRepository) ResourceExists(ctx context.Context, id uuid.UUID) (bool, error) {
+	return utils.Exists[models.Resource](ctx, r.Db, id)
+}
+
 // CreateResource creates a new Resource tuple
 func (r *ResourcesRepository) CreateResource(ctx context.Context, resource *models.Resource) (*models.Resource, error) {
 	return utils.Create[models.Resource](ctx, r.Db, *resource)
@@ -92,3 +184,34 @@ func (r *ResourcesRepository) CreateResource(ctx context.Context, resource *mode
 func (r *ResourcesRepository) UpdateResource(ctx context.Context, resource *models.Resource) (*models.Resource, error) {
 	return utils.Update[models.Resource](ctx, r.Db, resource.ResourceID, *resource)
 }
+
+// ImportResources persists a batch of Resource tuples and their data change events under a single transaction so that
+// either all or none of the batch is committed.  The data change events are returned so that they can be signaled to
+// the notifier once the transaction has completed.
+func (r *ResourcesRepository) ImportResources(ctx context.Context, resources []models.Resource) ([]models2.DataChangeEvent, error) {
+	var dataChangeEvents []models2.DataChangeEvent
+
+	err := pgx.BeginFunc(ctx, r.Db, func(tx pgx.Tx) error {
+		dataChangeEvents = nil
+		for _, resource := range resources {
+			_, after, err := utils.PersistObject(ctx, tx, resource, resource.ResourceID)
+			if err != nil {
+				return fmt.Errorf("failed to persist resource '%s': %w", resource.ResourceID, err)
+			}
+
+			dataChangeEvent, err := utils.PersistDataChangeEvent(ctx, tx, resource.TableName(), resource.ResourceID,
+				&resource.ResourcePoolID, nil, models.ResourceToModel(after, nil))
+			if err != nil {
+				return fmt.Errorf("failed to persist data change object: %w", err)
+			}
+			dataChangeEvents = append(dataChangeEvents, *dataChangeEvent)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute transaction: %w", err)
+	}
+
+	return dataChangeEvents, nil
+}
@@ -0,0 +1,53 @@
+package repo
+
+import (
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+)
+
+// buildWhere renders the condition of a query filtered by the given expression
+func buildWhere(e bob.Expression) (string, []any) {
+	sql, args, err := psql.Select(sm.From("t"), sm.Where(e)).Build()
+	Expect(err).ToNot(HaveOccurred())
+	return sql, args
+}
+
+var _ = Describe("Resource filters", func() {
+	It("does not filter the ResourcePools without identifiers", func() {
+		Expect(ResourcePoolFilter{}.expression()).To(BeNil())
+	})
+
+	It("matches no ResourcePool with an empty list of identifiers", func() {
+		sql, args := buildWhere(ResourcePoolFilter{ResourcePoolIDs: []uuid.UUID{}}.expression())
+		Expect(sql).To(ContainSubstring("WHERE ((1=0))"))
+		Expect(args).To(BeEmpty())
+	})
+
+	It("matches the ResourcePools with the listed identifiers", func() {
+		first, second := uuid.New(), uuid.New()
+		sql, args := buildWhere(ResourcePoolFilter{ResourcePoolIDs: []uuid.UUID{first, second}}.expression())
+		Expect(sql).To(ContainSubstring(`WHERE (("resource_pool_id" IN ($1, $2)))`))
+		Expect(args).To(Equal([]any{first, second}))
+	})
+
+	It("matches the Resources of the ResourcePool only without identifiers", func() {
+		id := uuid.New()
+		sql, args := buildWhere(ResourceFilter{}.expression(id))
+		Expect(sql).To(ContainSubstring(`WHERE (("resource_pool_id" = $1))`))
+		Expect(args).To(Equal([]any{id}))
+	})
+
+	It("combines the Resource criteria", func() {
+		id, resourceTypeID := uuid.New(), uuid.New()
+		sql, args := buildWhere(ResourceFilter{
+			ResourcePoolIDs: []uuid.UUID{},
+			ResourceTypeIDs: []uuid.UUID{resourceTypeID},
+		}.expression(id))
+		Expect(sql).To(ContainSubstring(`WHERE (("resource_pool_id" = $1) AND (1=0) AND ("resource_type_id" IN ($2)))`))
+		Expect(args).To(Equal([]any{id, resourceTypeID}))
+	})
+})
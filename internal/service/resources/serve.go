@@ -22,6 +22,7 @@ import (
 	"github.com/openshift-kni/oran-o2ims/internal/service/resources/api/generated"
 	"github.com/openshift-kni/oran-o2ims/internal/service/resources/collector"
 	"github.com/openshift-kni/oran-o2ims/internal/service/resources/db/repo"
+	utils2 "github.com/openshift-kni/oran-o2ims/internal/service/resources/utils"
 )
 
 // Resource server config values
@@ -34,6 +35,14 @@ const (
 	database = "resources"
 )
 
+// LogLevel is the logging level of the resource server loggers. It defaults to debug and can be changed at runtime
+// through the API.
+var LogLevel = func() *slog.LevelVar {
+	level := &slog.LevelVar{}
+	level.Set(slog.LevelDebug)
+	return level
+}()
+
 // Serve start alarms server
 func Serve(config *api.ResourceServerConfig) error {
 	slog.Info("Starting resource server")
@@ -111,8 +120,17 @@ func Serve(config *api.ResourceServerConfig) error {
 	clientFactory := notifier.NewClientFactory(oauthConfig, utils.DefaultBackendTokenFile)
 	resourceNotifier := notifier.NewNotifier(subscriptionsProvider, notificationsProvider, clientFactory)
 
+	// Create the optional response cache.  Inventory changes are passed through it so that the responses built from
+	// the changed resources are invalidated before subscribers are notified.
+	var notificationHandler collector.NotificationHandler = resourceNotifier
+	var responseCache *common.ResponseCache
+	if config.ResponseCache.TTL > 0 {
+		responseCache = common.NewResponseCache(config.ResponseCache, api.CacheGroup)
+		notificationHandler = &api.CacheInvalidator{Next: resourceNotifier, Cache: responseCache}
+	}
+
 	// Create the collector
-	resourceCollector := collector.NewCollector(repository, resourceNotifier, []collector.DataSource{k8s, acm})
+	resourceCollector := collector.NewCollector(repository, notificationHandler, []collector.DataSource{k8s, acm})
 
 	// Init server
 	// Create the handler
@@ -127,6 +145,9 @@ func Serve(config *api.ResourceServerConfig) error {
 			ServiceUri:    config.ExternalAddress,
 		},
 		SubscriptionEventHandler: resourceNotifier,
+		NotificationHandler:      notificationHandler,
+		RefreshHandler:           resourceCollector,
+		LogLevel:                 LogLevel,
 	}
 
 	serverStrictHandler := generated.NewStrictHandlerWithOptions(&server, nil,
@@ -141,7 +162,7 @@ func Serve(config *api.ResourceServerConfig) error {
 	// Create a new logger to be passed to things that need a logger
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
 		AddSource: true,
-		Level:     slog.LevelDebug, // TODO: set with server args
+		Level:     LogLevel,
 	}))
 
 	// This also validates the spec file
@@ -161,6 +182,7 @@ func Serve(config *api.ResourceServerConfig) error {
 		Middlewares: []generated.MiddlewareFunc{ // Add middlewares here
 			common.OpenAPIValidation(swagger),
 			common.ResponseFilter(filterAdapter),
+			common.RequestPath(),
 			common.LogDuration(),
 		},
 		ErrorHandlerFunc: common.GetOranReqErrFunc(),
@@ -169,9 +191,20 @@ func Serve(config *api.ResourceServerConfig) error {
 	// Register the handler
 	generated.HandlerWithOptions(serverStrictHandler, opt)
 
+	var handler http.Handler = router
+	if responseCache != nil {
+		handler = responseCache.Middleware()(handler)
+	}
+
+	// Route the requests for all the supported API versions to the handlers of the current version.  This is done
+	// ahead of the response cache so that the cached responses are shared by all versions.
+	versions := common.NewVersionNegotiator(utils2.InventoryURLPrefix,
+		common.APIVersion{Name: utils2.CurrentInventoryAPIVersion})
+	handler = versions.Middleware()(handler)
+
 	// Server config
 	srv := &http.Server{
-		Handler:      router,
+		Handler:      common.CORS(config.CORS)(common.ProblemDetails()(handler)),
 		Addr:         config.Listener.Address,
 		ReadTimeout:  readTimeout,
 		WriteTimeout: writeTimeout,
@@ -1,4 +1,15 @@
 package utils
 
-var BaseInventoryURL = "/o2ims-infrastructureInventory/v1"
+var InventoryURLPrefix = "/o2ims-infrastructureInventory"
+var CurrentInventoryAPIVersion = "v1"
+var BaseInventoryURL = InventoryURLPrefix + "/" + CurrentInventoryAPIVersion
 var CurrentInventoryVersion = "1.0.0"
+
+// DefaultImportBatchSize is the default number of resources persisted per transaction by the bulk import endpoint
+const DefaultImportBatchSize = 100
+
+// MaxSubscriptionBatchSize is the maximum number of operations accepted by the subscription batch endpoint
+const MaxSubscriptionBatchSize = 100
+
+// DefaultResponseCacheSize is the default maximum number of responses kept by the response cache
+const DefaultResponseCacheSize = 1000
@@ -0,0 +1,81 @@
+/*
+Copyright 2024 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in
+compliance with the License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is
+distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+implied. See the License for the specific language governing permissions and limitations under the
+License.
+*/
+
+package version
+
+import (
+	"runtime/debug"
+)
+
+// Names of build settings we are interested on:
+const (
+	vcsRevisionSettingKey = "vcs.revision"
+	vcsTimeSettingKey     = "vcs.time"
+)
+
+// Unknown is the fallback value for the build information that is not embedded in the binary.
+const Unknown = "unknown"
+
+// develVersion is the version reported by the Go toolchain for binaries built from a local checkout.
+const develVersion = "(devel)"
+
+// Info contains the build information embedded in the binary.
+type Info struct {
+	Version string
+	Commit  string
+	Time    string
+}
+
+// Get returns the build information embedded in the binary. Values that aren't available are set to Unknown.
+func Get() Info {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return Info{
+			Version: Unknown,
+			Commit:  Unknown,
+			Time:    Unknown,
+		}
+	}
+	return fromBuildInfo(info)
+}
+
+// fromBuildInfo extracts the values we are interested on from the build information.
+func fromBuildInfo(info *debug.BuildInfo) Info {
+	result := Info{
+		Version: info.Main.Version,
+		Commit:  getSetting(info, vcsRevisionSettingKey),
+		Time:    getSetting(info, vcsTimeSettingKey),
+	}
+	if result.Version == "" || result.Version == develVersion {
+		result.Version = Unknown
+	}
+	if result.Commit == "" {
+		result.Commit = Unknown
+	}
+	if result.Time == "" {
+		result.Time = Unknown
+	}
+	return result
+}
+
+// getSetting returns the value of the build setting with the given key. Returns an empty string
+// if no such setting exists.
+func getSetting(info *debug.BuildInfo, key string) string {
+	for _, s := range info.Settings {
+		if s.Key == key {
+			return s.Value
+		}
+	}
+	return ""
+}
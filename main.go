@@ -43,6 +43,7 @@ func main() {
 		SetErr(os.Stderr).
 		AddCommand(cmd.Start).
 		AddCommand(cmd.Version).
+		AddCommand(cmd.HwPlugin).
 		AddCommand(alarmscmd.GetAlarmRootCmd).              // TODO: all server should have same root to share init info
 		AddCommand(clustercmd.GetClusterRootCmd).           // TODO: all server should have same root to share init info
 		AddCommand(inventorycmd.GetResourcesRootCmd).       // TODO: all server should have same root to share init info